@@ -16,6 +16,7 @@ import (
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
@@ -55,7 +56,17 @@ var allCmd = &cobra.Command{
 			panic(err)
 		}
 
-		pubSub := p2p.NewBlossomSub(NodeConfig.P2P, logger)
+		peerstore, err := store.NewPeerstoreDatastore(db)
+		if err != nil {
+			panic(err)
+		}
+
+		pubSub := p2p.NewBlossomSub(
+			NodeConfig.P2P,
+			peerstore,
+			logger,
+			clock.NewRealClock(),
+		)
 		logger.Info("connecting to network")
 		time.Sleep(5 * time.Second)
 
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"encoding/hex"
 	"strings"
 
@@ -14,7 +13,9 @@ var mergeCmd = &cobra.Command{
 	Short: "Merges multiple coins",
 	Long: `Merges multiple coins:
 	
-	merge <Coin Addresses>...
+	merge <Coin Addresses>... [--simulate]
+	
+	--simulate – check the merge against the node's state without sending it
 	`,
 	Run: func(cmd *cobra.Command, args []string) {
 		conn, err := GetGRPCClient()
@@ -53,8 +54,8 @@ var mergeCmd = &cobra.Command{
 			panic(err)
 		}
 
-		_, err = client.SendMessage(
-			context.Background(),
+		sendTokenRequest(
+			client,
 			&protobufs.TokenRequest{
 				Request: &protobufs.TokenRequest_Merge{
 					Merge: &protobufs.MergeCoinRequest{
@@ -69,12 +70,10 @@ var mergeCmd = &cobra.Command{
 				},
 			},
 		)
-		if err != nil {
-			panic(err)
-		}
 	},
 }
 
 func init() {
+	addSimulateFlag(mergeCmd)
 	tokenCmd.AddCommand(mergeCmd)
 }
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var messageGroup bool
+var messageLimit uint32
+
+var messageCmd = &cobra.Command{
+	Use:   "message",
+	Short: "Sends and reads end-to-end encrypted messages between nodes",
+}
+
+// parseGroupId parses a hex encoded group id.
+func parseGroupId(arg string) []byte {
+	groupHex, _ := strings.CutPrefix(arg, "0x")
+	groupId, err := hex.DecodeString(groupHex)
+	if err != nil {
+		panic(err)
+	}
+
+	return groupId
+}
+
+func parsePeerId(arg string) []byte {
+	peerId, err := peer.Decode(arg)
+	if err != nil {
+		panic(err)
+	}
+
+	return []byte(peerId)
+}
+
+func printMessages(messages []*protobufs.StoredMessage) {
+	for _, message := range messages {
+		direction := "from"
+		if message.Outgoing {
+			direction = "to"
+		}
+
+		conversation := fmt.Sprintf(
+			"%s %s",
+			direction,
+			peer.ID(message.PeerId).String(),
+		)
+		if len(message.GroupId) != 0 {
+			conversation = fmt.Sprintf(
+				"%s in group 0x%x",
+				conversation,
+				message.GroupId,
+			)
+		}
+
+		status := ""
+		if message.Outgoing && len(message.GroupId) == 0 && !message.Delivered {
+			status = " (pending)"
+		}
+
+		fmt.Printf(
+			"[%s] %s%s: %s\n",
+			time.UnixMilli(message.Timestamp).Format(time.DateTime),
+			conversation,
+			status,
+			message.Text,
+		)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(messageCmd)
+}
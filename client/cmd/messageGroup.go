@@ -0,0 +1,12 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var messageGroupCmd = &cobra.Command{
+	Use:   "group",
+	Short: "Manages the messaging groups of the node",
+}
+
+func init() {
+	messageCmd.AddCommand(messageGroupCmd)
+}
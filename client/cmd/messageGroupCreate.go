@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var messageGroupCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Creates a messaging group and invites peers into it",
+	Long: `Creates an end-to-end encrypted messaging group:
+
+	create <Name> <Peer>...
+
+	Name – the name of the group, shown to the invited peers
+	Peer – the peer ids of the nodes to invite, their prekey bundles must be
+	known to the node
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 2 {
+			panic("invalid arguments")
+		}
+
+		req := &protobufs.CreateGroupRequest{Name: args[0]}
+		for _, arg := range args[1:] {
+			req.PeerIds = append(req.PeerIds, parsePeerId(arg))
+		}
+
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewMessagingServiceClient(conn)
+		resp, err := client.CreateGroup(context.Background(), req)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Group 0x%x created\n", resp.GroupId)
+	},
+}
+
+func init() {
+	messageGroupCmd.AddCommand(messageGroupCreateCmd)
+}
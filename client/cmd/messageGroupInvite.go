@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var messageGroupInviteCmd = &cobra.Command{
+	Use:   "invite",
+	Short: "Invites a peer into a messaging group",
+	Long: `Invites a peer into a messaging group the node is a member of:
+
+	invite <Group> <Peer>
+
+	Group – the hex encoded id of the group
+	Peer – the peer id of the node to invite, its prekey bundle must be known
+	to the node
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 2 {
+			panic("invalid arguments")
+		}
+
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewMessagingServiceClient(conn)
+		if _, err := client.InviteToGroup(
+			context.Background(),
+			&protobufs.InviteToGroupRequest{
+				GroupId: parseGroupId(args[0]),
+				PeerId:  parsePeerId(args[1]),
+			},
+		); err != nil {
+			panic(err)
+		}
+
+		fmt.Println("Invite queued")
+	},
+}
+
+func init() {
+	messageGroupCmd.AddCommand(messageGroupInviteCmd)
+}
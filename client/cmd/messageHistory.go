@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var messageHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Lists the latest messages of a conversation, oldest first",
+	Long: `Lists the latest messages exchanged with a peer or in a group:
+
+	history <Peer>
+	history --group <Group>
+
+	Peer – the peer id of the other node
+	Group – the hex encoded id of a group the node is a member of
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			panic("invalid arguments")
+		}
+
+		req := &protobufs.GetHistoryRequest{Limit: messageLimit}
+		if messageGroup {
+			req.Conversation = &protobufs.GetHistoryRequest_GroupId{
+				GroupId: parseGroupId(args[0]),
+			}
+		} else {
+			req.Conversation = &protobufs.GetHistoryRequest_PeerId{
+				PeerId: parsePeerId(args[0]),
+			}
+		}
+
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewMessagingServiceClient(conn)
+		resp, err := client.GetHistory(context.Background(), req)
+		if err != nil {
+			panic(err)
+		}
+
+		printMessages(resp.Messages)
+	},
+}
+
+func init() {
+	messageHistoryCmd.Flags().BoolVar(
+		&messageGroup,
+		"group",
+		false,
+		"list the history of a group instead of a peer",
+	)
+	messageHistoryCmd.Flags().Uint32Var(
+		&messageLimit,
+		"limit",
+		0,
+		"the most messages to list, zero lists the node's default",
+	)
+	messageCmd.AddCommand(messageHistoryCmd)
+}
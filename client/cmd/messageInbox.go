@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var messageInboxCmd = &cobra.Command{
+	Use:   "inbox",
+	Short: "Lists the latest messages received by the node, newest first",
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewMessagingServiceClient(conn)
+		resp, err := client.GetInbox(
+			context.Background(),
+			&protobufs.GetInboxRequest{Limit: messageLimit},
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		printMessages(resp.Messages)
+	},
+}
+
+func init() {
+	messageInboxCmd.Flags().Uint32Var(
+		&messageLimit,
+		"limit",
+		0,
+		"the most messages to list, zero lists the node's default",
+	)
+	messageCmd.AddCommand(messageInboxCmd)
+}
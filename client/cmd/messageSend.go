@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var messageSendCmd = &cobra.Command{
+	Use:   "send",
+	Short: "Sends an encrypted message to a peer or group",
+	Long: `Sends an end-to-end encrypted message to a peer or group:
+
+	send <Peer> <Text>
+	send --group <Group> <Text>
+
+	Peer – the peer id of the recipient node
+	Group – the hex encoded id of a group the node is a member of
+	Text – the message, the remaining arguments are joined by spaces
+
+	Messages to peers that are offline are queued by the node and delivered
+	once they are reachable.
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 2 {
+			panic("invalid arguments")
+		}
+
+		req := &protobufs.SendMessageRequest{
+			Text: strings.Join(args[1:], " "),
+		}
+		if messageGroup {
+			req.Recipient = &protobufs.SendMessageRequest_GroupId{
+				GroupId: parseGroupId(args[0]),
+			}
+		} else {
+			req.Recipient = &protobufs.SendMessageRequest_PeerId{
+				PeerId: parsePeerId(args[0]),
+			}
+		}
+
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewMessagingServiceClient(conn)
+		resp, err := client.Send(context.Background(), req)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Message 0x%x queued\n", resp.MessageId)
+	},
+}
+
+func init() {
+	messageSendCmd.Flags().BoolVar(
+		&messageGroup,
+		"group",
+		false,
+		"send to a group instead of a peer",
+	)
+	messageCmd.AddCommand(messageSendCmd)
+}
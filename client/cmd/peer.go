@@ -0,0 +1,12 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var peerCmd = &cobra.Command{
+	Use:   "peer",
+	Short: "Inspects the peers of the node",
+}
+
+func init() {
+	rootCmd.AddCommand(peerCmd)
+}
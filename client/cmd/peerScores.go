@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var peerScoresCmd = &cobra.Command{
+	Use:   "scores",
+	Short: "Lists the blossomsub score breakdown of each peer",
+	Long: `Lists the blossomsub score breakdown of each peer, as last inspected
+by the node. Bitmask counters are only tracked for bitmasks configured in the
+score section of the p2p config.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewNodeServiceClient(conn)
+		resp, err := client.GetPeerScores(
+			context.Background(),
+			&protobufs.GetPeerScoresRequest{},
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, score := range resp.PeerScores {
+			fmt.Printf(
+				"%s score: %.4f (app specific: %.4f, ip colocation: %.4f, "+
+					"behaviour penalty: %.4f)\n",
+				peer.ID(score.PeerId).String(),
+				score.Score,
+				score.AppSpecificScore,
+				score.IpColocationFactor,
+				score.BehaviourPenalty,
+			)
+			for _, bitmask := range score.BitmaskScores {
+				fmt.Printf(
+					"  bitmask %x: time in mesh %s, first deliveries %.4f, "+
+						"mesh deliveries %.4f, invalid deliveries %.4f\n",
+					bitmask.Bitmask,
+					time.Duration(bitmask.TimeInMeshMs)*time.Millisecond,
+					bitmask.FirstMessageDeliveries,
+					bitmask.MeshMessageDeliveries,
+					bitmask.InvalidMessageDeliveries,
+				)
+			}
+		}
+	},
+}
+
+func init() {
+	peerCmd.AddCommand(peerScoresCmd)
+}
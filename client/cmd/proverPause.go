@@ -12,9 +12,11 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
 )
 
 var proverPauseCmd = &cobra.Command{
@@ -32,7 +34,20 @@ var proverPauseCmd = &cobra.Command{
 		}
 
 		logger, err := zap.NewProduction()
-		pubsub := p2p.NewBlossomSub(NodeConfig.P2P, logger)
+
+		// the node may be running and holding its database, so the peerstore
+		// of this short lived host is kept in memory.
+		peerstore, err := store.NewPeerstoreDatastore(store.NewInMemKVDB())
+		if err != nil {
+			panic(err)
+		}
+
+		pubsub := p2p.NewBlossomSub(
+			NodeConfig.P2P,
+			peerstore,
+			logger,
+			clock.NewRealClock(),
+		)
 		intrinsicFilter := p2p.GetBloomFilter(application.TOKEN_ADDRESS, 256, 3)
 		pubsub.Subscribe(
 			intrinsicFilter,
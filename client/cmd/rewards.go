@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var rewardsVerbose bool
+
+var rewardsCmd = &cobra.Command{
+	Use:   "rewards",
+	Short: "Lists the reward proofs of the data workers awaiting or past minting",
+	Long: `Lists the reward proofs computed by the data workers of the node, per
+core, as unclaimed (not yet submitted), in flight (submitted, not yet minted)
+or confirmed (minted). Proofs are submitted in one batched mint request per
+frame, and resubmitted until minted or expired.
+
+	rewards [--verbose]
+
+--verbose also lists each proof, oldest first.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewNodeServiceClient(conn)
+		resp, err := client.GetRewardProofs(
+			context.Background(),
+			&protobufs.GetRewardProofsRequest{},
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, core := range resp.Cores {
+			fmt.Printf(
+				"core %d: %d unclaimed, %d in flight, %d confirmed\n",
+				core.Core,
+				core.Unclaimed,
+				core.InFlight,
+				core.Confirmed,
+			)
+		}
+
+		if !rewardsVerbose {
+			return
+		}
+
+		for _, proof := range resp.Proofs {
+			fmt.Printf(
+				"  core %d, frame %d: %s (status frame %d, %d attempts)\n",
+				proof.Core,
+				proof.FrameNumber,
+				proof.Status.String(),
+				proof.StatusFrameNumber,
+				proof.Attempts,
+			)
+		}
+	},
+}
+
+func init() {
+	rewardsCmd.Flags().BoolVar(
+		&rewardsVerbose,
+		"verbose",
+		false,
+		"list each proof",
+	)
+	mintCmd.AddCommand(rewardsCmd)
+}
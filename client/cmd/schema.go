@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/schema"
+)
+
+var schemaRegistryDirectory string
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Compiles and checks QCL schemas and manages the local schema registry",
+}
+
+// openSchemaRegistry opens the registry resolving references to the classes
+// of other schemas, kept in the config directory unless set otherwise.
+func openSchemaRegistry() *schema.SchemaRegistry {
+	dir := schemaRegistryDirectory
+	if dir == "" {
+		dir = filepath.Join(configDirectory, "schemas")
+	}
+
+	registry, err := schema.NewFileSchemaRegistry(dir)
+	if err != nil {
+		panic(err)
+	}
+
+	return registry
+}
+
+func readSchemaDocument(path string) string {
+	document, err := os.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(document)
+}
+
+func init() {
+	schemaCmd.PersistentFlags().StringVar(
+		&schemaRegistryDirectory,
+		"registry",
+		"",
+		"schema registry directory (default is the schemas directory of the config directory)",
+	)
+	rootCmd.AddCommand(schemaCmd)
+}
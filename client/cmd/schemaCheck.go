@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/schema"
+)
+
+var schemaClass string
+var schemaInstance string
+
+var schemaCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Checks a schema, and optionally an instance against it",
+	Long: `Checks a Turtle schema is valid and its references resolve:
+
+	check <File> [--class <Class> --instance <Instance>]
+
+	File – the Turtle document of the schema
+	Class – the name of the class the instance is of
+	Instance – a JSON file holding an object with a value for each field of
+	the class, byte arrays and references hex encoded
+
+	The instance is checked to have every field of the class, each of the
+	field's type and within its size.
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			panic("invalid arguments")
+		}
+
+		parser := schema.NewTurtleRDFParser(openSchemaRegistry())
+		s, err := parser.Parse(readSchemaDocument(args[0]))
+		if err != nil {
+			fmt.Println("Invalid schema:", err)
+			os.Exit(1)
+		}
+
+		fmt.Println("Schema", s.Address)
+		for _, class := range s.Classes {
+			fmt.Printf(
+				"  %s: %d fields, %d bytes\n",
+				class.Name,
+				len(class.Fields),
+				class.Size(),
+			)
+		}
+
+		if schemaInstance == "" {
+			return
+		}
+
+		class := s.GetClass(schemaClass)
+		if class == nil {
+			fmt.Println("Unknown class:", schemaClass)
+			os.Exit(1)
+		}
+
+		file, err := os.Open(schemaInstance)
+		if err != nil {
+			panic(err)
+		}
+		defer file.Close()
+
+		instance := map[string]any{}
+		decoder := json.NewDecoder(file)
+		decoder.UseNumber()
+		if err := decoder.Decode(&instance); err != nil {
+			panic(err)
+		}
+
+		payload, err := class.Encode(instance)
+		if err != nil {
+			fmt.Println("Invalid instance:", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Instance of %s valid, encoded as 0x%x\n", class.Name, payload)
+	},
+}
+
+func init() {
+	schemaCheckCmd.Flags().StringVar(
+		&schemaClass,
+		"class",
+		"",
+		"the class of the instance",
+	)
+	schemaCheckCmd.Flags().StringVar(
+		&schemaInstance,
+		"instance",
+		"",
+		"a JSON file holding an instance to check",
+	)
+	schemaCmd.AddCommand(schemaCheckCmd)
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/schema"
+)
+
+var schemaOutput string
+
+var schemaCompileCmd = &cobra.Command{
+	Use:   "compile",
+	Short: "Generates the marshaling code of a schema",
+	Long: `Generates a type for each class of a Turtle schema, with functions
+	marshaling it into and unmarshaling it from its encoding:
+
+	compile <File> [--out <Output>]
+
+	File – the Turtle document of the schema
+	Output – the file to write the code to, printed if unset
+
+	Struct fields referencing classes of other schemas are resolved from the
+	schema registry.
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			panic("invalid arguments")
+		}
+
+		parser := schema.NewTurtleRDFParser(openSchemaRegistry())
+		output, err := parser.GenerateQCL(readSchemaDocument(args[0]))
+		if err != nil {
+			panic(err)
+		}
+
+		if schemaOutput == "" {
+			fmt.Print(output)
+			return
+		}
+
+		if err := os.WriteFile(schemaOutput, []byte(output), 0644); err != nil {
+			panic(err)
+		}
+	},
+}
+
+func init() {
+	schemaCompileCmd.Flags().StringVar(
+		&schemaOutput,
+		"out",
+		"",
+		"the file to write the generated code to",
+	)
+	schemaCmd.AddCommand(schemaCompileCmd)
+}
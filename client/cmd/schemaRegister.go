@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var schemaRegisterCmd = &cobra.Command{
+	Use:   "register",
+	Short: "Adds a schema to the schema registry",
+	Long: `Checks a Turtle schema and adds it to the schema registry:
+
+	register <File>
+
+	File – the Turtle document of the schema
+
+	Schemas are addressed by the hash of their document. Other schemas
+	reference a class of the schema as its address followed by the class
+	name.
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			panic("invalid arguments")
+		}
+
+		address, err := openSchemaRegistry().Register(
+			readSchemaDocument(args[0]),
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println("Schema registered at", address)
+	},
+}
+
+func init() {
+	schemaCmd.AddCommand(schemaRegisterCmd)
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -18,10 +17,11 @@ var splitCmd = &cobra.Command{
 	Short: "Splits a coin into multiple coins",
 	Long: `Splits a coin into multiple coins:
 	
-	split <OfCoin> <Amounts>...
+	split <OfCoin> <Amounts>... [--simulate]
 	
 	OfCoin - the address of the coin to split
 	Amounts - the sets of amounts to split
+	--simulate - check the split against the node's state without sending it
 	`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) < 3 {
@@ -76,8 +76,8 @@ var splitCmd = &cobra.Command{
 			panic(err)
 		}
 
-		_, err = client.SendMessage(
-			context.Background(),
+		sendTokenRequest(
+			client,
 			&protobufs.TokenRequest{
 				Request: &protobufs.TokenRequest_Split{
 					Split: &protobufs.SplitCoinRequest{
@@ -93,12 +93,10 @@ var splitCmd = &cobra.Command{
 				},
 			},
 		)
-		if err != nil {
-			panic(err)
-		}
 	},
 }
 
 func init() {
+	addSimulateFlag(splitCmd)
 	tokenCmd.AddCommand(splitCmd)
 }
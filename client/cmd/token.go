@@ -1,14 +1,94 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"math/big"
+
 	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 )
 
+var tokenSimulate bool
+
 var tokenCmd = &cobra.Command{
 	Use:   "token",
 	Short: "Performs a token operation",
 }
 
+// sendTokenRequest publishes the request, or with --simulate checks it against
+// the node's current state and prints the outcome without publishing it.
+func sendTokenRequest(
+	client protobufs.NodeServiceClient,
+	request *protobufs.TokenRequest,
+) {
+	if !tokenSimulate {
+		_, err := client.SendMessage(context.Background(), request)
+		if err != nil {
+			panic(err)
+		}
+
+		return
+	}
+
+	resp, err := client.SimulateTokenRequests(
+		context.Background(),
+		&protobufs.TokenRequests{
+			Requests: []*protobufs.TokenRequest{request},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(resp.Outcomes) != 1 {
+		panic("invalid response from RPC")
+	}
+
+	outcome := resp.Outcomes[0]
+	if !outcome.Valid {
+		fmt.Printf(
+			"Request is invalid at frame %d: %s\n",
+			resp.FrameNumber,
+			outcome.Error,
+		)
+		return
+	}
+
+	fmt.Printf("Request is valid at frame %d\n", resp.FrameNumber)
+	conversionFactor, _ := new(big.Int).SetString("1DCD65000", 16)
+	for i, output := range outcome.Outputs {
+		switch o := output.Output.(type) {
+		case *protobufs.TokenOutput_Coin:
+			amount := new(big.Int).SetBytes(o.Coin.Amount)
+			r := new(big.Rat).SetFrac(amount, conversionFactor)
+			fmt.Printf(
+				"Creates %s QUIL (Coin 0x%x) owned by 0x%x\n",
+				r.FloatString(12),
+				outcome.Addresses[i],
+				o.Coin.Owner.GetImplicitAccount().GetAddress(),
+			)
+		case *protobufs.TokenOutput_DeletedCoin:
+			fmt.Printf("Spends Coin 0x%x\n", outcome.Addresses[i])
+		case *protobufs.TokenOutput_Proof:
+			fmt.Printf("Creates Proof 0x%x\n", outcome.Addresses[i])
+		case *protobufs.TokenOutput_DeletedProof:
+			fmt.Printf("Spends Proof 0x%x\n", outcome.Addresses[i])
+		}
+	}
+}
+
+// addSimulateFlag adds the --simulate flag to a command sending a token
+// request.
+func addSimulateFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(
+		&tokenSimulate,
+		"simulate",
+		false,
+		"check the request against the node's current state without sending it",
+	)
+}
+
 func init() {
 	rootCmd.AddCommand(tokenCmd)
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"encoding/hex"
 	"strings"
 
@@ -14,10 +13,11 @@ var transferCmd = &cobra.Command{
 	Short: "Creates a pending transfer of coin",
 	Long: `Creates a pending transfer of coin:
 	
-	transfer <ToAccount> <OfCoin>
+	transfer <ToAccount> <OfCoin> [--simulate]
 	
 	ToAccount – account address, must be specified
 	OfCoin – the address of the coin to send in whole
+	--simulate – check the transfer against the node's state without sending it
 	`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) != 2 {
@@ -67,8 +67,8 @@ var transferCmd = &cobra.Command{
 			panic(err)
 		}
 
-		_, err = client.SendMessage(
-			context.Background(),
+		sendTokenRequest(
+			client,
 			&protobufs.TokenRequest{
 				Request: &protobufs.TokenRequest_Transfer{
 					Transfer: &protobufs.TransferCoinRequest{
@@ -90,12 +90,10 @@ var transferCmd = &cobra.Command{
 				},
 			},
 		)
-		if err != nil {
-			panic(err)
-		}
 	},
 }
 
 func init() {
+	addSimulateFlag(transferCmd)
 	tokenCmd.AddCommand(transferCmd)
 }
@@ -3,6 +3,8 @@ package blossomsub
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
@@ -26,6 +28,9 @@ import (
 const (
 	// BlossomSubID_v2 is the protocol ID for version 2.0.0 of the BlossomSub protocol.
 	BlossomSubID_v2 = protocol.ID("/blossomsub/2.0.0")
+	// BlossomSubID_v21 is the protocol ID for version 2.1.0 of the BlossomSub protocol.
+	// It adds the IDONTWANT control message.
+	BlossomSubID_v21 = protocol.ID("/blossomsub/2.1.0")
 )
 
 // Defines the default BlossomSub parameters.
@@ -57,6 +62,10 @@ var (
 	BlossomSubMaxIHaveLength                   = 5000
 	BlossomSubMaxIHaveMessages                 = 10
 	BlossomSubIWantFollowupTime                = 3 * time.Second
+	BlossomSubIDontWantMessageThreshold        = 1024
+	BlossomSubIDontWantMessageTTL              = 3
+	BlossomSubMaxIDontWantMessages             = 1000
+	BlossomSubMaxIDontWantLength               = 10
 )
 
 // BlossomSubParams defines all the BlossomSub specific parameters.
@@ -199,6 +208,21 @@ type BlossomSubParams struct {
 	// If the message is not received within this window, a broken promise is declared and
 	// the router may apply bahavioural penalties.
 	IWantFollowupTime time.Duration
+
+	// IDontWantMessageThreshold is the size of a message in bytes above which IDONTWANT is sent to
+	// mesh peers on receipt, so they do not forward a copy we already have.
+	IDontWantMessageThreshold int
+
+	// IDontWantMessageTTL is the number of heartbeats an IDONTWANT received from a peer is honored for.
+	IDontWantMessageTTL int
+
+	// MaxIDontWantMessages is the maximum number of IDONTWANT messages to accept from a peer within a
+	// heartbeat.
+	MaxIDontWantMessages int
+
+	// MaxIDontWantLength is the maximum number of message IDs to accept from a peer in a single
+	// IDONTWANT message.
+	MaxIDontWantLength int
 }
 
 // NewBlossomSub returns a new PubSub object using the default BlossomSubRouter as the router.
@@ -216,23 +240,25 @@ func NewBlossomSubWithRouter(ctx context.Context, h host.Host, rt PubSubRouter,
 // NewBlossomSubRouter returns a new BlossomSubRouter with custom parameters.
 func NewBlossomSubRouter(h host.Host, params BlossomSubParams) *BlossomSubRouter {
 	return &BlossomSubRouter{
-		peers:     make(map[peer.ID]protocol.ID),
-		mesh:      make(map[string]map[peer.ID]struct{}),
-		fanout:    make(map[string]map[peer.ID]struct{}),
-		lastpub:   make(map[string]int64),
-		gossip:    make(map[peer.ID][]*pb.ControlIHave),
-		control:   make(map[peer.ID]*pb.ControlMessage),
-		cab:       pstoremem.NewAddrBook(),
-		backoff:   make(map[string]map[peer.ID]time.Time),
-		peerhave:  make(map[peer.ID]int),
-		iasked:    make(map[peer.ID]int),
-		outbound:  make(map[peer.ID]bool),
-		connect:   make(chan connectInfo, params.MaxPendingConnections),
-		mcache:    NewMessageCache(params.HistoryGossip, params.HistoryLength),
-		protos:    BlossomSubDefaultProtocols,
-		feature:   BlossomSubDefaultFeatures,
-		tagTracer: newTagTracer(h.ConnManager()),
-		params:    params,
+		peers:        make(map[peer.ID]protocol.ID),
+		mesh:         make(map[string]map[peer.ID]struct{}),
+		fanout:       make(map[string]map[peer.ID]struct{}),
+		lastpub:      make(map[string]int64),
+		gossip:       make(map[peer.ID][]*pb.ControlIHave),
+		control:      make(map[peer.ID]*pb.ControlMessage),
+		cab:          pstoremem.NewAddrBook(),
+		backoff:      make(map[string]map[peer.ID]time.Time),
+		peerhave:     make(map[peer.ID]int),
+		peerdontwant: make(map[peer.ID]int),
+		unwanted:     make(map[peer.ID]map[checksum]int),
+		iasked:       make(map[peer.ID]int),
+		outbound:     make(map[peer.ID]bool),
+		connect:      make(chan connectInfo, params.MaxPendingConnections),
+		mcache:       NewMessageCache(params.HistoryGossip, params.HistoryLength),
+		protos:       BlossomSubDefaultProtocols,
+		feature:      BlossomSubDefaultFeatures,
+		tagTracer:    newTagTracer(h.ConnManager()),
+		params:       params,
 	}
 }
 
@@ -240,23 +266,25 @@ func NewBlossomSubRouter(h host.Host, params BlossomSubParams) *BlossomSubRouter
 func DefaultBlossomSubRouter(h host.Host) *BlossomSubRouter {
 	params := DefaultBlossomSubParams()
 	return &BlossomSubRouter{
-		peers:     make(map[peer.ID]protocol.ID),
-		mesh:      make(map[string]map[peer.ID]struct{}),
-		fanout:    make(map[string]map[peer.ID]struct{}),
-		lastpub:   make(map[string]int64),
-		gossip:    make(map[peer.ID][]*pb.ControlIHave),
-		control:   make(map[peer.ID]*pb.ControlMessage),
-		backoff:   make(map[string]map[peer.ID]time.Time),
-		peerhave:  make(map[peer.ID]int),
-		iasked:    make(map[peer.ID]int),
-		outbound:  make(map[peer.ID]bool),
-		connect:   make(chan connectInfo, params.MaxPendingConnections),
-		cab:       pstoremem.NewAddrBook(),
-		mcache:    NewMessageCache(params.HistoryGossip, params.HistoryLength),
-		protos:    BlossomSubDefaultProtocols,
-		feature:   BlossomSubDefaultFeatures,
-		tagTracer: newTagTracer(h.ConnManager()),
-		params:    params,
+		peers:        make(map[peer.ID]protocol.ID),
+		mesh:         make(map[string]map[peer.ID]struct{}),
+		fanout:       make(map[string]map[peer.ID]struct{}),
+		lastpub:      make(map[string]int64),
+		gossip:       make(map[peer.ID][]*pb.ControlIHave),
+		control:      make(map[peer.ID]*pb.ControlMessage),
+		backoff:      make(map[string]map[peer.ID]time.Time),
+		peerhave:     make(map[peer.ID]int),
+		peerdontwant: make(map[peer.ID]int),
+		unwanted:     make(map[peer.ID]map[checksum]int),
+		iasked:       make(map[peer.ID]int),
+		outbound:     make(map[peer.ID]bool),
+		connect:      make(chan connectInfo, params.MaxPendingConnections),
+		cab:          pstoremem.NewAddrBook(),
+		mcache:       NewMessageCache(params.HistoryGossip, params.HistoryLength),
+		protos:       BlossomSubDefaultProtocols,
+		feature:      BlossomSubDefaultFeatures,
+		tagTracer:    newTagTracer(h.ConnManager()),
+		params:       params,
 	}
 }
 
@@ -290,6 +318,10 @@ func DefaultBlossomSubParams() BlossomSubParams {
 		MaxIHaveLength:            BlossomSubMaxIHaveLength,
 		MaxIHaveMessages:          BlossomSubMaxIHaveMessages,
 		IWantFollowupTime:         BlossomSubIWantFollowupTime,
+		IDontWantMessageThreshold: BlossomSubIDontWantMessageThreshold,
+		IDontWantMessageTTL:       BlossomSubIDontWantMessageTTL,
+		MaxIDontWantMessages:      BlossomSubMaxIDontWantMessages,
+		MaxIDontWantLength:        BlossomSubMaxIDontWantLength,
 		SlowHeartbeatWarning:      0.1,
 	}
 }
@@ -438,21 +470,23 @@ func WithBlossomSubParams(cfg BlossomSubParams) Option {
 // is the fanout map. Fanout peer lists are expired if we don't publish any
 // messages to their bitmask for BlossomSubFanoutTTL.
 type BlossomSubRouter struct {
-	p        *PubSub
-	peers    map[peer.ID]protocol.ID          // peer protocols
-	direct   map[peer.ID]struct{}             // direct peers
-	mesh     map[string]map[peer.ID]struct{}  // bitmask meshes
-	fanout   map[string]map[peer.ID]struct{}  // bitmask fanout
-	lastpub  map[string]int64                 // last publish time for fanout bitmasks
-	gossip   map[peer.ID][]*pb.ControlIHave   // pending gossip
-	control  map[peer.ID]*pb.ControlMessage   // pending control messages
-	peerhave map[peer.ID]int                  // number of IHAVEs received from peer in the last heartbeat
-	iasked   map[peer.ID]int                  // number of messages we have asked from peer in the last heartbeat
-	outbound map[peer.ID]bool                 // connection direction cache, marks peers with outbound connections
-	backoff  map[string]map[peer.ID]time.Time // prune backoff
-	connect  chan connectInfo                 // px connection requests
-	cab      peerstore.AddrBook
-	meshMx   sync.RWMutex
+	p            *PubSub
+	peers        map[peer.ID]protocol.ID          // peer protocols
+	direct       map[peer.ID]struct{}             // direct peers
+	mesh         map[string]map[peer.ID]struct{}  // bitmask meshes
+	fanout       map[string]map[peer.ID]struct{}  // bitmask fanout
+	lastpub      map[string]int64                 // last publish time for fanout bitmasks
+	gossip       map[peer.ID][]*pb.ControlIHave   // pending gossip
+	control      map[peer.ID]*pb.ControlMessage   // pending control messages
+	peerhave     map[peer.ID]int                  // number of IHAVEs received from peer in the last heartbeat
+	peerdontwant map[peer.ID]int                  // number of IDONTWANTs received from peer in the last heartbeat
+	unwanted     map[peer.ID]map[checksum]int     // TTL of the message ids peers don't want
+	iasked       map[peer.ID]int                  // number of messages we have asked from peer in the last heartbeat
+	outbound     map[peer.ID]bool                 // connection direction cache, marks peers with outbound connections
+	backoff      map[string]map[peer.ID]time.Time // prune backoff
+	connect      chan connectInfo                 // px connection requests
+	cab          peerstore.AddrBook
+	meshMx       sync.RWMutex
 
 	protos  []protocol.ID
 	feature BlossomSubFeatureTest
@@ -638,6 +672,8 @@ func (bs *BlossomSubRouter) RemovePeer(p peer.ID) {
 	delete(bs.gossip, p)
 	delete(bs.control, p)
 	delete(bs.outbound, p)
+	delete(bs.peerdontwant, p)
+	delete(bs.unwanted, p)
 }
 
 func (bs *BlossomSubRouter) EnoughPeers(bitmask []byte, suggested int) bool {
@@ -698,6 +734,7 @@ func (bs *BlossomSubRouter) HandleRPC(rpc *RPC) {
 	ihave := bs.handleIWant(rpc.from, ctl)
 	prune := bs.handleGraft(rpc.from, ctl)
 	bs.handlePrune(rpc.from, ctl)
+	bs.handleIDontWant(rpc.from, ctl)
 
 	if len(iwant) == 0 && len(ihave) == 0 && len(prune) == 0 {
 		return
@@ -707,6 +744,64 @@ func (bs *BlossomSubRouter) HandleRPC(rpc *RPC) {
 	bs.sendRPC(rpc.from, out)
 }
 
+// PreValidation sends IDONTWANT for large messages to the peers we would
+// otherwise forward them to, before the messages are validated, so they do
+// not send us copies we already have.
+func (bs *BlossomSubRouter) PreValidation(msgs []*Message) {
+	bitmaskMids := make(map[string][][]byte)
+	froms := make(map[string]map[peer.ID]struct{})
+	for _, msg := range msgs {
+		if len(msg.GetData()) < bs.params.IDontWantMessageThreshold {
+			continue
+		}
+
+		bitmask := string(msg.GetBitmask())
+		bitmaskMids[bitmask] = append(bitmaskMids[bitmask], bs.p.idGen.ID(msg))
+		if froms[bitmask] == nil {
+			froms[bitmask] = make(map[peer.ID]struct{})
+		}
+		froms[bitmask][msg.ReceivedFrom] = struct{}{}
+	}
+
+	for bitmask, mids := range bitmaskMids {
+		if len(mids) == 0 {
+			continue
+		}
+
+		// shuffle the messages got from the RPC envelope, so that a peer truncating
+		// IDONTWANT at MaxIDontWantLength does not always drop the same ones
+		shuffleBytes(mids)
+
+		var peers []peer.ID
+		if len(SliceBitmask([]byte(bitmask))) != 1 {
+			peers = bs.p.getPeersInBitmask([]byte(bitmask))
+		} else {
+			bs.meshMx.RLock()
+			for p := range bs.mesh[bitmask] {
+				peers = append(peers, p)
+			}
+			bs.meshMx.RUnlock()
+		}
+
+		for _, p := range peers {
+			// don't send IDONTWANT to the peers that sent us the messages
+			if _, ok := froms[bitmask][p]; ok {
+				continue
+			}
+
+			// send to only peers that support IDONTWANT
+			if !bs.feature(BlossomSubFeatureIDontWant, bs.peers[p]) {
+				continue
+			}
+
+			idontwant := []*pb.ControlIDontWant{{MessageIDs: mids}}
+			out := rpcWithControl(nil, nil, nil, nil, nil)
+			out.Control.Idontwant = idontwant
+			bs.sendRPC(p, out)
+		}
+	}
+}
+
 func (bs *BlossomSubRouter) handleIHave(p peer.ID, ctl *pb.ControlMessage) []*pb.ControlIWant {
 	// we ignore IHAVE gossip from any peer whose score is below the gossip threshold
 	score := bs.score.Score(p)
@@ -960,6 +1055,38 @@ func (bs *BlossomSubRouter) handlePrune(p peer.ID, ctl *pb.ControlMessage) {
 	}
 }
 
+func (bs *BlossomSubRouter) handleIDontWant(p peer.ID, ctl *pb.ControlMessage) {
+	if len(ctl.GetIdontwant()) == 0 {
+		return
+	}
+
+	// IDONTWANT flood protection
+	if bs.peerdontwant[p] >= bs.params.MaxIDontWantMessages {
+		log.Debugf("IDONTWANT: peer %s has advertised too many times (%d) within this heartbeat interval; ignoring", p, bs.peerdontwant[p])
+		return
+	}
+	bs.peerdontwant[p]++
+
+	if bs.unwanted[p] == nil {
+		bs.unwanted[p] = make(map[checksum]int)
+	}
+
+	// remember all the unwanted message ids, up to the per message limit
+	total := 0
+loop:
+	for _, idontwant := range ctl.GetIdontwant() {
+		for _, mid := range idontwant.GetMessageIDs() {
+			if total >= bs.params.MaxIDontWantLength {
+				log.Debugf("IDONTWANT: peer %s has advertised too many ids (%d) in a single message; ignoring the rest", p, total)
+				break loop
+			}
+			total++
+
+			bs.unwanted[p][computeChecksum(mid)] = bs.params.IDontWantMessageTTL
+		}
+	}
+}
+
 func (bs *BlossomSubRouter) addBackoff(p peer.ID, bitmask []byte, isUnsubscribe bool) {
 	backoff := bs.params.PruneBackoff
 	if isUnsubscribe {
@@ -1140,12 +1267,18 @@ func (bs *BlossomSubRouter) Publish(msg *Message) {
 		}
 	}
 
+	mid := computeChecksum(bs.p.idGen.ID(msg))
 	out := rpcWithMessages(msg.Message)
 	for pid := range tosend {
 		if pid == from || pid == peer.ID(msg.GetFrom()) {
 			continue
 		}
 
+		// skip peers that told us they already have the message
+		if _, ok := bs.unwanted[pid][mid]; ok {
+			continue
+		}
+
 		bs.sendRPC(pid, out)
 	}
 }
@@ -1277,7 +1410,7 @@ func (bs *BlossomSubRouter) sendRPC(p peer.ID, out *RPC) {
 	}
 
 	bs.p.peersMx.RLock()
-	mch, ok := bs.p.peers[p]
+	q, ok := bs.p.peers[p]
 	bs.p.peersMx.RUnlock()
 	if !ok {
 		return
@@ -1285,7 +1418,7 @@ func (bs *BlossomSubRouter) sendRPC(p peer.ID, out *RPC) {
 
 	// If we're below the max message size, go ahead and send
 	if out.Size() < bs.p.maxMessageSize {
-		bs.doSendRPC(out, p, mch)
+		bs.doSendRPC(out, p, q)
 		return
 	}
 
@@ -1298,7 +1431,7 @@ func (bs *BlossomSubRouter) sendRPC(p peer.ID, out *RPC) {
 			bs.doDropRPC(out, p, fmt.Sprintf("Dropping oversized RPC. Size: %d, limit: %d. (Over by %d bytes)", rpc.Size(), bs.p.maxMessageSize, rpc.Size()-bs.p.maxMessageSize))
 			continue
 		}
-		bs.doSendRPC(rpc, p, mch)
+		bs.doSendRPC(rpc, p, q)
 	}
 }
 
@@ -1312,12 +1445,18 @@ func (bs *BlossomSubRouter) doDropRPC(rpc *RPC, p peer.ID, reason string) {
 	}
 }
 
-func (bs *BlossomSubRouter) doSendRPC(rpc *RPC, p peer.ID, mch chan *RPC) {
-	select {
-	case mch <- rpc:
+func (bs *BlossomSubRouter) doSendRPC(rpc *RPC, p peer.ID, q *rpcQueue) {
+	priority := bs.p.rpcPriority(rpc)
+	depth, err := q.Push(rpc, priority)
+	switch {
+	case err == nil:
+		bs.tracer.QueueRPC(rpc, p, priority, depth)
 		bs.tracer.SendRPC(rpc, p)
+	case errors.Is(err, ErrQueueFull):
+		bs.tracer.OverflowRPC(rpc, p, priority)
+		bs.doDropRPC(rpc, p, fmt.Sprintf("%s queue full", priority))
 	default:
-		bs.doDropRPC(rpc, p, "queue full")
+		bs.doDropRPC(rpc, p, err.Error())
 	}
 }
 
@@ -1422,6 +1561,30 @@ func appendOrMergeRPC(slice []*RPC, limit int, elems ...*RPC) []*RPC {
 				}
 			}
 
+			for _, idontwant := range ctl.GetIdontwant() {
+				if len(lastRPC.Control.Idontwant) == 0 {
+					// Initialize with a single IDONTWANT, as with IWANT there are no
+					// bitmask IDs here.
+					newIDontWant := &pb.ControlIDontWant{}
+					if lastRPC.Control.Idontwant = append(lastRPC.Control.Idontwant, newIDontWant); lastRPC.Size() > limit {
+						lastRPC.Control.Idontwant = lastRPC.Control.Idontwant[:len(lastRPC.Control.Idontwant)-1]
+						lastRPC = &RPC{RPC: &pb.RPC{Control: &pb.ControlMessage{
+							Idontwant: []*pb.ControlIDontWant{newIDontWant},
+						}}, from: elem.from}
+						out = append(out, lastRPC)
+					}
+				}
+				for _, msgID := range idontwant.GetMessageIDs() {
+					if lastRPC.Control.Idontwant[0].MessageIDs = append(lastRPC.Control.Idontwant[0].MessageIDs, msgID); lastRPC.Size() > limit {
+						lastRPC.Control.Idontwant[0].MessageIDs = lastRPC.Control.Idontwant[0].MessageIDs[:len(lastRPC.Control.Idontwant[0].MessageIDs)-1]
+						lastRPC = &RPC{RPC: &pb.RPC{Control: &pb.ControlMessage{
+							Idontwant: []*pb.ControlIDontWant{{MessageIDs: [][]byte{msgID}}},
+						}}, from: elem.from}
+						out = append(out, lastRPC)
+					}
+				}
+			}
+
 			for _, ihave := range ctl.GetIhave() {
 				if len(lastRPC.Control.Ihave) == 0 ||
 					!bytes.Equal(lastRPC.Control.Ihave[len(lastRPC.Control.Ihave)-1].Bitmask, ihave.Bitmask) {
@@ -1493,6 +1656,9 @@ func (bs *BlossomSubRouter) heartbeat() {
 	// clean up iasked counters
 	bs.clearIHaveCounters()
 
+	// clean up IDONTWANT counters and expire unwanted message ids
+	bs.clearIDontWantCounters()
+
 	// apply IWANT request penalties
 	bs.applyIwantPenalties()
 
@@ -1758,6 +1924,26 @@ func (bs *BlossomSubRouter) clearIHaveCounters() {
 	}
 }
 
+func (bs *BlossomSubRouter) clearIDontWantCounters() {
+	if len(bs.peerdontwant) > 0 {
+		// throw away the old map and make a new one
+		bs.peerdontwant = make(map[peer.ID]int)
+	}
+
+	for p, mids := range bs.unwanted {
+		for mid := range mids {
+			mids[mid]--
+			if mids[mid] <= 0 {
+				delete(mids, mid)
+			}
+		}
+
+		if len(mids) == 0 {
+			delete(bs.unwanted, p)
+		}
+	}
+}
+
 func (bs *BlossomSubRouter) applyIwantPenalties() {
 	for p, count := range bs.gossipTracer.GetBrokenPromises() {
 		log.Infof("peer %s didn't follow up in %d IWANT requests; adding penalty", p, count)
@@ -2088,6 +2274,14 @@ func (bs *BlossomSubRouter) WithDefaultTagTracer() Option {
 	return WithRawTracer(bs.tagTracer)
 }
 
+// checksum bounds the memory used to remember message ids peers don't want,
+// as ids are chosen by the remote peer.
+type checksum [sha256.Size]byte
+
+func computeChecksum(mid []byte) checksum {
+	return sha256.Sum256(mid)
+}
+
 func peerListToMap(peers []peer.ID) map[peer.ID]struct{} {
 	pmap := make(map[peer.ID]struct{})
 	for _, p := range peers {
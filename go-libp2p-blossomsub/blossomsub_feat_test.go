@@ -19,6 +19,22 @@ func TestDefaultBlossomSubFeatures(t *testing.T) {
 	if !BlossomSubDefaultFeatures(BlossomSubFeaturePX, BlossomSubID_v2) {
 		t.Fatal("BlossomSub-v2.0 should support PX")
 	}
+
+	if BlossomSubDefaultFeatures(BlossomSubFeatureIDontWant, BlossomSubID_v2) {
+		t.Fatal("BlossomSub-v2.0 should not support IDONTWANT")
+	}
+
+	if !BlossomSubDefaultFeatures(BlossomSubFeatureMesh, BlossomSubID_v21) {
+		t.Fatal("BlossomSub-v2.1 should support Mesh")
+	}
+
+	if !BlossomSubDefaultFeatures(BlossomSubFeaturePX, BlossomSubID_v21) {
+		t.Fatal("BlossomSub-v2.1 should support PX")
+	}
+
+	if !BlossomSubDefaultFeatures(BlossomSubFeatureIDontWant, BlossomSubID_v21) {
+		t.Fatal("BlossomSub-v2.1 should support IDONTWANT")
+	}
 }
 
 func TestBlossomSubCustomProtocols(t *testing.T) {
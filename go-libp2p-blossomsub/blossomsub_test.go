@@ -3185,3 +3185,91 @@ func assertReceivedBitmaskSubgroup(t *testing.T, ctx context.Context, subs [][]*
 		}
 	}
 }
+
+func TestBlossomSubHandleIDontWant(t *testing.T) {
+	// this is a direct test of the IDONTWANT bookkeeping, as the timing of
+	// duplicate deliveries can't be reliably triggered between live hosts
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h := getDefaultHosts(t, 1)[0]
+	ps := getBlossomSub(ctx, h)
+
+	blah := peer.ID("bogotr0n")
+	mid := []byte("foo")
+
+	res := make(chan error, 1)
+	ps.eval <- func() {
+		gs := ps.rt.(*BlossomSubRouter)
+
+		gs.handleIDontWant(blah, &pb.ControlMessage{
+			Idontwant: []*pb.ControlIDontWant{{MessageIDs: [][]byte{mid}}},
+		})
+		if _, ok := gs.unwanted[blah][computeChecksum(mid)]; !ok {
+			res <- fmt.Errorf("expected message id to be unwanted")
+			return
+		}
+
+		// ids beyond MaxIDontWantLength are ignored
+		ids := make([][]byte, gs.params.MaxIDontWantLength+1)
+		for i := range ids {
+			ids[i] = []byte(fmt.Sprintf("bar%d", i))
+		}
+		gs.handleIDontWant(blah, &pb.ControlMessage{
+			Idontwant: []*pb.ControlIDontWant{{MessageIDs: ids}},
+		})
+		if _, ok := gs.unwanted[blah][computeChecksum(ids[len(ids)-1])]; ok {
+			res <- fmt.Errorf("expected message id past the limit to be ignored")
+			return
+		}
+
+		// unwanted ids expire after IDontWantMessageTTL heartbeats
+		for i := 0; i < gs.params.IDontWantMessageTTL; i++ {
+			if _, ok := gs.unwanted[blah][computeChecksum(mid)]; !ok {
+				res <- fmt.Errorf("expected message id to be unwanted after %d heartbeats", i)
+				return
+			}
+			gs.clearIDontWantCounters()
+		}
+		if _, ok := gs.unwanted[blah]; ok {
+			res <- fmt.Errorf("expected unwanted message ids to expire")
+			return
+		}
+
+		res <- nil
+	}
+
+	if err := <-res; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBlossomSubIDontWantFragment(t *testing.T) {
+	limit := 1024
+	ids := make([][]byte, 100)
+	for i := range ids {
+		ids[i] = make([]byte, 32)
+		rand.Read(ids[i])
+	}
+
+	rpc := &RPC{RPC: &pb.RPC{Control: &pb.ControlMessage{
+		Idontwant: []*pb.ControlIDontWant{{MessageIDs: ids}},
+	}}}
+	results := appendOrMergeRPC([]*RPC{}, limit, rpc)
+	if len(results) < 2 {
+		t.Fatalf("expected IDONTWANT to be fragmented, got %d RPCs", len(results))
+	}
+
+	var n int
+	for _, r := range results {
+		if r.Size() > limit {
+			t.Fatalf("expected fragmented RPC to be below %d bytes, was %d", limit, r.Size())
+		}
+		for _, idontwant := range r.Control.GetIdontwant() {
+			n += len(idontwant.GetMessageIDs())
+		}
+	}
+	if n != len(ids) {
+		t.Fatalf("expected %d message ids in fragmented RPCs, got %d", len(ids), n)
+	}
+}
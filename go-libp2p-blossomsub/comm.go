@@ -125,7 +125,7 @@ func (p *PubSub) notifyPeerDead(pid peer.ID) {
 	}
 }
 
-func (p *PubSub) handleNewPeer(ctx context.Context, pid peer.ID, outgoing <-chan *RPC) {
+func (p *PubSub) handleNewPeer(ctx context.Context, pid peer.ID, outgoing *rpcQueue) {
 	s, err := p.host.NewStream(p.ctx, pid, p.rt.Protocols()...)
 	if err != nil {
 		log.Debug("opening new stream to peer: ", err, pid)
@@ -146,7 +146,7 @@ func (p *PubSub) handleNewPeer(ctx context.Context, pid peer.ID, outgoing <-chan
 	}
 }
 
-func (p *PubSub) handleNewPeerWithBackoff(ctx context.Context, pid peer.ID, backoff time.Duration, outgoing <-chan *RPC) {
+func (p *PubSub) handleNewPeerWithBackoff(ctx context.Context, pid peer.ID, backoff time.Duration, outgoing *rpcQueue) {
 	select {
 	case <-time.After(backoff):
 		p.handleNewPeer(ctx, pid, outgoing)
@@ -167,41 +167,36 @@ func (p *PubSub) handlePeerDead(s network.Stream) {
 	p.notifyPeerDead(pid)
 }
 
-func (p *PubSub) handleSendingMessages(ctx context.Context, s network.Stream, outgoing <-chan *RPC) {
+func (p *PubSub) handleSendingMessages(ctx context.Context, s network.Stream, outgoing *rpcQueue) {
 	for {
-		select {
-		case rpc, ok := <-outgoing:
-			if !ok {
-				s.Close()
-				return
-			}
-
-			size := uint64(rpc.Size())
+		rpc, err := outgoing.Pop(ctx)
+		if err != nil {
+			s.Close()
+			return
+		}
 
-			buf := pool.Get(varint.UvarintSize(size) + int(size))
+		size := uint64(rpc.Size())
 
-			n := binary.PutUvarint(buf, size)
-			_, err := rpc.MarshalTo(buf[n:])
-			if err != nil {
-				s.Reset()
-				log.Debugf("writing message to %s: %s", s.Conn().RemotePeer(), err)
-				s.Close()
-				return
-			}
+		buf := pool.Get(varint.UvarintSize(size) + int(size))
 
-			_, err = s.Write(buf)
-			if err != nil {
-				s.Reset()
-				log.Debugf("writing message to %s: %s", s.Conn().RemotePeer(), err)
-				s.Close()
-				return
-			}
+		n := binary.PutUvarint(buf, size)
+		_, err = rpc.MarshalTo(buf[n:])
+		if err != nil {
+			s.Reset()
+			log.Debugf("writing message to %s: %s", s.Conn().RemotePeer(), err)
+			s.Close()
+			return
+		}
 
-			pool.Put(buf)
-		case <-ctx.Done():
+		_, err = s.Write(buf)
+		if err != nil {
+			s.Reset()
+			log.Debugf("writing message to %s: %s", s.Conn().RemotePeer(), err)
 			s.Close()
 			return
 		}
+
+		pool.Put(buf)
 	}
 }
 
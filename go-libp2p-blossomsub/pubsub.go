@@ -72,9 +72,16 @@ type PubSub struct {
 	// bitmasks.
 	maxMessageSize int
 
-	// size of the outbound message channel that we maintain for each peer
+	// size of each lane of the outbound queue that we maintain for each peer
 	peerOutboundQueueSize int
 
+	// priority of the lane messages on a bitmask are sent on, bitmasks not
+	// listed are sent on the normal lane
+	bitmaskPriorities map[string]Priority
+
+	// optional classifier raising the priority of individual messages
+	messagePriority func(*pb.Message) Priority
+
 	// incoming messages from other peers
 	incoming chan *RPC
 
@@ -150,7 +157,7 @@ type PubSub struct {
 	blacklist     Blacklist
 	blacklistPeer chan peer.ID
 
-	peers   map[peer.ID]chan *RPC
+	peers   map[peer.ID]*rpcQueue
 	peersMx sync.RWMutex
 
 	inboundStreamsMx sync.Mutex
@@ -208,6 +215,9 @@ type PubSubRouter interface {
 	// Allows routers with internal scoring to vet peers before committing any processing resources
 	// to the message and implement an effective graylist and react to validation queue overload.
 	AcceptFrom(peer.ID) AcceptStatus
+	// PreValidation is invoked on messages in the RPC envelope right before pushing them to
+	// the validation pipeline
+	PreValidation([]*Message)
 	// HandleRPC is invoked to process control messages in the RPC envelope.
 	// It is invoked after subscriptions and payload messages have been processed.
 	HandleRPC(*RPC)
@@ -292,7 +302,8 @@ func NewPubSub(ctx context.Context, h host.Host, rt PubSubRouter, opts ...Option
 		mySubs:                make(map[string]map[*Subscription]struct{}),
 		myRelays:              make(map[string]int),
 		bitmasks:              make(map[string]map[peer.ID]struct{}),
-		peers:                 make(map[peer.ID]chan *RPC),
+		peers:                 make(map[peer.ID]*rpcQueue),
+		bitmaskPriorities:     make(map[string]Priority),
 		inboundStreams:        make(map[peer.ID]network.Stream),
 		blacklist:             NewMapBlacklist(),
 		blacklistPeer:         make(chan peer.ID),
@@ -373,8 +384,8 @@ func WithPeerFilter(filter PeerFilter) Option {
 	}
 }
 
-// WithPeerOutboundQueueSize is an option to set the buffer size for outbound messages to a peer
-// We start dropping messages to a peer if the outbound queue if full
+// WithPeerOutboundQueueSize is an option to set the buffer size of each lane of the outbound
+// queue to a peer. We start dropping messages to a peer if their lane is full
 func WithPeerOutboundQueueSize(size int) Option {
 	return func(p *PubSub) error {
 		if size <= 0 {
@@ -385,6 +396,30 @@ func WithPeerOutboundQueueSize(size int) Option {
 	}
 }
 
+// WithBitmaskPriority assigns the priority of the outbound lane that messages
+// on the bitmask are sent on. Control messages always take precedence, the
+// priority can only be PriorityHigh or PriorityNormal.
+func WithBitmaskPriority(bitmask []byte, priority Priority) Option {
+	return func(p *PubSub) error {
+		if priority != PriorityHigh && priority != PriorityNormal {
+			return fmt.Errorf("invalid bitmask priority %s", priority)
+		}
+		p.bitmaskPriorities[string(bitmask)] = priority
+		return nil
+	}
+}
+
+// WithMessagePriority sets a function that may raise the priority of
+// individual messages above the priority of their bitmask, for bitmasks that
+// carry messages of differing urgency. The function is called for every
+// message sent to every peer, so it should be cheap.
+func WithMessagePriority(fn func(*pb.Message) Priority) Option {
+	return func(p *PubSub) error {
+		p.messagePriority = fn
+		return nil
+	}
+}
+
 // WithMessageSignaturePolicy sets the mode of operation for producing and verifying message signatures.
 func WithMessageSignaturePolicy(policy MessageSignaturePolicy) Option {
 	return func(p *PubSub) error {
@@ -493,6 +528,22 @@ func WithRawTracer(tracer RawTracer) Option {
 		} else {
 			p.tracer = &pubsubTracer{raw: []RawTracer{tracer}, pid: p.host.ID(), idGen: p.idGen}
 		}
+		if qt, ok := tracer.(QueueTracer); ok {
+			p.tracer.queue = append(p.tracer.queue, qt)
+		}
+		return nil
+	}
+}
+
+// WithQueueTracer adds a tracer observing the per-peer outbound queues.
+// Raw tracers implementing QueueTracer are added automatically.
+func WithQueueTracer(tracer QueueTracer) Option {
+	return func(p *PubSub) error {
+		if p.tracer != nil {
+			p.tracer.queue = append(p.tracer.queue, tracer)
+		} else {
+			p.tracer = &pubsubTracer{queue: []QueueTracer{tracer}, pid: p.host.ID(), idGen: p.idGen}
+		}
 		return nil
 	}
 }
@@ -568,8 +619,8 @@ func (p *PubSub) processLoop(ctx context.Context) {
 	defer func() {
 		p.peersMx.Lock()
 		// Clean up go routines.
-		for _, ch := range p.peers {
-			close(ch)
+		for _, q := range p.peers {
+			q.Close()
 		}
 		p.peers = nil
 		p.peersMx.Unlock()
@@ -586,7 +637,7 @@ func (p *PubSub) processLoop(ctx context.Context) {
 			pid := s.Conn().RemotePeer()
 
 			p.peersMx.RLock()
-			ch, ok := p.peers[pid]
+			q, ok := p.peers[pid]
 			p.peersMx.RUnlock()
 			if !ok {
 				log.Warn("new stream for unknown peer: ", pid)
@@ -596,7 +647,7 @@ func (p *PubSub) processLoop(ctx context.Context) {
 
 			if p.blacklist.Contains(pid) {
 				log.Warn("closing stream for blacklisted peer: ", pid)
-				close(ch)
+				q.Close()
 				p.peersMx.Lock()
 				delete(p.peers, pid)
 				p.peersMx.Unlock()
@@ -660,10 +711,10 @@ func (p *PubSub) processLoop(ctx context.Context) {
 			p.blacklist.Add(pid)
 
 			p.peersMx.RLock()
-			ch, ok := p.peers[pid]
+			q, ok := p.peers[pid]
 			p.peersMx.RUnlock()
 			if ok {
-				close(ch)
+				q.Close()
 				p.peersMx.Lock()
 				delete(p.peers, pid)
 				p.peersMx.Unlock()
@@ -756,8 +807,8 @@ func (p *PubSub) handlePendingPeers() {
 			continue
 		}
 
-		messages := make(chan *RPC, p.peerOutboundQueueSize)
-		messages <- p.getHelloPacket()
+		messages := newRPCQueue(p.peerOutboundQueueSize)
+		messages.Push(p.getHelloPacket(), PriorityControl)
 		go p.handleNewPeer(p.ctx, pid, messages)
 		p.peersMx.Lock()
 		p.peers[pid] = messages
@@ -779,13 +830,13 @@ func (p *PubSub) handleDeadPeers() {
 
 	for pid := range deadPeers {
 		p.peersMx.RLock()
-		ch, ok := p.peers[pid]
+		q, ok := p.peers[pid]
 		p.peersMx.RUnlock()
 		if !ok {
 			continue
 		}
 
-		close(ch)
+		q.Close()
 		p.peersMx.Lock()
 		delete(p.peers, pid)
 		p.peersMx.Unlock()
@@ -809,8 +860,8 @@ func (p *PubSub) handleDeadPeers() {
 			// still connected, must be a duplicate connection being closed.
 			// we respawn the writer as we need to ensure there is a stream active
 			log.Debugf("peer declared dead but still connected; respawning writer: %s", pid)
-			messages := make(chan *RPC, p.peerOutboundQueueSize)
-			messages <- p.getHelloPacket()
+			messages := newRPCQueue(p.peerOutboundQueueSize)
+			messages.Push(p.getHelloPacket(), PriorityControl)
 			p.peersMx.Lock()
 			p.peers[pid] = messages
 			p.peersMx.Unlock()
@@ -979,14 +1030,7 @@ func (p *PubSub) announce(bitmask []byte, sub bool) {
 	out := rpcWithSubs(subopt)
 	p.peersMx.RLock()
 	for pid, peer := range p.peers {
-		select {
-		case peer <- out:
-			p.tracer.SendRPC(out, pid)
-		default:
-			log.Infof("Can't send announce message to peer %s: queue full; scheduling retry", pid)
-			p.tracer.DropRPC(out, pid)
-			go p.announceRetry(pid, bitmask, sub)
-		}
+		p.pushAnnounce(pid, peer, out, bitmask, sub)
 	}
 	p.peersMx.RUnlock()
 }
@@ -1025,11 +1069,18 @@ func (p *PubSub) doAnnounceRetry(pid peer.ID, bitmask []byte, sub bool) {
 	}
 
 	out := rpcWithSubs(subopt)
-	select {
-	case peer <- out:
+	p.pushAnnounce(pid, peer, out, bitmask, sub)
+}
+
+func (p *PubSub) pushAnnounce(pid peer.ID, q *rpcQueue, out *RPC, bitmask []byte, sub bool) {
+	depth, err := q.Push(out, PriorityControl)
+	switch {
+	case err == nil:
+		p.tracer.QueueRPC(out, pid, PriorityControl, depth)
 		p.tracer.SendRPC(out, pid)
-	default:
+	case errors.Is(err, ErrQueueFull):
 		log.Infof("Can't send announce message to peer %s: queue full; scheduling retry", pid)
+		p.tracer.OverflowRPC(out, pid, PriorityControl)
 		p.tracer.DropRPC(out, pid)
 		go p.announceRetry(pid, bitmask, sub)
 	}
@@ -1172,13 +1223,19 @@ func (p *PubSub) handleIncomingRPC(rpc *RPC) {
 		p.tracer.ThrottlePeer(rpc.from)
 
 	case AcceptAll:
+		var toPush []*Message
 		for _, pmsg := range rpc.GetPublish() {
 			if !(p.subscribedToMsg(pmsg) || p.canRelayMsg(pmsg)) {
 				log.Debug("received message in bitmask we didn't subscribe to; ignoring message")
 				continue
 			}
 
-			p.pushMsg(&Message{pmsg, []byte{}, rpc.from, nil, false})
+			toPush = append(toPush, &Message{pmsg, []byte{}, rpc.from, nil, false})
+		}
+
+		p.rt.PreValidation(toPush)
+		for _, msg := range toPush {
+			p.pushMsg(msg)
 		}
 	}
 
@@ -0,0 +1,188 @@
+package blossomsub
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Priority is the lane an outbound RPC is scheduled on. Lanes are drained in
+// order of priority, so a burst of messages on busy bitmasks cannot delay
+// mesh maintenance or messages on bitmasks that have to propagate quickly.
+type Priority int
+
+const (
+	// PriorityControl is used for RPCs that carry no messages, i.e. control
+	// messages and subscription announcements.
+	PriorityControl Priority = iota
+	// PriorityHigh is used for messages on bitmasks assigned a high priority.
+	PriorityHigh
+	// PriorityNormal is used for all other messages.
+	PriorityNormal
+
+	numPriorities = 3
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityControl:
+		return "control"
+	case PriorityHigh:
+		return "high"
+	case PriorityNormal:
+		return "normal"
+	default:
+		return "unknown"
+	}
+}
+
+// highPriorityBurst is the number of consecutive high priority RPCs sent
+// while normal priority RPCs are waiting, before one normal priority RPC is
+// let through so the normal lane is never starved.
+const highPriorityBurst = 8
+
+var (
+	ErrQueueFull   = errors.New("queue full")
+	ErrQueueClosed = errors.New("queue closed")
+)
+
+// rpcPriority returns the lane an RPC is sent on, RPCs carrying messages are
+// sent on the highest priority lane of any of their messages.
+func (p *PubSub) rpcPriority(rpc *RPC) Priority {
+	if len(rpc.GetPublish()) == 0 {
+		return PriorityControl
+	}
+
+	priority := PriorityNormal
+	for _, msg := range rpc.GetPublish() {
+		if prio, ok := p.bitmaskPriorities[string(msg.GetBitmask())]; ok && prio < priority {
+			priority = prio
+		}
+		if p.messagePriority != nil {
+			if prio := p.messagePriority(msg); prio == PriorityHigh {
+				priority = prio
+			}
+		}
+		if priority == PriorityHigh {
+			break
+		}
+	}
+
+	return priority
+}
+
+// rpcQueue is the outbound queue of a peer. Each lane holds at most maxSize
+// RPCs, an RPC pushed to a full lane is dropped, which leaves the other lanes
+// unaffected. It supports any number of producers and a single consumer.
+type rpcQueue struct {
+	mx      sync.Mutex
+	lanes   [numPriorities][]*RPC
+	maxSize int
+	closed  bool
+	burst   int
+	notify  chan struct{}
+}
+
+func newRPCQueue(maxSize int) *rpcQueue {
+	return &rpcQueue{
+		maxSize: maxSize,
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// Push queues the RPC on the lane of the given priority, returning the
+// lane's depth afterwards.
+func (q *rpcQueue) Push(rpc *RPC, priority Priority) (int, error) {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	if q.closed {
+		return 0, ErrQueueClosed
+	}
+
+	if len(q.lanes[priority]) >= q.maxSize {
+		return len(q.lanes[priority]), ErrQueueFull
+	}
+
+	q.lanes[priority] = append(q.lanes[priority], rpc)
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+
+	return len(q.lanes[priority]), nil
+}
+
+// Pop blocks until an RPC is available and returns the one with the highest
+// priority. RPCs queued before the queue was closed are still returned, after
+// which Pop returns ErrQueueClosed.
+func (q *rpcQueue) Pop(ctx context.Context) (*RPC, error) {
+	for {
+		q.mx.Lock()
+		rpc := q.pop()
+		closed := q.closed
+		q.mx.Unlock()
+
+		if rpc != nil {
+			return rpc, nil
+		}
+
+		if closed {
+			return nil, ErrQueueClosed
+		}
+
+		select {
+		case <-q.notify:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// pop must be called with the lock held.
+func (q *rpcQueue) pop() *RPC {
+	lane := -1
+	switch {
+	case len(q.lanes[PriorityControl]) > 0:
+		lane = int(PriorityControl)
+	case len(q.lanes[PriorityHigh]) > 0 &&
+		(q.burst < highPriorityBurst || len(q.lanes[PriorityNormal]) == 0):
+		lane = int(PriorityHigh)
+		if len(q.lanes[PriorityNormal]) > 0 {
+			q.burst++
+		}
+	case len(q.lanes[PriorityNormal]) > 0:
+		lane = int(PriorityNormal)
+		q.burst = 0
+	default:
+		return nil
+	}
+
+	rpc := q.lanes[lane][0]
+	q.lanes[lane][0] = nil
+	q.lanes[lane] = q.lanes[lane][1:]
+
+	return rpc
+}
+
+// Len returns the depth of the lane of the given priority.
+func (q *rpcQueue) Len(priority Priority) int {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	return len(q.lanes[priority])
+}
+
+// Close stops the queue from accepting RPCs and wakes the consumer.
+func (q *rpcQueue) Close() {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	close(q.notify)
+}
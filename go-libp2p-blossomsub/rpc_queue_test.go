@@ -0,0 +1,160 @@
+package blossomsub
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+)
+
+func TestRPCQueuePriority(t *testing.T) {
+	q := newRPCQueue(32)
+	normal := rpcWithMessages(&pb.Message{Data: []byte("normal")})
+	high := rpcWithMessages(&pb.Message{Data: []byte("high")})
+	control := rpcWithControl(nil, nil, nil, nil, nil)
+
+	for _, push := range []struct {
+		rpc      *RPC
+		priority Priority
+	}{
+		{normal, PriorityNormal},
+		{high, PriorityHigh},
+		{control, PriorityControl},
+	} {
+		if _, err := q.Push(push.rpc, push.priority); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	for _, expected := range []*RPC{control, high, normal} {
+		rpc, err := q.Pop(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rpc != expected {
+			t.Fatal("expected RPCs to be popped in order of priority")
+		}
+	}
+}
+
+func TestRPCQueueLaneOverflow(t *testing.T) {
+	q := newRPCQueue(2)
+	for i := 0; i < 2; i++ {
+		depth, err := q.Push(rpcWithMessages(&pb.Message{}), PriorityNormal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if depth != i+1 {
+			t.Fatalf("expected depth %d, got %d", i+1, depth)
+		}
+	}
+
+	if _, err := q.Push(rpcWithMessages(&pb.Message{}), PriorityNormal); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected full normal lane, got %v", err)
+	}
+
+	// a full normal lane must not hold up control messages
+	if _, err := q.Push(rpcWithControl(nil, nil, nil, nil, nil), PriorityControl); err != nil {
+		t.Fatal(err)
+	}
+	if q.Len(PriorityControl) != 1 || q.Len(PriorityNormal) != 2 {
+		t.Fatal("unexpected lane depths")
+	}
+}
+
+func TestRPCQueueNormalNotStarved(t *testing.T) {
+	q := newRPCQueue(64)
+	normal := rpcWithMessages(&pb.Message{Data: []byte("normal")})
+	if _, err := q.Push(normal, PriorityNormal); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2*highPriorityBurst; i++ {
+		if _, err := q.Push(rpcWithMessages(&pb.Message{}), PriorityHigh); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	for i := 0; i <= highPriorityBurst; i++ {
+		rpc, err := q.Pop(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rpc == normal {
+			if i != highPriorityBurst {
+				t.Fatalf("expected normal RPC after %d high priority RPCs, got it after %d", highPriorityBurst, i)
+			}
+			return
+		}
+	}
+
+	t.Fatal("expected normal RPC to be let through")
+}
+
+func TestRPCQueueClose(t *testing.T) {
+	q := newRPCQueue(32)
+	rpc := rpcWithMessages(&pb.Message{})
+	if _, err := q.Push(rpc, PriorityNormal); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		popped, err := q.Pop(ctx)
+		if err != nil {
+			done <- err
+			return
+		}
+		if popped != rpc {
+			done <- errors.New("expected queued RPC")
+			return
+		}
+
+		_, err = q.Pop(ctx)
+		done <- err
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	q.Close()
+
+	if err := <-done; !errors.Is(err, ErrQueueClosed) {
+		t.Fatalf("expected queue to be drained and closed, got %v", err)
+	}
+
+	if _, err := q.Push(rpc, PriorityNormal); !errors.Is(err, ErrQueueClosed) {
+		t.Fatalf("expected closed queue to reject RPCs, got %v", err)
+	}
+}
+
+func TestRPCPriority(t *testing.T) {
+	frames := []byte{0x00, 0x01}
+	tokens := []byte{0x00, 0x02}
+	ps := &PubSub{
+		bitmaskPriorities: map[string]Priority{string(frames): PriorityHigh},
+		messagePriority: func(msg *pb.Message) Priority {
+			if string(msg.Data) == "urgent" {
+				return PriorityHigh
+			}
+			return PriorityNormal
+		},
+	}
+
+	if prio := ps.rpcPriority(rpcWithControl(nil, nil, nil, nil, nil)); prio != PriorityControl {
+		t.Fatalf("expected control priority, got %s", prio)
+	}
+	if prio := ps.rpcPriority(rpcWithMessages(&pb.Message{Bitmask: tokens})); prio != PriorityNormal {
+		t.Fatalf("expected normal priority, got %s", prio)
+	}
+	if prio := ps.rpcPriority(rpcWithMessages(&pb.Message{Bitmask: frames})); prio != PriorityHigh {
+		t.Fatalf("expected high priority, got %s", prio)
+	}
+	if prio := ps.rpcPriority(rpcWithMessages(&pb.Message{Bitmask: tokens, Data: []byte("urgent")})); prio != PriorityHigh {
+		t.Fatalf("expected high priority, got %s", prio)
+	}
+}
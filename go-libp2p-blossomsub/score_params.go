@@ -34,6 +34,12 @@ type PeerScoreThresholds struct {
 	OpportunisticGraftThreshold float64
 }
 
+// Validate checks the thresholds against the constraints enforced by
+// WithPeerScore.
+func (p *PeerScoreThresholds) Validate() error {
+	return p.validate()
+}
+
 func (p *PeerScoreThresholds) validate() error {
 
 	if !p.SkipAtomicValidation || p.PublishThreshold != 0 || p.GossipThreshold != 0 || p.GraylistThreshold != 0 {
@@ -169,6 +175,12 @@ type BitmaskScoreParams struct {
 	InvalidMessageDeliveriesWeight, InvalidMessageDeliveriesDecay float64
 }
 
+// Validate checks the parameters, including the parameters of every bitmask,
+// against the constraints enforced by WithPeerScore.
+func (p *PeerScoreParams) Validate() error {
+	return p.validate()
+}
+
 // peer score parameter validation
 func (p *PeerScoreParams) validate() error {
 	for bitmask, params := range p.Bitmasks {
@@ -233,6 +245,12 @@ func (p *PeerScoreParams) validate() error {
 	return nil
 }
 
+// Validate checks the parameters against the constraints enforced by
+// WithPeerScore and SetScoreParams.
+func (p *BitmaskScoreParams) Validate() error {
+	return p.validate()
+}
+
 func (p *BitmaskScoreParams) validate() error {
 	// make sure we have a sane bitmask weight
 	if p.BitmaskWeight < 0 || isInvalidNumber(p.BitmaskWeight) {
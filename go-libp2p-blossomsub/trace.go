@@ -59,10 +59,22 @@ type RawTracer interface {
 	UndeliverableMessage(msg *Message)
 }
 
+// QueueTracer is a tracer observing the per-peer outbound queues, for
+// instance to export their depth and drops as metrics.
+type QueueTracer interface {
+	// QueueRPC is invoked when an outbound RPC is queued, with the depth of
+	// its lane after queueing.
+	QueueRPC(rpc *RPC, p peer.ID, priority Priority, depth int)
+	// OverflowRPC is invoked when an outbound RPC is dropped because its lane
+	// is full. The RPC is also reported to raw tracers with DropRPC.
+	OverflowRPC(rpc *RPC, p peer.ID, priority Priority)
+}
+
 // pubsub tracer details
 type pubsubTracer struct {
 	tracer EventTracer
 	raw    []RawTracer
+	queue  []QueueTracer
 	pid    peer.ID
 	idGen  *msgIDGenerator
 }
@@ -328,6 +340,26 @@ func (t *pubsubTracer) DropRPC(rpc *RPC, p peer.ID) {
 	// t.tracer.Trace(evt)
 }
 
+func (t *pubsubTracer) QueueRPC(rpc *RPC, p peer.ID, priority Priority, depth int) {
+	if t == nil {
+		return
+	}
+
+	for _, tr := range t.queue {
+		tr.QueueRPC(rpc, p, priority, depth)
+	}
+}
+
+func (t *pubsubTracer) OverflowRPC(rpc *RPC, p peer.ID, priority Priority) {
+	if t == nil {
+		return
+	}
+
+	for _, tr := range t.queue {
+		tr.OverflowRPC(rpc, p, priority)
+	}
+}
+
 func (t *pubsubTracer) UndeliverableMessage(msg *Message) {
 	if t == nil {
 		return
@@ -401,6 +433,17 @@ func (t *pubsubTracer) traceRPCMeta(rpc *RPC) *pb.TraceEvent_RPCMeta {
 			})
 		}
 
+		var idontwant []*pb.TraceEvent_ControlIDontWantMeta
+		for _, ctl := range rpc.Control.Idontwant {
+			var mids [][]byte
+			for _, mid := range ctl.MessageIDs {
+				mids = append(mids, []byte(mid))
+			}
+			idontwant = append(idontwant, &pb.TraceEvent_ControlIDontWantMeta{
+				MessageIDs: mids,
+			})
+		}
+
 		var graft []*pb.TraceEvent_ControlGraftMeta
 		for _, ctl := range rpc.Control.Graft {
 			graft = append(graft, &pb.TraceEvent_ControlGraftMeta{
@@ -421,10 +464,11 @@ func (t *pubsubTracer) traceRPCMeta(rpc *RPC) *pb.TraceEvent_RPCMeta {
 		}
 
 		rpcMeta.Control = &pb.TraceEvent_ControlMeta{
-			Ihave: ihave,
-			Iwant: iwant,
-			Graft: graft,
-			Prune: prune,
+			Ihave:     ihave,
+			Iwant:     iwant,
+			Graft:     graft,
+			Prune:     prune,
+			Idontwant: idontwant,
 		}
 	}
 
@@ -12,21 +12,26 @@ import (
 	"source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token"
+	"source.quilibrium.com/quilibrium/monorepo/node/hypergraph/application"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/store"
 )
 
 type Node struct {
-	logger         *zap.Logger
-	dataProofStore store.DataProofStore
-	clockStore     store.ClockStore
-	coinStore      store.CoinStore
-	keyManager     keys.KeyManager
-	pubSub         p2p.PubSub
-	execEngines    map[string]execution.ExecutionEngine
-	engine         consensus.ConsensusEngine
-	pebble         store.KVDB
+	logger          *zap.Logger
+	dataProofStore  store.DataProofStore
+	clockStore      store.ClockStore
+	coinStore       store.CoinStore
+	keyManager      keys.KeyManager
+	pubSub          p2p.PubSub
+	preKeys         *p2p.PreKeyDirectory
+	messenger       *p2p.Messenger
+	hypergraphQuery *application.HypergraphQueryService
+	replicator      *application.HypergraphReplicator
+	execEngines     map[string]execution.ExecutionEngine
+	engine          consensus.ConsensusEngine
+	pebble          store.KVDB
 }
 
 type DHTNode struct {
@@ -50,6 +55,10 @@ func newNode(
 	coinStore store.CoinStore,
 	keyManager keys.KeyManager,
 	pubSub p2p.PubSub,
+	preKeys *p2p.PreKeyDirectory,
+	messenger *p2p.Messenger,
+	hypergraphQuery *application.HypergraphQueryService,
+	replicator *application.HypergraphReplicator,
 	tokenExecutionEngine *token.TokenExecutionEngine,
 	engine consensus.ConsensusEngine,
 	pebble store.KVDB,
@@ -70,6 +79,10 @@ func newNode(
 		coinStore,
 		keyManager,
 		pubSub,
+		preKeys,
+		messenger,
+		hypergraphQuery,
+		replicator,
 		execEngines,
 		engine,
 		pebble,
@@ -165,9 +178,23 @@ func (n *Node) Start() {
 	for _, e := range n.execEngines {
 		n.engine.RegisterExecutor(e, 0)
 	}
+
+	if err := n.preKeys.Start(); err != nil {
+		panic(err)
+	}
+
+	n.messenger.Start()
+
+	if err := n.replicator.Start(); err != nil {
+		panic(err)
+	}
 }
 
 func (n *Node) Stop() {
+	n.replicator.Stop()
+	n.messenger.Stop()
+	n.preKeys.Stop()
+
 	err := <-n.engine.Stop(false)
 	if err != nil {
 		panic(err)
@@ -200,6 +227,18 @@ func (n *Node) GetPubSub() p2p.PubSub {
 	return n.pubSub
 }
 
+func (n *Node) GetPreKeyDirectory() *p2p.PreKeyDirectory {
+	return n.preKeys
+}
+
+func (n *Node) GetMessenger() *p2p.Messenger {
+	return n.messenger
+}
+
+func (n *Node) GetHypergraphQueryService() *application.HypergraphQueryService {
+	return n.hypergraphQuery
+}
+
 func (n *Node) GetMasterClock() *master.MasterClockConsensusEngine {
 	return n.engine.(*master.MasterClockConsensusEngine)
 }
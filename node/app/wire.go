@@ -6,12 +6,14 @@ package app
 import (
 	"github.com/google/wire"
 	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/master"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
 	"source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token"
+	"source.quilibrium.com/quilibrium/monorepo/node/hypergraph/application"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
@@ -44,6 +46,11 @@ var debugLoggerSet = wire.NewSet(
 	debugLogger,
 )
 
+var clockSet = wire.NewSet(
+	clock.NewRealClock,
+	wire.Bind(new(clock.Clock), new(*clock.RealClock)),
+)
+
 var keyManagerSet = wire.NewSet(
 	wire.FieldsOf(new(*config.Config), "Key"),
 	keys.NewFileKeyManager,
@@ -59,17 +66,48 @@ var storeSet = wire.NewSet(
 	store.NewPebbleKeyStore,
 	store.NewPebbleDataProofStore,
 	store.NewPeerstoreDatastore,
+	store.NewPebbleChannelStore,
+	store.NewPebblePreKeyStore,
+	store.NewPebbleMessageStore,
+	store.NewPebbleHypergraphStore,
+	store.NewPebbleMempoolStore,
+	store.NewPebblePeerReputationStore,
+	store.NewPebbleRewardProofStore,
 	wire.Bind(new(store.ClockStore), new(*store.PebbleClockStore)),
 	wire.Bind(new(store.CoinStore), new(*store.PebbleCoinStore)),
 	wire.Bind(new(store.KeyStore), new(*store.PebbleKeyStore)),
 	wire.Bind(new(store.DataProofStore), new(*store.PebbleDataProofStore)),
 	wire.Bind(new(store.Peerstore), new(*store.PeerstoreDatastore)),
+	wire.Bind(new(store.ChannelStore), new(*store.PebbleChannelStore)),
+	wire.Bind(new(store.PreKeyStore), new(*store.PebblePreKeyStore)),
+	wire.Bind(new(store.MessageStore), new(*store.PebbleMessageStore)),
+	wire.Bind(
+		new(store.HypergraphStore),
+		new(*store.PebbleHypergraphStore),
+	),
+	wire.Bind(new(store.MempoolStore), new(*store.PebbleMempoolStore)),
+	wire.Bind(
+		new(store.PeerReputationStore),
+		new(*store.PebblePeerReputationStore),
+	),
+	wire.Bind(
+		new(store.RewardProofStore),
+		new(*store.PebbleRewardProofStore),
+	),
+)
+
+var hypergraphSet = wire.NewSet(
+	application.NewPersistentHypergraph,
+	application.NewHypergraphQueryService,
+	application.NewHypergraphReplicator,
 )
 
 var pubSubSet = wire.NewSet(
 	wire.FieldsOf(new(*config.Config), "P2P"),
 	p2p.NewInMemoryPeerInfoManager,
 	p2p.NewBlossomSub,
+	p2p.NewPreKeyDirectory,
+	p2p.NewMessenger,
 	wire.Bind(new(p2p.PubSub), new(*p2p.BlossomSub)),
 	wire.Bind(new(p2p.PeerInfoManager), new(*p2p.InMemoryPeerInfoManager)),
 )
@@ -95,6 +133,8 @@ var consensusSet = wire.NewSet(
 func NewDHTNode(*config.Config) (*DHTNode, error) {
 	panic(wire.Build(
 		debugLoggerSet,
+		clockSet,
+		storeSet,
 		pubSubSet,
 		newDHTNode,
 	))
@@ -103,9 +143,11 @@ func NewDHTNode(*config.Config) (*DHTNode, error) {
 func NewDebugNode(*config.Config, *protobufs.SelfTestReport) (*Node, error) {
 	panic(wire.Build(
 		debugLoggerSet,
+		clockSet,
 		keyManagerSet,
 		storeSet,
 		pubSubSet,
+		hypergraphSet,
 		engineSet,
 		consensusSet,
 		newNode,
@@ -115,9 +157,11 @@ func NewDebugNode(*config.Config, *protobufs.SelfTestReport) (*Node, error) {
 func NewNode(*config.Config, *protobufs.SelfTestReport) (*Node, error) {
 	panic(wire.Build(
 		loggerSet,
+		clockSet,
 		keyManagerSet,
 		storeSet,
 		pubSubSet,
+		hypergraphSet,
 		engineSet,
 		consensusSet,
 		newNode,
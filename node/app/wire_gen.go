@@ -9,12 +9,14 @@ package app
 import (
 	"github.com/google/wire"
 	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/master"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
 	"source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token"
+	"source.quilibrium.com/quilibrium/monorepo/node/hypergraph/application"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
@@ -25,8 +27,15 @@ import (
 
 func NewDHTNode(configConfig *config.Config) (*DHTNode, error) {
 	p2PConfig := configConfig.P2P
+	dbConfig := configConfig.DB
+	pebbleDB := store.NewPebbleDB(dbConfig)
+	peerstoreDatastore, err := store.NewPeerstoreDatastore(pebbleDB)
+	if err != nil {
+		return nil, err
+	}
 	zapLogger := debugLogger()
-	blossomSub := p2p.NewBlossomSub(p2PConfig, zapLogger)
+	realClock := clock.NewRealClock()
+	blossomSub := p2p.NewBlossomSub(p2PConfig, peerstoreDatastore, zapLogger, realClock)
 	dhtNode, err := newDHTNode(blossomSub)
 	if err != nil {
 		return nil, err
@@ -44,16 +53,33 @@ func NewDebugNode(configConfig *config.Config, selfTestReport *protobufs.SelfTes
 	keyConfig := configConfig.Key
 	fileKeyManager := keys.NewFileKeyManager(keyConfig, zapLogger)
 	p2PConfig := configConfig.P2P
-	blossomSub := p2p.NewBlossomSub(p2PConfig, zapLogger)
+	peerstoreDatastore, err := store.NewPeerstoreDatastore(pebbleDB)
+	if err != nil {
+		return nil, err
+	}
+	realClock := clock.NewRealClock()
+	blossomSub := p2p.NewBlossomSub(p2PConfig, peerstoreDatastore, zapLogger, realClock)
+	pebblePreKeyStore := store.NewPebblePreKeyStore(pebbleDB, fileKeyManager, zapLogger)
+	preKeyDirectory := p2p.NewPreKeyDirectory(zapLogger, blossomSub, fileKeyManager, pebblePreKeyStore, realClock)
+	pebbleChannelStore := store.NewPebbleChannelStore(pebbleDB, fileKeyManager, zapLogger)
+	pebbleMessageStore := store.NewPebbleMessageStore(pebbleDB, fileKeyManager, zapLogger)
+	messenger := p2p.NewMessenger(zapLogger, blossomSub, fileKeyManager, preKeyDirectory, pebbleChannelStore, pebbleMessageStore, realClock)
+	pebbleHypergraphStore := store.NewPebbleHypergraphStore(pebbleDB, zapLogger)
+	hypergraph := application.NewPersistentHypergraph(pebbleHypergraphStore)
+	hypergraphQueryService := application.NewHypergraphQueryService(zapLogger, hypergraph)
 	wesolowskiFrameProver := crypto.NewWesolowskiFrameProver(zapLogger)
 	kzgInclusionProver := crypto.NewKZGInclusionProver(zapLogger)
 	engineConfig := configConfig.Engine
+	hypergraphReplicator := application.NewHypergraphReplicator(zapLogger, engineConfig, blossomSub, hypergraph, realClock)
 	masterTimeReel := time.NewMasterTimeReel(zapLogger, pebbleClockStore, engineConfig, wesolowskiFrameProver)
 	inMemoryPeerInfoManager := p2p.NewInMemoryPeerInfoManager(zapLogger)
 	pebbleKeyStore := store.NewPebbleKeyStore(pebbleDB, zapLogger)
-	tokenExecutionEngine := token.NewTokenExecutionEngine(zapLogger, configConfig, fileKeyManager, blossomSub, wesolowskiFrameProver, kzgInclusionProver, pebbleClockStore, pebbleDataProofStore, pebbleCoinStore, masterTimeReel, inMemoryPeerInfoManager, pebbleKeyStore, selfTestReport)
-	masterClockConsensusEngine := master.NewMasterClockConsensusEngine(engineConfig, zapLogger, pebbleClockStore, fileKeyManager, blossomSub, kzgInclusionProver, wesolowskiFrameProver, masterTimeReel, inMemoryPeerInfoManager, selfTestReport)
-	node, err := newNode(zapLogger, pebbleDataProofStore, pebbleClockStore, pebbleCoinStore, fileKeyManager, blossomSub, tokenExecutionEngine, masterClockConsensusEngine, pebbleDB)
+	pebbleMempoolStore := store.NewPebbleMempoolStore(pebbleDB, zapLogger)
+	pebblePeerReputationStore := store.NewPebblePeerReputationStore(pebbleDB, zapLogger)
+	pebbleRewardProofStore := store.NewPebbleRewardProofStore(pebbleDB, zapLogger)
+	tokenExecutionEngine := token.NewTokenExecutionEngine(zapLogger, configConfig, fileKeyManager, blossomSub, wesolowskiFrameProver, kzgInclusionProver, pebbleClockStore, pebbleDataProofStore, pebbleCoinStore, masterTimeReel, inMemoryPeerInfoManager, realClock, pebbleKeyStore, pebbleMempoolStore, pebblePeerReputationStore, pebbleRewardProofStore, selfTestReport)
+	masterClockConsensusEngine := master.NewMasterClockConsensusEngine(engineConfig, zapLogger, pebbleClockStore, fileKeyManager, blossomSub, kzgInclusionProver, wesolowskiFrameProver, masterTimeReel, inMemoryPeerInfoManager, realClock, selfTestReport)
+	node, err := newNode(zapLogger, pebbleDataProofStore, pebbleClockStore, pebbleCoinStore, fileKeyManager, blossomSub, preKeyDirectory, messenger, hypergraphQueryService, hypergraphReplicator, tokenExecutionEngine, masterClockConsensusEngine, pebbleDB)
 	if err != nil {
 		return nil, err
 	}
@@ -70,16 +96,33 @@ func NewNode(configConfig *config.Config, selfTestReport *protobufs.SelfTestRepo
 	keyConfig := configConfig.Key
 	fileKeyManager := keys.NewFileKeyManager(keyConfig, zapLogger)
 	p2PConfig := configConfig.P2P
-	blossomSub := p2p.NewBlossomSub(p2PConfig, zapLogger)
+	peerstoreDatastore, err := store.NewPeerstoreDatastore(pebbleDB)
+	if err != nil {
+		return nil, err
+	}
+	realClock := clock.NewRealClock()
+	blossomSub := p2p.NewBlossomSub(p2PConfig, peerstoreDatastore, zapLogger, realClock)
+	pebblePreKeyStore := store.NewPebblePreKeyStore(pebbleDB, fileKeyManager, zapLogger)
+	preKeyDirectory := p2p.NewPreKeyDirectory(zapLogger, blossomSub, fileKeyManager, pebblePreKeyStore, realClock)
+	pebbleChannelStore := store.NewPebbleChannelStore(pebbleDB, fileKeyManager, zapLogger)
+	pebbleMessageStore := store.NewPebbleMessageStore(pebbleDB, fileKeyManager, zapLogger)
+	messenger := p2p.NewMessenger(zapLogger, blossomSub, fileKeyManager, preKeyDirectory, pebbleChannelStore, pebbleMessageStore, realClock)
+	pebbleHypergraphStore := store.NewPebbleHypergraphStore(pebbleDB, zapLogger)
+	hypergraph := application.NewPersistentHypergraph(pebbleHypergraphStore)
+	hypergraphQueryService := application.NewHypergraphQueryService(zapLogger, hypergraph)
 	wesolowskiFrameProver := crypto.NewWesolowskiFrameProver(zapLogger)
 	kzgInclusionProver := crypto.NewKZGInclusionProver(zapLogger)
 	engineConfig := configConfig.Engine
+	hypergraphReplicator := application.NewHypergraphReplicator(zapLogger, engineConfig, blossomSub, hypergraph, realClock)
 	masterTimeReel := time.NewMasterTimeReel(zapLogger, pebbleClockStore, engineConfig, wesolowskiFrameProver)
 	inMemoryPeerInfoManager := p2p.NewInMemoryPeerInfoManager(zapLogger)
 	pebbleKeyStore := store.NewPebbleKeyStore(pebbleDB, zapLogger)
-	tokenExecutionEngine := token.NewTokenExecutionEngine(zapLogger, configConfig, fileKeyManager, blossomSub, wesolowskiFrameProver, kzgInclusionProver, pebbleClockStore, pebbleDataProofStore, pebbleCoinStore, masterTimeReel, inMemoryPeerInfoManager, pebbleKeyStore, selfTestReport)
-	masterClockConsensusEngine := master.NewMasterClockConsensusEngine(engineConfig, zapLogger, pebbleClockStore, fileKeyManager, blossomSub, kzgInclusionProver, wesolowskiFrameProver, masterTimeReel, inMemoryPeerInfoManager, selfTestReport)
-	node, err := newNode(zapLogger, pebbleDataProofStore, pebbleClockStore, pebbleCoinStore, fileKeyManager, blossomSub, tokenExecutionEngine, masterClockConsensusEngine, pebbleDB)
+	pebbleMempoolStore := store.NewPebbleMempoolStore(pebbleDB, zapLogger)
+	pebblePeerReputationStore := store.NewPebblePeerReputationStore(pebbleDB, zapLogger)
+	pebbleRewardProofStore := store.NewPebbleRewardProofStore(pebbleDB, zapLogger)
+	tokenExecutionEngine := token.NewTokenExecutionEngine(zapLogger, configConfig, fileKeyManager, blossomSub, wesolowskiFrameProver, kzgInclusionProver, pebbleClockStore, pebbleDataProofStore, pebbleCoinStore, masterTimeReel, inMemoryPeerInfoManager, realClock, pebbleKeyStore, pebbleMempoolStore, pebblePeerReputationStore, pebbleRewardProofStore, selfTestReport)
+	masterClockConsensusEngine := master.NewMasterClockConsensusEngine(engineConfig, zapLogger, pebbleClockStore, fileKeyManager, blossomSub, kzgInclusionProver, wesolowskiFrameProver, masterTimeReel, inMemoryPeerInfoManager, realClock, selfTestReport)
+	node, err := newNode(zapLogger, pebbleDataProofStore, pebbleClockStore, pebbleCoinStore, fileKeyManager, blossomSub, preKeyDirectory, messenger, hypergraphQueryService, hypergraphReplicator, tokenExecutionEngine, masterClockConsensusEngine, pebbleDB)
 	if err != nil {
 		return nil, err
 	}
@@ -130,11 +173,25 @@ var debugLoggerSet = wire.NewSet(
 	debugLogger,
 )
 
+var clockSet = wire.NewSet(clock.NewRealClock, wire.Bind(new(clock.Clock), new(*clock.RealClock)))
+
 var keyManagerSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "Key"), keys.NewFileKeyManager, wire.Bind(new(keys.KeyManager), new(*keys.FileKeyManager)))
 
-var storeSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "DB"), store.NewPebbleDB, wire.Bind(new(store.KVDB), new(*store.PebbleDB)), store.NewPebbleClockStore, store.NewPebbleCoinStore, store.NewPebbleKeyStore, store.NewPebbleDataProofStore, store.NewPeerstoreDatastore, wire.Bind(new(store.ClockStore), new(*store.PebbleClockStore)), wire.Bind(new(store.CoinStore), new(*store.PebbleCoinStore)), wire.Bind(new(store.KeyStore), new(*store.PebbleKeyStore)), wire.Bind(new(store.DataProofStore), new(*store.PebbleDataProofStore)), wire.Bind(new(store.Peerstore), new(*store.PeerstoreDatastore)))
+var storeSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "DB"), store.NewPebbleDB, wire.Bind(new(store.KVDB), new(*store.PebbleDB)), store.NewPebbleClockStore, store.NewPebbleCoinStore, store.NewPebbleKeyStore, store.NewPebbleDataProofStore, store.NewPeerstoreDatastore, store.NewPebbleChannelStore, store.NewPebblePreKeyStore, store.NewPebbleMessageStore, store.NewPebbleHypergraphStore, store.NewPebbleMempoolStore, store.NewPebblePeerReputationStore, store.NewPebbleRewardProofStore, wire.Bind(new(store.ClockStore), new(*store.PebbleClockStore)), wire.Bind(new(store.CoinStore), new(*store.PebbleCoinStore)), wire.Bind(new(store.KeyStore), new(*store.PebbleKeyStore)), wire.Bind(new(store.DataProofStore), new(*store.PebbleDataProofStore)), wire.Bind(new(store.Peerstore), new(*store.PeerstoreDatastore)), wire.Bind(new(store.ChannelStore), new(*store.PebbleChannelStore)), wire.Bind(new(store.PreKeyStore), new(*store.PebblePreKeyStore)), wire.Bind(new(store.MessageStore), new(*store.PebbleMessageStore)), wire.Bind(
+	new(store.HypergraphStore),
+	new(*store.PebbleHypergraphStore),
+), wire.Bind(new(store.MempoolStore), new(*store.PebbleMempoolStore)), wire.Bind(
+	new(store.PeerReputationStore),
+	new(*store.PebblePeerReputationStore),
+), wire.Bind(
+	new(store.RewardProofStore),
+	new(*store.PebbleRewardProofStore),
+),
+)
+
+var hypergraphSet = wire.NewSet(application.NewPersistentHypergraph, application.NewHypergraphQueryService, application.NewHypergraphReplicator)
 
-var pubSubSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "P2P"), p2p.NewInMemoryPeerInfoManager, p2p.NewBlossomSub, wire.Bind(new(p2p.PubSub), new(*p2p.BlossomSub)), wire.Bind(new(p2p.PeerInfoManager), new(*p2p.InMemoryPeerInfoManager)))
+var pubSubSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "P2P"), p2p.NewInMemoryPeerInfoManager, p2p.NewBlossomSub, p2p.NewPreKeyDirectory, p2p.NewMessenger, wire.Bind(new(p2p.PubSub), new(*p2p.BlossomSub)), wire.Bind(new(p2p.PeerInfoManager), new(*p2p.InMemoryPeerInfoManager)))
 
 var engineSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "Engine"), crypto.NewWesolowskiFrameProver, wire.Bind(new(crypto.FrameProver), new(*crypto.WesolowskiFrameProver)), crypto.NewKZGInclusionProver, wire.Bind(new(crypto.InclusionProver), new(*crypto.KZGInclusionProver)), time.NewMasterTimeReel, token.NewTokenExecutionEngine)
 
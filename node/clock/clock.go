@@ -0,0 +1,220 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for the consensus and p2p loops. The node runs
+// on the real clock, tests on a fake clock advanced by hand, so behavior that
+// depends on timing can be tested deterministically.
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	Sleep(d time.Duration)
+	After(d time.Duration) <-chan time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers the time on its channel every period, dropping ticks for a
+// slow receiver, as a time.Ticker does.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// RealClock is the wall clock, as provided by the time package.
+type RealClock struct{}
+
+var _ Clock = (*RealClock)(nil)
+
+func NewRealClock() *RealClock {
+	return &RealClock{}
+}
+
+func (*RealClock) Now() time.Time {
+	return time.Now()
+}
+
+func (*RealClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (*RealClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func (*RealClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (*RealClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	*time.Ticker
+}
+
+func (t *realTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+// waiter is a sleeper or After channel, fired once at its deadline, or a
+// ticker, fired at its deadline and then every period after it.
+type waiter struct {
+	deadline time.Time
+	period   time.Duration
+	ch       chan time.Time
+}
+
+// FakeClock is a clock that only moves when advanced. Sleeps, channels
+// returned by After and tickers fire once the clock is advanced past their
+// deadline. A ticker fires at most once per advance.
+type FakeClock struct {
+	mx      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*waiter
+}
+
+var _ Clock = (*FakeClock)(nil)
+
+// NewFakeClock creates a fake clock set to the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	c := &FakeClock{now: now}
+	c.cond = sync.NewCond(&c.mx)
+	return c
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.now
+}
+
+func (c *FakeClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *FakeClock) Sleep(d time.Duration) {
+	<-c.After(d)
+}
+
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+
+	c.waiters = append(c.waiters, &waiter{deadline: c.now.Add(d), ch: ch})
+	c.cond.Broadcast()
+	return ch
+}
+
+// NewTicker panics on a non-positive duration, as time.NewTicker does.
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	t := &fakeTicker{
+		clock: c,
+		waiter: &waiter{
+			deadline: c.now.Add(d),
+			period:   d,
+			ch:       make(chan time.Time, 1),
+		},
+	}
+	c.waiters = append(c.waiters, t.waiter)
+	c.cond.Broadcast()
+	return t
+}
+
+type fakeTicker struct {
+	clock  *FakeClock
+	waiter *waiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.waiter.ch
+}
+
+func (t *fakeTicker) Stop() {
+	t.clock.mx.Lock()
+	defer t.clock.mx.Unlock()
+
+	for i, w := range t.clock.waiters {
+		if w == t.waiter {
+			t.clock.waiters = append(t.clock.waiters[:i], t.clock.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+// Advance moves the clock forward by the duration, firing the waiters whose
+// deadline has been reached, earliest first.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.now = c.now.Add(d)
+
+	sort.SliceStable(c.waiters, func(i, j int) bool {
+		return c.waiters[i].deadline.Before(c.waiters[j].deadline)
+	})
+
+	remaining := []*waiter{}
+	for _, w := range c.waiters {
+		if w.deadline.After(c.now) {
+			remaining = append(remaining, w)
+			continue
+		}
+
+		if w.period == 0 {
+			w.ch <- c.now
+			continue
+		}
+
+		select {
+		case w.ch <- c.now:
+		default:
+		}
+
+		for !w.deadline.After(c.now) {
+			w.deadline = w.deadline.Add(w.period)
+		}
+		remaining = append(remaining, w)
+	}
+
+	c.waiters = remaining
+}
+
+// BlockUntil waits until at least n sleepers, After channels or tickers are
+// pending on the clock, so a test can advance it once the code under test is
+// waiting.
+func (c *FakeClock) BlockUntil(n int) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
+
+// Waiters returns the number of sleepers, After channels and tickers pending.
+func (c *FakeClock) Waiters() int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return len(c.waiters)
+}
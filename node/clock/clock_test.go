@@ -0,0 +1,84 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+)
+
+func TestFakeClockAdvance(t *testing.T) {
+	start := time.UnixMilli(1_700_000_000_000)
+	c := clock.NewFakeClock(start)
+
+	later := c.After(20 * time.Millisecond)
+	sooner := c.After(10 * time.Millisecond)
+	assert.Equal(t, 2, c.Waiters())
+
+	c.Advance(9 * time.Millisecond)
+	assert.Empty(t, sooner)
+	assert.Empty(t, later)
+
+	c.Advance(time.Millisecond)
+	assert.Equal(t, start.Add(10*time.Millisecond), <-sooner)
+	assert.Empty(t, later)
+	assert.Equal(t, 10*time.Millisecond, c.Since(start))
+
+	c.Advance(time.Second)
+	assert.Equal(t, start.Add(1010*time.Millisecond), <-later)
+	assert.Equal(t, 0, c.Waiters())
+
+	// Non-positive durations fire immediately.
+	assert.Equal(t, c.Now(), <-c.After(0))
+}
+
+func TestFakeClockSleep(t *testing.T) {
+	c := clock.NewFakeClock(time.UnixMilli(0))
+
+	done := make(chan struct{})
+	go func() {
+		c.Sleep(time.Second)
+		close(done)
+	}()
+
+	c.BlockUntil(1)
+	c.Advance(999 * time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("sleep returned before its deadline")
+	default:
+	}
+
+	c.Advance(time.Millisecond)
+	<-done
+	assert.Equal(t, int64(1000), c.Now().UnixMilli())
+}
+
+func TestFakeClockTicker(t *testing.T) {
+	start := time.UnixMilli(0)
+	c := clock.NewFakeClock(start)
+
+	ticker := c.NewTicker(10 * time.Millisecond)
+	assert.Equal(t, 1, c.Waiters())
+
+	c.Advance(9 * time.Millisecond)
+	assert.Empty(t, ticker.C())
+
+	c.Advance(time.Millisecond)
+	assert.Equal(t, start.Add(10*time.Millisecond), <-ticker.C())
+
+	// Ticks missed by a slow receiver are dropped, and the ticker keeps its
+	// period.
+	c.Advance(35 * time.Millisecond)
+	assert.Equal(t, start.Add(45*time.Millisecond), <-ticker.C())
+	assert.Empty(t, ticker.C())
+
+	c.Advance(5 * time.Millisecond)
+	assert.Equal(t, start.Add(50*time.Millisecond), <-ticker.C())
+
+	ticker.Stop()
+	assert.Equal(t, 0, c.Waiters())
+	c.Advance(time.Second)
+	assert.Empty(t, ticker.C())
+}
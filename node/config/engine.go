@@ -1,5 +1,20 @@
 package config
 
+// Every node keeps the coin state of the frames at multiples of this many
+// frames, so checkpoints at those frames can be served by any node holding
+// them, not only by the nodes that had them configured.
+const CheckpointInterval uint64 = 10000
+
+// Checkpoint identifies a trusted data frame by number and hex encoded
+// selector, with the hex encoded digests of its coin state and prover tries.
+// A checkpoint missing either digest is not bootstrapped from.
+type Checkpoint struct {
+	FrameNumber       uint64 `yaml:"frameNumber"`
+	Selector          string `yaml:"selector"`
+	StateDigest       string `yaml:"stateDigest"`
+	ProverTriesDigest string `yaml:"proverTriesDigest"`
+}
+
 type EngineConfig struct {
 	ProvingKeyId         string `yaml:"provingKeyId"`
 	Filter               string `yaml:"filter"`
@@ -19,6 +34,30 @@ type EngineConfig struct {
 	// Alternative configuration path to manually specify data workers by multiaddr
 	DataWorkerMultiaddrs          []string `yaml:"dataWorkerMultiaddrs"`
 	MultisigProverEnrollmentPaths []string `yaml:"multisigProverEnrollmentPaths"`
+	// Limits of the mempool of pending token requests: the number of requests
+	// held in total and per sender, and the number of frames a request stays
+	// pending before it expires. Zero values use the defaults.
+	MempoolMaxEntries          int    `yaml:"mempoolMaxEntries"`
+	MempoolMaxEntriesPerSender int    `yaml:"mempoolMaxEntriesPerSender"`
+	MempoolMaxFrameAge         uint64 `yaml:"mempoolMaxFrameAge"`
+	// Frame sync fetches windows of this many frames concurrently from up to
+	// this many peers. Zero values use the defaults.
+	SyncWindowSize uint64 `yaml:"syncWindowSize"`
+	SyncMaxPeers   int    `yaml:"syncMaxPeers"`
+	// Frames trusted without replaying the frames before them. A node far
+	// behind the latest checkpoint bootstraps from it instead of syncing from
+	// its own head.
+	Checkpoints []Checkpoint `yaml:"checkpoints"`
+	// Reward proofs of the data workers are minted in batches of up to this
+	// many proofs, resubmitted every this many frames until minted, and
+	// dropped this many frames after the frame they were computed for. Zero
+	// values use the defaults.
+	RewardBatchSize   int    `yaml:"rewardBatchSize"`
+	RewardRetryFrames uint64 `yaml:"rewardRetryFrames"`
+	RewardMaxFrameAge uint64 `yaml:"rewardMaxFrameAge"`
+	// Shards of the hypergraph the node replicates, as hex encoded shard
+	// addresses. They are pulled from a random replica every interval.
+	HypergraphShards []string `yaml:"hypergraphShards"`
 
 	// Values used only for testing – do not override these in production, your
 	// node will get kicked out
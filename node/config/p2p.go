@@ -31,13 +31,99 @@ type P2PConfig struct {
 	MaxIHaveLength            int           `yaml:"maxIHaveLength"`
 	MaxIHaveMessages          int           `yaml:"maxIHaveMessages"`
 	IWantFollowupTime         time.Duration `yaml:"iWantFollowupTime"`
+	IDontWantMessageThreshold int           `yaml:"iDontWantMessageThreshold"`
+	IDontWantMessageTTL       int           `yaml:"iDontWantMessageTTL"`
+	MaxIDontWantMessages      int           `yaml:"maxIDontWantMessages"`
+	MaxIDontWantLength        int           `yaml:"maxIDontWantLength"`
+	PeerOutboundQueueSize     int           `yaml:"peerOutboundQueueSize"`
+	HighPriorityBitmasks      []string      `yaml:"highPriorityBitmasks"`
+	SubscriptionQueueSize     int           `yaml:"subscriptionQueueSize"`
+	SubscriptionOverflow      string        `yaml:"subscriptionOverflow"`
 	BootstrapPeers            []string      `yaml:"bootstrapPeers"`
 	ListenMultiaddr           string        `yaml:"listenMultiaddr"`
 	PeerPrivKey               string        `yaml:"peerPrivKey"`
 	TraceLogFile              string        `yaml:"traceLogFile"`
+	TraceRemotePeer           string        `yaml:"traceRemotePeer"`
 	MinPeers                  int           `yaml:"minPeers"`
 	Network                   uint8         `yaml:"network"`
 	LowWatermarkConnections   uint          `yaml:"lowWatermarkConnections"`
 	HighWatermarkConnections  uint          `yaml:"highWatermarkConnections"`
 	DirectPeers               []string      `yaml:"directPeers"`
+	PeerstoreRetention        time.Duration `yaml:"peerstoreRetention"`
+	PeerstoreRedialCount      int           `yaml:"peerstoreRedialCount"`
+	ForceReachability         string        `yaml:"forceReachability"`
+	DisableAutoNATService     bool          `yaml:"disableAutoNATService"`
+	AutoNATServiceGlobalLimit int           `yaml:"autoNATServiceGlobalLimit"`
+	AutoNATServicePeerLimit   int           `yaml:"autoNATServicePeerLimit"`
+	DisableAutoRelay          bool          `yaml:"disableAutoRelay"`
+	AutoRelayNumRelays        int           `yaml:"autoRelayNumRelays"`
+	DisableHolePunching       bool          `yaml:"disableHolePunching"`
+	EnableRelayService        bool          `yaml:"enableRelayService"`
+	RelayMaxReservations      int           `yaml:"relayMaxReservations"`
+	RelayMaxCircuits          int           `yaml:"relayMaxCircuits"`
+	RelayReservationTTL       time.Duration `yaml:"relayReservationTTL"`
+	RelayLimitDuration        time.Duration `yaml:"relayLimitDuration"`
+	RelayLimitData            int64         `yaml:"relayLimitData"`
+	PrivateNetworkPSK         string        `yaml:"privateNetworkPSK"`
+	AllowedPeers              []string      `yaml:"allowedPeers"`
+	AllowedCIDRs              []string      `yaml:"allowedCIDRs"`
+	Score                     *ScoreConfig  `yaml:"score"`
+	// DirectChannels overrides the policies of direct channels by purpose.
+	DirectChannels map[string]*DirectChannelConfig `yaml:"directChannels"`
+}
+
+// DirectChannelConfig overrides the non-zero values of a direct channel's
+// policy. Access is one of anyone, known-peers or provers.
+type DirectChannelConfig struct {
+	Access                string  `yaml:"access"`
+	RequestsPerSecond     float64 `yaml:"requestsPerSecond"`
+	RequestBurst          int     `yaml:"requestBurst"`
+	MaxConcurrentRequests int     `yaml:"maxConcurrentRequests"`
+	MaxMessageSize        int     `yaml:"maxMessageSize"`
+}
+
+// ScoreConfig configures blossomsub peer scoring. Global parameters and
+// thresholds left unset fall back to the node's defaults, so they can be set
+// to zero.
+type ScoreConfig struct {
+	BitmaskScoreCap             *float64              `yaml:"bitmaskScoreCap"`
+	AppSpecificWeight           *float64              `yaml:"appSpecificWeight"`
+	IPColocationFactorWeight    *float64              `yaml:"ipColocationFactorWeight"`
+	IPColocationFactorThreshold *int                  `yaml:"ipColocationFactorThreshold"`
+	BehaviourPenaltyWeight      *float64              `yaml:"behaviourPenaltyWeight"`
+	BehaviourPenaltyThreshold   *float64              `yaml:"behaviourPenaltyThreshold"`
+	BehaviourPenaltyDecay       *float64              `yaml:"behaviourPenaltyDecay"`
+	DecayInterval               *time.Duration        `yaml:"decayInterval"`
+	DecayToZero                 *float64              `yaml:"decayToZero"`
+	RetainScore                 *time.Duration        `yaml:"retainScore"`
+	GossipThreshold             *float64              `yaml:"gossipThreshold"`
+	PublishThreshold            *float64              `yaml:"publishThreshold"`
+	GraylistThreshold           *float64              `yaml:"graylistThreshold"`
+	AcceptPXThreshold           *float64              `yaml:"acceptPXThreshold"`
+	OpportunisticGraftThreshold *float64              `yaml:"opportunisticGraftThreshold"`
+	Bitmasks                    []*BitmaskScoreConfig `yaml:"bitmasks"`
+}
+
+// BitmaskScoreConfig configures the scoring of a single bitmask, given in hex,
+// replacing the node's default scoring of the bitmask if any. Groups of
+// parameters left at zero are not scored.
+type BitmaskScoreConfig struct {
+	Bitmask                         string        `yaml:"bitmask"`
+	BitmaskWeight                   float64       `yaml:"bitmaskWeight"`
+	TimeInMeshWeight                float64       `yaml:"timeInMeshWeight"`
+	TimeInMeshQuantum               time.Duration `yaml:"timeInMeshQuantum"`
+	TimeInMeshCap                   float64       `yaml:"timeInMeshCap"`
+	FirstMessageDeliveriesWeight    float64       `yaml:"firstMessageDeliveriesWeight"`
+	FirstMessageDeliveriesDecay     float64       `yaml:"firstMessageDeliveriesDecay"`
+	FirstMessageDeliveriesCap       float64       `yaml:"firstMessageDeliveriesCap"`
+	MeshMessageDeliveriesWeight     float64       `yaml:"meshMessageDeliveriesWeight"`
+	MeshMessageDeliveriesDecay      float64       `yaml:"meshMessageDeliveriesDecay"`
+	MeshMessageDeliveriesCap        float64       `yaml:"meshMessageDeliveriesCap"`
+	MeshMessageDeliveriesThreshold  float64       `yaml:"meshMessageDeliveriesThreshold"`
+	MeshMessageDeliveriesWindow     time.Duration `yaml:"meshMessageDeliveriesWindow"`
+	MeshMessageDeliveriesActivation time.Duration `yaml:"meshMessageDeliveriesActivation"`
+	MeshFailurePenaltyWeight        float64       `yaml:"meshFailurePenaltyWeight"`
+	MeshFailurePenaltyDecay         float64       `yaml:"meshFailurePenaltyDecay"`
+	InvalidMessageDeliveriesWeight  float64       `yaml:"invalidMessageDeliveriesWeight"`
+	InvalidMessageDeliveriesDecay   float64       `yaml:"invalidMessageDeliveriesDecay"`
 }
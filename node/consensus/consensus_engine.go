@@ -46,4 +46,9 @@ type DataConsensusEngine interface {
 	) (crypto.Signer, keys.KeyType, []byte, []byte)
 	IsInProverTrie(key []byte) bool
 	GetPeerInfo() *protobufs.PeerInfoResponse
+	GetMempool(sender []byte) *protobufs.MempoolResponse
+	RestoreRequests(requests []*protobufs.TokenRequest)
+	GetForks() *protobufs.ForksResponse
+	GetReorgHistory(limit int) (*protobufs.ReorgHistoryResponse, error)
+	GetRewardProofs() *protobufs.RewardProofsResponse
 }
@@ -3,7 +3,6 @@ package data
 import (
 	"encoding/binary"
 	"strings"
-	"time"
 
 	"github.com/iden3/go-iden3-crypto/poseidon"
 	"github.com/pkg/errors"
@@ -33,7 +32,7 @@ func (e *DataClockConsensusEngine) publishProof(
 		zap.Uint64("frame_number", frame.FrameNumber),
 	)
 
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.clock.Now().UnixMilli()
 	msg := binary.BigEndian.AppendUint64([]byte{}, frame.FrameNumber)
 	msg = append(msg, config.GetVersion()...)
 	msg = binary.BigEndian.AppendUint64(msg, uint64(timestamp))
@@ -75,7 +74,9 @@ func (e *DataClockConsensusEngine) publishProof(
 		e.logger.Debug("error publishing message", zap.Error(err))
 	}
 
-	e.publishMessage(e.filter, frame)
+	if err := e.publishMessage(e.filter, frame); err != nil {
+		return errors.Wrap(err, "publish proof")
+	}
 
 	return nil
 }
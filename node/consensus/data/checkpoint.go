@@ -0,0 +1,715 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+var ErrInvalidCheckpoint = errors.New("invalid checkpoint")
+
+const (
+	// A checkpoint is streamed in responses of up to this many bytes, well
+	// under the message size limit of the sync channel.
+	checkpointMessageSize = 4 * 1024 * 1024
+	checkpointTimeout     = 5 * time.Minute
+)
+
+// checkpoint is a configured checkpoint with its selector and digests
+// decoded.
+type checkpoint struct {
+	frameNumber       uint64
+	selector          []byte
+	stateDigest       []byte
+	proverTriesDigest []byte
+}
+
+// servedCheckpoint is the checkpoint last served to a peer, kept so repeated
+// requests for it do not each load the coin state from the store.
+type servedCheckpoint struct {
+	frame       *protobufs.ClockFrame
+	proverTries [][]byte
+	stateDigest []byte
+	coins       []*protobufs.CheckpointCoin
+	proofs      []*protobufs.CheckpointPreCoinProof
+}
+
+// GetCheckpoint serves a checkpoint frame whose coin state the node holds to
+// a bootstrapping peer: the frame, its prover tries, the weak recursive proofs
+// linking it back to a frame the peer holds, and the coin state as of the
+// frame.
+func (e *DataClockConsensusEngine) GetCheckpoint(
+	request *protobufs.GetCheckpointRequest,
+	server protobufs.DataService_GetCheckpointServer,
+) error {
+	peerID, _ := p2p.DirectChannelPeerID(server.Context())
+	e.logger.Debug(
+		"received checkpoint request",
+		zap.String("peer_id", peerID.String()),
+		zap.Uint64("frame_number", request.FrameNumber),
+		zap.Uint64("known_frame_number", request.KnownFrameNumber),
+	)
+
+	served, err := e.loadCheckpoint(request.FrameNumber, request.Selector)
+	if err != nil {
+		return errors.Wrap(err, "get checkpoint")
+	}
+
+	recursiveProofs, err := e.recursiveProofs(
+		served.frame,
+		request.KnownFrameNumber,
+	)
+	if err != nil {
+		return errors.Wrap(err, "get checkpoint")
+	}
+
+	pager := newCheckpointPager(server, &protobufs.CheckpointResponse{
+		ClockFrame:  served.frame,
+		StateDigest: served.stateDigest,
+	})
+	for _, trie := range served.proverTries {
+		if err := pager.add(len(trie), func(r *protobufs.CheckpointResponse) {
+			r.ProverTries = append(r.ProverTries, trie)
+		}); err != nil {
+			return errors.Wrap(err, "get checkpoint")
+		}
+	}
+	for _, proof := range recursiveProofs {
+		if err := pager.add(len(proof), func(r *protobufs.CheckpointResponse) {
+			r.RecursiveProofs = append(r.RecursiveProofs, proof)
+		}); err != nil {
+			return errors.Wrap(err, "get checkpoint")
+		}
+	}
+	for _, coin := range served.coins {
+		if err := pager.add(
+			proto.Size(coin),
+			func(r *protobufs.CheckpointResponse) {
+				r.Coins = append(r.Coins, coin)
+			},
+		); err != nil {
+			return errors.Wrap(err, "get checkpoint")
+		}
+	}
+	for _, proof := range served.proofs {
+		if err := pager.add(
+			proto.Size(proof),
+			func(r *protobufs.CheckpointResponse) {
+				r.Proofs = append(r.Proofs, proof)
+			},
+		); err != nil {
+			return errors.Wrap(err, "get checkpoint")
+		}
+	}
+
+	return errors.Wrap(pager.flush(), "get checkpoint")
+}
+
+// checkpointPager packs the parts of a checkpoint into responses of up to
+// checkpointMessageSize bytes, sending each response once the next part would
+// not fit in it. A single part larger than that is sent on its own.
+type checkpointPager struct {
+	server   protobufs.DataService_GetCheckpointServer
+	response *protobufs.CheckpointResponse
+	size     int
+}
+
+func newCheckpointPager(
+	server protobufs.DataService_GetCheckpointServer,
+	first *protobufs.CheckpointResponse,
+) *checkpointPager {
+	return &checkpointPager{
+		server:   server,
+		response: first,
+		size:     proto.Size(first),
+	}
+}
+
+// add adds a part of the given encoded length to the pending response with
+// put, sending the pending response first if the part would overflow it.
+func (p *checkpointPager) add(
+	length int,
+	put func(response *protobufs.CheckpointResponse),
+) error {
+	// Each part is a length-delimited field with a one-byte tag.
+	size := 1 + protowire.SizeBytes(length)
+	if p.size != 0 && p.size+size > checkpointMessageSize {
+		if err := p.flush(); err != nil {
+			return errors.Wrap(err, "add")
+		}
+	}
+
+	put(p.response)
+	p.size += size
+	return nil
+}
+
+// flush sends the pending response, if it holds anything.
+func (p *checkpointPager) flush() error {
+	if p.size == 0 {
+		return nil
+	}
+
+	if err := p.server.Send(p.response); err != nil {
+		return errors.Wrap(err, "flush")
+	}
+
+	p.response = &protobufs.CheckpointResponse{}
+	p.size = 0
+	return nil
+}
+
+// loadCheckpoint returns the checkpoint frame with the selector, if it is on
+// the canonical chain and its coin state was snapshotted. The last checkpoint
+// loaded is cached, and loads are serialized, so the coin state is read from
+// the store once however many peers request it.
+func (e *DataClockConsensusEngine) loadCheckpoint(
+	frameNumber uint64,
+	selector []byte,
+) (*servedCheckpoint, error) {
+	frame, proverTries, err := e.clockStore.GetDataClockFrame(
+		e.filter,
+		frameNumber,
+		false,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "load checkpoint")
+	}
+
+	canonical, err := frame.GetSelector()
+	if err != nil {
+		return nil, errors.Wrap(err, "load checkpoint")
+	}
+
+	if canonical.Cmp(new(big.Int).SetBytes(selector)) != 0 {
+		return nil, errors.Wrap(
+			errors.New("checkpoint not on canonical chain"),
+			"load checkpoint",
+		)
+	}
+
+	selector = canonical.FillBytes(make([]byte, 32))
+
+	e.checkpointMx.Lock()
+	defer e.checkpointMx.Unlock()
+
+	if e.lastCheckpoint != nil &&
+		proto.Equal(e.lastCheckpoint.frame, frame) {
+		return e.lastCheckpoint, nil
+	}
+
+	coins, proofs, err := e.coinStore.GetCheckpointState(frameNumber, selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "load checkpoint")
+	}
+
+	stateDigest, err := checkpointStateDigest(coins, proofs)
+	if err != nil {
+		return nil, errors.Wrap(err, "load checkpoint")
+	}
+
+	serializedTries := [][]byte{}
+	for _, trie := range proverTries {
+		serialized, err := trie.Serialize()
+		if err != nil {
+			return nil, errors.Wrap(err, "load checkpoint")
+		}
+
+		serializedTries = append(serializedTries, serialized)
+	}
+
+	e.lastCheckpoint = &servedCheckpoint{
+		frame:       frame,
+		proverTries: serializedTries,
+		stateDigest: stateDigest,
+		coins:       coins,
+		proofs:      proofs,
+	}
+	return e.lastCheckpoint, nil
+}
+
+// recursiveProofs returns the weak recursive proofs of the frames sampled in
+// turn starting from the frame, until the sampled frame is at or before the
+// known frame number.
+func (e *DataClockConsensusEngine) recursiveProofs(
+	frame *protobufs.ClockFrame,
+	knownFrameNumber uint64,
+) ([][]byte, error) {
+	proofs := [][]byte{}
+	for {
+		index, err := e.frameProver.GenerateWeakRecursiveProofIndex(frame)
+		if err != nil {
+			return nil, errors.Wrap(err, "recursive proofs")
+		}
+
+		if index <= knownFrameNumber {
+			return proofs, nil
+		}
+
+		frame, _, err = e.clockStore.GetDataClockFrame(e.filter, index, false)
+		if err != nil {
+			return nil, errors.Wrap(err, "recursive proofs")
+		}
+
+		proof := e.frameProver.FetchRecursiveProof(frame)
+		if proof == nil {
+			return nil, errors.Wrap(
+				errors.New("frame has no recursive proof"),
+				"recursive proofs",
+			)
+		}
+
+		proofs = append(proofs, proof)
+	}
+}
+
+// checkpointStateDigest hashes the coin state of a checkpoint, in the address
+// order the coin store returns it in.
+func checkpointStateDigest(
+	coins []*protobufs.CheckpointCoin,
+	proofs []*protobufs.CheckpointPreCoinProof,
+) ([]byte, error) {
+	digest := sha3.New256()
+	marshal := proto.MarshalOptions{Deterministic: true}
+	for _, c := range coins {
+		coinBytes, err := marshal.Marshal(c.Coin)
+		if err != nil {
+			return nil, errors.Wrap(err, "checkpoint state digest")
+		}
+
+		digest.Write(c.Address)
+		digest.Write(binary.BigEndian.AppendUint64(nil, c.FrameNumber))
+		digest.Write(coinBytes)
+	}
+
+	for _, p := range proofs {
+		proofBytes, err := marshal.Marshal(p.Proof)
+		if err != nil {
+			return nil, errors.Wrap(err, "checkpoint state digest")
+		}
+
+		digest.Write(p.Address)
+		digest.Write(binary.BigEndian.AppendUint64(nil, p.FrameNumber))
+		digest.Write(proofBytes)
+	}
+
+	return digest.Sum(nil), nil
+}
+
+// checkpointProverTriesDigest hashes the serialized prover tries of a
+// checkpoint, in order.
+func checkpointProverTriesDigest(proverTries [][]byte) []byte {
+	digest := sha3.New256()
+	for _, trie := range proverTries {
+		digest.Write(binary.BigEndian.AppendUint64(nil, uint64(len(trie))))
+		digest.Write(trie)
+	}
+
+	return digest.Sum(nil)
+}
+
+// nextCheckpoint returns the latest configured checkpoint, if it is further
+// ahead of the frame number than a single sync covers.
+func (e *DataClockConsensusEngine) nextCheckpoint(
+	frameNumber uint64,
+) *checkpoint {
+	var next *config.Checkpoint
+	for i, c := range e.config.Engine.Checkpoints {
+		if next == nil || c.FrameNumber > next.FrameNumber {
+			next = &e.config.Engine.Checkpoints[i]
+		}
+	}
+
+	if next == nil || next.FrameNumber <= frameNumber+maxSyncFrames {
+		return nil
+	}
+
+	selector, err := hex.DecodeString(next.Selector)
+	if err != nil {
+		e.logger.Error(
+			"invalid checkpoint selector",
+			zap.Uint64("frame_number", next.FrameNumber),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	c := &checkpoint{
+		frameNumber: next.FrameNumber,
+		selector:    selector,
+	}
+	for _, digest := range []struct {
+		hex     string
+		decoded *[]byte
+	}{
+		{next.StateDigest, &c.stateDigest},
+		{next.ProverTriesDigest, &c.proverTriesDigest},
+	} {
+		// The coin state and prover tries are only trusted against configured
+		// digests, digests supplied by peers could come from Sybils.
+		if digest.hex == "" {
+			e.logger.Error(
+				"checkpoint digest not configured",
+				zap.Uint64("frame_number", next.FrameNumber),
+			)
+			return nil
+		}
+
+		decoded, err := hex.DecodeString(digest.hex)
+		if err != nil {
+			e.logger.Error(
+				"invalid checkpoint digest",
+				zap.Uint64("frame_number", next.FrameNumber),
+				zap.Error(err),
+			)
+			return nil
+		}
+
+		*digest.decoded = decoded
+	}
+
+	return c
+}
+
+// bootstrap jumps from the latest frame to the checkpoint, fetching it from
+// the peers that have reached it, best reputation first. Its coin state and
+// prover tries must match the configured digests. It returns the checkpoint
+// frame, or the latest frame if no peer served a valid checkpoint.
+func (e *DataClockConsensusEngine) bootstrap(
+	latest *protobufs.ClockFrame,
+	c *checkpoint,
+) *protobufs.ClockFrame {
+	peers, err := e.GetMostAheadPeers(c.frameNumber - 1)
+	if err != nil {
+		return latest
+	}
+
+	peerIds := [][]byte{}
+	for _, p := range peers {
+		peerIds = append(peerIds, p.PeerId)
+	}
+	peerIds = e.reputations.Rank(peerIds)
+
+	e.logger.Info(
+		"bootstrapping from checkpoint",
+		zap.Uint64("frame_number", c.frameNumber),
+		zap.Uint64("current_head_frame", latest.FrameNumber),
+		zap.Int("peers", len(peerIds)),
+	)
+
+	for _, peerId := range peerIds {
+		response, proverTries, err := e.fetchCheckpoint(
+			peerId,
+			c,
+			latest.FrameNumber,
+		)
+		if err != nil {
+			e.logger.Info(
+				"could not fetch checkpoint from peer",
+				zap.Binary("peer_id", peerId),
+				zap.Error(err),
+			)
+			if errors.Is(err, ErrInvalidCheckpoint) {
+				e.reputations.RecordInvalid(peerId)
+			} else {
+				e.reputations.RecordTimeout(peerId)
+			}
+			continue
+		}
+
+		// The verified frame carries the selector at its full length, as the
+		// snapshots are keyed with.
+		selector, err := response.ClockFrame.GetSelector()
+		if err != nil {
+			e.logger.Error("could not bootstrap from checkpoint", zap.Error(err))
+			return latest
+		}
+
+		if err := e.dataTimeReel.Bootstrap(
+			response.ClockFrame,
+			proverTries,
+			func() error {
+				return e.coinStore.RestoreCheckpointState(
+					c.frameNumber,
+					selector.FillBytes(make([]byte, 32)),
+					response.Coins,
+					response.Proofs,
+				)
+			},
+		); err != nil {
+			e.logger.Error("could not bootstrap from checkpoint", zap.Error(err))
+			return latest
+		}
+
+		e.frameProverTriesMx.Lock()
+		e.frameProverTries = proverTries
+		e.frameProverTriesMx.Unlock()
+
+		return response.ClockFrame
+	}
+
+	return latest
+}
+
+// fetchCheckpoint fetches the checkpoint and its coin state from the peer,
+// and verifies them against the frame and digests of the checkpoint. The
+// coins and proofs of all batches are gathered in the returned response.
+func (e *DataClockConsensusEngine) fetchCheckpoint(
+	peerId []byte,
+	c *checkpoint,
+	knownFrameNumber uint64,
+) (
+	*protobufs.CheckpointResponse,
+	[]*tries.RollingFrecencyCritbitTrie,
+	error,
+) {
+	responses, err := e.requestCheckpoint(
+		peerId,
+		&protobufs.GetCheckpointRequest{
+			FrameNumber:      c.frameNumber,
+			Selector:         c.selector,
+			KnownFrameNumber: knownFrameNumber,
+		},
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "fetch checkpoint")
+	}
+
+	response := responses[0]
+	for _, r := range responses[1:] {
+		response.ProverTries = append(response.ProverTries, r.ProverTries...)
+		response.RecursiveProofs = append(
+			response.RecursiveProofs,
+			r.RecursiveProofs...,
+		)
+		response.Coins = append(response.Coins, r.Coins...)
+		response.Proofs = append(response.Proofs, r.Proofs...)
+	}
+
+	if err := e.verifyCheckpointFrame(
+		response,
+		c,
+		knownFrameNumber,
+	); err != nil {
+		return nil, nil, errors.Wrap(err, "fetch checkpoint")
+	}
+
+	proverTries := []*tries.RollingFrecencyCritbitTrie{}
+	for _, serialized := range response.ProverTries {
+		trie := &tries.RollingFrecencyCritbitTrie{}
+		if err := trie.Deserialize(serialized); err != nil {
+			return nil, nil, errors.Wrap(
+				errors.Wrap(ErrInvalidCheckpoint, err.Error()),
+				"fetch checkpoint",
+			)
+		}
+
+		proverTries = append(proverTries, trie)
+	}
+
+	if len(proverTries) == 0 {
+		return nil, nil, errors.Wrap(
+			errors.Wrap(ErrInvalidCheckpoint, "no prover tries"),
+			"fetch checkpoint",
+		)
+	}
+
+	if !bytes.Equal(
+		checkpointProverTriesDigest(response.ProverTries),
+		c.proverTriesDigest,
+	) {
+		return nil, nil, errors.Wrap(
+			errors.Wrap(ErrInvalidCheckpoint, "prover tries digest mismatch"),
+			"fetch checkpoint",
+		)
+	}
+
+	digest, err := checkpointStateDigest(response.Coins, response.Proofs)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "fetch checkpoint")
+	}
+
+	if !bytes.Equal(digest, c.stateDigest) {
+		return nil, nil, errors.Wrap(
+			errors.Wrap(ErrInvalidCheckpoint, "state digest mismatch"),
+			"fetch checkpoint",
+		)
+	}
+
+	return response, proverTries, nil
+}
+
+func (e *DataClockConsensusEngine) requestCheckpoint(
+	peerId []byte,
+	request *protobufs.GetCheckpointRequest,
+) ([]*protobufs.CheckpointResponse, error) {
+	cc, err := e.pubSub.GetDirectChannel(peerId, "sync")
+	if err != nil {
+		return nil, errors.Wrap(err, "request checkpoint")
+	}
+	defer cc.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkpointTimeout)
+	defer cancel()
+
+	stream, err := protobufs.NewDataServiceClient(cc).GetCheckpoint(
+		ctx,
+		request,
+		grpc.MaxCallRecvMsgSize(p2p.DefaultDirectChannelMaxMessageSize),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "request checkpoint")
+	}
+
+	responses := []*protobufs.CheckpointResponse{}
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, errors.Wrap(err, "request checkpoint")
+		}
+
+		responses = append(responses, response)
+	}
+
+	if len(responses) == 0 || responses[0].ClockFrame == nil {
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidCheckpoint, "no checkpoint frame"),
+			"request checkpoint",
+		)
+	}
+
+	return responses, nil
+}
+
+// verifyCheckpointFrame checks that the frame is the configured checkpoint,
+// that its proofs hold, and that the weak recursive proofs link it back to a
+// frame held locally.
+func (e *DataClockConsensusEngine) verifyCheckpointFrame(
+	response *protobufs.CheckpointResponse,
+	c *checkpoint,
+	knownFrameNumber uint64,
+) error {
+	frame := response.ClockFrame
+	if frame.FrameNumber != c.frameNumber ||
+		!bytes.Equal(frame.Filter, e.filter) {
+		return errors.Wrap(
+			errors.Wrap(ErrInvalidCheckpoint, "unexpected frame"),
+			"verify checkpoint frame",
+		)
+	}
+
+	selector, err := frame.GetSelector()
+	if err != nil || selector.Cmp(new(big.Int).SetBytes(c.selector)) != 0 {
+		return errors.Wrap(
+			errors.Wrap(ErrInvalidCheckpoint, "selector mismatch"),
+			"verify checkpoint frame",
+		)
+	}
+
+	if err := e.frameProver.VerifyDataClockFrame(frame); err != nil {
+		return errors.Wrap(
+			errors.Wrap(ErrInvalidCheckpoint, err.Error()),
+			"verify checkpoint frame",
+		)
+	}
+
+	current := frame
+	for _, proof := range response.RecursiveProofs {
+		if !e.frameProver.VerifyWeakRecursiveProof(current, proof, nil) {
+			return errors.Wrap(
+				errors.Wrap(ErrInvalidCheckpoint, "invalid recursive proof"),
+				"verify checkpoint frame",
+			)
+		}
+
+		current = sampledFrame(current.Filter, proof)
+		if current.FrameNumber <= knownFrameNumber {
+			return errors.Wrap(
+				errors.Wrap(ErrInvalidCheckpoint, "recursive proof past known frame"),
+				"verify checkpoint frame",
+			)
+		}
+	}
+
+	index, err := e.frameProver.GenerateWeakRecursiveProofIndex(current)
+	if err != nil {
+		return errors.Wrap(err, "verify checkpoint frame")
+	}
+
+	if index > knownFrameNumber {
+		return errors.Wrap(
+			errors.Wrap(ErrInvalidCheckpoint, "recursive proofs incomplete"),
+			"verify checkpoint frame",
+		)
+	}
+
+	known, _, err := e.clockStore.GetDataClockFrame(e.filter, index, false)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return errors.Wrap(
+				errors.New("sampled frame not held"),
+				"verify checkpoint frame",
+			)
+		}
+
+		return errors.Wrap(err, "verify checkpoint frame")
+	}
+
+	// The genesis frame carries no signature, and so has no recursive proof,
+	// but it is derived locally from the genesis seed.
+	proof := e.frameProver.FetchRecursiveProof(known)
+	if proof == nil && index == 0 {
+		return nil
+	}
+
+	if proof == nil ||
+		!e.frameProver.VerifyWeakRecursiveProof(current, proof, known) {
+		return errors.Wrap(
+			errors.Wrap(ErrInvalidCheckpoint, "does not link to known frame"),
+			"verify checkpoint frame",
+		)
+	}
+
+	return nil
+}
+
+// sampledFrame reconstructs from a verified weak recursive proof the fields of
+// the frame it covers that the proof of the next sampled frame depends on.
+func sampledFrame(filter []byte, proof []byte) *protobufs.ClockFrame {
+	offset := len(filter)
+	input := proof[offset+52:]
+	return &protobufs.ClockFrame{
+		Filter: filter,
+		FrameNumber: binary.BigEndian.Uint64(
+			proof[offset : offset+8],
+		),
+		Timestamp: int64(binary.BigEndian.Uint64(
+			proof[offset+8 : offset+16],
+		)),
+		Difficulty: binary.BigEndian.Uint32(
+			proof[offset+16 : offset+20],
+		),
+		Input:  input[:len(input)-516],
+		Output: input[len(input)-516:],
+	}
+}
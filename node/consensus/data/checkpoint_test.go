@@ -0,0 +1,296 @@
+package data
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/ed448"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// checkpointChain commits a chain of frames from genesis to the clock store
+// of the engine, and returns it.
+func checkpointChain(
+	t *testing.T,
+	e *DataClockConsensusEngine,
+	length int,
+) []*protobufs.ClockFrame {
+	pub, key, err := ed448.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	genesis, proverTries, err := e.frameProver.CreateDataGenesisFrame(
+		e.filter,
+		bytes.Repeat([]byte{0x00}, 516),
+		10,
+		&qcrypto.InclusionAggregateProof{
+			InclusionCommitments: []*qcrypto.InclusionCommitment{},
+			AggregateCommitment:  []byte{},
+			Proof:                []byte{},
+		},
+		[][]byte{pub},
+	)
+	require.NoError(t, err)
+
+	frames := []*protobufs.ClockFrame{genesis}
+	for len(frames) <= length {
+		parent := frames[len(frames)-1]
+		frame, err := e.frameProver.ProveDataClockFrame(
+			parent,
+			[][]byte{},
+			[]*protobufs.InclusionAggregateProof{},
+			key,
+			parent.Timestamp+1,
+			10,
+		)
+		require.NoError(t, err)
+		frames = append(frames, frame)
+	}
+
+	for _, frame := range frames {
+		selector, err := frame.GetSelector()
+		require.NoError(t, err)
+
+		txn, err := e.clockStore.NewTransaction()
+		require.NoError(t, err)
+		require.NoError(t, e.clockStore.StageDataClockFrame(
+			selector.FillBytes(make([]byte, 32)),
+			frame,
+			txn,
+		))
+		require.NoError(t, e.clockStore.CommitDataClockFrame(
+			e.filter,
+			frame.FrameNumber,
+			selector.FillBytes(make([]byte, 32)),
+			proverTries,
+			txn,
+			false,
+		))
+		require.NoError(t, txn.Commit())
+	}
+
+	return frames
+}
+
+func TestVerifyCheckpointFrame(t *testing.T) {
+	logger := zap.NewNop()
+	e := &DataClockConsensusEngine{
+		logger:      logger,
+		filter:      bytes.Repeat([]byte{0xff}, 32),
+		clockStore:  store.NewPebbleClockStore(store.NewInMemKVDB(), logger),
+		frameProver: qcrypto.NewWesolowskiFrameProver(logger),
+	}
+	frames := checkpointChain(t, e, 32)
+
+	// The checkpoint is the latest frame whose proofs reach back to genesis
+	// through at least one sampled frame.
+	var (
+		c      *checkpoint
+		proofs [][]byte
+	)
+	for i := len(frames) - 1; i > 0 && c == nil; i-- {
+		var err error
+		proofs, err = e.recursiveProofs(frames[i], 0)
+		require.NoError(t, err)
+		if len(proofs) == 0 {
+			continue
+		}
+
+		selector, err := frames[i].GetSelector()
+		require.NoError(t, err)
+		c = &checkpoint{
+			frameNumber: frames[i].FrameNumber,
+			selector:    selector.FillBytes(make([]byte, 32)),
+		}
+	}
+	require.NotNil(t, c)
+
+	response := func() *protobufs.CheckpointResponse {
+		return &protobufs.CheckpointResponse{
+			ClockFrame: proto.Clone(
+				frames[c.frameNumber],
+			).(*protobufs.ClockFrame),
+			RecursiveProofs: append([][]byte{}, proofs...),
+		}
+	}
+
+	require.NoError(t, e.verifyCheckpointFrame(response(), c, 0))
+
+	wrongFrame := response()
+	wrongFrame.ClockFrame = frames[c.frameNumber-1]
+	assert.ErrorIs(
+		t,
+		e.verifyCheckpointFrame(wrongFrame, c, 0),
+		ErrInvalidCheckpoint,
+	)
+
+	wrongSelector := &checkpoint{
+		frameNumber: c.frameNumber,
+		selector:    bytes.Repeat([]byte{0x01}, 32),
+	}
+	assert.ErrorIs(
+		t,
+		e.verifyCheckpointFrame(response(), wrongSelector, 0),
+		ErrInvalidCheckpoint,
+	)
+
+	forged := response()
+	forged.ClockFrame.Output[0] ^= 0xff
+	assert.ErrorIs(
+		t,
+		e.verifyCheckpointFrame(forged, c, 0),
+		ErrInvalidCheckpoint,
+	)
+
+	tampered := response()
+	tampered.RecursiveProofs[0] = append([]byte{}, proofs[0]...)
+	tampered.RecursiveProofs[0][len(proofs[0])-1] ^= 0xff
+	assert.ErrorIs(
+		t,
+		e.verifyCheckpointFrame(tampered, c, 0),
+		ErrInvalidCheckpoint,
+	)
+
+	incomplete := response()
+	incomplete.RecursiveProofs = incomplete.RecursiveProofs[:len(proofs)-1]
+	assert.ErrorIs(
+		t,
+		e.verifyCheckpointFrame(incomplete, c, 0),
+		ErrInvalidCheckpoint,
+	)
+}
+
+func TestCheckpointProverTriesDigest(t *testing.T) {
+	digest := checkpointProverTriesDigest([][]byte{{0x01, 0x02}, {0x03}})
+	assert.Equal(
+		t,
+		digest,
+		checkpointProverTriesDigest([][]byte{{0x01, 0x02}, {0x03}}),
+	)
+	assert.NotEqual(
+		t,
+		digest,
+		checkpointProverTriesDigest([][]byte{{0x01}, {0x02, 0x03}}),
+	)
+	assert.NotEqual(
+		t,
+		digest,
+		checkpointProverTriesDigest([][]byte{{0x03}, {0x01, 0x02}}),
+	)
+}
+
+func TestNextCheckpoint(t *testing.T) {
+	selector := bytes.Repeat([]byte{0x01}, 32)
+	digest := bytes.Repeat([]byte{0x02}, 32)
+	e := &DataClockConsensusEngine{
+		logger: zap.NewNop(),
+		config: &config.Config{Engine: &config.EngineConfig{}},
+	}
+	configure := func(checkpoints ...config.Checkpoint) {
+		e.config.Engine.Checkpoints = checkpoints
+	}
+
+	configure(config.Checkpoint{
+		FrameNumber:       maxSyncFrames + 1,
+		Selector:          hex.EncodeToString(selector),
+		StateDigest:       hex.EncodeToString(digest),
+		ProverTriesDigest: hex.EncodeToString(digest),
+	})
+	assert.Nil(t, e.nextCheckpoint(1))
+	assert.Equal(t, &checkpoint{
+		frameNumber:       maxSyncFrames + 1,
+		selector:          selector,
+		stateDigest:       digest,
+		proverTriesDigest: digest,
+	}, e.nextCheckpoint(0))
+
+	configure(config.Checkpoint{
+		FrameNumber:       2 * maxSyncFrames,
+		Selector:          hex.EncodeToString(selector),
+		ProverTriesDigest: hex.EncodeToString(digest),
+	})
+	assert.Nil(t, e.nextCheckpoint(0))
+
+	configure(config.Checkpoint{
+		FrameNumber: 2 * maxSyncFrames,
+		Selector:    hex.EncodeToString(selector),
+		StateDigest: hex.EncodeToString(digest),
+	})
+	assert.Nil(t, e.nextCheckpoint(0))
+}
+
+// checkpointStream records the responses sent by GetCheckpoint.
+type checkpointStream struct {
+	protobufs.DataService_GetCheckpointServer
+	responses []*protobufs.CheckpointResponse
+}
+
+func (s *checkpointStream) Send(response *protobufs.CheckpointResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func TestCheckpointPager(t *testing.T) {
+	stream := &checkpointStream{}
+	first := &protobufs.CheckpointResponse{
+		ClockFrame:  &protobufs.ClockFrame{FrameNumber: 1},
+		StateDigest: bytes.Repeat([]byte{0x01}, 32),
+	}
+	pager := newCheckpointPager(stream, first)
+
+	tries := [][]byte{}
+	for i := 0; i < 3; i++ {
+		trie := bytes.Repeat([]byte{byte(i)}, 3*1024*1024)
+		tries = append(tries, trie)
+		require.NoError(t, pager.add(
+			len(trie),
+			func(r *protobufs.CheckpointResponse) {
+				r.ProverTries = append(r.ProverTries, trie)
+			},
+		))
+	}
+	coins := []*protobufs.CheckpointCoin{}
+	for i := 0; i < 100000; i++ {
+		coin := &protobufs.CheckpointCoin{
+			Address:     bytes.Repeat([]byte{byte(i)}, 32),
+			Coin:        &protobufs.Coin{Amount: []byte{0x01}},
+			FrameNumber: uint64(i),
+		}
+		coins = append(coins, coin)
+		require.NoError(t, pager.add(
+			proto.Size(coin),
+			func(r *protobufs.CheckpointResponse) {
+				r.Coins = append(r.Coins, coin)
+			},
+		))
+	}
+	require.NoError(t, pager.flush())
+
+	require.Greater(t, len(stream.responses), 3)
+	assert.Same(t, first, stream.responses[0])
+	gotTries := [][]byte{}
+	gotCoins := []*protobufs.CheckpointCoin{}
+	for i, response := range stream.responses {
+		assert.LessOrEqual(t, proto.Size(response), checkpointMessageSize)
+		if i != 0 {
+			assert.Nil(t, response.ClockFrame)
+		}
+		gotTries = append(gotTries, response.ProverTries...)
+		gotCoins = append(gotCoins, response.Coins...)
+	}
+	assert.Equal(t, tries, gotTries)
+	assert.Equal(t, coins, gotCoins)
+
+	// Nothing is left pending once flushed.
+	sent := len(stream.responses)
+	require.NoError(t, pager.flush())
+	assert.Len(t, stream.responses, sent)
+}
@@ -3,6 +3,7 @@ package data
 import (
 	"bytes"
 	"context"
+	"sort"
 	"time"
 
 	"golang.org/x/crypto/sha3"
@@ -12,40 +13,62 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/framesync"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 )
 
+// maxSyncFrames bounds the frames synced before collect returns the latest
+// frame, so the caller gets to act on progress made during a long catch-up.
+const maxSyncFrames = 1000
+
+// maxSyncAttempts bounds the rounds of sync collect makes before returning
+// to the caller without progress.
+const maxSyncAttempts = 3
+
 func (e *DataClockConsensusEngine) collect(
 	enqueuedFrame *protobufs.ClockFrame,
 ) (*protobufs.ClockFrame, error) {
 	e.logger.Info("collecting vdf proofs")
 
 	latest := enqueuedFrame
+	if c := e.nextCheckpoint(latest.FrameNumber); c != nil {
+		latest = e.bootstrap(latest, c)
+	}
+
+	var syncErr error
+	for attempt := 0; attempt < maxSyncAttempts; attempt++ {
+		if attempt != 0 {
+			e.logger.Info(
+				"could not sync frames from peers, retrying",
+				zap.Error(syncErr),
+			)
+			e.clock.Sleep(time.Second)
+		}
+
+		peers, err := e.GetMostAheadPeers(latest.FrameNumber)
+		if err != nil {
+			syncErr = nil
+			break
+		}
+
+		e.syncingStatus = SyncStatusSynchronizing
+		maxFrame := peers[0].MaxFrame
+		if maxFrame-latest.FrameNumber > maxSyncFrames {
+			maxFrame = latest.FrameNumber + maxSyncFrames
+		}
 
-	for {
-		peerId, maxFrame, err := e.GetMostAheadPeer(latest.FrameNumber)
-		if maxFrame > latest.FrameNumber {
-			e.syncingStatus = SyncStatusSynchronizing
-			if err != nil {
-				e.logger.Info("no peers available for sync, waiting")
-				time.Sleep(5 * time.Second)
-			} else if maxFrame > latest.FrameNumber {
-				if maxFrame-latest.FrameNumber > 100 {
-					maxFrame = latest.FrameNumber + 100
-				}
-				latest, err = e.sync(latest, maxFrame, peerId)
-				if err == nil {
-					break
-				}
-			}
-		} else {
+		latest, syncErr = e.sync(latest, maxFrame, peers)
+		if syncErr == nil {
 			break
 		}
 	}
 
 	e.syncingStatus = SyncStatusNotSyncing
+	if syncErr != nil {
+		return latest, errors.Wrap(syncErr, "collect")
+	}
 
 	e.logger.Info(
 		"returning leader frame",
@@ -58,7 +81,6 @@ func (e *DataClockConsensusEngine) collect(
 func (e *DataClockConsensusEngine) prove(
 	previousFrame *protobufs.ClockFrame,
 ) (*protobufs.ClockFrame, error) {
-	e.stagedTransactionsMx.Lock()
 	executionOutput := &protobufs.IntrinsicExecutionOutput{}
 	app, err := application.MaterializeApplicationFromFrame(
 		e.provingKey,
@@ -68,29 +90,23 @@ func (e *DataClockConsensusEngine) prove(
 		e.logger,
 	)
 	if err != nil {
-		e.stagedTransactionsMx.Unlock()
 		return nil, errors.Wrap(err, "prove")
 	}
 
-	if e.stagedTransactions == nil {
-		e.stagedTransactions = &protobufs.TokenRequests{}
-	}
-
+	pending := e.mempool.Requests()
 	e.logger.Info(
 		"proving new frame",
-		zap.Int("transactions", len(e.stagedTransactions.Requests)),
+		zap.Int("transactions", len(pending.Requests)),
 	)
 
 	var validTransactions *protobufs.TokenRequests
 	var invalidTransactions *protobufs.TokenRequests
 	app, validTransactions, invalidTransactions, err = app.ApplyTransitions(
 		previousFrame.FrameNumber,
-		e.stagedTransactions,
+		pending,
 		true,
 	)
 	if err != nil {
-		e.stagedTransactions = &protobufs.TokenRequests{}
-		e.stagedTransactionsMx.Unlock()
 		return nil, errors.Wrap(err, "prove")
 	}
 
@@ -99,8 +115,12 @@ func (e *DataClockConsensusEngine) prove(
 		zap.Int("successful", len(validTransactions.Requests)),
 		zap.Int("failed", len(invalidTransactions.Requests)),
 	)
-	e.stagedTransactions = &protobufs.TokenRequests{}
-	e.stagedTransactionsMx.Unlock()
+
+	// Valid requests stay in the mempool until a frame including them is
+	// received, in case this frame does not become the head.
+	if err := e.mempool.Remove(invalidTransactions.Requests); err != nil {
+		e.logger.Error("could not remove invalid transactions", zap.Error(err))
+	}
 
 	outputState, err := app.MaterializeStateFromApplication()
 	if err != nil {
@@ -185,7 +205,7 @@ func (e *DataClockConsensusEngine) prove(
 			},
 		},
 		e.provingKey,
-		time.Now().UnixMilli(),
+		e.clock.Now().UnixMilli(),
 		e.difficulty,
 	)
 	if err != nil {
@@ -200,11 +220,12 @@ func (e *DataClockConsensusEngine) prove(
 	return frame, nil
 }
 
-func (e *DataClockConsensusEngine) GetMostAheadPeer(
+// GetMostAheadPeers returns the peers eligible for sync that are ahead of the
+// frame number, most ahead first. Provers do not sync.
+func (e *DataClockConsensusEngine) GetMostAheadPeers(
 	frameNumber uint64,
 ) (
-	[]byte,
-	uint64,
+	[]framesync.Peer,
 	error,
 ) {
 	e.logger.Debug(
@@ -215,11 +236,10 @@ func (e *DataClockConsensusEngine) GetMostAheadPeer(
 	)
 
 	if e.GetFrameProverTries()[0].Contains(e.provingKeyAddress) {
-		return e.pubSub.GetPeerID(), frameNumber, nil
+		return nil, p2p.ErrNoPeersAvailable
 	}
 
-	max := frameNumber
-	var peer []byte = nil
+	peers := []framesync.Peer{}
 	e.peerMapMx.RLock()
 	for _, v := range e.peerMap {
 		e.logger.Debug(
@@ -230,126 +250,116 @@ func (e *DataClockConsensusEngine) GetMostAheadPeer(
 			zap.Binary("version", v.version),
 		)
 		_, ok := e.uncooperativePeersMap[string(v.peerId)]
-		if v.maxFrame > max &&
+		if v.maxFrame > frameNumber &&
 			v.timestamp > config.GetMinimumVersionCutoff().UnixMilli() &&
 			bytes.Compare(v.version, config.GetMinimumVersion()) >= 0 && !ok {
-			peer = v.peerId
-			max = v.maxFrame
+			peers = append(peers, framesync.Peer{
+				PeerId:   v.peerId,
+				MaxFrame: v.maxFrame,
+			})
 		}
 	}
 	e.peerMapMx.RUnlock()
 
-	if peer == nil {
-		return nil, 0, p2p.ErrNoPeersAvailable
+	if len(peers) == 0 {
+		return nil, p2p.ErrNoPeersAvailable
 	}
 
-	return peer, max, nil
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].MaxFrame > peers[j].MaxFrame
+	})
+
+	return peers, nil
 }
 
+// sync fetches the frames after currentLatest up to maxFrame from the peers
+// and inserts them into the time reel, returning the latest frame inserted.
 func (e *DataClockConsensusEngine) sync(
 	currentLatest *protobufs.ClockFrame,
 	maxFrame uint64,
-	peerId []byte,
+	peers []framesync.Peer,
 ) (*protobufs.ClockFrame, error) {
-	latest := currentLatest
-	e.logger.Info("polling peer for new frames", zap.Binary("peer_id", peerId))
+	e.logger.Info(
+		"polling peers for new frames",
+		zap.Int("peers", len(peers)),
+		zap.Uint64("current_head_frame", currentLatest.FrameNumber),
+		zap.Uint64("max_frame_number", maxFrame),
+	)
+
+	frames := e.syncScheduler.Sync(
+		context.TODO(),
+		currentLatest,
+		maxFrame,
+		peers,
+	)
+	if len(frames) == 0 {
+		return currentLatest, errors.Wrap(
+			errors.New("no frames received"),
+			"sync",
+		)
+	}
+
+	for _, frame := range frames {
+		e.logger.Info(
+			"received new leading frame",
+			zap.Uint64("frame_number", frame.FrameNumber),
+		)
+		e.dataTimeReel.Insert(frame, true)
+	}
+
+	return frames[len(frames)-1], nil
+}
+
+// dialSyncPeer opens a direct channel to the peer for sync. Peers that cannot
+// be reached are set aside as uncooperative.
+func (e *DataClockConsensusEngine) dialSyncPeer(
+	peerId []byte,
+) (framesync.FrameSource, error) {
 	cc, err := e.pubSub.GetDirectChannel(peerId, "sync")
 	if err != nil {
-		e.logger.Debug(
-			"could not establish direct channel",
-			zap.Error(err),
-		)
 		e.peerMapMx.Lock()
 		if _, ok := e.peerMap[string(peerId)]; ok {
 			e.uncooperativePeersMap[string(peerId)] = e.peerMap[string(peerId)]
-			e.uncooperativePeersMap[string(peerId)].timestamp = time.Now().UnixMilli()
+			e.uncooperativePeersMap[string(peerId)].timestamp = e.clock.Now().UnixMilli()
 			delete(e.peerMap, string(peerId))
 		}
 		e.peerMapMx.Unlock()
-		return latest, errors.Wrap(err, "sync")
+		return nil, errors.Wrap(err, "dial sync peer")
 	}
 
-	client := protobufs.NewDataServiceClient(cc)
-
-	for {
-		response, err := client.GetDataFrame(
-			context.TODO(),
-			&protobufs.GetDataFrameRequest{
-				FrameNumber: latest.FrameNumber + 1,
-			},
-			grpc.MaxCallRecvMsgSize(600*1024*1024),
-		)
-		if err != nil {
-			e.logger.Debug(
-				"could not get frame",
-				zap.Error(err),
-			)
-			e.peerMapMx.Lock()
-			if _, ok := e.peerMap[string(peerId)]; ok {
-				e.uncooperativePeersMap[string(peerId)] = e.peerMap[string(peerId)]
-				e.uncooperativePeersMap[string(peerId)].timestamp = time.Now().UnixMilli()
-				delete(e.peerMap, string(peerId))
-			}
-			e.peerMapMx.Unlock()
-			if err := cc.Close(); err != nil {
-				e.logger.Error("error while closing connection", zap.Error(err))
-			}
-			return latest, errors.Wrap(err, "sync")
-		}
+	return &dataFrameSource{
+		cc:     cc,
+		client: protobufs.NewDataServiceClient(cc),
+	}, nil
+}
 
-		if response == nil {
-			e.logger.Debug("received no response from peer")
-			if err := cc.Close(); err != nil {
-				e.logger.Error("error while closing connection", zap.Error(err))
-			}
-			return latest, nil
-		}
+type dataFrameSource struct {
+	cc     *grpc.ClientConn
+	client protobufs.DataServiceClient
+}
 
-		if response.ClockFrame == nil ||
-			response.ClockFrame.FrameNumber != latest.FrameNumber+1 ||
-
-			response.ClockFrame.Timestamp < latest.Timestamp {
-			e.logger.Debug("received invalid response from peer")
-			e.peerMapMx.Lock()
-			if _, ok := e.peerMap[string(peerId)]; ok {
-				e.uncooperativePeersMap[string(peerId)] = e.peerMap[string(peerId)]
-				e.uncooperativePeersMap[string(peerId)].timestamp = time.Now().UnixMilli()
-				delete(e.peerMap, string(peerId))
-			}
-			e.peerMapMx.Unlock()
-			if err := cc.Close(); err != nil {
-				e.logger.Error("error while closing connection", zap.Error(err))
-			}
-			return latest, nil
-		}
-		e.logger.Info(
-			"received new leading frame",
-			zap.Uint64("frame_number", response.ClockFrame.FrameNumber),
-		)
-		if !e.IsInProverTrie(
-			response.ClockFrame.GetPublicKeySignatureEd448().PublicKey.KeyValue,
-		) {
-			e.peerMapMx.Lock()
-			if _, ok := e.peerMap[string(peerId)]; ok {
-				e.uncooperativePeersMap[string(peerId)] = e.peerMap[string(peerId)]
-				e.uncooperativePeersMap[string(peerId)].timestamp = time.Now().UnixMilli()
-				delete(e.peerMap, string(peerId))
-			}
-			e.peerMapMx.Unlock()
-		}
-		if err := e.frameProver.VerifyDataClockFrame(
-			response.ClockFrame,
-		); err != nil {
-			return nil, errors.Wrap(err, "sync")
-		}
-		e.dataTimeReel.Insert(response.ClockFrame, true)
-		latest = response.ClockFrame
-		if latest.FrameNumber >= maxFrame {
-			break
-		}
+func (s *dataFrameSource) GetDataFrame(
+	ctx context.Context,
+	frameNumber uint64,
+) (*protobufs.ClockFrame, error) {
+	response, err := s.client.GetDataFrame(
+		ctx,
+		&protobufs.GetDataFrameRequest{
+			FrameNumber: frameNumber,
+		},
+		grpc.MaxCallRecvMsgSize(p2p.DefaultDirectChannelMaxMessageSize),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "get data frame")
 	}
-	if err := cc.Close(); err != nil {
-		e.logger.Error("error while closing connection", zap.Error(err))
+
+	if response == nil {
+		return nil, errors.Wrap(errors.New("no response"), "get data frame")
 	}
-	return latest, nil
+
+	return response.ClockFrame, nil
+}
+
+func (s *dataFrameSource) Close() error {
+	return s.cc.Close()
 }
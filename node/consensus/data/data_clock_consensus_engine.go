@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/iden3/go-iden3-crypto/poseidon"
 	"github.com/multiformats/go-multiaddr"
 	mn "github.com/multiformats/go-multiaddr/net"
 	"github.com/pkg/errors"
@@ -17,8 +18,12 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/anypb"
 	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/framesync"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/mempool"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/rewards"
 	qtime "source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
 	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution"
@@ -69,10 +74,12 @@ type DataClockConsensusEngine struct {
 	dataProofStore              store.DataProofStore
 	keyStore                    store.KeyStore
 	pubSub                      p2p.PubSub
+	subscription                *p2p.Subscription
 	keyManager                  keys.KeyManager
 	masterTimeReel              *qtime.MasterTimeReel
 	dataTimeReel                *qtime.DataTimeReel
 	peerInfoManager             p2p.PeerInfoManager
+	clock                       clock.Clock
 	provingKey                  crypto.Signer
 	provingKeyBytes             []byte
 	provingKeyType              keys.KeyType
@@ -93,20 +100,24 @@ type DataClockConsensusEngine struct {
 	currentReceivingSyncPeersMx sync.Mutex
 	currentReceivingSyncPeers   int
 
-	frameChan            chan *protobufs.ClockFrame
-	executionEngines     map[string]execution.ExecutionEngine
-	filter               []byte
-	input                []byte
-	parentSelector       []byte
-	syncingStatus        SyncStatusType
-	syncingTarget        []byte
-	previousHead         *protobufs.ClockFrame
-	engineMx             sync.Mutex
-	dependencyMapMx      sync.Mutex
-	stagedTransactions   *protobufs.TokenRequests
-	stagedTransactionsMx sync.Mutex
-	peerMapMx            sync.RWMutex
-	peerAnnounceMapMx    sync.Mutex
+	frameChan         chan *protobufs.ClockFrame
+	executionEngines  map[string]execution.ExecutionEngine
+	filter            []byte
+	input             []byte
+	parentSelector    []byte
+	syncingStatus     SyncStatusType
+	syncingTarget     []byte
+	previousHead      *protobufs.ClockFrame
+	engineMx          sync.Mutex
+	dependencyMapMx   sync.Mutex
+	mempool           *mempool.Mempool
+	reputations       *framesync.Reputations
+	rewards           *rewards.Queue
+	syncScheduler     *framesync.Scheduler
+	checkpointMx      sync.Mutex
+	lastCheckpoint    *servedCheckpoint
+	peerMapMx         sync.RWMutex
+	peerAnnounceMapMx sync.Mutex
 	// proverTrieJoinRequests         map[string]string
 	// proverTrieLeaveRequests        map[string]string
 	// proverTriePauseRequests        map[string]string
@@ -152,12 +163,16 @@ func NewDataClockConsensusEngine(
 	coinStore store.CoinStore,
 	dataProofStore store.DataProofStore,
 	keyStore store.KeyStore,
+	mempoolStore store.MempoolStore,
+	peerReputationStore store.PeerReputationStore,
+	rewardProofStore store.RewardProofStore,
 	pubSub p2p.PubSub,
 	frameProver qcrypto.FrameProver,
 	inclusionProver qcrypto.InclusionProver,
 	masterTimeReel *qtime.MasterTimeReel,
 	dataTimeReel *qtime.DataTimeReel,
 	peerInfoManager p2p.PeerInfoManager,
+	clock clock.Clock,
 	report *protobufs.SelfTestReport,
 	filter []byte,
 	seed []byte,
@@ -191,6 +206,18 @@ func NewDataClockConsensusEngine(
 		panic(errors.New("key store is nil"))
 	}
 
+	if mempoolStore == nil {
+		panic(errors.New("mempool store is nil"))
+	}
+
+	if peerReputationStore == nil {
+		panic(errors.New("peer reputation store is nil"))
+	}
+
+	if rewardProofStore == nil {
+		panic(errors.New("reward proof store is nil"))
+	}
+
 	if pubSub == nil {
 		panic(errors.New("pubsub is nil"))
 	}
@@ -215,6 +242,10 @@ func NewDataClockConsensusEngine(
 		panic(errors.New("peer info manager is nil"))
 	}
 
+	if clock == nil {
+		panic(errors.New("clock is nil"))
+	}
+
 	minimumPeersRequired := config.Engine.MinimumPeersRequired
 	if minimumPeersRequired == 0 {
 		minimumPeersRequired = 3
@@ -225,6 +256,13 @@ func NewDataClockConsensusEngine(
 		difficulty = 160000
 	}
 
+	// Reward proofs are minted to the address of the peer key signing the mint
+	// requests.
+	rewardOwner, err := poseidon.HashBytes(pubSub.GetPublicKey())
+	if err != nil {
+		panic(err)
+	}
+
 	e := &DataClockConsensusEngine{
 		difficulty:       difficulty,
 		logger:           logger,
@@ -257,12 +295,47 @@ func NewDataClockConsensusEngine(
 		masterTimeReel:            masterTimeReel,
 		dataTimeReel:              dataTimeReel,
 		peerInfoManager:           peerInfoManager,
+		clock:                     clock,
 		peerSeniority:             newFromMap(peerSeniority),
 		messageProcessorCh:        make(chan *pb.Message),
 		config:                    config,
 		preMidnightMint:           map[string]struct{}{},
+		mempool: mempool.NewMempool(
+			logger,
+			mempoolStore,
+			coinStore,
+			config.Engine.MempoolMaxEntries,
+			config.Engine.MempoolMaxEntriesPerSender,
+			config.Engine.MempoolMaxFrameAge,
+		),
+		reputations: framesync.NewReputations(
+			logger,
+			peerReputationStore,
+			pubSub,
+			clock,
+		),
+		rewards: rewards.NewQueue(
+			logger,
+			rewardProofStore,
+			coinStore,
+			rewardOwner.FillBytes(make([]byte, 32)),
+			config.Engine.RewardBatchSize,
+			config.Engine.RewardMaxFrameAge,
+			config.Engine.RewardRetryFrames,
+		),
 	}
 
+	e.syncScheduler = framesync.NewScheduler(
+		logger,
+		e.reputations,
+		e.dialSyncPeer,
+		e.frameProver.VerifyDataClockFrame,
+		config.Engine.SyncWindowSize,
+		config.Engine.SyncMaxPeers,
+		0,
+		clock,
+	)
+
 	logger.Info("constructing consensus engine")
 
 	signer, keyType, bytes, address := e.GetProvingKey(
@@ -293,6 +366,21 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 
 	e.frameProverTries = e.dataTimeReel.GetFrameProverTries()
 
+	e.logger.Info("loading mempool")
+	if err := e.mempool.Load(); err != nil {
+		panic(err)
+	}
+
+	e.logger.Info("loading peer reputations")
+	if err := e.reputations.Load(); err != nil {
+		panic(err)
+	}
+
+	e.logger.Info("loading reward proofs")
+	if err := e.rewards.Load(); err != nil {
+		panic(err)
+	}
+
 	err = e.createCommunicationKeys()
 	if err != nil {
 		panic(err)
@@ -300,18 +388,34 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 
 	go e.runMessageHandler()
 
+	e.logger.Info("registering pubsub validators")
+	if err := e.pubSub.RegisterValidator(
+		e.filter,
+		e.validateMessage,
+		false,
+	); err != nil {
+		panic(err)
+	}
+
 	e.logger.Info("subscribing to pubsub messages")
-	e.pubSub.Subscribe(e.filter, e.handleMessage)
+	subscription, err := e.pubSub.Subscribe(e.filter, e.handleMessage)
+	if err != nil {
+		panic(err)
+	}
+	e.subscription = subscription
 	go func() {
-		server := grpc.NewServer(
-			grpc.MaxSendMsgSize(600*1024*1024),
-			grpc.MaxRecvMsgSize(600*1024*1024),
-		)
-		protobufs.RegisterDataServiceServer(server, e)
 		if err := e.pubSub.StartDirectChannelListener(
 			e.pubSub.GetPeerID(),
 			"sync",
-			server,
+			p2p.DirectChannelPolicy{
+				Access:                p2p.DirectChannelAllowAnyone,
+				RequestsPerSecond:     5,
+				RequestBurst:          20,
+				MaxConcurrentRequests: 4,
+			},
+			func(server *grpc.Server) {
+				protobufs.RegisterDataServiceServer(server, e)
+			},
 		); err != nil {
 			panic(err)
 		}
@@ -319,16 +423,20 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 
 	go func() {
 		if e.dataTimeReel.GetFrameProverTries()[0].Contains(e.provingKeyAddress) {
-			server := grpc.NewServer(
-				grpc.MaxSendMsgSize(1*1024*1024),
-				grpc.MaxRecvMsgSize(1*1024*1024),
-			)
-			protobufs.RegisterDataServiceServer(server, e)
-
 			if err := e.pubSub.StartDirectChannelListener(
 				e.pubSub.GetPeerID(),
 				"worker",
-				server,
+				p2p.DirectChannelPolicy{
+					Access:                p2p.DirectChannelAllowProvers,
+					IsProver:              e.isProverPeer,
+					RequestsPerSecond:     2,
+					RequestBurst:          10,
+					MaxConcurrentRequests: 2,
+					MaxMessageSize:        1 * 1024 * 1024,
+				},
+				func(server *grpc.Server) {
+					protobufs.RegisterDataServiceServer(server, e)
+				},
 			); err != nil {
 				panic(err)
 			}
@@ -351,7 +459,7 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 
 			if frame.FrameNumber-100 >= nextFrame.FrameNumber ||
 				nextFrame.FrameNumber == 0 {
-				time.Sleep(60 * time.Second)
+				e.clock.Sleep(60 * time.Second)
 				continue
 			}
 
@@ -367,7 +475,7 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 				zap.Uint64("frame_number", frame.FrameNumber),
 			)
 
-			timestamp := time.Now().UnixMilli()
+			timestamp := e.clock.Now().UnixMilli()
 			msg := binary.BigEndian.AppendUint64([]byte{}, frame.FrameNumber)
 			msg = append(msg, config.GetVersion()...)
 			msg = binary.BigEndian.AppendUint64(msg, uint64(timestamp))
@@ -406,7 +514,7 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 				if v == nil {
 					continue
 				}
-				if v.timestamp <= time.Now().UnixMilli()-UNCOOPERATIVE_PEER_INFO_TTL ||
+				if v.timestamp <= e.clock.Now().UnixMilli()-UNCOOPERATIVE_PEER_INFO_TTL ||
 					thresholdBeforeConfirming > 0 {
 					deletes = append(deletes, v)
 				}
@@ -429,14 +537,14 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 				thresholdBeforeConfirming--
 			}
 
-			time.Sleep(120 * time.Second)
+			e.clock.Sleep(120 * time.Second)
 		}
 	}()
 
 	go e.runLoop()
 	go e.rebroadcastLoop()
 	go func() {
-		time.Sleep(30 * time.Second)
+		e.clock.Sleep(30 * time.Second)
 		e.logger.Info("checking for snapshots to play forward")
 		if err := e.downloadSnapshot(e.config.DB.Path, e.config.P2P.Network); err != nil {
 			e.logger.Error("error downloading snapshot", zap.Error(err))
@@ -458,7 +566,7 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 		}
 
 		// Let it sit until we at least have a few more peers inbound
-		time.Sleep(30 * time.Second)
+		e.clock.Sleep(30 * time.Second)
 		parallelism := e.report.Cores - 1
 
 		if parallelism < 3 {
@@ -487,7 +595,7 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 			}
 
 			if frame.FrameNumber == nextFrame.FrameNumber {
-				time.Sleep(5 * time.Second)
+				e.clock.Sleep(5 * time.Second)
 				continue
 			}
 
@@ -499,6 +607,8 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 					e.PerformTimeProof(frame, frame.Difficulty, clients)
 				}
 			}
+
+			e.submitRewardProofs(frame)
 		}
 	}()
 
@@ -513,7 +623,7 @@ func (e *DataClockConsensusEngine) PerformTimeProof(
 	wg := sync.WaitGroup{}
 	wg.Add(len(clients))
 	for i, client := range clients {
-		client := client
+		i, client := i, client
 		go func() {
 			for j := 3; j >= 0; j-- {
 				var err error
@@ -523,7 +633,7 @@ func (e *DataClockConsensusEngine) PerformTimeProof(
 							"client failed, reconnecting after 50ms",
 							zap.Uint32("client", uint32(i)),
 						)
-						time.Sleep(50 * time.Millisecond)
+						e.clock.Sleep(50 * time.Millisecond)
 						client, err = e.createParallelDataClientsFromListAndIndex(uint32(i))
 						if err != nil {
 							e.logger.Error("failed to reconnect", zap.Error(err))
@@ -532,7 +642,7 @@ func (e *DataClockConsensusEngine) PerformTimeProof(
 						e.logger.Error(
 							"client failed, reconnecting after 50ms",
 						)
-						time.Sleep(50 * time.Millisecond)
+						e.clock.Sleep(50 * time.Millisecond)
 						client, err =
 							e.createParallelDataClientsFromBaseMultiaddrAndIndex(uint32(i))
 						if err != nil {
@@ -562,7 +672,7 @@ func (e *DataClockConsensusEngine) PerformTimeProof(
 							"client failed, reconnecting after 50ms",
 							zap.Uint32("client", uint32(i)),
 						)
-						time.Sleep(50 * time.Millisecond)
+						e.clock.Sleep(50 * time.Millisecond)
 						client, err = e.createParallelDataClientsFromListAndIndex(uint32(i))
 						if err != nil {
 							e.logger.Error("failed to reconnect", zap.Error(err))
@@ -571,7 +681,7 @@ func (e *DataClockConsensusEngine) PerformTimeProof(
 						e.logger.Error(
 							"client failed, reconnecting after 50ms",
 						)
-						time.Sleep(50 * time.Millisecond)
+						e.clock.Sleep(50 * time.Millisecond)
 						client, err =
 							e.createParallelDataClientsFromBaseMultiaddrAndIndex(uint32(i))
 						if err != nil {
@@ -581,26 +691,13 @@ func (e *DataClockConsensusEngine) PerformTimeProof(
 					continue
 				}
 
-				sig, err := e.pubSub.SignMessage(
-					append([]byte("mint"), resp.Output...),
-				)
-				if err != nil {
-					e.logger.Error("failed to reconnect", zap.Error(err))
-					continue
+				if err := e.rewards.Add(
+					uint32(i),
+					frame.FrameNumber,
+					resp.Output,
+				); err != nil {
+					e.logger.Error("could not queue reward proof", zap.Error(err))
 				}
-				e.publishMessage(e.filter, &protobufs.TokenRequest{
-					Request: &protobufs.TokenRequest_Mint{
-						Mint: &protobufs.MintCoinRequest{
-							Proofs: [][]byte{resp.Output},
-							Signature: &protobufs.Ed448Signature{
-								PublicKey: &protobufs.Ed448PublicKey{
-									KeyValue: e.pubSub.GetPublicKey(),
-								},
-								Signature: sig,
-							},
-						},
-					},
-				})
 				break
 			}
 			wg.Done()
@@ -610,11 +707,84 @@ func (e *DataClockConsensusEngine) PerformTimeProof(
 	return []byte{}
 }
 
+// submitRewardProofs publishes the reward proofs due at the frame in a single
+// mint request.
+func (e *DataClockConsensusEngine) submitRewardProofs(
+	frame *protobufs.ClockFrame,
+) {
+	// The mempool indexes mint requests by signer, a new batch waits for the
+	// pending one to be included or expire.
+	for _, entry := range e.mempool.Entries(e.pubSub.GetPublicKey()) {
+		if entry.Request.GetMint() != nil {
+			e.logger.Debug(
+				"mint request pending, not submitting reward proofs",
+				zap.Uint64("frame_number", frame.FrameNumber),
+			)
+			return
+		}
+	}
+
+	proofs, err := e.rewards.Next(frame.FrameNumber)
+	if err != nil {
+		e.logger.Error("could not collect reward proofs", zap.Error(err))
+		return
+	}
+
+	if len(proofs) == 0 {
+		return
+	}
+
+	payload := []byte("mint")
+	for _, p := range proofs {
+		payload = append(payload, p...)
+	}
+
+	sig, err := e.pubSub.SignMessage(payload)
+	if err != nil {
+		e.logger.Error("could not sign mint request", zap.Error(err))
+		return
+	}
+
+	// Proofs of a request failing to publish stay in flight, and are
+	// resubmitted with a later frame.
+	if err := e.publishMessage(e.filter, &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Mint{
+			Mint: &protobufs.MintCoinRequest{
+				Proofs: proofs,
+				Signature: &protobufs.Ed448Signature{
+					PublicKey: &protobufs.Ed448PublicKey{
+						KeyValue: e.pubSub.GetPublicKey(),
+					},
+					Signature: sig,
+				},
+			},
+		},
+	}); err != nil {
+		e.logger.Error("could not publish mint request", zap.Error(err))
+		return
+	}
+
+	e.logger.Info(
+		"submitted reward proofs",
+		zap.Uint64("frame_number", frame.FrameNumber),
+		zap.Int("proofs", len(proofs)),
+	)
+}
+
+// GetRewardProofs returns the queued reward proofs of the data workers.
+func (
+	e *DataClockConsensusEngine,
+) GetRewardProofs() *protobufs.RewardProofsResponse {
+	return e.rewards.Inspect()
+}
+
 func (e *DataClockConsensusEngine) Stop(force bool) <-chan error {
 	e.logger.Info("stopping ceremony consensus engine")
 	e.state = consensus.EngineStateStopping
 	errChan := make(chan error)
 
+	e.subscription.Cancel()
+
 	// msg := []byte("pause")
 	// msg = binary.BigEndian.AppendUint64(msg, e.GetFrame().FrameNumber)
 	// msg = append(msg, e.filter...)
@@ -720,6 +890,65 @@ func (
 	return resp
 }
 
+// GetMempool returns the pending token requests of the sender, or all of them
+// if the sender is nil.
+func (
+	e *DataClockConsensusEngine,
+) GetMempool(sender []byte) *protobufs.MempoolResponse {
+	return e.mempool.Inspect(sender)
+}
+
+// RestoreRequests returns requests included in frames dropped from the head
+// chain to the mempool, so they can be included again. Requests no longer
+// fitting the mempool are discarded.
+func (e *DataClockConsensusEngine) RestoreRequests(
+	requests []*protobufs.TokenRequest,
+) {
+	for _, request := range requests {
+		if err := e.mempool.Add(request, e.latestFrameReceived); err != nil {
+			e.logger.Debug("could not restore request", zap.Error(err))
+		}
+	}
+}
+
+// GetForks returns the head of the time reel and the branches competing with
+// it.
+func (e *DataClockConsensusEngine) GetForks() *protobufs.ForksResponse {
+	head, err := e.dataTimeReel.Head()
+	if err != nil {
+		panic(err)
+	}
+
+	selector, err := head.GetSelector()
+	if err != nil {
+		panic(err)
+	}
+
+	return &protobufs.ForksResponse{
+		Head: &protobufs.FrameRef{
+			FrameNumber: head.FrameNumber,
+			Selector:    selector.FillBytes(make([]byte, 32)),
+		},
+		HeadDistance: e.dataTimeReel.GetHeadDistance().Bytes(),
+		Forks:        e.dataTimeReel.GetForks(),
+	}
+}
+
+// GetReorgHistory returns up to limit of the most recent switches of the time
+// reel head to a competing branch, most recent first.
+func (e *DataClockConsensusEngine) GetReorgHistory(
+	limit int,
+) (*protobufs.ReorgHistoryResponse, error) {
+	events, err := e.dataTimeReel.GetReorgHistory(limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "get reorg history")
+	}
+
+	return &protobufs.ReorgHistoryResponse{
+		Events: events,
+	}, nil
+}
+
 func (e *DataClockConsensusEngine) createCommunicationKeys() error {
 	_, err := e.keyManager.GetAgreementKey("q-ratchet-idk")
 	if err != nil {
@@ -29,7 +29,7 @@ func (e *DataClockConsensusEngine) downloadSnapshot(
 		return errors.Wrap(err, "download snapshot")
 	}
 
-	if frame.Timestamp > time.Now().Add(-6*time.Hour).UnixMilli() {
+	if frame.Timestamp > e.clock.Now().Add(-6*time.Hour).UnixMilli() {
 		return errors.Wrap(
 			errors.New("synced higher than recent snapshot"),
 			"download snapshot",
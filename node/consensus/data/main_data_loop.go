@@ -7,6 +7,7 @@ import (
 
 	"go.uber.org/zap"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
+	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 	"source.quilibrium.com/quilibrium/monorepo/node/tries"
 )
@@ -38,6 +39,20 @@ func (
 	return frameProverTries
 }
 
+// pruneMempool drops the pending requests the new head frame included or
+// made unspendable, and the requests which expired.
+func (e *DataClockConsensusEngine) pruneMempool(frame *protobufs.ClockFrame) {
+	included, outputs, err := application.GetOutputsFromClockFrame(frame)
+	if err != nil {
+		e.logger.Debug("could not get outputs from frame", zap.Error(err))
+		included, outputs = nil, nil
+	}
+
+	if err := e.mempool.Prune(frame.FrameNumber, included, outputs); err != nil {
+		e.logger.Error("could not prune mempool", zap.Error(err))
+	}
+}
+
 func (e *DataClockConsensusEngine) runLoop() {
 	dataFrameCh := e.dataTimeReel.NewFrameCh()
 
@@ -48,7 +63,7 @@ func (e *DataClockConsensusEngine) runLoop() {
 				"waiting for minimum peers",
 				zap.Int("peer_count", peerCount),
 			)
-			time.Sleep(1 * time.Second)
+			e.clock.Sleep(1 * time.Second)
 		} else {
 			latestFrame, err := e.dataTimeReel.Head()
 			if err != nil {
@@ -61,6 +76,8 @@ func (e *DataClockConsensusEngine) runLoop() {
 					"current frame head",
 					zap.Uint64("frame_number", dataFrame.FrameNumber),
 				)
+				e.pruneMempool(dataFrame)
+
 				if !e.IsInProverTrie(e.provingKeyBytes) {
 					if latestFrame, err = e.collect(dataFrame); err != nil {
 						e.logger.Error("could not collect", zap.Error(err))
@@ -167,7 +184,7 @@ func (e *DataClockConsensusEngine) runLoop() {
 					}
 					break
 				}
-			case <-time.After(20 * time.Second):
+			case <-e.clock.After(20 * time.Second):
 				dataFrame, err := e.dataTimeReel.Head()
 				if err != nil {
 					panic(err)
@@ -291,12 +308,12 @@ func (e *DataClockConsensusEngine) runLoop() {
 
 func (e *DataClockConsensusEngine) rebroadcastLoop() {
 	if e.GetFrameProverTries()[0].Contains(e.provingKeyAddress) {
-		time.Sleep(120 * time.Second)
+		e.clock.Sleep(120 * time.Second)
 		for {
 			_, err := e.dataTimeReel.Head()
 			if err != nil {
 				e.logger.Info("no frames to rebroadcast yet, waiting...")
-				time.Sleep(10 * time.Second)
+				e.clock.Sleep(10 * time.Second)
 				continue
 			}
 
@@ -334,7 +351,7 @@ func (e *DataClockConsensusEngine) rebroadcastLoop() {
 						To:          frames[len(frames)-1].FrameNumber,
 						ClockFrames: frames,
 					})
-					time.Sleep(60 * time.Second)
+					e.clock.Sleep(60 * time.Second)
 					sent = true
 					frames = []*protobufs.ClockFrame{}
 				}
@@ -354,7 +371,7 @@ func (e *DataClockConsensusEngine) rebroadcastLoop() {
 					ClockFrames: frames,
 					Random:      b,
 				})
-				time.Sleep(60 * time.Second)
+				e.clock.Sleep(60 * time.Second)
 			}
 		}
 	}
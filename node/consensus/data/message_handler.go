@@ -3,7 +3,6 @@ package data
 import (
 	"bytes"
 	"encoding/binary"
-	"time"
 
 	"github.com/iden3/go-iden3-crypto/poseidon"
 	pcrypto "github.com/libp2p/go-libp2p/core/crypto"
@@ -13,6 +12,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/mempool"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 )
@@ -29,8 +29,9 @@ func (e *DataClockConsensusEngine) runMessageHandler() {
 				continue
 			}
 
-			if e.frameProverTries[0].Contains(e.provingKeyAddress) &&
-				e.syncingStatus == SyncStatusNotSyncing {
+			// Every node keeps a mempool, so requests stay pending across changes
+			// of the prover set and can be inspected on any node.
+			if e.syncingStatus == SyncStatusNotSyncing {
 				for name := range e.executionEngines {
 					name := name
 					go func() error {
@@ -391,7 +392,7 @@ func (e *DataClockConsensusEngine) handleDataPeerListAnnounce(
 			multiaddr:     multiaddr,
 			maxFrame:      p.MaxFrame,
 			direct:        bytes.Equal(p.PeerId, peerID),
-			lastSeen:      time.Now().Unix(),
+			lastSeen:      e.clock.Now().Unix(),
 			timestamp:     p.Timestamp,
 			version:       p.Version,
 			signature:     p.Signature,
@@ -597,68 +598,11 @@ func (e *DataClockConsensusEngine) getAddressFromSignature(
 func (e *DataClockConsensusEngine) handleTokenRequest(
 	transition *protobufs.TokenRequest,
 ) error {
-	if e.GetFrameProverTries()[0].Contains(e.provingKeyAddress) {
-		e.stagedTransactionsMx.Lock()
-		if e.stagedTransactions == nil {
-			e.stagedTransactions = &protobufs.TokenRequests{}
-		}
-
-		found := false
-		for _, ti := range e.stagedTransactions.Requests {
-			switch t := ti.Request.(type) {
-			case *protobufs.TokenRequest_Transfer:
-				switch r := transition.Request.(type) {
-				case *protobufs.TokenRequest_Transfer:
-					if bytes.Equal(r.Transfer.OfCoin.Address, t.Transfer.OfCoin.Address) {
-						found = true
-					}
-				}
-			case *protobufs.TokenRequest_Split:
-				switch r := transition.Request.(type) {
-				case *protobufs.TokenRequest_Split:
-					if bytes.Equal(r.Split.OfCoin.Address, r.Split.OfCoin.Address) {
-						found = true
-					}
-				}
-			case *protobufs.TokenRequest_Merge:
-				switch r := transition.Request.(type) {
-				case *protobufs.TokenRequest_Merge:
-				checkmerge:
-					for i := range t.Merge.Coins {
-						for j := range r.Merge.Coins {
-							if bytes.Equal(t.Merge.Coins[i].Address, r.Merge.Coins[j].Address) {
-								found = true
-								break checkmerge
-							}
-						}
-					}
-				}
-			case *protobufs.TokenRequest_Mint:
-				switch r := transition.Request.(type) {
-				case *protobufs.TokenRequest_Mint:
-				checkmint:
-					for i := range t.Mint.Proofs {
-						if len(r.Mint.Proofs) < 2 {
-							for j := range r.Mint.Proofs {
-								if bytes.Equal(t.Mint.Proofs[i], r.Mint.Proofs[j]) {
-									found = true
-									break checkmint
-								}
-							}
-						}
-					}
-				}
-			}
-		}
-
-		if !found {
-			e.stagedTransactions.Requests = append(
-				e.stagedTransactions.Requests,
-				transition,
-			)
-		}
-		e.stagedTransactionsMx.Unlock()
+	err := e.mempool.Add(transition, e.latestFrameReceived)
+	if err != nil && !errors.Is(err, mempool.ErrDuplicate) {
+		e.logger.Debug("token request not added to mempool", zap.Error(err))
 	}
+
 	return nil
 }
 
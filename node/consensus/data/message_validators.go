@@ -0,0 +1,143 @@
+package data
+
+import (
+	"bytes"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+const (
+	malformedMessagePenalty    = -1000
+	invalidTokenRequestPenalty = -1000
+	invalidFramePenalty        = -100000
+)
+
+// validateMessage is registered as the gossip-layer validator for the
+// engine's bitmask, so invalid payloads are dropped before they are relayed
+// to the rest of the mesh rather than after delivery.
+func (e *DataClockConsensusEngine) validateMessage(
+	peerID []byte,
+	message *pb.Message,
+) p2p.ValidationResult {
+	// The node's own publishes are validated synchronously, and its own frames
+	// are only published after the time reel has advanced its head to them, so
+	// they are accepted as-is rather than being dropped or penalized.
+	if bytes.Equal(peerID, e.pubSub.GetPeerID()) {
+		return p2p.ValidationResultAccept
+	}
+
+	msg := &protobufs.Message{}
+	if err := proto.Unmarshal(message.Data, msg); err != nil ||
+		len(msg.Payload) == 0 {
+		e.logger.Debug("rejecting malformed message", zap.Error(err))
+		e.pubSub.AddPeerScore(peerID, malformedMessagePenalty)
+		return p2p.ValidationResultReject
+	}
+
+	any := &anypb.Any{}
+	if err := proto.Unmarshal(msg.Payload, any); err != nil {
+		e.logger.Debug("rejecting malformed payload", zap.Error(err))
+		e.pubSub.AddPeerScore(peerID, malformedMessagePenalty)
+		return p2p.ValidationResultReject
+	}
+
+	switch any.TypeUrl {
+	case protobufs.ClockFrameType:
+		return e.validateFrameMessage(peerID, any)
+	case protobufs.TokenRequestType:
+		return e.validateTokenRequestMessage(peerID, any)
+	default:
+		return p2p.ValidationResultAccept
+	}
+}
+
+func (e *DataClockConsensusEngine) validateFrameMessage(
+	peerID []byte,
+	any *anypb.Any,
+) p2p.ValidationResult {
+	frame := &protobufs.ClockFrame{}
+	if err := any.UnmarshalTo(frame); err != nil {
+		e.pubSub.AddPeerScore(peerID, malformedMessagePenalty)
+		return p2p.ValidationResultReject
+	}
+
+	if frame.GetPublicKeySignatureEd448() == nil ||
+		frame.GetPublicKeySignatureEd448().PublicKey == nil ||
+		len(frame.Output) < 516 {
+		e.pubSub.AddPeerScore(peerID, malformedMessagePenalty)
+		return p2p.ValidationResultReject
+	}
+
+	head, err := e.dataTimeReel.Head()
+	if err != nil {
+		return p2p.ValidationResultIgnore
+	}
+
+	// Frames at or below the head are either duplicates or competing frames
+	// the time reel already had a chance to see, neither is worth relaying.
+	if frame.FrameNumber <= head.FrameNumber {
+		return p2p.ValidationResultIgnore
+	}
+
+	addr, err := poseidon.HashBytes(
+		frame.GetPublicKeySignatureEd448().PublicKey.KeyValue,
+	)
+	if err != nil {
+		e.pubSub.AddPeerScore(peerID, malformedMessagePenalty)
+		return p2p.ValidationResultReject
+	}
+
+	if !e.GetFrameProverTries()[0].Contains(addr.Bytes()) {
+		return p2p.ValidationResultIgnore
+	}
+
+	if err := e.frameProver.VerifyDataClockFrame(frame); err != nil {
+		e.logger.Debug(
+			"rejecting invalid clock frame",
+			zap.Uint64("frame_number", frame.FrameNumber),
+			zap.Error(err),
+		)
+		e.pubSub.AddPeerScore(peerID, invalidFramePenalty)
+		return p2p.ValidationResultReject
+	}
+
+	return p2p.ValidationResultAccept
+}
+
+func (e *DataClockConsensusEngine) validateTokenRequestMessage(
+	peerID []byte,
+	any *anypb.Any,
+) p2p.ValidationResult {
+	request := &protobufs.TokenRequest{}
+	if err := any.UnmarshalTo(request); err != nil {
+		e.pubSub.AddPeerScore(peerID, malformedMessagePenalty)
+		return p2p.ValidationResultReject
+	}
+
+	if err := request.Verify(); err != nil {
+		e.logger.Debug("rejecting invalid token request", zap.Error(err))
+		e.pubSub.AddPeerScore(peerID, invalidTokenRequestPenalty)
+		return p2p.ValidationResultReject
+	}
+
+	// A missing coin is most likely already spent, but this node may simply be
+	// behind, so the request is dropped without penalizing the relaying peer.
+	for _, address := range request.CoinAddresses() {
+		if _, err := e.coinStore.GetCoinByAddress(nil, address); err != nil {
+			if !errors.Is(err, store.ErrNotFound) {
+				e.logger.Debug("could not look up coin", zap.Error(err))
+			}
+			return p2p.ValidationResultIgnore
+		}
+	}
+
+	return p2p.ValidationResultAccept
+}
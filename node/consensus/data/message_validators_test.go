@@ -0,0 +1,420 @@
+package data
+
+import (
+	"bytes"
+	gocrypto "crypto"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/ed448"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	qtime "source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
+	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+// scoringPubSub records the score changes the validators make.
+type scoringPubSub struct {
+	pubsub
+	peerID []byte
+	scores map[string]int64
+}
+
+func (s *scoringPubSub) GetPeerID() []byte {
+	return s.peerID
+}
+
+func (s *scoringPubSub) AddPeerScore(peerId []byte, scoreDelta int64) {
+	s.scores[string(peerId)] += scoreDelta
+}
+
+func signPayload(
+	t *testing.T,
+	key ed448.PrivateKey,
+	payload []byte,
+) *protobufs.Ed448Signature {
+	sig, err := key.Sign(rand.Reader, payload, gocrypto.Hash(0))
+	require.NoError(t, err)
+
+	return &protobufs.Ed448Signature{
+		PublicKey: &protobufs.Ed448PublicKey{
+			KeyValue: key.Public().(ed448.PublicKey),
+		},
+		Signature: sig,
+	}
+}
+
+func gossipMessage(t *testing.T, message proto.Message) *pb.Message {
+	any := &anypb.Any{}
+	require.NoError(t, any.MarshalFrom(message))
+	any.TypeUrl = strings.Replace(
+		any.TypeUrl,
+		"type.googleapis.com",
+		"types.quilibrium.com",
+		1,
+	)
+	payload, err := proto.Marshal(any)
+	require.NoError(t, err)
+	data, err := proto.Marshal(&protobufs.Message{Payload: payload})
+	require.NoError(t, err)
+
+	return &pb.Message{Data: data}
+}
+
+func TestValidateMessage(t *testing.T) {
+	log := zap.NewNop()
+	_, key, err := ed448.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, other, err := ed448.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	coinStore := store.NewPebbleCoinStore(store.NewInMemKVDB(), log)
+	coin := make([]byte, 32)
+	coin[0] = 0x01
+	otherCoin := make([]byte, 32)
+	otherCoin[0] = 0x02
+	missingCoin := make([]byte, 32)
+	missingCoin[0] = 0x03
+	txn, err := coinStore.NewTransaction()
+	require.NoError(t, err)
+	for _, address := range [][]byte{coin, otherCoin} {
+		require.NoError(t, coinStore.PutCoin(txn, 1, address, &protobufs.Coin{
+			Amount: []byte{0x01},
+		}))
+	}
+	require.NoError(t, txn.Commit())
+
+	transfer := func(of []byte, to []byte) *protobufs.TokenRequest {
+		payload := append(append([]byte("transfer"), of...), to...)
+		return &protobufs.TokenRequest{
+			Request: &protobufs.TokenRequest_Transfer{
+				Transfer: &protobufs.TransferCoinRequest{
+					OfCoin: &protobufs.CoinRef{Address: of},
+					ToAccount: &protobufs.AccountRef{
+						Account: &protobufs.AccountRef_ImplicitAccount{
+							ImplicitAccount: &protobufs.ImplicitAccount{Address: to},
+						},
+					},
+					Signature: signPayload(t, key, payload),
+				},
+			},
+		}
+	}
+	split := func(of []byte, amounts [][]byte) *protobufs.TokenRequest {
+		payload := append([]byte("split"), of...)
+		for _, a := range amounts {
+			payload = append(payload, a...)
+		}
+		return &protobufs.TokenRequest{
+			Request: &protobufs.TokenRequest_Split{
+				Split: &protobufs.SplitCoinRequest{
+					OfCoin:    &protobufs.CoinRef{Address: of},
+					Amounts:   amounts,
+					Signature: signPayload(t, key, payload),
+				},
+			},
+		}
+	}
+	merge := func(coins ...[]byte) *protobufs.TokenRequest {
+		payload := []byte("merge")
+		refs := []*protobufs.CoinRef{}
+		for _, c := range coins {
+			payload = append(payload, c...)
+			refs = append(refs, &protobufs.CoinRef{Address: c})
+		}
+		return &protobufs.TokenRequest{
+			Request: &protobufs.TokenRequest_Merge{
+				Merge: &protobufs.MergeCoinRequest{
+					Coins:     refs,
+					Signature: signPayload(t, key, payload),
+				},
+			},
+		}
+	}
+	mint := func(proofs ...[]byte) *protobufs.TokenRequest {
+		payload := []byte("mint")
+		for _, p := range proofs {
+			payload = append(payload, p...)
+		}
+		return &protobufs.TokenRequest{
+			Request: &protobufs.TokenRequest_Mint{
+				Mint: &protobufs.MintCoinRequest{
+					Proofs:    proofs,
+					Signature: signPayload(t, key, payload),
+				},
+			},
+		}
+	}
+	announce := func() *protobufs.TokenRequest {
+		return &protobufs.TokenRequest{
+			Request: &protobufs.TokenRequest_Announce{
+				Announce: &protobufs.AnnounceProverRequest{
+					PublicKeySignaturesEd448: []*protobufs.Ed448Signature{
+						signPayload(t, key, other.Public().(ed448.PublicKey)),
+						signPayload(t, other, key.Public().(ed448.PublicKey)),
+					},
+				},
+			},
+		}
+	}
+	badSignature := func(r *protobufs.TokenRequest) *protobufs.TokenRequest {
+		r.GetTransfer().Signature.Signature[0] ^= 0xff
+		return r
+	}
+	proof := make([]byte, 64)
+
+	// The rules of each request type are covered by TestTokenRequestVerify, the
+	// cases here only check how the validator scores and drops the results.
+	tests := []struct {
+		name    string
+		message *pb.Message
+		result  p2p.ValidationResult
+		penalty int64
+	}{
+		{
+			"undecodable message",
+			&pb.Message{Data: []byte{0xff}},
+			p2p.ValidationResultReject,
+			malformedMessagePenalty,
+		},
+		{
+			"empty payload",
+			&pb.Message{Data: []byte{}},
+			p2p.ValidationResultReject,
+			malformedMessagePenalty,
+		},
+		{
+			"undecodable token request",
+			func() *pb.Message {
+				data, err := proto.Marshal(&protobufs.Message{
+					Payload: func() []byte {
+						b, err := proto.Marshal(&anypb.Any{
+							TypeUrl: protobufs.TokenRequestType,
+							Value:   []byte{0xff},
+						})
+						require.NoError(t, err)
+						return b
+					}(),
+				})
+				require.NoError(t, err)
+				return &pb.Message{Data: data}
+			}(),
+			p2p.ValidationResultReject,
+			malformedMessagePenalty,
+		},
+		{
+			"frame without signature",
+			gossipMessage(t, &protobufs.ClockFrame{
+				FrameNumber: 1,
+				Output:      make([]byte, 516),
+			}),
+			p2p.ValidationResultReject,
+			malformedMessagePenalty,
+		},
+		{
+			"transfer",
+			gossipMessage(t, transfer(coin, otherCoin)),
+			p2p.ValidationResultAccept,
+			0,
+		},
+		{
+			"transfer of missing coin",
+			gossipMessage(t, transfer(missingCoin, otherCoin)),
+			p2p.ValidationResultIgnore,
+			0,
+		},
+		{
+			"transfer with bad signature",
+			gossipMessage(t, badSignature(transfer(coin, otherCoin))),
+			p2p.ValidationResultReject,
+			invalidTokenRequestPenalty,
+		},
+		{
+			"split",
+			gossipMessage(t, split(coin, [][]byte{{0x01}})),
+			p2p.ValidationResultAccept,
+			0,
+		},
+		{
+			"merge",
+			gossipMessage(t, merge(coin, otherCoin)),
+			p2p.ValidationResultAccept,
+			0,
+		},
+		{
+			"merge with missing coin",
+			gossipMessage(t, merge(coin, missingCoin)),
+			p2p.ValidationResultIgnore,
+			0,
+		},
+		{
+			"mint",
+			gossipMessage(t, mint(proof)),
+			p2p.ValidationResultAccept,
+			0,
+		},
+		{
+			"announce",
+			gossipMessage(t, announce()),
+			p2p.ValidationResultAccept,
+			0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := &scoringPubSub{scores: map[string]int64{}}
+			e := &DataClockConsensusEngine{
+				logger:    log,
+				pubSub:    ps,
+				coinStore: coinStore,
+			}
+
+			assert.Equal(t, tt.result, e.validateMessage([]byte("peer"), tt.message))
+			assert.Equal(t, tt.penalty, ps.scores["peer"])
+		})
+	}
+}
+
+func TestValidateFrameMessage(t *testing.T) {
+	log := zap.NewNop()
+	filter := bytes.Repeat([]byte{0xff}, 32)
+	prover := qcrypto.NewWesolowskiFrameProver(log)
+	pub, key, err := ed448.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, outsider, err := ed448.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	genesis, _, err := prover.CreateDataGenesisFrame(
+		filter,
+		bytes.Repeat([]byte{0x00}, 516),
+		10,
+		&qcrypto.InclusionAggregateProof{
+			InclusionCommitments: []*qcrypto.InclusionCommitment{},
+			AggregateCommitment:  []byte{},
+			Proof:                []byte{},
+		},
+		[][]byte{pub},
+	)
+	require.NoError(t, err)
+
+	prove := func(
+		parent *protobufs.ClockFrame,
+		signer ed448.PrivateKey,
+	) *protobufs.ClockFrame {
+		frame, err := prover.ProveDataClockFrame(
+			parent,
+			[][]byte{},
+			[]*protobufs.InclusionAggregateProof{},
+			signer,
+			parent.Timestamp+1,
+			10,
+		)
+		require.NoError(t, err)
+		return frame
+	}
+	frames := []*protobufs.ClockFrame{genesis}
+	for len(frames) < 3 {
+		frames = append(frames, prove(frames[len(frames)-1], key))
+	}
+	head := frames[2]
+
+	addr, err := poseidon.HashBytes(pub)
+	require.NoError(t, err)
+	proverTrie := &tries.RollingFrecencyCritbitTrie{}
+	proverTrie.Add(addr.Bytes(), 0)
+
+	forged := prove(head, key)
+	forged.Output[0] ^= 0xff
+
+	tests := []struct {
+		name    string
+		from    []byte
+		frame   *protobufs.ClockFrame
+		result  p2p.ValidationResult
+		penalty int64
+	}{
+		{
+			"next frame",
+			[]byte("peer"),
+			prove(head, key),
+			p2p.ValidationResultAccept,
+			0,
+		},
+		{"frame at head", []byte("peer"), head, p2p.ValidationResultIgnore, 0},
+		{
+			"frame below head",
+			[]byte("peer"),
+			frames[1],
+			p2p.ValidationResultIgnore,
+			0,
+		},
+		{
+			"frame from outside the prover trie",
+			[]byte("peer"),
+			prove(head, outsider),
+			p2p.ValidationResultIgnore,
+			0,
+		},
+		{
+			"frame failing verification",
+			[]byte("peer"),
+			forged,
+			p2p.ValidationResultReject,
+			invalidFramePenalty,
+		},
+		{"own frame at head", []byte("self"), head, p2p.ValidationResultAccept, 0},
+		{"own forged frame", []byte("self"), forged, p2p.ValidationResultAccept, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := &scoringPubSub{
+				peerID: []byte("self"),
+				scores: map[string]int64{},
+			}
+			reel := qtime.NewDataTimeReel(
+				filter,
+				log,
+				store.NewPebbleClockStore(store.NewInMemKVDB(), log),
+				&config.EngineConfig{},
+				prover,
+				clock.NewRealClock(),
+				func(txn store.Transaction, frame *protobufs.ClockFrame) error {
+					return nil
+				},
+				func(txn store.Transaction, frame *protobufs.FrameRef) error {
+					return nil
+				},
+				nil,
+				nil,
+				nil,
+			)
+			reel.SetHead(head)
+			e := &DataClockConsensusEngine{
+				logger:           log,
+				pubSub:           ps,
+				dataTimeReel:     reel,
+				frameProver:      prover,
+				frameProverTries: []*tries.RollingFrecencyCritbitTrie{proverTrie},
+			}
+
+			assert.Equal(
+				t,
+				tt.result,
+				e.validateMessage(tt.from, gossipMessage(t, tt.frame)),
+			)
+			assert.Equal(t, tt.penalty, ps.scores[string(tt.from)])
+		})
+	}
+}
@@ -28,8 +28,10 @@ func (e *DataClockConsensusEngine) GetDataFrame(
 	ctx context.Context,
 	request *protobufs.GetDataFrameRequest,
 ) (*protobufs.DataFrameResponse, error) {
+	peerID, _ := p2p.DirectChannelPeerID(ctx)
 	e.logger.Debug(
 		"received frame request",
+		zap.String("peer_id", peerID.String()),
 		zap.Uint64("frame_number", request.FrameNumber),
 	)
 	var frame *protobufs.ClockFrame
@@ -101,7 +103,12 @@ func (e *DataClockConsensusEngine) HandlePreMidnightMint(
 ) (*protobufs.PreMidnightMintResponse, error) {
 	addr, err := e.handleMint(t)
 	if err != nil {
-		e.logger.Error("error while handling pre-midnight mint", zap.Error(err))
+		peerID, _ := p2p.DirectChannelPeerID(ctx)
+		e.logger.Error(
+			"error while handling pre-midnight mint",
+			zap.String("peer_id", peerID.String()),
+			zap.Error(err),
+		)
 		return nil, err
 	}
 
@@ -592,22 +599,23 @@ func (e *DataClockConsensusEngine) GetPublicChannelForProvingKey(
 		svrChan := make(
 			chan protobufs.DataService_GetPublicChannelServer,
 		)
-		after := time.After(20 * time.Second)
+		after := e.clock.After(20 * time.Second)
 		go func() {
-			server := grpc.NewServer(
-				grpc.MaxSendMsgSize(600*1024*1024),
-				grpc.MaxRecvMsgSize(600*1024*1024),
-			)
-
 			s := &svr{
 				svrChan: svrChan,
 			}
-			protobufs.RegisterDataServiceServer(server, s)
 
 			if err := e.pubSub.StartDirectChannelListener(
 				peerID,
 				base58.Encode(provingKey),
-				server,
+				p2p.DirectChannelPolicy{
+					Access:                p2p.DirectChannelAllowProvers,
+					IsProver:              e.isProverPeer,
+					MaxConcurrentRequests: 1,
+				},
+				func(server *grpc.Server) {
+					protobufs.RegisterDataServiceServer(server, s)
+				},
 			); err != nil {
 				e.logger.Error(
 					"could not get public channel for proving key",
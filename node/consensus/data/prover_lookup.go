@@ -4,6 +4,7 @@ import (
 	"crypto"
 
 	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
@@ -65,3 +66,19 @@ func (e *DataClockConsensusEngine) IsInProverTrie(key []byte) bool {
 
 	return false
 }
+
+// isProverPeer reports whether the key of the peer is in the prover trie, for
+// provers using their peer key as proving key.
+func (e *DataClockConsensusEngine) isProverPeer(peerID []byte) bool {
+	pub, err := peer.ID(peerID).ExtractPublicKey()
+	if err != nil {
+		return false
+	}
+
+	raw, err := pub.Raw()
+	if err != nil {
+		return false
+	}
+
+	return e.IsInProverTrie(raw)
+}
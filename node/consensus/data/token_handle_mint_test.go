@@ -1,6 +1,7 @@
 package data
 
 import (
+	"bytes"
 	"context"
 	gocrypto "crypto"
 	"crypto/rand"
@@ -22,7 +23,11 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/mempool"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/rewards"
 	qtime "source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
 	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution"
@@ -39,15 +44,29 @@ type pubsub struct {
 	pubkey  []byte
 }
 
-func (pubsub) GetBitmaskPeers() map[string][]string                                    { return nil }
-func (pubsub) Publish(address []byte, data []byte) error                               { return nil }
-func (pubsub) PublishToBitmask(bitmask []byte, data []byte) error                      { return nil }
-func (pubsub) Subscribe(bitmask []byte, handler func(message *pb.Message) error) error { return nil }
-func (pubsub) Unsubscribe(bitmask []byte, raw bool)                                    {}
-func (pubsub) GetPeerID() []byte                                                       { return nil }
-func (pubsub) GetPeerstoreCount() int                                                  { return 0 }
-func (pubsub) GetNetworkPeersCount() int                                               { return 0 }
-func (pubsub) GetRandomPeer(bitmask []byte) ([]byte, error)                            { return nil, nil }
+func (pubsub) GetBitmaskPeers() map[string][]string               { return nil }
+func (pubsub) Publish(address []byte, data []byte) error          { return nil }
+func (pubsub) PublishToBitmask(bitmask []byte, data []byte) error { return nil }
+func (pubsub) Subscribe(
+	bitmask []byte,
+	handler func(message *pb.Message) error,
+	opts ...p2p.SubscribeOption,
+) (*p2p.Subscription, error) {
+	return nil, nil
+}
+func (pubsub) Unsubscribe(bitmask []byte) {}
+func (pubsub) RegisterValidator(
+	bitmask []byte,
+	validator func(peerID []byte, message *pb.Message) p2p.ValidationResult,
+	sync bool,
+) error {
+	return nil
+}
+func (pubsub) UnregisterValidator(bitmask []byte) error     { return nil }
+func (pubsub) GetPeerID() []byte                            { return nil }
+func (pubsub) GetPeerstoreCount() int                       { return 0 }
+func (pubsub) GetNetworkPeersCount() int                    { return 0 }
+func (pubsub) GetRandomPeer(bitmask []byte) ([]byte, error) { return nil, nil }
 func (pubsub) GetMultiaddrOfPeerStream(ctx context.Context, peerId []byte) <-chan multiaddr.Multiaddr {
 	return nil
 }
@@ -55,7 +74,8 @@ func (pubsub) GetMultiaddrOfPeer(peerId []byte) string { return "" }
 func (pubsub) StartDirectChannelListener(
 	key []byte,
 	purpose string,
-	server *grpc.Server,
+	policy p2p.DirectChannelPolicy,
+	register func(server *grpc.Server),
 ) error {
 	return nil
 }
@@ -65,6 +85,9 @@ func (pubsub) GetDirectChannel(peerId []byte, purpose string) (*grpc.ClientConn,
 func (pubsub) GetNetworkInfo() *protobufs.NetworkInfoResponse {
 	return nil
 }
+func (pubsub) GetPeerScores() *protobufs.PeerScoresResponse {
+	return nil
+}
 func (p pubsub) SignMessage(msg []byte) ([]byte, error) {
 	return p.privkey.Sign(rand.Reader, msg, gocrypto.Hash(0))
 }
@@ -73,6 +96,9 @@ func (pubsub) GetPeerScore(peerId []byte) int64             { return 0 }
 func (pubsub) SetPeerScore(peerId []byte, score int64)      {}
 func (pubsub) AddPeerScore(peerId []byte, scoreDelta int64) {}
 func (pubsub) Reconnect(peerId []byte) error                { return nil }
+func (pubsub) ReloadAllowlist(p2pConfig *config.P2PConfig) error {
+	return nil
+}
 
 type outputs struct {
 	difficulty  uint32
@@ -129,6 +155,7 @@ func TestHandlePreMidnightMint(t *testing.T) {
 		masterTimeReel:            nil,
 		dataTimeReel:              &qtime.DataTimeReel{},
 		peerInfoManager:           nil,
+		clock:                     clock.NewRealClock(),
 		peerSeniority:             newFromMap(map[string]uint64{}),
 		messageProcessorCh:        make(chan *pb.Message),
 		config:                    nil,
@@ -640,15 +667,15 @@ func TestHandlePreMidnightMint(t *testing.T) {
 	err = proto.Unmarshal(appMsg.Value, tr)
 	assert.NoError(t, err)
 
-	d.stagedTransactions = &protobufs.TokenRequests{
+	stagedTransactions := &protobufs.TokenRequests{
 		Requests: []*protobufs.TokenRequest{tr},
 	}
 	// confirm operation cannot occur twice:
-	d.stagedTransactions.Requests = append(
-		d.stagedTransactions.Requests,
-		d.stagedTransactions.Requests[0],
+	stagedTransactions.Requests = append(
+		stagedTransactions.Requests,
+		stagedTransactions.Requests[0],
 	)
-	app, success, fail, err := app.ApplyTransitions(1, d.stagedTransactions, true)
+	app, success, fail, err := app.ApplyTransitions(1, stagedTransactions, true)
 	assert.NoError(t, err)
 
 	assert.Len(t, success.Requests, 1)
@@ -683,7 +710,7 @@ func TestHandlePreMidnightMint(t *testing.T) {
 	}
 	err = txn.Commit()
 	// confirm updated app state does fail transition
-	_, _, _, err = app.ApplyTransitions(1, d.stagedTransactions, false)
+	_, _, _, err = app.ApplyTransitions(1, stagedTransactions, false)
 	assert.Error(t, err)
 
 	_, _, coin, err := app.CoinStore.GetCoinsForOwner(addr)
@@ -713,3 +740,134 @@ func GetAddressOfCoin(
 
 	return addressBI.FillBytes(make([]byte, 32)), nil
 }
+
+// publishingPubSub records the messages published to bitmasks.
+type publishingPubSub struct {
+	pubsub
+	published [][]byte
+}
+
+func (p *publishingPubSub) PublishToBitmask(bitmask []byte, data []byte) error {
+	p.published = append(p.published, data)
+	return nil
+}
+
+func TestSubmitRewardProofsMintsWorkerBatch(t *testing.T) {
+	log := zap.NewNop()
+	db := store.NewInMemKVDB()
+	coinStore := store.NewPebbleCoinStore(db, log)
+	prover := qcrypto.NewWesolowskiFrameProver(log)
+
+	pub, key, err := ed448.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	pk, err := crypto.UnmarshalEd448PublicKey(pub)
+	assert.NoError(t, err)
+	peerId, err := peer.IDFromPublicKey(pk)
+	assert.NoError(t, err)
+	addr, err := poseidon.HashBytes(pub)
+	assert.NoError(t, err)
+	owner := addr.FillBytes(make([]byte, 32))
+
+	proverTrie := &tries.RollingFrecencyCritbitTrie{}
+	proverTrie.Add(owner, 0)
+
+	ps := &publishingPubSub{pubsub: pubsub{privkey: key, pubkey: pub}}
+	filter := bytes.Repeat([]byte{0xff}, 32)
+	e := &DataClockConsensusEngine{
+		logger: log,
+		pubSub: ps,
+		filter: filter,
+		mempool: mempool.NewMempool(
+			log,
+			store.NewPebbleMempoolStore(db, log),
+			coinStore,
+			0,
+			0,
+			0,
+		),
+		rewards: rewards.NewQueue(
+			log,
+			store.NewPebbleRewardProofStore(db, log),
+			coinStore,
+			owner,
+			0,
+			0,
+			0,
+		),
+	}
+
+	// Each worker proves the challenge of a frame as the data workers do, the
+	// proof followed by its challenge.
+	frameNumber := uint64(80000)
+	for core := uint32(0); core < 4; core++ {
+		challenge := append([]byte{}, []byte(peerId)...)
+		challenge = binary.BigEndian.AppendUint64(
+			challenge,
+			frameNumber+uint64(core),
+		)
+		challenge = append(challenge, filter...)
+		challenge = append(challenge, []byte{byte(core)}...)
+		proof, err := prover.CalculateChallengeProof(challenge, 10)
+		assert.NoError(t, err)
+		assert.NoError(t, e.rewards.Add(
+			core,
+			frameNumber+uint64(core),
+			append(proof, challenge...),
+		))
+	}
+
+	current := frameNumber + 4
+	e.submitRewardProofs(&protobufs.ClockFrame{FrameNumber: current})
+	assert.Len(t, ps.published, 1)
+
+	message := &protobufs.Message{}
+	assert.NoError(t, proto.Unmarshal(ps.published[0], message))
+	appMsg := &anypb.Any{}
+	assert.NoError(t, proto.Unmarshal(message.Payload, appMsg))
+	tr := &protobufs.TokenRequest{}
+	assert.NoError(t, proto.Unmarshal(appMsg.Value, tr))
+	assert.Len(t, tr.GetMint().Proofs, 4)
+
+	app := &application.TokenApplication{
+		Beacon:     make([]byte, 57),
+		Tries:      []*tries.RollingFrecencyCritbitTrie{proverTrie},
+		CoinStore:  coinStore,
+		Logger:     log,
+		Difficulty: 10,
+	}
+	app, success, fail, err := app.ApplyTransitions(
+		current,
+		&protobufs.TokenRequests{Requests: []*protobufs.TokenRequest{tr}},
+		false,
+	)
+	assert.NoError(t, err)
+	assert.Len(t, success.Requests, 1)
+	assert.Empty(t, fail.Requests)
+	assert.Len(t, app.TokenOutputs.Outputs, 8)
+
+	txn, err := coinStore.NewTransaction()
+	assert.NoError(t, err)
+	for _, o := range app.TokenOutputs.Outputs {
+		if e, ok := o.Output.(*protobufs.TokenOutput_Proof); ok {
+			assert.Equal(
+				t,
+				owner,
+				e.Proof.Owner.GetImplicitAccount().Address,
+			)
+			a, err := GetAddressOfPreCoinProof(e.Proof)
+			assert.NoError(t, err)
+			assert.NoError(t, coinStore.PutPreCoinProof(txn, current, a, e.Proof))
+		}
+	}
+	assert.NoError(t, txn.Commit())
+
+	// The queue sees the batch minted, and has nothing left to submit.
+	proofs, err := e.rewards.Next(current + 1)
+	assert.NoError(t, err)
+	assert.Empty(t, proofs)
+	cores := e.rewards.Inspect().Cores
+	assert.Len(t, cores, 4)
+	for _, core := range cores {
+		assert.Equal(t, uint64(1), core.Confirmed)
+	}
+}
@@ -0,0 +1,271 @@
+package framesync
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+const (
+	// Peer score deltas applied for each frame served during sync.
+	ValidFrameScore   int64 = 1
+	TimeoutScore      int64 = -100
+	InvalidFrameScore int64 = -1000
+	// Peer score delta applied when the peer could not be dialed, which may
+	// be a transient failure of the network rather than of the peer.
+	DialFailureScore int64 = -10
+
+	// Peers scoring below this are not synced from, unless no peer scores
+	// above it.
+	MinPeerScore = InvalidFrameScore
+
+	// The score of a peer halves for every half-life elapsed since it was
+	// last recorded, and its record is forgotten once it expires, so peers
+	// get another chance after a while.
+	ReputationHalfLife = time.Hour
+	ReputationExpiry   = 7 * 24 * time.Hour
+
+	// Every millisecond of average latency per frame costs this many
+	// reputation points when ranking peers.
+	latencyScoreDivisor = 10
+)
+
+// PeerScorer adjusts the score of peers at the p2p layer, as p2p.PubSub does.
+type PeerScorer interface {
+	AddPeerScore(peerId []byte, scoreDelta int64)
+}
+
+// Reputations keeps a persistent record of how each peer has served frames
+// during sync. The record ranks peers for future syncs, and every update is
+// also applied to the peer's score at the p2p layer.
+type Reputations struct {
+	mx     sync.Mutex
+	logger *zap.Logger
+	store  store.PeerReputationStore
+	scorer PeerScorer
+	clock  clock.Clock
+	peers  map[string]*protobufs.PeerReputation
+}
+
+func NewReputations(
+	logger *zap.Logger,
+	reputationStore store.PeerReputationStore,
+	scorer PeerScorer,
+	clock clock.Clock,
+) *Reputations {
+	if logger == nil {
+		panic(errors.New("logger is nil"))
+	}
+
+	if reputationStore == nil {
+		panic(errors.New("reputation store is nil"))
+	}
+
+	if scorer == nil {
+		panic(errors.New("scorer is nil"))
+	}
+
+	if clock == nil {
+		panic(errors.New("clock is nil"))
+	}
+
+	return &Reputations{
+		logger: logger,
+		store:  reputationStore,
+		scorer: scorer,
+		clock:  clock,
+		peers:  map[string]*protobufs.PeerReputation{},
+	}
+}
+
+// Load restores the reputations persisted in the store, deleting the expired
+// ones.
+func (r *Reputations) Load() error {
+	reputations, err := r.store.GetPeerReputations()
+	if err != nil {
+		return errors.Wrap(err, "load")
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	now := r.clock.Now()
+	for _, reputation := range reputations {
+		if expired(reputation, now) {
+			if err := r.store.DeletePeerReputation(reputation.PeerId); err != nil {
+				return errors.Wrap(err, "load")
+			}
+			continue
+		}
+
+		r.peers[string(reputation.PeerId)] = reputation
+	}
+
+	return nil
+}
+
+// RecordValid records the peer serving a number of valid frames, taking
+// elapsed time in total.
+func (r *Reputations) RecordValid(
+	peerId []byte,
+	frames uint64,
+	elapsed time.Duration,
+) {
+	if frames == 0 {
+		return
+	}
+
+	latency := uint64(elapsed.Milliseconds()) / frames
+	r.update(peerId, int64(frames)*ValidFrameScore, func(
+		reputation *protobufs.PeerReputation,
+	) {
+		if reputation.ValidFrames == 0 {
+			reputation.LatencyMs = latency
+		} else {
+			reputation.LatencyMs = (reputation.LatencyMs*3 + latency) / 4
+		}
+		reputation.ValidFrames += frames
+	})
+}
+
+// RecordInvalid records the peer serving a frame that failed validation or
+// contradicted the frames of another peer.
+func (r *Reputations) RecordInvalid(peerId []byte) {
+	r.update(peerId, InvalidFrameScore, func(
+		reputation *protobufs.PeerReputation,
+	) {
+		reputation.InvalidFrames++
+	})
+}
+
+// RecordTimeout records the peer failing to serve a frame in time.
+func (r *Reputations) RecordTimeout(peerId []byte) {
+	r.update(peerId, TimeoutScore, func(
+		reputation *protobufs.PeerReputation,
+	) {
+		reputation.Timeouts++
+	})
+}
+
+// RecordDialFailure records the peer failing to be dialed.
+func (r *Reputations) RecordDialFailure(peerId []byte) {
+	r.update(peerId, DialFailureScore, func(
+		reputation *protobufs.PeerReputation,
+	) {
+		reputation.DialFailures++
+	})
+}
+
+func (r *Reputations) update(
+	peerId []byte,
+	scoreDelta int64,
+	apply func(reputation *protobufs.PeerReputation),
+) {
+	r.mx.Lock()
+	now := r.clock.Now()
+	reputation, ok := r.peers[string(peerId)]
+	if !ok || expired(reputation, now) {
+		reputation = &protobufs.PeerReputation{PeerId: peerId}
+		r.peers[string(peerId)] = reputation
+	}
+
+	apply(reputation)
+	reputation.LastUpdated = now.UnixMilli()
+	stored := proto.Clone(reputation).(*protobufs.PeerReputation)
+	r.mx.Unlock()
+
+	r.scorer.AddPeerScore(peerId, scoreDelta)
+
+	if err := r.store.PutPeerReputation(stored); err != nil {
+		r.logger.Error(
+			"could not store peer reputation",
+			zap.Binary("peer_id", peerId),
+			zap.Error(err),
+		)
+	}
+}
+
+// Get returns a copy of the peer's reputation, or nil if the peer has not
+// served any frames or its reputation expired.
+func (r *Reputations) Get(peerId []byte) *protobufs.PeerReputation {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	reputation, ok := r.peers[string(peerId)]
+	if !ok || expired(reputation, r.clock.Now()) {
+		return nil
+	}
+
+	return proto.Clone(reputation).(*protobufs.PeerReputation)
+}
+
+// Score returns the peer's reputation as a single number, higher being
+// better, decayed by the time elapsed since it was last recorded. Peers
+// without a record score zero.
+func (r *Reputations) Score(peerId []byte) int64 {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	return score(r.peers[string(peerId)], r.clock.Now())
+}
+
+func score(reputation *protobufs.PeerReputation, now time.Time) int64 {
+	if reputation == nil || expired(reputation, now) {
+		return 0
+	}
+
+	total := int64(reputation.ValidFrames)*ValidFrameScore +
+		int64(reputation.InvalidFrames)*InvalidFrameScore +
+		int64(reputation.Timeouts)*TimeoutScore +
+		int64(reputation.DialFailures)*DialFailureScore -
+		int64(reputation.LatencyMs/latencyScoreDivisor)
+
+	age := now.Sub(time.UnixMilli(reputation.LastUpdated))
+	if age <= 0 {
+		return total
+	}
+
+	return int64(
+		float64(total) * math.Exp2(-float64(age)/float64(ReputationHalfLife)),
+	)
+}
+
+func expired(reputation *protobufs.PeerReputation, now time.Time) bool {
+	return now.Sub(time.UnixMilli(reputation.LastUpdated)) >= ReputationExpiry
+}
+
+// Rank orders the peers by descending score. Peers with equal scores keep
+// their relative order.
+func (r *Reputations) Rank(peerIds [][]byte) [][]byte {
+	r.mx.Lock()
+	now := r.clock.Now()
+	scores := make([]int64, len(peerIds))
+	for i, peerId := range peerIds {
+		scores[i] = score(r.peers[string(peerId)], now)
+	}
+	r.mx.Unlock()
+
+	indices := make([]int, len(peerIds))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		return scores[indices[i]] > scores[indices[j]]
+	})
+
+	ranked := make([][]byte, len(peerIds))
+	for i, index := range indices {
+		ranked[i] = peerIds[index]
+	}
+
+	return ranked
+}
@@ -0,0 +1,444 @@
+package framesync
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var ErrInvalidFrame = errors.New("invalid frame")
+
+const (
+	DefaultWindowSize     = 16
+	DefaultMaxPeers       = 8
+	DefaultRequestTimeout = 30 * time.Second
+)
+
+// FrameSource serves frames from a single peer.
+type FrameSource interface {
+	GetDataFrame(
+		ctx context.Context,
+		frameNumber uint64,
+	) (*protobufs.ClockFrame, error)
+	Close() error
+}
+
+// Dialer opens a frame source to the peer.
+type Dialer func(peerId []byte) (FrameSource, error)
+
+// Verifier checks the proofs of a frame.
+type Verifier func(frame *protobufs.ClockFrame) error
+
+// Peer is a sync candidate, along with the highest frame it announced.
+type Peer struct {
+	PeerId   []byte
+	MaxFrame uint64
+}
+
+// window is a contiguous range of frames fetched from a single peer. The
+// first window has the head frame synced from as parent.
+type window struct {
+	parent     *protobufs.ClockFrame
+	start      uint64
+	end        uint64
+	frames     []*protobufs.ClockFrame
+	source     []byte
+	excluded   map[string]struct{}
+	mismatches int
+}
+
+// Scheduler fetches a range of frames from several peers at once. The range
+// is split into windows, each fetched from one peer, with every peer working
+// on one window at a time. A window that fails, whether by timeout or by
+// serving an invalid frame, is handed to another peer. Once fetched, the
+// windows are cross-checked: the first frame of each window must name the
+// selector of the last frame of the previous window, which was served by a
+// different peer, as its parent. Every outcome feeds the peer reputations,
+// which in turn decide the peers used, leaving out those scoring below
+// MinPeerScore as long as another peer scores above it.
+type Scheduler struct {
+	logger         *zap.Logger
+	reputations    *Reputations
+	dial           Dialer
+	verify         Verifier
+	windowSize     uint64
+	maxPeers       int
+	requestTimeout time.Duration
+	clock          clock.Clock
+}
+
+// NewScheduler creates a scheduler. Limits of zero take their default value.
+func NewScheduler(
+	logger *zap.Logger,
+	reputations *Reputations,
+	dial Dialer,
+	verify Verifier,
+	windowSize uint64,
+	maxPeers int,
+	requestTimeout time.Duration,
+	clock clock.Clock,
+) *Scheduler {
+	if logger == nil {
+		panic(errors.New("logger is nil"))
+	}
+
+	if reputations == nil {
+		panic(errors.New("reputations is nil"))
+	}
+
+	if dial == nil {
+		panic(errors.New("dialer is nil"))
+	}
+
+	if verify == nil {
+		panic(errors.New("verifier is nil"))
+	}
+
+	if clock == nil {
+		panic(errors.New("clock is nil"))
+	}
+
+	if windowSize == 0 {
+		windowSize = DefaultWindowSize
+	}
+
+	if maxPeers == 0 {
+		maxPeers = DefaultMaxPeers
+	}
+
+	if requestTimeout == 0 {
+		requestTimeout = DefaultRequestTimeout
+	}
+
+	return &Scheduler{
+		logger:         logger,
+		reputations:    reputations,
+		dial:           dial,
+		verify:         verify,
+		windowSize:     windowSize,
+		maxPeers:       maxPeers,
+		requestTimeout: requestTimeout,
+		clock:          clock,
+	}
+}
+
+// Sync fetches the frames after head up to target from the peers, and returns
+// the longest run of them, in order, that could be fetched and linked
+// together. The run may be empty.
+func (s *Scheduler) Sync(
+	ctx context.Context,
+	head *protobufs.ClockFrame,
+	target uint64,
+	peers []Peer,
+) []*protobufs.ClockFrame {
+	windows := []*window{}
+	for start := head.FrameNumber + 1; start <= target; start += s.windowSize {
+		end := start + s.windowSize - 1
+		if end > target {
+			end = target
+		}
+
+		var parent *protobufs.ClockFrame
+		if len(windows) == 0 {
+			parent = head
+		}
+
+		windows = append(windows, &window{
+			parent:   parent,
+			start:    start,
+			end:      end,
+			excluded: map[string]struct{}{},
+		})
+	}
+
+	maxFrames := map[string]uint64{}
+	peerIds := [][]byte{}
+	for _, p := range peers {
+		if _, ok := maxFrames[string(p.PeerId)]; !ok {
+			peerIds = append(peerIds, p.PeerId)
+		}
+		maxFrames[string(p.PeerId)] = p.MaxFrame
+	}
+
+	ranked := []Peer{}
+	rankedIds := s.reputations.Rank(peerIds)
+	for _, peerId := range rankedIds {
+		if len(ranked) == s.maxPeers {
+			break
+		}
+
+		if s.reputations.Score(peerId) < MinPeerScore {
+			s.logger.Debug(
+				"skipping peer with low reputation",
+				zap.Binary("peer_id", peerId),
+			)
+			continue
+		}
+
+		ranked = append(ranked, Peer{
+			PeerId:   peerId,
+			MaxFrame: maxFrames[string(peerId)],
+		})
+	}
+
+	// Syncing from the best of the peers beats not syncing at all.
+	if len(ranked) == 0 && len(rankedIds) != 0 {
+		s.logger.Info("all peers have a low reputation, using the best ranked")
+		for _, peerId := range rankedIds[:min(len(rankedIds), s.maxPeers)] {
+			ranked = append(ranked, Peer{
+				PeerId:   peerId,
+				MaxFrame: maxFrames[string(peerId)],
+			})
+		}
+	}
+
+	for ctx.Err() == nil {
+		pending := []*window{}
+		for _, w := range windows {
+			if w.frames == nil {
+				pending = append(pending, w)
+			}
+		}
+
+		if len(pending) != 0 {
+			s.fetch(ctx, pending, ranked)
+		}
+
+		if !s.crossCheck(windows) {
+			break
+		}
+	}
+
+	frames := []*protobufs.ClockFrame{}
+	for _, w := range windows {
+		if w.frames == nil {
+			break
+		}
+
+		frames = append(frames, w.frames...)
+	}
+
+	return frames
+}
+
+// crossCheck finds the first pair of adjacent windows that do not link, and
+// discards the window of the peer it holds responsible. The later window is
+// blamed first; once two of its sources have disagreed with the earlier
+// window, the earlier window is blamed instead. It reports whether a window
+// was discarded.
+func (s *Scheduler) crossCheck(windows []*window) bool {
+	for i := 1; i < len(windows); i++ {
+		prev, w := windows[i-1], windows[i]
+		if prev.frames == nil || w.frames == nil {
+			continue
+		}
+
+		if links(prev.frames[len(prev.frames)-1], w.frames[0]) {
+			continue
+		}
+
+		w.mismatches++
+		blamed := w
+		if w.mismatches >= 2 {
+			w.mismatches = 0
+			blamed = prev
+		}
+
+		s.logger.Info(
+			"frames from peers do not link",
+			zap.Uint64("frame_number", w.start),
+			zap.Binary("blamed_peer_id", blamed.source),
+		)
+		s.reputations.RecordInvalid(blamed.source)
+		blamed.excluded[string(blamed.source)] = struct{}{}
+		blamed.frames = nil
+		blamed.source = nil
+		return true
+	}
+
+	return false
+}
+
+// fetch fetches the pending windows from the peers, until every window is
+// fetched or no peer is left to fetch the remaining ones from.
+func (s *Scheduler) fetch(
+	ctx context.Context,
+	pending []*window,
+	peers []Peer,
+) {
+	mx := sync.Mutex{}
+	cond := sync.NewCond(&mx)
+	inFlight := 0
+
+	take := func(p Peer) *window {
+		mx.Lock()
+		defer mx.Unlock()
+
+		for {
+			for i, w := range pending {
+				if _, ok := w.excluded[string(p.PeerId)]; ok || w.end > p.MaxFrame {
+					continue
+				}
+
+				pending = append(pending[:i:i], pending[i+1:]...)
+				inFlight++
+				return w
+			}
+
+			// A window in flight may still fail and come back.
+			if inFlight == 0 || ctx.Err() != nil {
+				return nil
+			}
+
+			cond.Wait()
+		}
+	}
+
+	release := func(w *window, failed bool) {
+		mx.Lock()
+		if failed {
+			pending = append(pending, w)
+		}
+		inFlight--
+		mx.Unlock()
+		cond.Broadcast()
+	}
+
+	wg := sync.WaitGroup{}
+	for _, p := range peers {
+		p := p
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			source, err := s.dial(p.PeerId)
+			if err != nil {
+				s.logger.Debug(
+					"could not dial peer",
+					zap.Binary("peer_id", p.PeerId),
+					zap.Error(err),
+				)
+				s.reputations.RecordDialFailure(p.PeerId)
+				return
+			}
+
+			defer func() {
+				if err := source.Close(); err != nil {
+					s.logger.Debug("could not close source", zap.Error(err))
+				}
+			}()
+
+			for {
+				w := take(p)
+				if w == nil {
+					return
+				}
+
+				err := s.fetchWindow(ctx, source, p.PeerId, w)
+				if err == nil {
+					release(w, false)
+					continue
+				}
+
+				if ctx.Err() == nil {
+					s.logger.Debug(
+						"could not fetch frames from peer",
+						zap.Binary("peer_id", p.PeerId),
+						zap.Uint64("frame_number", w.start),
+						zap.Error(err),
+					)
+					w.excluded[string(p.PeerId)] = struct{}{}
+					if errors.Is(err, ErrInvalidFrame) {
+						s.reputations.RecordInvalid(p.PeerId)
+					} else {
+						s.reputations.RecordTimeout(p.PeerId)
+					}
+				}
+
+				release(w, true)
+				return
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// fetchWindow fetches and validates the frames of the window from the source.
+func (s *Scheduler) fetchWindow(
+	ctx context.Context,
+	source FrameSource,
+	peerId []byte,
+	w *window,
+) error {
+	frames := []*protobufs.ClockFrame{}
+	parent := w.parent
+	start := s.clock.Now()
+	for frameNumber := w.start; frameNumber <= w.end; frameNumber++ {
+		requestCtx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+		frame, err := source.GetDataFrame(requestCtx, frameNumber)
+		cancel()
+		if err != nil {
+			return errors.Wrap(err, "fetch window")
+		}
+
+		if err := s.validate(parent, frameNumber, frame); err != nil {
+			return errors.Wrap(err, "fetch window")
+		}
+
+		frames = append(frames, frame)
+		parent = frame
+	}
+
+	s.reputations.RecordValid(peerId, uint64(len(frames)), s.clock.Since(start))
+	w.frames = frames
+	w.source = peerId
+	return nil
+}
+
+// validate checks the frame against its parent, if known.
+func (s *Scheduler) validate(
+	parent *protobufs.ClockFrame,
+	frameNumber uint64,
+	frame *protobufs.ClockFrame,
+) error {
+	if frame == nil || frame.FrameNumber != frameNumber {
+		return errors.Wrap(ErrInvalidFrame, "unexpected frame number")
+	}
+
+	if len(frame.Output) < 516 {
+		return errors.Wrap(ErrInvalidFrame, "output too short")
+	}
+
+	if parent != nil {
+		if frame.Timestamp < parent.Timestamp {
+			return errors.Wrap(ErrInvalidFrame, "timestamp before parent")
+		}
+
+		if !links(parent, frame) {
+			return errors.Wrap(ErrInvalidFrame, "parent selector mismatch")
+		}
+	}
+
+	if err := s.verify(frame); err != nil {
+		return errors.Wrap(ErrInvalidFrame, err.Error())
+	}
+
+	return nil
+}
+
+// links reports whether the frame names the parent's selector as its parent.
+func links(parent *protobufs.ClockFrame, frame *protobufs.ClockFrame) bool {
+	selector, err := parent.GetSelector()
+	if err != nil {
+		return false
+	}
+
+	return new(big.Int).SetBytes(frame.ParentSelector).Cmp(selector) == 0
+}
@@ -0,0 +1,416 @@
+package framesync_test
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/framesync"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+type scorer struct {
+	mx     sync.Mutex
+	scores map[string]int64
+}
+
+func (s *scorer) AddPeerScore(peerId []byte, scoreDelta int64) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.scores[string(peerId)] += scoreDelta
+}
+
+// chain builds frames 0 to length, each naming its predecessor's selector as
+// parent. The seed distinguishes the outputs of different chains.
+func chain(t *testing.T, length uint64, seed byte) []*protobufs.ClockFrame {
+	frames := []*protobufs.ClockFrame{}
+	for i := uint64(0); i <= length; i++ {
+		output := make([]byte, 516)
+		binary.BigEndian.PutUint64(output, i)
+		output[8] = seed
+
+		frame := &protobufs.ClockFrame{
+			FrameNumber: i,
+			Timestamp:   int64(i),
+			Output:      output,
+		}
+		if i != 0 {
+			selector, err := frames[i-1].GetSelector()
+			require.NoError(t, err)
+			frame.ParentSelector = selector.FillBytes(make([]byte, 32))
+		}
+
+		frames = append(frames, frame)
+	}
+
+	return frames
+}
+
+type source struct {
+	frames     []*protobufs.ClockFrame
+	delay      time.Duration
+	dialDelay  time.Duration
+	clock      *clock.FakeClock
+	step       time.Duration
+	mx         sync.Mutex
+	frameCalls int
+}
+
+func (s *source) GetDataFrame(
+	ctx context.Context,
+	frameNumber uint64,
+) (*protobufs.ClockFrame, error) {
+	s.mx.Lock()
+	s.frameCalls++
+	s.mx.Unlock()
+
+	// Serving a frame takes exactly step on the fake clock.
+	if s.clock != nil {
+		s.clock.Advance(s.step)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(s.delay):
+	}
+
+	if frameNumber >= uint64(len(s.frames)) {
+		return nil, errors.New("frame not found")
+	}
+
+	return s.frames[frameNumber], nil
+}
+
+func (s *source) Close() error {
+	return nil
+}
+
+func newScheduler(
+	t *testing.T,
+	db store.KVDB,
+	scores *scorer,
+	sources map[string]*source,
+	clk clock.Clock,
+) (*framesync.Scheduler, *framesync.Reputations) {
+	reputations := framesync.NewReputations(
+		zap.NewNop(),
+		store.NewPebblePeerReputationStore(db, zap.NewNop()),
+		scores,
+		clk,
+	)
+	require.NoError(t, reputations.Load())
+
+	scheduler := framesync.NewScheduler(
+		zap.NewNop(),
+		reputations,
+		func(peerId []byte) (framesync.FrameSource, error) {
+			s, ok := sources[string(peerId)]
+			if !ok {
+				return nil, errors.New("peer unreachable")
+			}
+			time.Sleep(s.dialDelay)
+			return s, nil
+		},
+		func(frame *protobufs.ClockFrame) error {
+			return nil
+		},
+		8,
+		5,
+		50*time.Millisecond,
+		clk,
+	)
+
+	return scheduler, reputations
+}
+
+func TestSchedulerSync(t *testing.T) {
+	frames := chain(t, 40, 0)
+	sources := map[string]*source{
+		"a":    {frames: frames, delay: 2 * time.Millisecond},
+		"b":    {frames: frames, delay: 2 * time.Millisecond},
+		"c":    {frames: frames[:25], delay: 2 * time.Millisecond},
+		"slow": {frames: frames, delay: time.Second},
+	}
+	scores := &scorer{scores: map[string]int64{}}
+	db := store.NewInMemKVDB()
+	scheduler, reputations := newScheduler(
+		t,
+		db,
+		scores,
+		sources,
+		clock.NewRealClock(),
+	)
+
+	synced := scheduler.Sync(
+		context.Background(),
+		frames[0],
+		40,
+		[]framesync.Peer{
+			{PeerId: []byte("down"), MaxFrame: 40},
+			{PeerId: []byte("slow"), MaxFrame: 40},
+			{PeerId: []byte("a"), MaxFrame: 40},
+			{PeerId: []byte("b"), MaxFrame: 40},
+			{PeerId: []byte("c"), MaxFrame: 24},
+		},
+	)
+	assert.Equal(t, frames[1:], synced)
+
+	// The unreachable peer is recorded as such, not as a timeout.
+	down := reputations.Get([]byte("down"))
+	require.NotNil(t, down)
+	assert.Equal(t, uint64(1), down.DialFailures)
+	assert.Equal(t, uint64(0), down.Timeouts)
+	assert.Equal(t, framesync.DialFailureScore, scores.scores["down"])
+
+	// The slow peer times out on its first window, which another peer serves.
+	slow := reputations.Get([]byte("slow"))
+	require.NotNil(t, slow)
+	assert.Equal(t, uint64(1), slow.Timeouts)
+	assert.Equal(t, uint64(0), slow.ValidFrames)
+	assert.Equal(t, framesync.TimeoutScore, scores.scores["slow"])
+	assert.Equal(t, 1, sources["slow"].frameCalls)
+
+	valid := uint64(0)
+	for _, peerId := range []string{"a", "b", "c"} {
+		if reputation := reputations.Get([]byte(peerId)); reputation != nil {
+			valid += reputation.ValidFrames
+		}
+	}
+	assert.Equal(t, uint64(40), valid)
+
+	// The reputations survive a restart and put the slow peer last.
+	_, reloaded := newScheduler(
+		t,
+		db,
+		scores,
+		sources,
+		clock.NewRealClock(),
+	)
+	assert.Equal(t, slow, reloaded.Get([]byte("slow")))
+	ranked := reloaded.Rank([][]byte{
+		[]byte("slow"),
+		[]byte("a"),
+		[]byte("b"),
+		[]byte("c"),
+	})
+	assert.Equal(t, []byte("slow"), ranked[3])
+}
+
+func TestSchedulerCrossCheck(t *testing.T) {
+	frames := chain(t, 16, 0)
+
+	// The liar serves frame 8 from a competing chain, which is consistent
+	// within its window but does not link to the frames after it.
+	forged := append([]*protobufs.ClockFrame{}, frames[:9]...)
+	forged[8] = chain(t, 8, 1)[8]
+	forged[8].ParentSelector = frames[8].ParentSelector
+
+	sources := map[string]*source{
+		"liar": {frames: forged},
+		"a":    {frames: frames, dialDelay: 20 * time.Millisecond},
+		"b":    {frames: frames, dialDelay: 20 * time.Millisecond},
+	}
+	scores := &scorer{scores: map[string]int64{}}
+	scheduler, reputations := newScheduler(
+		t,
+		store.NewInMemKVDB(),
+		scores,
+		sources,
+		clock.NewRealClock(),
+	)
+
+	synced := scheduler.Sync(
+		context.Background(),
+		frames[0],
+		16,
+		[]framesync.Peer{
+			{PeerId: []byte("liar"), MaxFrame: 8},
+			{PeerId: []byte("a"), MaxFrame: 16},
+			{PeerId: []byte("b"), MaxFrame: 16},
+		},
+	)
+	assert.Equal(t, frames[1:], synced)
+
+	liar := reputations.Get([]byte("liar"))
+	require.NotNil(t, liar)
+	assert.Equal(t, uint64(1), liar.InvalidFrames)
+	assert.Less(t, scores.scores["liar"], int64(0))
+}
+
+func TestSchedulerLatency(t *testing.T) {
+	start := time.UnixMilli(1_700_000_000_000)
+	fake := clock.NewFakeClock(start)
+	frames := chain(t, 16, 0)
+	sources := map[string]*source{
+		"a": {frames: frames, clock: fake, step: 10 * time.Millisecond},
+	}
+	scores := &scorer{scores: map[string]int64{}}
+	scheduler, reputations := newScheduler(
+		t,
+		store.NewInMemKVDB(),
+		scores,
+		sources,
+		fake,
+	)
+
+	synced := scheduler.Sync(
+		context.Background(),
+		frames[0],
+		16,
+		[]framesync.Peer{{PeerId: []byte("a"), MaxFrame: 16}},
+	)
+	assert.Equal(t, frames[1:], synced)
+
+	// Both windows are served by the single peer, one after the other, at
+	// 10ms per frame on the fake clock.
+	reputation := reputations.Get([]byte("a"))
+	require.NotNil(t, reputation)
+	assert.Equal(t, uint64(16), reputation.ValidFrames)
+	assert.Equal(t, uint64(10), reputation.LatencyMs)
+	assert.Equal(
+		t,
+		start.Add(160*time.Millisecond).UnixMilli(),
+		reputation.LastUpdated,
+	)
+	assert.Equal(t, 16*framesync.ValidFrameScore, scores.scores["a"])
+}
+
+func TestSchedulerHeadLink(t *testing.T) {
+	frames := chain(t, 16, 0)
+
+	// The fork peer serves a chain that does not descend from the head.
+	sources := map[string]*source{
+		"fork": {frames: chain(t, 16, 1)},
+		"a":    {frames: frames, dialDelay: 20 * time.Millisecond},
+	}
+	scores := &scorer{scores: map[string]int64{}}
+	scheduler, reputations := newScheduler(
+		t,
+		store.NewInMemKVDB(),
+		scores,
+		sources,
+		clock.NewRealClock(),
+	)
+
+	synced := scheduler.Sync(
+		context.Background(),
+		frames[0],
+		16,
+		[]framesync.Peer{
+			{PeerId: []byte("fork"), MaxFrame: 16},
+			{PeerId: []byte("a"), MaxFrame: 16},
+		},
+	)
+	assert.Equal(t, frames[1:], synced)
+
+	fork := reputations.Get([]byte("fork"))
+	require.NotNil(t, fork)
+	assert.Equal(t, uint64(1), fork.InvalidFrames)
+
+	// Without an honest peer, nothing is synced.
+	synced = scheduler.Sync(
+		context.Background(),
+		frames[0],
+		16,
+		[]framesync.Peer{{PeerId: []byte("fork"), MaxFrame: 16}},
+	)
+	assert.Empty(t, synced)
+}
+
+func TestSchedulerSkipsLowScorePeers(t *testing.T) {
+	frames := chain(t, 16, 0)
+	sources := map[string]*source{
+		"bad": {frames: frames},
+		"a":   {frames: frames},
+	}
+	scores := &scorer{scores: map[string]int64{}}
+	scheduler, reputations := newScheduler(
+		t,
+		store.NewInMemKVDB(),
+		scores,
+		sources,
+		clock.NewRealClock(),
+	)
+	reputations.RecordInvalid([]byte("bad"))
+	reputations.RecordTimeout([]byte("bad"))
+	require.Less(t, reputations.Score([]byte("bad")), framesync.MinPeerScore)
+
+	synced := scheduler.Sync(
+		context.Background(),
+		frames[0],
+		16,
+		[]framesync.Peer{
+			{PeerId: []byte("bad"), MaxFrame: 16},
+			{PeerId: []byte("a"), MaxFrame: 16},
+		},
+	)
+	assert.Equal(t, frames[1:], synced)
+	assert.Equal(t, 0, sources["bad"].frameCalls)
+
+	// With no other peer, the low scoring peer is still synced from.
+	synced = scheduler.Sync(
+		context.Background(),
+		frames[0],
+		16,
+		[]framesync.Peer{{PeerId: []byte("bad"), MaxFrame: 16}},
+	)
+	assert.Equal(t, frames[1:], synced)
+	assert.Equal(t, 16, sources["bad"].frameCalls)
+}
+
+func TestReputationsDecay(t *testing.T) {
+	fake := clock.NewFakeClock(time.UnixMilli(1_700_000_000_000))
+	db := store.NewInMemKVDB()
+	scores := &scorer{scores: map[string]int64{}}
+	_, reputations := newScheduler(t, db, scores, nil, fake)
+
+	reputations.RecordInvalid([]byte("bad"))
+	reputations.RecordInvalid([]byte("stale"))
+	assert.Equal(t, framesync.InvalidFrameScore, reputations.Score([]byte("bad")))
+
+	fake.Advance(framesync.ReputationHalfLife)
+	assert.Equal(
+		t,
+		framesync.InvalidFrameScore/2,
+		reputations.Score([]byte("bad")),
+	)
+	assert.Greater(t, reputations.Score([]byte("bad")), framesync.MinPeerScore)
+
+	// A record for the peer starts over from its counters, decayed from then.
+	reputations.RecordTimeout([]byte("bad"))
+	assert.Equal(
+		t,
+		framesync.InvalidFrameScore+framesync.TimeoutScore,
+		reputations.Score([]byte("bad")),
+	)
+
+	// Expired reputations are forgotten, and deleted on restart.
+	fake.Advance(framesync.ReputationExpiry - framesync.ReputationHalfLife)
+	assert.Nil(t, reputations.Get([]byte("stale")))
+	assert.Zero(t, reputations.Score([]byte("stale")))
+	assert.NotNil(t, reputations.Get([]byte("bad")))
+
+	_, reloaded := newScheduler(t, db, scores, nil, fake)
+	assert.Nil(t, reloaded.Get([]byte("stale")))
+	stored, err := store.NewPebblePeerReputationStore(
+		db,
+		zap.NewNop(),
+	).GetPeerReputations()
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	assert.Equal(t, []byte("bad"), stored[0].PeerId)
+
+	reloaded.RecordInvalid([]byte("stale"))
+	stale := reloaded.Get([]byte("stale"))
+	require.NotNil(t, stale)
+	assert.Equal(t, uint64(1), stale.InvalidFrames)
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/binary"
 	"strings"
-	"time"
 
 	"github.com/iden3/go-iden3-crypto/poseidon"
 	"github.com/mr-tron/base58"
@@ -106,14 +105,14 @@ func (e *MasterClockConsensusEngine) handleSelfTestReport(
 
 	if bytes.Equal(peerID, e.pubSub.GetPeerID()) {
 		info := e.peerInfoManager.GetPeerInfo(peerID)
-		info.LastSeen = time.Now().UnixMilli()
+		info.LastSeen = e.clock.Now().UnixMilli()
 		info.MasterHeadFrame = report.MasterHeadFrame
 		return nil
 	}
 
 	info := e.peerInfoManager.GetPeerInfo(peerID)
 	if info != nil {
-		if (time.Now().UnixMilli() - info.LastSeen) < (270 * 1000) {
+		if (e.clock.Now().UnixMilli() - info.LastSeen) < (270 * 1000) {
 			return nil
 		}
 	}
@@ -2,7 +2,6 @@ package master
 
 import (
 	"bytes"
-	"time"
 
 	"github.com/pkg/errors"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
@@ -22,7 +21,7 @@ func (e *MasterClockConsensusEngine) prove(
 
 		frame, err := e.frameProver.ProveMasterClockFrame(
 			previousFrame,
-			time.Now().UnixMilli(),
+			e.clock.Now().UnixMilli(),
 			e.difficulty,
 			collectedProverSlots,
 		)
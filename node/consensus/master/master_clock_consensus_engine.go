@@ -12,6 +12,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
 	qtime "source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
@@ -38,9 +39,11 @@ type MasterClockConsensusEngine struct {
 	logger              *zap.Logger
 	state               consensus.EngineState
 	pubSub              p2p.PubSub
+	subscription        *p2p.Subscription
 	keyManager          keys.KeyManager
 	dataProver          crypto.InclusionProver
 	frameProver         crypto.FrameProver
+	clock               clock.Clock
 	lastFrameReceivedAt time.Time
 
 	frameChan        chan *protobufs.ClockFrame
@@ -80,6 +83,7 @@ func NewMasterClockConsensusEngine(
 	frameProver crypto.FrameProver,
 	masterTimeReel *qtime.MasterTimeReel,
 	peerInfoManager p2p.PeerInfoManager,
+	clock clock.Clock,
 	report *protobufs.SelfTestReport,
 ) *MasterClockConsensusEngine {
 	if logger == nil {
@@ -110,6 +114,10 @@ func NewMasterClockConsensusEngine(
 		panic(errors.New("master time reel is nil"))
 	}
 
+	if clock == nil {
+		panic(errors.New("clock is nil"))
+	}
+
 	seed, err := hex.DecodeString(engineConfig.GenesisSeed)
 	if err != nil {
 		panic(errors.New("genesis seed is nil"))
@@ -129,6 +137,7 @@ func NewMasterClockConsensusEngine(
 		clockStore:           clockStore,
 		dataProver:           dataProver,
 		frameProver:          frameProver,
+		clock:                clock,
 		masterTimeReel:       masterTimeReel,
 		peerInfoManager:      peerInfoManager,
 		report:               report,
@@ -212,7 +221,11 @@ func (e *MasterClockConsensusEngine) Start() <-chan error {
 	}()
 
 	e.logger.Info("subscribing to pubsub messages")
-	e.pubSub.Subscribe(e.filter, e.handleMessage)
+	subscription, err := e.pubSub.Subscribe(e.filter, e.handleMessage)
+	if err != nil {
+		panic(err)
+	}
+	e.subscription = subscription
 
 	e.state = consensus.EngineStateCollecting
 
@@ -223,7 +236,7 @@ func (e *MasterClockConsensusEngine) Start() <-chan error {
 				zap.Int("peer_store_count", e.pubSub.GetPeerstoreCount()),
 				zap.Int("network_peer_count", e.pubSub.GetNetworkPeersCount()),
 			)
-			time.Sleep(10 * time.Second)
+			e.clock.Sleep(10 * time.Second)
 		}
 	}()
 
@@ -256,6 +269,8 @@ func (e *MasterClockConsensusEngine) Stop(force bool) <-chan error {
 	e.state = consensus.EngineStateStopping
 	errChan := make(chan error)
 
+	e.subscription.Cancel()
+
 	wg := sync.WaitGroup{}
 	wg.Add(len(e.executionEngines))
 	for name := range e.executionEngines {
@@ -361,7 +376,7 @@ func (e *MasterClockConsensusEngine) addPeerManifestReport(
 		Storage:         report.Storage,
 		Capabilities:    []p2p.Capability{},
 		MasterHeadFrame: report.MasterHeadFrame,
-		LastSeen:        time.Now().UnixMilli(),
+		LastSeen:        e.clock.Now().UnixMilli(),
 	}
 
 	for _, capability := range manifest.Capabilities {
@@ -103,6 +103,6 @@ func (e *MasterClockConsensusEngine) Sync(
 		from = response[len(response)-1].FrameNumber + 1
 		to = from + 15
 
-		time.Sleep(1 * time.Second)
+		e.clock.Sleep(1 * time.Second)
 	}
 }
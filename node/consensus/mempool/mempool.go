@@ -0,0 +1,554 @@
+package mempool
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+var ErrInvalidRequest = errors.New("invalid request")
+var ErrDuplicate = errors.New("request already pending")
+var ErrConflict = errors.New("request conflicts with a pending request")
+var ErrSenderLimit = errors.New("sender has too many pending requests")
+var ErrFull = errors.New("mempool is full")
+var ErrNotOwner = errors.New("sender does not own the coin")
+
+const (
+	DefaultMaxEntries          = 10000
+	DefaultMaxEntriesPerSender = 100
+	DefaultMaxFrameAge         = 360
+)
+
+// Mempool holds the token requests received and not yet included in a frame.
+// Each request is indexed by the coins and pre-coin proofs it consumes, the
+// same resources the token application locks when applying it, so a request
+// spending what a pending request already spends is rejected up front. Coins
+// are only spent by requests their owner signed, so a request for another
+// sender's coin can't hold it in place of the owner's. The entries are
+// bounded per sender and in total, expire after a number of frames, and are
+// persisted so they survive restarts.
+type Mempool struct {
+	mx                  sync.RWMutex
+	logger              *zap.Logger
+	store               store.MempoolStore
+	coinStore           store.CoinStore
+	maxEntries          int
+	maxEntriesPerSender int
+	maxFrameAge         uint64
+	entries             map[string]*protobufs.MempoolEntry
+	resources           map[string]string
+	senders             map[string]int
+}
+
+// NewMempool creates an empty mempool persisted in the store. Limits of zero
+// take their default value.
+func NewMempool(
+	logger *zap.Logger,
+	mempoolStore store.MempoolStore,
+	coinStore store.CoinStore,
+	maxEntries int,
+	maxEntriesPerSender int,
+	maxFrameAge uint64,
+) *Mempool {
+	if maxEntries == 0 {
+		maxEntries = DefaultMaxEntries
+	}
+
+	if maxEntriesPerSender == 0 {
+		maxEntriesPerSender = DefaultMaxEntriesPerSender
+	}
+
+	if maxFrameAge == 0 {
+		maxFrameAge = DefaultMaxFrameAge
+	}
+
+	return &Mempool{
+		logger:              logger,
+		store:               mempoolStore,
+		coinStore:           coinStore,
+		maxEntries:          maxEntries,
+		maxEntriesPerSender: maxEntriesPerSender,
+		maxFrameAge:         maxFrameAge,
+		entries:             map[string]*protobufs.MempoolEntry{},
+		resources:           map[string]string{},
+		senders:             map[string]int{},
+	}
+}
+
+// Load restores the entries persisted in the store. Entries that no longer
+// fit the limits, conflict with an earlier entry or spend coins their sender
+// no longer owns are dropped.
+func (m *Mempool) Load() error {
+	entries, err := m.store.GetMempoolEntries()
+	if err != nil {
+		return errors.Wrap(err, "load")
+	}
+
+	sortEntries(entries)
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	dropped := [][]byte{}
+	for _, entry := range entries {
+		resources, err := consumedResources(entry.Request)
+		if err == nil {
+			err = m.checkOwner(entry.Request, entry.Sender)
+		}
+		if err == nil {
+			err = m.check(string(entry.RequestId), entry.Sender, resources)
+		}
+		if err != nil {
+			dropped = append(dropped, entry.RequestId)
+			continue
+		}
+
+		m.insert(entry, resources)
+	}
+
+	m.logger.Info(
+		"loaded mempool",
+		zap.Int("entries", len(m.entries)),
+		zap.Int("dropped", len(dropped)),
+	)
+
+	return errors.Wrap(m.store.DeleteMempoolEntries(dropped), "load")
+}
+
+// RequestId returns the hash identifying the request.
+func RequestId(request *protobufs.TokenRequest) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "request id")
+	}
+
+	id := sha3.Sum256(data)
+	return id[:], nil
+}
+
+// Add adds the request, received at the frame number, to the mempool. It
+// fails with ErrNotOwner if the sender doesn't own a coin it spends,
+// ErrDuplicate if the request is already pending, ErrConflict if a pending
+// request consumes one of its coins or proofs, and ErrSenderLimit or ErrFull
+// if the mempool has no room for it.
+func (m *Mempool) Add(
+	request *protobufs.TokenRequest,
+	frameNumber uint64,
+) error {
+	sender, err := requestSender(request)
+	if err != nil {
+		return errors.Wrap(err, "add")
+	}
+
+	resources, err := consumedResources(request)
+	if err != nil {
+		return errors.Wrap(err, "add")
+	}
+
+	id, err := RequestId(request)
+	if err != nil {
+		return errors.Wrap(err, "add")
+	}
+
+	if err := m.checkOwner(request, sender); err != nil {
+		return errors.Wrap(err, "add")
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if err := m.check(string(id), sender, resources); err != nil {
+		return errors.Wrap(err, "add")
+	}
+
+	entry := &protobufs.MempoolEntry{
+		Request:     request,
+		RequestId:   id,
+		Sender:      sender,
+		FrameNumber: frameNumber,
+	}
+	if err := m.store.PutMempoolEntry(entry); err != nil {
+		return errors.Wrap(err, "add")
+	}
+
+	m.insert(entry, resources)
+	return nil
+}
+
+// Requests returns the pending requests, oldest first.
+func (m *Mempool) Requests() *protobufs.TokenRequests {
+	entries := m.Entries(nil)
+	requests := &protobufs.TokenRequests{
+		Requests: make([]*protobufs.TokenRequest, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		requests.Requests = append(requests.Requests, entry.Request)
+	}
+
+	return requests
+}
+
+// Entries returns the pending entries of the sender, or all of them if the
+// sender is nil, oldest first.
+func (m *Mempool) Entries(sender []byte) []*protobufs.MempoolEntry {
+	m.mx.RLock()
+	entries := []*protobufs.MempoolEntry{}
+	for _, entry := range m.entries {
+		if sender == nil || bytes.Equal(entry.Sender, sender) {
+			entries = append(entries, entry)
+		}
+	}
+	m.mx.RUnlock()
+
+	sortEntries(entries)
+	return entries
+}
+
+// Inspect returns the pending entries of the sender, or all of them if the
+// sender is nil, along with the limits of the mempool.
+func (m *Mempool) Inspect(sender []byte) *protobufs.MempoolResponse {
+	entries := m.Entries(sender)
+
+	m.mx.RLock()
+	total := len(m.entries)
+	m.mx.RUnlock()
+
+	return &protobufs.MempoolResponse{
+		Entries:             entries,
+		TotalEntries:        uint64(total),
+		MaxEntries:          uint64(m.maxEntries),
+		MaxEntriesPerSender: uint64(m.maxEntriesPerSender),
+		MaxFrameAge:         m.maxFrameAge,
+	}
+}
+
+// Remove drops the requests from the mempool, if pending.
+func (m *Mempool) Remove(requests []*protobufs.TokenRequest) error {
+	ids := [][]byte{}
+	for _, request := range requests {
+		id, err := RequestId(request)
+		if err != nil {
+			return errors.Wrap(err, "remove")
+		}
+
+		ids = append(ids, id)
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	removed := [][]byte{}
+	for _, id := range ids {
+		if m.delete(string(id)) {
+			removed = append(removed, id)
+		}
+	}
+
+	return errors.Wrap(m.store.DeleteMempoolEntries(removed), "remove")
+}
+
+// Prune updates the mempool for a new head frame: it drops the requests the
+// frame included, the requests consuming a coin the frame included requests
+// or outputs consumed, and the requests received more than the maximum frame
+// age before the frame. The other requests stay pending for a later frame.
+func (m *Mempool) Prune(
+	frameNumber uint64,
+	included *protobufs.TokenRequests,
+	outputs *protobufs.TokenOutputs,
+) error {
+	ids := map[string]struct{}{}
+	consumed := map[string]struct{}{}
+	for _, request := range included.GetRequests() {
+		id, err := RequestId(request)
+		if err != nil {
+			return errors.Wrap(err, "prune")
+		}
+
+		ids[string(id)] = struct{}{}
+
+		// Included requests were validated by the frame's prover, only the
+		// resources they consume matter here.
+		resources, _ := consumedResources(request)
+		for _, resource := range resources {
+			consumed[resource] = struct{}{}
+		}
+	}
+
+	for _, output := range outputs.GetOutputs() {
+		if deleted := output.GetDeletedCoin(); deleted != nil {
+			consumed[string(deleted.Address)] = struct{}{}
+		}
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	for resource := range consumed {
+		if id, ok := m.resources[resource]; ok {
+			ids[id] = struct{}{}
+		}
+	}
+
+	expired := 0
+	for id, entry := range m.entries {
+		if frameNumber > entry.FrameNumber &&
+			frameNumber-entry.FrameNumber > m.maxFrameAge {
+			ids[id] = struct{}{}
+			expired++
+		}
+	}
+
+	removed := [][]byte{}
+	for id := range ids {
+		if m.delete(id) {
+			removed = append(removed, []byte(id))
+		}
+	}
+
+	if len(removed) != 0 {
+		m.logger.Debug(
+			"pruned mempool",
+			zap.Uint64("frame_number", frameNumber),
+			zap.Int("removed", len(removed)),
+			zap.Int("expired", expired),
+			zap.Int("remaining", len(m.entries)),
+		)
+	}
+
+	return errors.Wrap(m.store.DeleteMempoolEntries(removed), "prune")
+}
+
+// check returns why a request could not be added, if it can't.
+func (m *Mempool) check(id string, sender []byte, resources []string) error {
+	if _, ok := m.entries[id]; ok {
+		return ErrDuplicate
+	}
+
+	for _, resource := range resources {
+		if _, ok := m.resources[resource]; ok {
+			return ErrConflict
+		}
+	}
+
+	if m.senders[string(sender)] >= m.maxEntriesPerSender {
+		return ErrSenderLimit
+	}
+
+	if len(m.entries) >= m.maxEntries {
+		return ErrFull
+	}
+
+	return nil
+}
+
+// checkOwner checks the sender owns the coins the request spends, by the
+// address of its public key or of its peer ID as the token application
+// does.
+func (m *Mempool) checkOwner(
+	request *protobufs.TokenRequest,
+	sender []byte,
+) error {
+	var coins []*protobufs.CoinRef
+	switch r := request.Request.(type) {
+	case *protobufs.TokenRequest_Transfer:
+		coins = []*protobufs.CoinRef{r.Transfer.GetOfCoin()}
+	case *protobufs.TokenRequest_Split:
+		coins = []*protobufs.CoinRef{r.Split.GetOfCoin()}
+	case *protobufs.TokenRequest_Merge:
+		coins = r.Merge.GetCoins()
+	default:
+		return nil
+	}
+
+	owners, err := ownerAddresses(sender)
+	if err != nil {
+		return errors.Wrap(err, "check owner")
+	}
+
+	for _, ref := range coins {
+		coin, err := m.coinStore.GetCoinByAddress(nil, ref.GetAddress())
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return errors.Wrap(ErrNotOwner, "check owner")
+			}
+
+			return errors.Wrap(err, "check owner")
+		}
+
+		owner := coin.GetOwner().GetImplicitAccount().GetAddress()
+		if !bytes.Equal(owner, owners[0]) && !bytes.Equal(owner, owners[1]) {
+			return errors.Wrap(ErrNotOwner, "check owner")
+		}
+	}
+
+	return nil
+}
+
+// ownerAddresses returns the addresses a coin of the public key may be owned
+// by: the hash of the key and the hash of its peer ID.
+func ownerAddresses(publicKey []byte) ([][]byte, error) {
+	addr, err := poseidon.HashBytes(publicKey)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidRequest, "owner addresses")
+	}
+
+	pk, err := pcrypto.UnmarshalEd448PublicKey(publicKey)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidRequest, "owner addresses")
+	}
+
+	peerId, err := peer.IDFromPublicKey(pk)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidRequest, "owner addresses")
+	}
+
+	altAddr, err := poseidon.HashBytes([]byte(peerId))
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidRequest, "owner addresses")
+	}
+
+	return [][]byte{
+		addr.FillBytes(make([]byte, 32)),
+		altAddr.FillBytes(make([]byte, 32)),
+	}, nil
+}
+
+func (m *Mempool) insert(entry *protobufs.MempoolEntry, resources []string) {
+	id := string(entry.RequestId)
+	m.entries[id] = entry
+	for _, resource := range resources {
+		m.resources[resource] = id
+	}
+	m.senders[string(entry.Sender)]++
+}
+
+// delete removes the entry from the indexes, returning whether it was
+// pending.
+func (m *Mempool) delete(id string) bool {
+	entry, ok := m.entries[id]
+	if !ok {
+		return false
+	}
+
+	delete(m.entries, id)
+	resources, _ := consumedResources(entry.Request)
+	for _, resource := range resources {
+		if m.resources[resource] == id {
+			delete(m.resources, resource)
+		}
+	}
+
+	sender := string(entry.Sender)
+	m.senders[sender]--
+	if m.senders[sender] <= 0 {
+		delete(m.senders, sender)
+	}
+
+	return true
+}
+
+func sortEntries(entries []*protobufs.MempoolEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].FrameNumber != entries[j].FrameNumber {
+			return entries[i].FrameNumber < entries[j].FrameNumber
+		}
+
+		return bytes.Compare(entries[i].RequestId, entries[j].RequestId) < 0
+	})
+}
+
+// requestSender returns the public key signing the request.
+func requestSender(request *protobufs.TokenRequest) ([]byte, error) {
+	var sender []byte
+	switch r := request.Request.(type) {
+	case *protobufs.TokenRequest_Transfer:
+		sender = r.Transfer.GetSignature().GetPublicKey().GetKeyValue()
+	case *protobufs.TokenRequest_Split:
+		sender = r.Split.GetSignature().GetPublicKey().GetKeyValue()
+	case *protobufs.TokenRequest_Merge:
+		sender = r.Merge.GetSignature().GetPublicKey().GetKeyValue()
+	case *protobufs.TokenRequest_Mint:
+		sender = r.Mint.GetSignature().GetPublicKey().GetKeyValue()
+	case *protobufs.TokenRequest_Announce:
+		signatures := r.Announce.GetPublicKeySignaturesEd448()
+		if len(signatures) != 0 {
+			sender = signatures[0].GetPublicKey().GetKeyValue()
+		}
+	}
+
+	if len(sender) == 0 {
+		return nil, errors.Wrap(ErrInvalidRequest, "request sender")
+	}
+
+	return sender, nil
+}
+
+// consumedResources returns the keys the token application locks when
+// applying the request: the addresses of the coins it spends, and for mints
+// the proof or prover it mints for. Announcements consume nothing.
+func consumedResources(request *protobufs.TokenRequest) ([]string, error) {
+	switch r := request.Request.(type) {
+	case *protobufs.TokenRequest_Transfer:
+		address := r.Transfer.GetOfCoin().GetAddress()
+		if len(address) == 0 {
+			return nil, errors.Wrap(ErrInvalidRequest, "consumed resources")
+		}
+
+		return []string{string(address)}, nil
+	case *protobufs.TokenRequest_Split:
+		address := r.Split.GetOfCoin().GetAddress()
+		if len(address) == 0 {
+			return nil, errors.Wrap(ErrInvalidRequest, "consumed resources")
+		}
+
+		return []string{string(address)}, nil
+	case *protobufs.TokenRequest_Merge:
+		resources := []string{}
+		seen := map[string]struct{}{}
+		for _, coin := range r.Merge.GetCoins() {
+			if len(coin.GetAddress()) == 0 {
+				return nil, errors.Wrap(ErrInvalidRequest, "consumed resources")
+			}
+
+			if _, ok := seen[string(coin.Address)]; ok {
+				return nil, errors.Wrap(ErrInvalidRequest, "consumed resources")
+			}
+
+			seen[string(coin.Address)] = struct{}{}
+			resources = append(resources, string(coin.Address))
+		}
+
+		if len(resources) == 0 {
+			return nil, errors.Wrap(ErrInvalidRequest, "consumed resources")
+		}
+
+		return resources, nil
+	case *protobufs.TokenRequest_Mint:
+		proofs := r.Mint.GetProofs()
+		if len(proofs) == 1 && len(proofs[0]) == 64 {
+			return []string{string(proofs[0][32:])}, nil
+		}
+
+		if len(proofs) == 3 {
+			return []string{}, nil
+		}
+
+		return []string{
+			string(r.Mint.GetSignature().GetPublicKey().GetKeyValue()),
+		}, nil
+	case *protobufs.TokenRequest_Announce:
+		return []string{}, nil
+	default:
+		return nil, errors.Wrap(ErrInvalidRequest, "consumed resources")
+	}
+}
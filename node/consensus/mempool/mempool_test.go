@@ -0,0 +1,244 @@
+package mempool_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/mempool"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// senderKeys holds a public key for each test sender.
+var senderKeys = map[byte][]byte{}
+
+func publicKey(sender byte) []byte {
+	if key, ok := senderKeys[sender]; ok {
+		return key
+	}
+
+	_, pub, err := pcrypto.GenerateEd448Key(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	key, err := pub.Raw()
+	if err != nil {
+		panic(err)
+	}
+
+	senderKeys[sender] = key
+	return key
+}
+
+// The mempool doesn't verify signatures, the gossip validator does.
+func signature(sender byte) *protobufs.Ed448Signature {
+	return &protobufs.Ed448Signature{
+		Signature: []byte{sender},
+		PublicKey: &protobufs.Ed448PublicKey{KeyValue: publicKey(sender)},
+	}
+}
+
+// own stores the coins as owned by the sender.
+func own(t *testing.T, coinStore store.CoinStore, sender byte, coins ...byte) {
+	addr, err := poseidon.HashBytes(publicKey(sender))
+	require.NoError(t, err)
+
+	txn, err := coinStore.NewTransaction()
+	require.NoError(t, err)
+	for _, coin := range coins {
+		require.NoError(t, coinStore.PutCoin(txn, 1, []byte{coin}, &protobufs.Coin{
+			Amount: []byte{1},
+			Owner: &protobufs.AccountRef{
+				Account: &protobufs.AccountRef_ImplicitAccount{
+					ImplicitAccount: &protobufs.ImplicitAccount{
+						Address: addr.FillBytes(make([]byte, 32)),
+					},
+				},
+			},
+		}))
+	}
+	require.NoError(t, txn.Commit())
+}
+
+func transfer(sender byte, coin byte, to byte) *protobufs.TokenRequest {
+	return &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Transfer{
+			Transfer: &protobufs.TransferCoinRequest{
+				OfCoin: &protobufs.CoinRef{Address: []byte{coin}},
+				ToAccount: &protobufs.AccountRef{
+					Account: &protobufs.AccountRef_ImplicitAccount{
+						ImplicitAccount: &protobufs.ImplicitAccount{
+							Address: []byte{to},
+						},
+					},
+				},
+				Signature: signature(sender),
+			},
+		},
+	}
+}
+
+func split(sender byte, coin byte) *protobufs.TokenRequest {
+	return &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Split{
+			Split: &protobufs.SplitCoinRequest{
+				OfCoin:    &protobufs.CoinRef{Address: []byte{coin}},
+				Amounts:   [][]byte{{1}, {2}},
+				Signature: signature(sender),
+			},
+		},
+	}
+}
+
+func merge(sender byte, coins ...byte) *protobufs.TokenRequest {
+	refs := []*protobufs.CoinRef{}
+	for _, coin := range coins {
+		refs = append(refs, &protobufs.CoinRef{Address: []byte{coin}})
+	}
+
+	return &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Merge{
+			Merge: &protobufs.MergeCoinRequest{
+				Coins:     refs,
+				Signature: signature(sender),
+			},
+		},
+	}
+}
+
+func newMempool(t *testing.T, db store.KVDB) *mempool.Mempool {
+	m := mempool.NewMempool(
+		zap.NewNop(),
+		store.NewPebbleMempoolStore(db, zap.NewNop()),
+		store.NewPebbleCoinStore(db, zap.NewNop()),
+		4,
+		2,
+		10,
+	)
+	require.NoError(t, m.Load())
+	return m
+}
+
+func TestMempoolAdd(t *testing.T) {
+	db := store.NewInMemKVDB()
+	coinStore := store.NewPebbleCoinStore(db, zap.NewNop())
+	own(t, coinStore, 1, 0x10, 0x11)
+	own(t, coinStore, 2, 0x20, 0x21, 0x22)
+	own(t, coinStore, 3, 0x30, 0x31, 0x32, 0x33)
+	own(t, coinStore, 4, 0x40)
+	m := newMempool(t, db)
+
+	require.NoError(t, m.Add(transfer(1, 0x10, 0x01), 1))
+	assert.ErrorIs(t, m.Add(transfer(1, 0x10, 0x01), 1), mempool.ErrDuplicate)
+
+	// Spending the same coin again is a double spend, whatever the request.
+	assert.ErrorIs(t, m.Add(transfer(1, 0x10, 0x02), 1), mempool.ErrConflict)
+	assert.ErrorIs(t, m.Add(split(1, 0x10), 1), mempool.ErrConflict)
+	assert.ErrorIs(t, m.Add(merge(1, 0x11, 0x10), 1), mempool.ErrConflict)
+
+	// Only the owner may spend a coin, so others can't hold it pending.
+	assert.ErrorIs(t, m.Add(transfer(2, 0x10, 0x02), 1), mempool.ErrNotOwner)
+	assert.ErrorIs(t, m.Add(split(2, 0x11), 1), mempool.ErrNotOwner)
+	assert.ErrorIs(t, m.Add(merge(2, 0x20, 0x11), 1), mempool.ErrNotOwner)
+	assert.ErrorIs(t, m.Add(split(2, 0x99), 1), mempool.ErrNotOwner)
+
+	// Splits of different coins do not conflict.
+	require.NoError(t, m.Add(split(2, 0x20), 1))
+	require.NoError(t, m.Add(split(2, 0x21), 2))
+	assert.ErrorIs(t, m.Add(split(2, 0x22), 2), mempool.ErrSenderLimit)
+
+	require.NoError(t, m.Add(merge(3, 0x30, 0x31), 2))
+	assert.ErrorIs(t, m.Add(merge(3, 0x32, 0x33), 2), mempool.ErrFull)
+
+	assert.ErrorIs(t, m.Add(merge(4, 0x40, 0x40), 2), mempool.ErrInvalidRequest)
+	assert.ErrorIs(
+		t,
+		m.Add(&protobufs.TokenRequest{}, 2),
+		mempool.ErrInvalidRequest,
+	)
+
+	assert.Len(t, m.Requests().Requests, 4)
+	entries := m.Entries(publicKey(2))
+	require.Len(t, entries, 2)
+	assert.Equal(t, uint64(1), entries[0].FrameNumber)
+	assert.Equal(t, uint64(2), entries[1].FrameNumber)
+
+	response := m.Inspect(nil)
+	assert.Equal(t, uint64(4), response.TotalEntries)
+	assert.Equal(t, uint64(2), response.MaxEntriesPerSender)
+
+	// Removing a request frees its coin and its sender's slot.
+	require.NoError(t, m.Remove([]*protobufs.TokenRequest{split(2, 0x20)}))
+	require.NoError(t, m.Add(transfer(2, 0x20, 0x02), 3))
+}
+
+func TestMempoolPrune(t *testing.T) {
+	db := store.NewInMemKVDB()
+	coinStore := store.NewPebbleCoinStore(db, zap.NewNop())
+	own(t, coinStore, 1, 0x10)
+	own(t, coinStore, 2, 0x20)
+	own(t, coinStore, 3, 0x30, 0x31)
+	m := newMempool(t, db)
+
+	require.NoError(t, m.Add(transfer(1, 0x10, 0x01), 1))
+	require.NoError(t, m.Add(split(2, 0x20), 5))
+	require.NoError(t, m.Add(split(3, 0x30), 5))
+	require.NoError(t, m.Add(split(3, 0x31), 8))
+
+	// The frame includes the transfer and another prover's spend of 0x30,
+	// leaving the split of 0x30 unspendable.
+	require.NoError(t, m.Prune(
+		9,
+		&protobufs.TokenRequests{
+			Requests: []*protobufs.TokenRequest{transfer(1, 0x10, 0x01)},
+		},
+		&protobufs.TokenOutputs{
+			Outputs: []*protobufs.TokenOutput{{
+				Output: &protobufs.TokenOutput_DeletedCoin{
+					DeletedCoin: &protobufs.CoinRef{Address: []byte{0x30}},
+				},
+			}},
+		},
+	))
+	assert.Len(t, m.Entries(nil), 2)
+
+	// The remaining requests survive a restart, until they expire.
+	reloaded := newMempool(t, db)
+	ids := func(entries []*protobufs.MempoolEntry) [][]byte {
+		ids := [][]byte{}
+		for _, entry := range entries {
+			ids = append(ids, entry.RequestId)
+		}
+		return ids
+	}
+	assert.Equal(t, ids(m.Entries(nil)), ids(reloaded.Entries(nil)))
+
+	require.NoError(t, reloaded.Prune(16, nil, nil))
+	entries := reloaded.Entries(nil)
+	require.Len(t, entries, 1)
+	assert.Equal(t, uint64(8), entries[0].FrameNumber)
+
+	assert.Len(t, newMempool(t, db).Entries(nil), 1)
+}
+
+func TestMempoolLoadDropsUnownedCoins(t *testing.T) {
+	db := store.NewInMemKVDB()
+	coinStore := store.NewPebbleCoinStore(db, zap.NewNop())
+	own(t, coinStore, 1, 0x10, 0x11)
+	m := newMempool(t, db)
+
+	require.NoError(t, m.Add(split(1, 0x10), 1))
+	require.NoError(t, m.Add(split(1, 0x11), 1))
+
+	// The coin changed hands while the node was down.
+	own(t, coinStore, 2, 0x11)
+	entries := newMempool(t, db).Entries(nil)
+	require.Len(t, entries, 1)
+	assert.Equal(t, split(1, 0x10).GetSplit().OfCoin, entries[0].Request.GetSplit().OfCoin)
+}
@@ -0,0 +1,355 @@
+package rewards
+
+import (
+	"bytes"
+	"math/bits"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+var ErrDuplicate = errors.New("proof already queued")
+var ErrExpired = errors.New("proof expired")
+
+const (
+	DefaultMaxBatchSize = 64
+	DefaultMaxFrameAge  = 360
+	DefaultRetryFrames  = 5
+	// A proof failing this many times when submitted alone is dropped.
+	SoloAttempts = 2
+)
+
+// Queue holds the reward proofs computed by the data workers until they are
+// minted. The token application accepts a single mint request per signer and
+// frame, so the proofs are submitted in one batched request per frame. A
+// submitted proof is resubmitted if it is not seen minted, as a pre-coin proof
+// of the owner in the coin store, within a number of frames, until it expires.
+// Confirmed proofs are kept for the same number of frames, for inspection.
+// The queue is persisted so proofs are not lost across restarts.
+//
+// A batch is only minted if all of its proofs are valid, so the proofs of a
+// batch not minted are resubmitted in batches halved with each failure. A
+// proof the application rejects thus ends up submitted alone, and is dropped
+// once it failed alone SoloAttempts times, instead of failing every batch it
+// is part of.
+type Queue struct {
+	mx           sync.Mutex
+	logger       *zap.Logger
+	store        store.RewardProofStore
+	coinStore    store.CoinStore
+	owner        []byte
+	maxBatchSize int
+	maxFrameAge  uint64
+	retryFrames  uint64
+	maxAttempts  uint32
+	proofs       map[string]*protobufs.RewardProof
+}
+
+// NewQueue creates an empty queue persisted in the store, for proofs minted
+// to the owner address. Limits of zero take their default value.
+func NewQueue(
+	logger *zap.Logger,
+	rewardProofStore store.RewardProofStore,
+	coinStore store.CoinStore,
+	owner []byte,
+	maxBatchSize int,
+	maxFrameAge uint64,
+	retryFrames uint64,
+) *Queue {
+	if logger == nil {
+		panic(errors.New("logger is nil"))
+	}
+
+	if rewardProofStore == nil {
+		panic(errors.New("reward proof store is nil"))
+	}
+
+	if coinStore == nil {
+		panic(errors.New("coin store is nil"))
+	}
+
+	if maxBatchSize == 0 {
+		maxBatchSize = DefaultMaxBatchSize
+	}
+
+	if maxFrameAge == 0 {
+		maxFrameAge = DefaultMaxFrameAge
+	}
+
+	if retryFrames == 0 {
+		retryFrames = DefaultRetryFrames
+	}
+
+	return &Queue{
+		logger:       logger,
+		store:        rewardProofStore,
+		coinStore:    coinStore,
+		owner:        owner,
+		maxBatchSize: maxBatchSize,
+		maxFrameAge:  maxFrameAge,
+		retryFrames:  retryFrames,
+		maxAttempts: uint32(
+			bits.Len(uint(maxBatchSize)) - 1 + SoloAttempts,
+		),
+		proofs: map[string]*protobufs.RewardProof{},
+	}
+}
+
+// Load restores the proofs persisted in the store.
+func (q *Queue) Load() error {
+	proofs, err := q.store.GetRewardProofs()
+	if err != nil {
+		return errors.Wrap(err, "load")
+	}
+
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	for _, proof := range proofs {
+		q.proofs[string(proof.Proof)] = proof
+	}
+
+	q.logger.Info("loaded reward proofs", zap.Int("proofs", len(q.proofs)))
+	return nil
+}
+
+// Add queues the proof computed by the core for the frame number.
+func (q *Queue) Add(core uint32, frameNumber uint64, proof []byte) error {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	if _, ok := q.proofs[string(proof)]; ok {
+		return errors.Wrap(ErrDuplicate, "add")
+	}
+
+	entry := &protobufs.RewardProof{
+		Proof:       proof,
+		Core:        core,
+		FrameNumber: frameNumber,
+		Status:      protobufs.RewardProofStatus_REWARD_PROOF_UNCLAIMED,
+	}
+	if err := q.store.PutRewardProofs(
+		[]*protobufs.RewardProof{entry},
+	); err != nil {
+		return errors.Wrap(err, "add")
+	}
+
+	q.proofs[string(proof)] = entry
+	return nil
+}
+
+// Next updates the queue for the frame number, then returns the proofs to
+// submit at it and marks them in flight. Nothing is submitted while the last
+// batch may still be minted, as a signer has a single mint request pending at
+// a time. The proofs of a batch not confirmed within the retry frames are
+// resubmitted first, the most failed first, in a batch halved for each of
+// their failures. Otherwise the unclaimed proofs are submitted, oldest first.
+func (q *Queue) Next(frameNumber uint64) ([][]byte, error) {
+	if err := q.Update(frameNumber); err != nil {
+		return nil, errors.Wrap(err, "next")
+	}
+
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	pending := false
+	failed := []*protobufs.RewardProof{}
+	unclaimed := []*protobufs.RewardProof{}
+	dropped := []*protobufs.RewardProof{}
+	for key, proof := range q.proofs {
+		switch proof.Status {
+		case protobufs.RewardProofStatus_REWARD_PROOF_UNCLAIMED:
+			unclaimed = append(unclaimed, proof)
+		case protobufs.RewardProofStatus_REWARD_PROOF_IN_FLIGHT:
+			switch {
+			case frameNumber < proof.StatusFrameNumber+q.retryFrames:
+				pending = true
+			case proof.Attempts >= q.maxAttempts:
+				dropped = append(dropped, proof)
+				delete(q.proofs, key)
+			default:
+				failed = append(failed, proof)
+			}
+		}
+	}
+
+	if len(dropped) != 0 {
+		q.logger.Warn(
+			"reward proofs dropped after failing to be minted alone",
+			zap.Uint64("frame_number", frameNumber),
+			zap.Int("dropped", len(dropped)),
+		)
+
+		if err := q.store.DeleteRewardProofs(dropped); err != nil {
+			return nil, errors.Wrap(err, "next")
+		}
+	}
+
+	if pending {
+		return [][]byte{}, nil
+	}
+
+	due, batchSize := unclaimed, q.maxBatchSize
+	sortProofs(due)
+	if len(failed) != 0 {
+		due = failed
+		sortProofs(due)
+		sort.SliceStable(due, func(i, j int) bool {
+			return due[i].Attempts > due[j].Attempts
+		})
+		batchSize = max(1, q.maxBatchSize>>due[0].Attempts)
+	}
+
+	if len(due) > batchSize {
+		due = due[:batchSize]
+	}
+
+	// Mint requests of exactly three proofs are taken for the legacy mint
+	// format, the third proof waits for the next batch.
+	if len(due) == 3 {
+		due = due[:2]
+	}
+
+	updated := []*protobufs.RewardProof{}
+	for _, proof := range due {
+		proof = proto.Clone(proof).(*protobufs.RewardProof)
+		proof.Status = protobufs.RewardProofStatus_REWARD_PROOF_IN_FLIGHT
+		proof.StatusFrameNumber = frameNumber
+		proof.Attempts++
+		updated = append(updated, proof)
+	}
+
+	if err := q.store.PutRewardProofs(updated); err != nil {
+		return nil, errors.Wrap(err, "next")
+	}
+
+	batch := [][]byte{}
+	for _, proof := range updated {
+		q.proofs[string(proof.Proof)] = proof
+		batch = append(batch, proof.Proof)
+	}
+
+	return batch, nil
+}
+
+// Update confirms the in flight proofs minted to the owner, and drops the
+// proofs older than the maximum frame age at the frame number: unconfirmed
+// ones by the frame they were computed for, confirmed ones by the frame they
+// were confirmed at.
+func (q *Queue) Update(frameNumber uint64) error {
+	_, minted, err := q.coinStore.GetPreCoinProofsForOwner(q.owner)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return errors.Wrap(err, "update")
+	}
+
+	mintedProofs := map[string]struct{}{}
+	for _, m := range minted {
+		mintedProofs[string(m.Proof)] = struct{}{}
+	}
+
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	updated := []*protobufs.RewardProof{}
+	dropped := []*protobufs.RewardProof{}
+	expired := 0
+	for key, proof := range q.proofs {
+		if proof.Status == protobufs.RewardProofStatus_REWARD_PROOF_CONFIRMED {
+			if frameNumber > proof.StatusFrameNumber+q.maxFrameAge {
+				dropped = append(dropped, proof)
+				delete(q.proofs, key)
+			}
+			continue
+		}
+
+		if _, ok := mintedProofs[key]; ok {
+			proof = proto.Clone(proof).(*protobufs.RewardProof)
+			proof.Status = protobufs.RewardProofStatus_REWARD_PROOF_CONFIRMED
+			proof.StatusFrameNumber = frameNumber
+			updated = append(updated, proof)
+			q.proofs[key] = proof
+			continue
+		}
+
+		if frameNumber > proof.FrameNumber+q.maxFrameAge {
+			dropped = append(dropped, proof)
+			delete(q.proofs, key)
+			expired++
+		}
+	}
+
+	if expired != 0 {
+		q.logger.Warn(
+			"reward proofs expired before being minted",
+			zap.Uint64("frame_number", frameNumber),
+			zap.Int("expired", expired),
+		)
+	}
+
+	if err := q.store.PutRewardProofs(updated); err != nil {
+		return errors.Wrap(err, "update")
+	}
+
+	return errors.Wrap(q.store.DeleteRewardProofs(dropped), "update")
+}
+
+// Inspect returns the queued proofs, oldest first, along with their number
+// per status and core.
+func (q *Queue) Inspect() *protobufs.RewardProofsResponse {
+	q.mx.Lock()
+	proofs := make([]*protobufs.RewardProof, 0, len(q.proofs))
+	for _, proof := range q.proofs {
+		proofs = append(proofs, proof)
+	}
+	q.mx.Unlock()
+
+	sortProofs(proofs)
+
+	cores := map[uint32]*protobufs.CoreRewardProofs{}
+	for _, proof := range proofs {
+		core, ok := cores[proof.Core]
+		if !ok {
+			core = &protobufs.CoreRewardProofs{Core: proof.Core}
+			cores[proof.Core] = core
+		}
+
+		switch proof.Status {
+		case protobufs.RewardProofStatus_REWARD_PROOF_UNCLAIMED:
+			core.Unclaimed++
+		case protobufs.RewardProofStatus_REWARD_PROOF_IN_FLIGHT:
+			core.InFlight++
+		case protobufs.RewardProofStatus_REWARD_PROOF_CONFIRMED:
+			core.Confirmed++
+		}
+	}
+
+	response := &protobufs.RewardProofsResponse{Proofs: proofs}
+	for _, core := range cores {
+		response.Cores = append(response.Cores, core)
+	}
+
+	sort.Slice(response.Cores, func(i, j int) bool {
+		return response.Cores[i].Core < response.Cores[j].Core
+	})
+
+	return response
+}
+
+func sortProofs(proofs []*protobufs.RewardProof) {
+	sort.Slice(proofs, func(i, j int) bool {
+		if proofs[i].FrameNumber != proofs[j].FrameNumber {
+			return proofs[i].FrameNumber < proofs[j].FrameNumber
+		}
+
+		if proofs[i].Core != proofs[j].Core {
+			return proofs[i].Core < proofs[j].Core
+		}
+
+		return bytes.Compare(proofs[i].Proof, proofs[j].Proof) < 0
+	})
+}
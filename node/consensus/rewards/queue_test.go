@@ -0,0 +1,195 @@
+package rewards_test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/rewards"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+var owner = bytes.Repeat([]byte{0x01}, 32)
+
+func proof(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 516)
+}
+
+// mint records the proof as minted to the owner, as the token application
+// does when applying a mint request.
+func mint(t *testing.T, coinStore *store.PebbleCoinStore, p []byte) {
+	txn, err := coinStore.NewTransaction()
+	require.NoError(t, err)
+	require.NoError(t, coinStore.PutPreCoinProof(
+		txn,
+		1,
+		append([]byte{}, p[:32]...),
+		&protobufs.PreCoinProof{
+			Proof: p,
+			Owner: &protobufs.AccountRef{
+				Account: &protobufs.AccountRef_ImplicitAccount{
+					ImplicitAccount: &protobufs.ImplicitAccount{Address: owner},
+				},
+			},
+		},
+	))
+	require.NoError(t, txn.Commit())
+}
+
+func newQueue(
+	t *testing.T,
+	db store.KVDB,
+) (*rewards.Queue, *store.PebbleCoinStore) {
+	coinStore := store.NewPebbleCoinStore(db, zap.NewNop())
+	q := rewards.NewQueue(
+		zap.NewNop(),
+		store.NewPebbleRewardProofStore(db, zap.NewNop()),
+		coinStore,
+		owner,
+		4,
+		10,
+		2,
+	)
+	require.NoError(t, q.Load())
+	return q, coinStore
+}
+
+func TestQueueBatchesAndRetries(t *testing.T) {
+	db := store.NewInMemKVDB()
+	q, coinStore := newQueue(t, db)
+
+	for i := byte(1); i <= 5; i++ {
+		require.NoError(t, q.Add(uint32(i%2), 1, proof(i)))
+	}
+	assert.ErrorIs(t, q.Add(0, 1, proof(2)), rewards.ErrDuplicate)
+
+	// The batch is capped, oldest proofs first.
+	batch, err := q.Next(2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{proof(2), proof(4), proof(1), proof(3)}, batch)
+
+	// Nothing goes out while the batch may still be minted.
+	batch, err = q.Next(3)
+	require.NoError(t, err)
+	assert.Empty(t, batch)
+
+	mint(t, coinStore, proof(2))
+	mint(t, coinStore, proof(4))
+
+	// The unconfirmed proofs of the first batch are retried after the retry
+	// frames, in a halved batch and ahead of the unclaimed proof, and the
+	// minted ones are confirmed.
+	batch, err = q.Next(4)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{proof(1), proof(3)}, batch)
+
+	response := q.Inspect()
+	assert.Equal(t, []*protobufs.CoreRewardProofs{
+		{Core: 0, Confirmed: 2},
+		{Core: 1, Unclaimed: 1, InFlight: 2},
+	}, response.Cores)
+
+	// The queue survives a restart.
+	reloaded, _ := newQueue(t, db)
+	assert.Equal(t, response.Cores, reloaded.Inspect().Cores)
+	for _, p := range reloaded.Inspect().Proofs {
+		if bytes.Equal(p.Proof, proof(1)) {
+			assert.Equal(t, uint32(2), p.Attempts)
+			assert.Equal(t, uint64(4), p.StatusFrameNumber)
+		}
+	}
+}
+
+func TestQueueExpiry(t *testing.T) {
+	db := store.NewInMemKVDB()
+	q, coinStore := newQueue(t, db)
+
+	require.NoError(t, q.Add(0, 1, proof(1)))
+	require.NoError(t, q.Add(0, 1, proof(2)))
+	_, err := q.Next(1)
+	require.NoError(t, err)
+	mint(t, coinStore, proof(1))
+	require.NoError(t, q.Update(5))
+
+	// Unconfirmed proofs expire by the frame they were computed for,
+	// confirmed ones by the frame they were confirmed at.
+	require.NoError(t, q.Update(12))
+	assert.Equal(t, []*protobufs.CoreRewardProofs{
+		{Core: 0, Confirmed: 1},
+	}, q.Inspect().Cores)
+
+	require.NoError(t, q.Update(16))
+	assert.Empty(t, q.Inspect().Proofs)
+
+	reloaded, _ := newQueue(t, db)
+	assert.Empty(t, reloaded.Inspect().Proofs)
+}
+
+func TestQueueAvoidsLegacyBatchSize(t *testing.T) {
+	q, coinStore := newQueue(t, store.NewInMemKVDB())
+
+	for i := byte(1); i <= 3; i++ {
+		require.NoError(t, q.Add(0, 1, proof(i)))
+	}
+
+	batch, err := q.Next(1)
+	require.NoError(t, err)
+	assert.Len(t, batch, 2)
+
+	for _, p := range batch {
+		mint(t, coinStore, p)
+	}
+
+	batch, err = q.Next(3)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{proof(3)}, batch)
+}
+
+func TestQueueIsolatesFailingProof(t *testing.T) {
+	q, coinStore := newQueue(t, store.NewInMemKVDB())
+
+	for i := byte(1); i <= 4; i++ {
+		require.NoError(t, q.Add(0, 5, proof(i)))
+	}
+
+	// Batches holding the invalid proof are not minted, the others are. The
+	// invalid proof is isolated by halving the retried batches, and dropped
+	// once it failed alone.
+	batches := [][][]byte{}
+	for frameNumber := uint64(5); frameNumber <= 15; frameNumber += 2 {
+		batch, err := q.Next(frameNumber)
+		require.NoError(t, err)
+		batches = append(batches, batch)
+
+		if slices.ContainsFunc(batch, func(p []byte) bool {
+			return bytes.Equal(p, proof(1))
+		}) {
+			continue
+		}
+
+		for _, p := range batch {
+			mint(t, coinStore, p)
+		}
+	}
+
+	assert.Equal(t, [][][]byte{
+		{proof(1), proof(2), proof(3), proof(4)},
+		{proof(1), proof(2)},
+		{proof(1)},
+		{proof(1)},
+		{proof(2)},
+		{proof(3), proof(4)},
+	}, batches)
+
+	require.NoError(t, q.Update(17))
+	assert.Equal(t, []*protobufs.CoreRewardProofs{
+		{Core: 0, Confirmed: 3},
+	}, q.Inspect().Cores)
+	for _, p := range q.Inspect().Proofs {
+		assert.NotEqual(t, proof(1), p.Proof)
+	}
+}
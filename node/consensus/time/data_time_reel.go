@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"encoding/hex"
 	"math/big"
+	"sort"
 	"sync"
 
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
@@ -23,12 +26,27 @@ var unknownDistance = new(big.Int).SetBytes([]byte{
 	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
 })
 
+const (
+	// The competing branches tracked are bounded in number, and dropped once
+	// their tip falls this many frames behind the head.
+	maxForks   = 32
+	maxForkAge = 360
+)
+
 type pendingFrame struct {
 	selector       *big.Int
 	parentSelector *big.Int
 	frameNumber    uint64
 }
 
+// bootstrapRequest replaces the head of the reel with a checkpoint frame.
+type bootstrapRequest struct {
+	frame       *protobufs.ClockFrame
+	proverTries []*tries.RollingFrecencyCritbitTrie
+	apply       func() error
+	done        chan error
+}
+
 type DataTimeReel struct {
 	rwMutex sync.RWMutex
 	running bool
@@ -38,7 +56,9 @@ type DataTimeReel struct {
 	logger       *zap.Logger
 	clockStore   store.ClockStore
 	frameProver  crypto.FrameProver
+	clock        clock.Clock
 	exec         func(txn store.Transaction, frame *protobufs.ClockFrame) error
+	unwind       func(txn store.Transaction, frame *protobufs.FrameRef) error
 
 	origin                []byte
 	initialInclusionProof *crypto.InclusionAggregateProof
@@ -50,9 +70,13 @@ type DataTimeReel struct {
 	proverTries           []*tries.RollingFrecencyCritbitTrie
 	// pending               map[uint64][]*pendingFrame
 	incompleteForks map[uint64][]*pendingFrame
+	forksMx         sync.RWMutex
+	forks           map[string]*protobufs.ForkBranch
 	frames          chan *pendingFrame
 	newFrameCh      chan *protobufs.ClockFrame
 	badFrameCh      chan *protobufs.ClockFrame
+	reorgCh         chan *protobufs.ReorgEvent
+	bootstrapCh     chan *bootstrapRequest
 	done            chan bool
 }
 
@@ -62,7 +86,9 @@ func NewDataTimeReel(
 	clockStore store.ClockStore,
 	engineConfig *config.EngineConfig,
 	frameProver crypto.FrameProver,
+	clock clock.Clock,
 	exec func(txn store.Transaction, frame *protobufs.ClockFrame) error,
+	unwind func(txn store.Transaction, frame *protobufs.FrameRef) error,
 	origin []byte,
 	initialInclusionProof *crypto.InclusionAggregateProof,
 	initialProverKeys [][]byte,
@@ -87,10 +113,18 @@ func NewDataTimeReel(
 		panic("execution function is nil")
 	}
 
+	if unwind == nil {
+		panic("unwind function is nil")
+	}
+
 	if frameProver == nil {
 		panic("frame prover is nil")
 	}
 
+	if clock == nil {
+		panic("clock is nil")
+	}
+
 	cache, err := lru.New[string, string](10000)
 	if err != nil {
 		panic(err)
@@ -103,16 +137,21 @@ func NewDataTimeReel(
 		engineConfig:          engineConfig,
 		clockStore:            clockStore,
 		frameProver:           frameProver,
+		clock:                 clock,
 		exec:                  exec,
+		unwind:                unwind,
 		origin:                origin,
 		initialInclusionProof: initialInclusionProof,
 		initialProverKeys:     initialProverKeys,
 		lruFrames:             cache,
 		// pending:               make(map[uint64][]*pendingFrame),
 		incompleteForks: make(map[uint64][]*pendingFrame),
+		forks:           make(map[string]*protobufs.ForkBranch),
 		frames:          make(chan *pendingFrame),
 		newFrameCh:      make(chan *protobufs.ClockFrame),
 		badFrameCh:      make(chan *protobufs.ClockFrame),
+		reorgCh:         make(chan *protobufs.ReorgEvent, 16),
+		bootstrapCh:     make(chan *bootstrapRequest),
 		done:            make(chan bool),
 	}
 }
@@ -151,6 +190,16 @@ func (d *DataTimeReel) SetHead(frame *protobufs.ClockFrame) {
 	d.head = frame
 }
 
+func (d *DataTimeReel) SetProverTries(
+	proverTries []*tries.RollingFrecencyCritbitTrie,
+) {
+	if d.running == true {
+		panic("internal test function should never be called outside of tests")
+	}
+
+	d.proverTries = proverTries
+}
+
 func (d *DataTimeReel) Head() (*protobufs.ClockFrame, error) {
 	return d.head, nil
 }
@@ -214,6 +263,107 @@ func (d *DataTimeReel) BadFrameCh() <-chan *protobufs.ClockFrame {
 	return d.badFrameCh
 }
 
+// ReorgCh emits an event every time the head switches to a competing branch,
+// so consumers can roll back what they derived from the dropped frames.
+func (d *DataTimeReel) ReorgCh() <-chan *protobufs.ReorgEvent {
+	return d.reorgCh
+}
+
+// Bootstrap makes a verified checkpoint frame, with its prover tries, the head
+// of the reel. The frames before it are not required to be held, and the
+// pending frames and tracked forks are discarded. Apply is called first, with
+// no frame processed concurrently, to put the execution state at the
+// checkpoint; the head is left as is if it fails.
+func (d *DataTimeReel) Bootstrap(
+	frame *protobufs.ClockFrame,
+	proverTries []*tries.RollingFrecencyCritbitTrie,
+	apply func() error,
+) error {
+	if !d.running {
+		return errors.Wrap(errors.New("time reel not running"), "bootstrap")
+	}
+
+	request := &bootstrapRequest{
+		frame:       frame,
+		proverTries: proverTries,
+		apply:       apply,
+		done:        make(chan error),
+	}
+	d.bootstrapCh <- request
+	return errors.Wrap(<-request.done, "bootstrap")
+}
+
+func (d *DataTimeReel) bootstrap(
+	frame *protobufs.ClockFrame,
+	proverTries []*tries.RollingFrecencyCritbitTrie,
+	apply func() error,
+) error {
+	if frame.FrameNumber <= d.head.FrameNumber {
+		return errors.New("checkpoint not ahead of head")
+	}
+
+	selector, err := frame.GetSelector()
+	if err != nil {
+		return errors.Wrap(err, "bootstrap")
+	}
+
+	if err := apply(); err != nil {
+		return errors.Wrap(err, "bootstrap")
+	}
+
+	txn, err := d.clockStore.NewTransaction()
+	if err != nil {
+		return errors.Wrap(err, "bootstrap")
+	}
+
+	if err := d.clockStore.StageDataClockFrame(
+		selector.FillBytes(make([]byte, 32)),
+		frame,
+		txn,
+	); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "bootstrap")
+	}
+
+	if err := d.clockStore.CommitDataClockFrame(
+		d.filter,
+		frame.FrameNumber,
+		selector.FillBytes(make([]byte, 32)),
+		proverTries,
+		txn,
+		false,
+	); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "bootstrap")
+	}
+
+	if err := txn.Commit(); err != nil {
+		return errors.Wrap(err, "bootstrap")
+	}
+
+	d.logger.Info(
+		"bootstrapped from checkpoint",
+		zap.Uint64("frame_number", frame.FrameNumber),
+		zap.Uint64("previous_head_number", d.head.FrameNumber),
+	)
+
+	d.head = frame
+	d.proverTries = proverTries
+	d.headDistance = big.NewInt(0)
+	d.incompleteForks = make(map[uint64][]*pendingFrame)
+	d.forksMx.Lock()
+	d.forks = make(map[string]*protobufs.ForkBranch)
+	d.forksMx.Unlock()
+	go func() {
+		select {
+		case d.newFrameCh <- frame:
+		default:
+		}
+	}()
+
+	return nil
+}
+
 func (d *DataTimeReel) Stop() {
 	d.done <- true
 }
@@ -338,6 +488,7 @@ func (d *DataTimeReel) runLoop() {
 
 					// d.addPending(selector, parent, frame.frameNumber)
 					d.processPending(d.head, frame)
+					d.trackFork(rawFrame)
 					continue
 				}
 
@@ -345,6 +496,7 @@ func (d *DataTimeReel) runLoop() {
 				// pending:
 				if rawFrame.FrameNumber-d.head.FrameNumber != 1 {
 					d.processPending(d.head, frame)
+					d.trackFork(rawFrame)
 					continue
 				}
 
@@ -356,6 +508,7 @@ func (d *DataTimeReel) runLoop() {
 				if bytes.Equal(d.head.Output, rawFrame.Output) {
 					d.logger.Debug("equivalent frame")
 					d.processPending(d.head, frame)
+					d.trackFork(rawFrame)
 					continue
 				}
 
@@ -422,6 +575,13 @@ func (d *DataTimeReel) runLoop() {
 				// 	}
 				// }
 			}
+			d.trackFork(rawFrame)
+		case request := <-d.bootstrapCh:
+			request.done <- d.bootstrap(
+				request.frame,
+				request.proverTries,
+				request.apply,
+			)
 		case <-d.done:
 			return
 		}
@@ -630,6 +790,9 @@ func (d *DataTimeReel) setHead(frame *protobufs.ClockFrame, distance *big.Int) {
 	d.head = frame
 
 	d.headDistance = distance
+	d.forksMx.Lock()
+	delete(d.forks, string(selector.FillBytes(make([]byte, 32))))
+	d.forksMx.Unlock()
 	go func() {
 		select {
 		case d.newFrameCh <- frame:
@@ -747,6 +910,9 @@ func (d *DataTimeReel) forkChoice(
 
 	rightReplaySelectors := [][]byte{}
 
+	oldHead := d.head
+	dropped := []*protobufs.FrameRef{frameRef(d.head)}
+
 	for rightIndex.FrameNumber > leftIndex.FrameNumber {
 		rightReplaySelectors = append(
 			append(
@@ -818,6 +984,7 @@ func (d *DataTimeReel) forkChoice(
 			)
 			panic(err)
 		}
+		dropped = append(dropped, frameRef(leftIndex))
 
 		rightIndex, err = d.clockStore.GetStagedDataClockFrame(
 			d.filter,
@@ -852,6 +1019,16 @@ func (d *DataTimeReel) forkChoice(
 	}
 	d.logger.Debug("found mutual root")
 
+	ancestor := &protobufs.FrameRef{
+		FrameNumber: leftIndex.FrameNumber - 1,
+		Selector:    left,
+	}
+	if bytes.Equal(leftIndex.Output, rightIndex.Output) {
+		// The head is itself an ancestor of the frame, nothing is dropped
+		dropped = dropped[:len(dropped)-1]
+		ancestor = frameRef(leftIndex)
+	}
+
 	frameNumber := rightIndex.FrameNumber
 
 	overweight.Add(overweight, leftTotal)
@@ -866,6 +1043,35 @@ func (d *DataTimeReel) forkChoice(
 		return
 	}
 
+	branch := []*protobufs.ClockFrame{}
+	for i, next := range rightReplaySelectors {
+		replayFrameNumber := rightIndex.FrameNumber + uint64(i)
+		if replayFrameNumber <= ancestor.FrameNumber {
+			continue
+		}
+
+		replayFrame, err := d.clockStore.GetStagedDataClockFrame(
+			d.filter,
+			replayFrameNumber,
+			next,
+			false,
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		branch = append(branch, replayFrame)
+	}
+
+	if err := d.switchExecution(dropped, append(branch, frame)); err != nil {
+		d.logger.Error(
+			"could not execute competing branch, keeping head",
+			zap.Uint64("frame_number", frame.FrameNumber),
+			zap.Error(err),
+		)
+		return
+	}
+
 	for {
 		if len(rightReplaySelectors) == 0 {
 			break
@@ -933,6 +1139,10 @@ func (d *DataTimeReel) forkChoice(
 		d.totalDistance,
 	)
 
+	if len(dropped) != 0 {
+		d.recordReorg(oldHead, frame, ancestor, dropped, leftTotal, rightTotal)
+	}
+
 	go func() {
 		select {
 		case d.newFrameCh <- frame:
@@ -941,6 +1151,280 @@ func (d *DataTimeReel) forkChoice(
 	}()
 }
 
+// switchExecution moves the execution state from the dropped frames, ordered
+// from the head down, to the frames of the new branch, ordered from the common
+// ancestor up. If the new branch cannot be executed, the state is moved back
+// to the dropped frames.
+func (d *DataTimeReel) switchExecution(
+	dropped []*protobufs.FrameRef,
+	branch []*protobufs.ClockFrame,
+) error {
+	if err := d.unwindFrames(dropped); err != nil {
+		return errors.Wrap(err, "switch execution")
+	}
+
+	for i, frame := range branch {
+		err := d.execFrame(frame)
+		if err == nil {
+			continue
+		}
+
+		executed := []*protobufs.FrameRef{}
+		for j := i - 1; j >= 0; j-- {
+			executed = append(executed, frameRef(branch[j]))
+		}
+
+		if err := d.unwindFrames(executed); err != nil {
+			panic(err)
+		}
+
+		for j := len(dropped) - 1; j >= 0; j-- {
+			droppedFrame, err := d.clockStore.GetStagedDataClockFrame(
+				d.filter,
+				dropped[j].FrameNumber,
+				dropped[j].Selector,
+				false,
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			if err := d.execFrame(droppedFrame); err != nil {
+				panic(err)
+			}
+		}
+
+		return errors.Wrap(err, "switch execution")
+	}
+
+	return nil
+}
+
+// unwindFrames undoes the execution of the frames, in order, at once.
+func (d *DataTimeReel) unwindFrames(frames []*protobufs.FrameRef) error {
+	txn, err := d.clockStore.NewTransaction()
+	if err != nil {
+		return errors.Wrap(err, "unwind frames")
+	}
+
+	for _, ref := range frames {
+		if err := d.unwind(txn, ref); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "unwind frames")
+		}
+	}
+
+	return errors.Wrap(txn.Commit(), "unwind frames")
+}
+
+func (d *DataTimeReel) execFrame(frame *protobufs.ClockFrame) error {
+	txn, err := d.clockStore.NewTransaction()
+	if err != nil {
+		return errors.Wrap(err, "exec frame")
+	}
+
+	if err := d.exec(txn, frame); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "exec frame")
+	}
+
+	return errors.Wrap(txn.Commit(), "exec frame")
+}
+
+// recordReorg records the switch of the head from oldHead to newHead, and
+// keeps the dropped branch as a competing one.
+func (d *DataTimeReel) recordReorg(
+	oldHead *protobufs.ClockFrame,
+	newHead *protobufs.ClockFrame,
+	ancestor *protobufs.FrameRef,
+	dropped []*protobufs.FrameRef,
+	oldDistance *big.Int,
+	newDistance *big.Int,
+) {
+	// dropped was collected walking back from the old head
+	for i, j := 0, len(dropped)-1; i < j; i, j = i+1, j-1 {
+		dropped[i], dropped[j] = dropped[j], dropped[i]
+	}
+
+	now := d.clock.Now().UnixMilli()
+	event := &protobufs.ReorgEvent{
+		OldHead:        frameRef(oldHead),
+		NewHead:        frameRef(newHead),
+		CommonAncestor: ancestor,
+		Depth:          uint64(len(dropped)),
+		OldDistance:    oldDistance.Bytes(),
+		NewDistance:    newDistance.Bytes(),
+		DroppedFrames:  dropped,
+		Timestamp:      now,
+	}
+
+	d.logger.Warn(
+		"data time reel switched to a competing branch",
+		zap.Uint64("old_head_frame_number", oldHead.FrameNumber),
+		zap.Uint64("new_head_frame_number", newHead.FrameNumber),
+		zap.Uint64("common_ancestor_frame_number", ancestor.FrameNumber),
+		zap.Uint64("depth", event.Depth),
+		zap.String("old_distance", oldDistance.Text(16)),
+		zap.String("new_distance", newDistance.Text(16)),
+	)
+
+	if err := d.clockStore.PutReorgEvent(d.filter, event); err != nil {
+		d.logger.Error("could not store reorg event", zap.Error(err))
+	}
+
+	d.forksMx.Lock()
+	delete(d.forks, string(event.NewHead.Selector))
+	d.forks[string(event.OldHead.Selector)] = &protobufs.ForkBranch{
+		Tip:       event.OldHead,
+		Ancestor:  ancestor,
+		Connected: true,
+		Length:    event.Depth,
+		Distance:  event.OldDistance,
+		LastSeen:  now,
+	}
+	d.pruneForks()
+	d.forksMx.Unlock()
+
+	go func() {
+		d.reorgCh <- event
+	}()
+}
+
+// trackFork records the frame in the competing branches if it did not become
+// part of the chain of the head.
+func (d *DataTimeReel) trackFork(frame *protobufs.ClockFrame) {
+	ref := frameRef(frame)
+	if d.isCanonical(ref) {
+		return
+	}
+
+	distance, err := d.GetDistance(frame)
+	if err != nil {
+		distance = big.NewInt(0)
+	}
+
+	d.forksMx.Lock()
+	defer d.forksMx.Unlock()
+
+	if _, ok := d.forks[string(ref.Selector)]; ok {
+		return
+	}
+
+	branch, ok := d.forks[string(frame.ParentSelector)]
+	if ok {
+		delete(d.forks, string(frame.ParentSelector))
+		branch.Tip = ref
+		branch.Length++
+		branch.Distance = new(big.Int).Add(
+			new(big.Int).SetBytes(branch.Distance),
+			distance,
+		).Bytes()
+	} else {
+		ancestor := &protobufs.FrameRef{
+			FrameNumber: frame.FrameNumber - 1,
+			Selector:    frame.ParentSelector,
+		}
+		branch = &protobufs.ForkBranch{
+			Tip:       ref,
+			Ancestor:  ancestor,
+			Connected: d.isCanonical(ancestor),
+			Length:    1,
+			Distance:  distance.Bytes(),
+		}
+	}
+
+	branch.LastSeen = d.clock.Now().UnixMilli()
+	d.forks[string(ref.Selector)] = branch
+	d.pruneForks()
+}
+
+// pruneForks drops the branches fallen too far behind the head, then the
+// lowest ones beyond the maximum tracked. The caller must hold forksMx.
+func (d *DataTimeReel) pruneForks() {
+	for key, branch := range d.forks {
+		if branch.Tip.FrameNumber+maxForkAge < d.head.FrameNumber {
+			delete(d.forks, key)
+		}
+	}
+
+	for len(d.forks) > maxForks {
+		lowest := ""
+		for key, branch := range d.forks {
+			if lowest == "" ||
+				branch.Tip.FrameNumber < d.forks[lowest].Tip.FrameNumber {
+				lowest = key
+			}
+		}
+
+		delete(d.forks, lowest)
+	}
+}
+
+// isCanonical reports whether the frame is on the chain of the head.
+func (d *DataTimeReel) isCanonical(ref *protobufs.FrameRef) bool {
+	if ref.FrameNumber > d.head.FrameNumber {
+		return false
+	}
+
+	frame, _, err := d.clockStore.GetDataClockFrame(
+		d.filter,
+		ref.FrameNumber,
+		true,
+	)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(frameRef(frame).Selector, ref.Selector)
+}
+
+func frameRef(frame *protobufs.ClockFrame) *protobufs.FrameRef {
+	selector, err := frame.GetSelector()
+	if err != nil {
+		panic(err)
+	}
+
+	return &protobufs.FrameRef{
+		FrameNumber: frame.FrameNumber,
+		Selector:    selector.FillBytes(make([]byte, 32)),
+	}
+}
+
+// GetForks returns the branches competing with the head, most recently grown
+// first.
+func (d *DataTimeReel) GetForks() []*protobufs.ForkBranch {
+	d.forksMx.RLock()
+	forks := []*protobufs.ForkBranch{}
+	for _, branch := range d.forks {
+		forks = append(forks, proto.Clone(branch).(*protobufs.ForkBranch))
+	}
+	d.forksMx.RUnlock()
+
+	sort.Slice(forks, func(i, j int) bool {
+		if forks[i].LastSeen != forks[j].LastSeen {
+			return forks[i].LastSeen > forks[j].LastSeen
+		}
+
+		return forks[i].Tip.FrameNumber > forks[j].Tip.FrameNumber
+	})
+
+	return forks
+}
+
+// GetReorgHistory returns up to limit of the most recent switches of the head
+// to a competing branch, most recent first. A limit of zero returns all
+// retained switches.
+func (d *DataTimeReel) GetReorgHistory(
+	limit int,
+) ([]*protobufs.ReorgEvent, error) {
+	events, err := d.clockStore.GetReorgEvents(d.filter, limit)
+	return events, errors.Wrap(err, "get reorg history")
+}
+
+func (d *DataTimeReel) GetHeadDistance() *big.Int {
+	return new(big.Int).Set(d.headDistance)
+}
+
 func (d *DataTimeReel) GetTotalDistance() *big.Int {
 	return new(big.Int).Set(d.totalDistance)
 }
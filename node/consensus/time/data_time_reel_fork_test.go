@@ -0,0 +1,260 @@
+package time
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+	gotime "time"
+
+	"github.com/cloudflare/circl/sign/ed448"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func selectorOf(t *testing.T, frame *protobufs.ClockFrame) []byte {
+	selector, err := frame.GetSelector()
+	require.NoError(t, err)
+	return selector.FillBytes(make([]byte, 32))
+}
+
+// TestDataTimeReelForkChoice switches the head from a branch of suboptimal
+// provers to a competing branch of optimal ones, and checks the reorg is
+// recorded and the execution state follows the new branch. Each frame's
+// execution stores a coin at the address of its selector.
+func TestDataTimeReelForkChoice(t *testing.T) {
+	logger := zap.NewNop()
+	db := store.NewInMemKVDB()
+	clockStore := store.NewPebbleClockStore(db, logger)
+	coinStore := store.NewPebbleCoinStore(db, logger)
+	prover := crypto.NewWesolowskiFrameProver(logger)
+	now := gotime.UnixMilli(1_700_000_000_000)
+
+	signers := map[string]ed448.PrivateKey{}
+	proverKeys := [][]byte{}
+	for i := 0; i < 8; i++ {
+		pub, priv, err := ed448.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		addr, err := poseidon.HashBytes(pub)
+		require.NoError(t, err)
+		signers[string(addr.FillBytes(make([]byte, 32)))] = priv
+		proverKeys = append(proverKeys, pub)
+	}
+
+	owner := &protobufs.AccountRef{
+		Account: &protobufs.AccountRef_ImplicitAccount{
+			ImplicitAccount: &protobufs.ImplicitAccount{
+				Address: make([]byte, 32),
+			},
+		},
+	}
+
+	d := NewDataTimeReel(
+		make([]byte, 32),
+		logger,
+		clockStore,
+		&config.EngineConfig{Difficulty: 10},
+		prover,
+		clock.NewFakeClock(now),
+		func(txn store.Transaction, frame *protobufs.ClockFrame) error {
+			journal, err := coinStore.JournalFrame(
+				txn,
+				frame.FrameNumber,
+				selectorOf(t, frame),
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := coinStore.PutCoin(
+				journal,
+				frame.FrameNumber,
+				selectorOf(t, frame),
+				&protobufs.Coin{Amount: []byte{0x01}, Owner: owner},
+			); err != nil {
+				return err
+			}
+
+			return coinStore.SetLatestFrameProcessed(txn, frame.FrameNumber)
+		},
+		func(txn store.Transaction, frame *protobufs.FrameRef) error {
+			err := coinStore.RollbackFrame(txn, frame.FrameNumber, frame.Selector)
+			if err != nil {
+				return err
+			}
+
+			return coinStore.SetLatestFrameProcessed(txn, frame.FrameNumber-1)
+		},
+		bytes.Repeat([]byte{0x00}, 516),
+		&crypto.InclusionAggregateProof{
+			InclusionCommitments: []*crypto.InclusionCommitment{},
+			AggregateCommitment:  []byte{},
+			Proof:                []byte{},
+		},
+		proverKeys,
+	)
+	d.head, d.proverTries = d.createGenesisFrame()
+	d.totalDistance = big.NewInt(0)
+	d.headDistance = big.NewInt(0)
+
+	// The optimal prover for a frame is the one nearest to the selector its
+	// distance is measured from, any other is suboptimal.
+	prove := func(
+		parent *protobufs.ClockFrame,
+		distanceFrom *protobufs.ClockFrame,
+		optimal bool,
+	) *protobufs.ClockFrame {
+		nearest := string(
+			d.proverTries[0].FindNearest(selectorOf(t, distanceFrom)).External.Key,
+		)
+		signer := signers[nearest]
+		if !optimal {
+			for key, other := range signers {
+				if key != nearest {
+					signer = other
+					break
+				}
+			}
+		}
+
+		frame, err := prover.ProveDataClockFrame(
+			parent,
+			[][]byte{},
+			[]*protobufs.InclusionAggregateProof{},
+			signer,
+			int64(parent.FrameNumber+1),
+			10,
+		)
+		require.NoError(t, err)
+
+		d.storePending(
+			new(big.Int).SetBytes(selectorOf(t, frame)),
+			new(big.Int).SetBytes(frame.ParentSelector),
+			big.NewInt(0),
+			frame,
+		)
+		return frame
+	}
+
+	extend := func(frame *protobufs.ClockFrame) {
+		distance, err := d.GetDistance(frame)
+		require.NoError(t, err)
+		d.setHead(frame, distance)
+		require.Equal(t, frame, d.head)
+	}
+
+	// The head branch runs through a2 and a3 from f1.
+	f1 := prove(d.head, d.head, true)
+	extend(f1)
+	a2 := prove(f1, f1, false)
+	extend(a2)
+	a3 := prove(a2, a2, false)
+	extend(a3)
+
+	// The competing branch from f1 is tracked as a fork. Its distances are
+	// measured against the frames of the head at the same heights.
+	b2 := prove(f1, f1, true)
+	d.trackFork(b2)
+	b3 := prove(b2, a2, true)
+	d.trackFork(b3)
+
+	forks := d.GetForks()
+	require.Len(t, forks, 1)
+	assert.True(t, proto.Equal(frameRef(b3), forks[0].Tip))
+	assert.True(t, proto.Equal(frameRef(f1), forks[0].Ancestor))
+	assert.True(t, forks[0].Connected)
+	assert.Equal(t, uint64(2), forks[0].Length)
+
+	distance, err := d.GetDistance(b3)
+	require.NoError(t, err)
+	d.forkChoice(b3, distance)
+	require.Equal(t, b3, d.head)
+
+	var event *protobufs.ReorgEvent
+	select {
+	case event = <-d.ReorgCh():
+	case <-gotime.After(5 * gotime.Second):
+		t.Fatal("no reorg event")
+	}
+
+	assert.Equal(t, uint64(2), event.Depth)
+	assert.True(t, proto.Equal(frameRef(f1), event.CommonAncestor))
+	assert.True(t, proto.Equal(frameRef(a3), event.OldHead))
+	assert.True(t, proto.Equal(frameRef(b3), event.NewHead))
+	require.Len(t, event.DroppedFrames, 2)
+	assert.True(t, proto.Equal(frameRef(a2), event.DroppedFrames[0]))
+	assert.True(t, proto.Equal(frameRef(a3), event.DroppedFrames[1]))
+	assert.Equal(t, now.UnixMilli(), event.Timestamp)
+
+	history, err := d.GetReorgHistory(0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.True(t, proto.Equal(event, history[0]))
+
+	// The dropped branch is now the one competing with the head.
+	forks = d.GetForks()
+	require.Len(t, forks, 1)
+	assert.True(t, proto.Equal(frameRef(a3), forks[0].Tip))
+	assert.True(t, proto.Equal(frameRef(f1), forks[0].Ancestor))
+	assert.Equal(t, uint64(2), forks[0].Length)
+
+	// The coins of the dropped frames are rolled back, and those of the new
+	// branch written.
+	for _, frame := range []*protobufs.ClockFrame{f1, b2, b3} {
+		_, err := coinStore.GetCoinByAddress(nil, selectorOf(t, frame))
+		assert.NoError(t, err, "frame %d", frame.FrameNumber)
+	}
+	for _, frame := range []*protobufs.ClockFrame{a2, a3} {
+		_, err := coinStore.GetCoinByAddress(nil, selectorOf(t, frame))
+		assert.ErrorIs(t, err, store.ErrNotFound, "frame %d", frame.FrameNumber)
+	}
+	_, addresses, _, err := coinStore.GetCoinsForOwner(
+		owner.GetImplicitAccount().Address,
+	)
+	require.NoError(t, err)
+	assert.Len(t, addresses, 3)
+
+	latest, err := coinStore.GetLatestFrameProcessed()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), latest)
+}
+
+func TestDataTimeReelPruneForks(t *testing.T) {
+	d := &DataTimeReel{
+		head:  &protobufs.ClockFrame{FrameNumber: 1000},
+		forks: map[string]*protobufs.ForkBranch{},
+	}
+
+	branch := func(tip uint64) *protobufs.ForkBranch {
+		return &protobufs.ForkBranch{
+			Tip: &protobufs.FrameRef{
+				FrameNumber: tip,
+				Selector:    big.NewInt(int64(tip)).FillBytes(make([]byte, 32)),
+			},
+		}
+	}
+
+	// One branch has fallen too far behind, and there are more of the others
+	// than are tracked.
+	stale := branch(1000 - maxForkAge - 1)
+	d.forks[string(stale.Tip.Selector)] = stale
+	for tip := uint64(900); tip < 900+maxForks+8; tip++ {
+		b := branch(tip)
+		d.forks[string(b.Tip.Selector)] = b
+	}
+
+	d.pruneForks()
+
+	require.Len(t, d.forks, maxForks)
+	for _, b := range d.forks {
+		assert.GreaterOrEqual(t, b.Tip.FrameNumber, uint64(908))
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
 	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
@@ -169,7 +170,9 @@ func TestDataTimeReel(t *testing.T) {
 			Difficulty:  10,
 		},
 		prover,
+		clock.NewFakeClock(gotime.UnixMilli(1_700_000_000_000)),
 		func(txn store.Transaction, frame *protobufs.ClockFrame) error { return nil },
+		func(txn store.Transaction, frame *protobufs.FrameRef) error { return nil },
 		bytes.Repeat([]byte{0x00}, 516),
 		&qcrypto.InclusionAggregateProof{
 			InclusionCommitments: []*qcrypto.InclusionCommitment{},
@@ -189,12 +192,19 @@ func TestDataTimeReel(t *testing.T) {
 	datawg := sync.WaitGroup{}
 	datawg.Add(1)
 	dataFrameCh := d.NewFrameCh()
+	headAt400 := make(chan struct{}, 1)
 	go func() {
 	loop:
 		for {
 			select {
 			case frame := <-dataFrameCh:
 				dataFrames = append(dataFrames, frame)
+				if frame.FrameNumber == 400 {
+					select {
+					case headAt400 <- struct{}{}:
+					default:
+					}
+				}
 				if frame.FrameNumber == 500 {
 					break loop
 				}
@@ -382,10 +392,12 @@ func TestDataTimeReel(t *testing.T) {
 	// 	assert.NoError(t, err)
 	// }
 
-	// Someone is honest, but running backwards:
+	// Someone is honest, but running backwards. The frames are only linked up
+	// once the first of them extends the head, so the earlier scenarios must
+	// have been processed by then.
+	<-headAt400
 	for i := 99; i >= 0; i-- {
 		err := d.Insert(insertFrames[i], false)
-		gotime.Sleep(1 * gotime.Second)
 		assert.NoError(t, err)
 	}
 
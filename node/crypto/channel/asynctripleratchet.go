@@ -0,0 +1,1271 @@
+package channel
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/nekryptology/pkg/core/curves"
+	"source.quilibrium.com/quilibrium/monorepo/nekryptology/pkg/zkp/schnorr"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+const ASYNC_TRIPLE_RATCHET_PROTOCOL_VERSION = 2
+const ASYNC_TRIPLE_RATCHET_PROTOCOL = 3<<8 + ASYNC_TRIPLE_RATCHET_PROTOCOL_VERSION
+
+// A member ratchets the group key before sending once it sent this many
+// messages in the current epoch, or once the epoch is older than
+// GROUP_RATCHET_PERIOD.
+const GROUP_RATCHET_MESSAGES = 100
+const GROUP_RATCHET_PERIOD = 24 * time.Hour
+
+// The most epochs retained to decrypt messages delayed past a group key
+// change, the oldest are discarded first.
+const MAX_RETAINED_EPOCHS = 16
+
+// The most updates held until the epoch they were made on is known, the
+// oldest are discarded first.
+const MAX_HELD_UPDATES = 16
+
+var ErrNotGroupMember = errors.New("not a group member")
+var ErrUnknownEpoch = errors.New("unknown epoch")
+var ErrInvalidSignature = errors.New("invalid signature")
+
+type groupEpoch struct {
+	epoch     uint64
+	id        []byte
+	secret    []byte
+	headerKey []byte
+	parentId  []byte
+	created   time.Time
+}
+
+type receivingChain struct {
+	chainKey []byte
+	length   uint32
+}
+
+// A pair of double ratchet channels with another member, one for each
+// direction, so either side can send first while the other is offline.
+type pairwiseChannel struct {
+	outgoing *DoubleRatchetParticipant
+	incoming *DoubleRatchetParticipant
+}
+
+// AsyncTripleRatchetParticipant is a member of an asynchronous triple ratchet
+// group. Unlike TripleRatchetParticipant, the group key changes without
+// synchronous rounds: the member making a change picks the secret of the next
+// epoch and sends it to the members of that epoch over the pairwise double
+// ratchet channels with them, which they process whenever they come online.
+// Adding or removing a member ratchets the group key, as does sending once the
+// epoch is due, so removed members can't decrypt messages of later epochs.
+//
+// Each member's sending chain in an epoch is derived from the epoch secret,
+// so messages are confidential to the group but are not authenticated to a
+// specific member. Members added again after removal must use a fresh peer
+// key.
+//
+// Note: If an HSM with raw primitive access becomes available, the raw crypto
+// mechanisms should be refactored into calls in KeyManager and implemented
+// through the driver
+type AsyncTripleRatchetParticipant struct {
+	groupId            []byte
+	curve              *curves.Curve
+	keyManager         keys.KeyManager
+	peerKey            curves.Scalar
+	identityKey        curves.Scalar
+	signedPreKey       curves.Scalar
+	publicKey          []byte
+	members            map[string]*PeerInfo
+	epochs             []*groupEpoch
+	sendingChainKey    []byte
+	sendingChainLength uint32
+	channels           map[string]*pairwiseChannel
+	receivingChains    map[string]map[string]*receivingChain
+	skippedKeysMap     map[string]map[string]map[uint32]*skippedKey
+	pending            *protobufs.AsyncTripleRatchetUpdate
+	held               []*protobufs.AsyncTripleRatchetHeldUpdate
+}
+
+func newAsyncTripleRatchetParticipant(
+	groupId []byte,
+	curve *curves.Curve,
+	keyManager keys.KeyManager,
+	peerKey curves.Scalar,
+	identityKey curves.Scalar,
+	signedPreKey curves.Scalar,
+) *AsyncTripleRatchetParticipant {
+	return &AsyncTripleRatchetParticipant{
+		groupId:         groupId,
+		curve:           curve,
+		keyManager:      keyManager,
+		peerKey:         peerKey,
+		identityKey:     identityKey,
+		signedPreKey:    signedPreKey,
+		publicKey:       curve.NewGeneratorPoint().Mul(peerKey).ToAffineCompressed(),
+		members:         make(map[string]*PeerInfo),
+		channels:        make(map[string]*pairwiseChannel),
+		receivingChains: make(map[string]map[string]*receivingChain),
+		skippedKeysMap:  make(map[string]map[string]map[uint32]*skippedKey),
+	}
+}
+
+// NewAsyncTripleRatchetParticipant creates a group with the given peers. The
+// returned updates, keyed by the peers' public keys, must be delivered to them
+// so they can join with JoinAsyncTripleRatchetGroup.
+func NewAsyncTripleRatchetParticipant(
+	groupId []byte,
+	peers []*PeerInfo,
+	curve *curves.Curve,
+	keyManager keys.KeyManager,
+	peerKey curves.Scalar,
+	identityKey curves.Scalar,
+	signedPreKey curves.Scalar,
+) (
+	*AsyncTripleRatchetParticipant,
+	map[string]*protobufs.P2PChannelEnvelope,
+	error,
+) {
+	participant := newAsyncTripleRatchetParticipant(
+		groupId,
+		curve,
+		keyManager,
+		peerKey,
+		identityKey,
+		signedPreKey,
+	)
+
+	members := map[string]*PeerInfo{
+		string(participant.publicKey): participant.peerInfo(),
+	}
+	for _, p := range peers {
+		members[string(p.PublicKey.ToAffineCompressed())] = p
+	}
+
+	updates, err := participant.advance(
+		protobufs.AsyncTripleRatchetUpdate_RATCHET,
+		nil,
+		members,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "new async triple ratchet participant")
+	}
+
+	return participant, updates, nil
+}
+
+// JoinAsyncTripleRatchetGroup joins a group from the update the inviter sent
+// when adding the participant.
+func JoinAsyncTripleRatchetGroup(
+	groupId []byte,
+	inviter *PeerInfo,
+	welcome *protobufs.P2PChannelEnvelope,
+	curve *curves.Curve,
+	keyManager keys.KeyManager,
+	peerKey curves.Scalar,
+	identityKey curves.Scalar,
+	signedPreKey curves.Scalar,
+) (*AsyncTripleRatchetParticipant, error) {
+	participant := newAsyncTripleRatchetParticipant(
+		groupId,
+		curve,
+		keyManager,
+		peerKey,
+		identityKey,
+		signedPreKey,
+	)
+
+	inviterKey := inviter.PublicKey.ToAffineCompressed()
+	participant.members[string(inviterKey)] = inviter
+	if _, err := participant.ReceiveUpdate(inviterKey, welcome); err != nil {
+		return nil, errors.Wrap(err, "join async triple ratchet group")
+	}
+
+	if len(participant.epochs) == 0 {
+		return nil, errors.Wrap(
+			errors.New("stale welcome"),
+			"join async triple ratchet group",
+		)
+	}
+
+	return participant, nil
+}
+
+func (r *AsyncTripleRatchetParticipant) GroupId() []byte {
+	return r.groupId
+}
+
+func (r *AsyncTripleRatchetParticipant) PublicKey() []byte {
+	return r.publicKey
+}
+
+// PeerInfo returns the public keys other members add the participant with.
+func (r *AsyncTripleRatchetParticipant) PeerInfo() *PeerInfo {
+	return &PeerInfo{
+		PublicKey:          r.curve.NewGeneratorPoint().Mul(r.peerKey),
+		IdentityPublicKey:  r.curve.NewGeneratorPoint().Mul(r.identityKey),
+		SignedPrePublicKey: r.curve.NewGeneratorPoint().Mul(r.signedPreKey),
+	}
+}
+
+// Epoch returns the number of the current epoch.
+func (r *AsyncTripleRatchetParticipant) Epoch() uint64 {
+	return r.current().epoch
+}
+
+// Members returns the public keys of the members of the current epoch, in
+// ascending order.
+func (r *AsyncTripleRatchetParticipant) Members() [][]byte {
+	members := make([][]byte, 0, len(r.members))
+	for k := range r.members {
+		members = append(members, []byte(k))
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return bytes.Compare(members[i], members[j]) < 0
+	})
+
+	return members
+}
+
+// AddMember adds the peer to the group, ratcheting the group key. The update
+// for the new member is its welcome for JoinAsyncTripleRatchetGroup.
+func (r *AsyncTripleRatchetParticipant) AddMember(
+	peer *PeerInfo,
+) (map[string]*protobufs.P2PChannelEnvelope, error) {
+	publicKey := peer.PublicKey.ToAffineCompressed()
+	if _, ok := r.members[string(publicKey)]; ok {
+		return nil, errors.Wrap(errors.New("already a member"), "add member")
+	}
+
+	members := map[string]*PeerInfo{string(publicKey): peer}
+	for k, m := range r.members {
+		members[k] = m
+	}
+
+	updates, err := r.advance(
+		protobufs.AsyncTripleRatchetUpdate_ADD_MEMBER,
+		publicKey,
+		members,
+	)
+	return updates, errors.Wrap(err, "add member")
+}
+
+// RemoveMember removes the member from the group, ratcheting the group key
+// without sending the new key to them.
+func (r *AsyncTripleRatchetParticipant) RemoveMember(
+	publicKey []byte,
+) (map[string]*protobufs.P2PChannelEnvelope, error) {
+	if _, ok := r.members[string(publicKey)]; !ok {
+		return nil, errors.Wrap(ErrNotGroupMember, "remove member")
+	}
+
+	if bytes.Equal(publicKey, r.publicKey) {
+		return nil, errors.Wrap(errors.New("can't remove self"), "remove member")
+	}
+
+	members := map[string]*PeerInfo{}
+	for k, m := range r.members {
+		if k != string(publicKey) {
+			members[k] = m
+		}
+	}
+
+	updates, err := r.advance(
+		protobufs.AsyncTripleRatchetUpdate_REMOVE_MEMBER,
+		publicKey,
+		members,
+	)
+	return updates, errors.Wrap(err, "remove member")
+}
+
+// Ratchet moves the group to a new group key, with the members unchanged.
+func (r *AsyncTripleRatchetParticipant) Ratchet() (
+	map[string]*protobufs.P2PChannelEnvelope,
+	error,
+) {
+	updates, err := r.advance(
+		protobufs.AsyncTripleRatchetUpdate_RATCHET,
+		nil,
+		r.members,
+	)
+	return updates, errors.Wrap(err, "ratchet")
+}
+
+// ReceiveUpdate processes a group key update sent by the member, which is
+// authenticated by the pairwise channel with the member. Updates may arrive
+// in any order, an update made on an epoch not known yet is held until that
+// epoch is. If the update overrides a concurrent membership change of the
+// participant, the change is made again, and the resulting updates are
+// returned for delivery.
+func (r *AsyncTripleRatchetParticipant) ReceiveUpdate(
+	sender []byte,
+	envelope *protobufs.P2PChannelEnvelope,
+) (map[string]*protobufs.P2PChannelEnvelope, error) {
+	channel, err := r.channel(string(sender))
+	if err != nil {
+		return nil, errors.Wrap(err, "receive update")
+	}
+
+	data, err := channel.incoming.RatchetDecrypt(envelope)
+	if err != nil {
+		return nil, errors.Wrap(err, "receive update")
+	}
+
+	update := &protobufs.AsyncTripleRatchetUpdate{}
+	if err := proto.Unmarshal(data, update); err != nil {
+		return nil, errors.Wrap(err, "receive update")
+	}
+
+	if !bytes.Equal(update.GroupId, r.groupId) {
+		return nil, errors.Wrap(errors.New("wrong group"), "receive update")
+	}
+
+	// A welcome starts the participant at any epoch, every later update must
+	// be made on an epoch the participant knows.
+	if len(r.epochs) == 0 {
+		if update.Epoch == 0 || update.Epoch == math.MaxUint64 {
+			return nil, errors.Wrap(errors.New("invalid epoch"), "receive update")
+		}
+	} else {
+		parent, err := r.parent(update)
+		if err != nil {
+			return nil, errors.Wrap(err, "receive update")
+		}
+
+		if parent == nil {
+			r.hold(sender, update)
+			return nil, nil
+		}
+	}
+
+	adopted, err := r.apply(sender, update)
+	if err != nil {
+		return nil, errors.Wrap(err, "receive update")
+	}
+
+	for _, held := range r.releaseHeld() {
+		heldAdopted, err := r.apply(held.Sender, held.Update)
+		if err != nil {
+			// The held update is discarded, as it would be had it arrived in
+			// order.
+			continue
+		}
+
+		if heldAdopted != nil {
+			adopted = heldAdopted
+		}
+	}
+
+	if adopted == nil {
+		return nil, nil
+	}
+
+	updates, err := r.reapplyPending(adopted)
+	return updates, errors.Wrap(err, "receive update")
+}
+
+// parent returns the retained epoch the update was made on, or nil if it is
+// not known. The update must be numbered one past it.
+func (r *AsyncTripleRatchetParticipant) parent(
+	update *protobufs.AsyncTripleRatchetUpdate,
+) (*groupEpoch, error) {
+	for _, e := range r.epochs {
+		if !bytes.Equal(e.id, update.ParentEpochId) {
+			continue
+		}
+
+		// The last number is never used, so the epoch can always advance.
+		if update.Epoch != e.epoch+1 || update.Epoch == math.MaxUint64 {
+			return nil, errors.Wrap(errors.New("invalid epoch"), "parent")
+		}
+
+		return e, nil
+	}
+
+	return nil, nil
+}
+
+// hold keeps the update until the epoch it was made on is known, discarding
+// the oldest held updates past MAX_HELD_UPDATES.
+func (r *AsyncTripleRatchetParticipant) hold(
+	sender []byte,
+	update *protobufs.AsyncTripleRatchetUpdate,
+) {
+	r.held = append(r.held, &protobufs.AsyncTripleRatchetHeldUpdate{
+		Sender: sender,
+		Update: update,
+	})
+	if len(r.held) > MAX_HELD_UPDATES {
+		r.held = r.held[len(r.held)-MAX_HELD_UPDATES:]
+	}
+}
+
+// releaseHeld returns the held updates made on epochs now known, in the order
+// they can be applied, and discards those numbered wrongly.
+func (r *AsyncTripleRatchetParticipant) releaseHeld() (
+	released []*protobufs.AsyncTripleRatchetHeldUpdate,
+) {
+	known := map[string]uint64{}
+	for _, e := range r.epochs {
+		known[string(e.id)] = e.epoch
+	}
+
+	for progress := true; progress; {
+		progress = false
+		held := r.held[:0]
+		for _, h := range r.held {
+			parent, ok := known[string(h.Update.ParentEpochId)]
+			if !ok {
+				held = append(held, h)
+				continue
+			}
+
+			progress = true
+			if h.Update.Epoch != parent+1 || h.Update.Epoch == math.MaxUint64 ||
+				len(h.Update.EpochSecret) != 32 {
+				continue
+			}
+
+			id := sha512.Sum512_256(h.Update.EpochSecret)
+			known[string(id[:])] = h.Update.Epoch
+			released = append(released, h)
+		}
+		r.held = held
+	}
+
+	return released
+}
+
+// apply adopts the epoch of the update if it supersedes the current one, and
+// otherwise only retains it. It returns the update if adopted.
+func (r *AsyncTripleRatchetParticipant) apply(
+	sender []byte,
+	update *protobufs.AsyncTripleRatchetUpdate,
+) (*protobufs.AsyncTripleRatchetUpdate, error) {
+	e, err := r.epochFromUpdate(update)
+	if err != nil {
+		return nil, errors.Wrap(err, "apply")
+	}
+
+	for _, existing := range r.epochs {
+		if bytes.Equal(existing.id, e.id) {
+			return nil, nil
+		}
+	}
+
+	if len(r.epochs) != 0 && !epochSupersedes(e, r.current()) {
+		r.retain(e, false)
+		return nil, nil
+	}
+
+	found := false
+	for _, m := range update.Members {
+		if bytes.Equal(m.PublicKey, sender) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.Wrap(
+			errors.New("update from outside the group"),
+			"apply",
+		)
+	}
+
+	if err := r.adopt(update, e); err != nil {
+		return nil, errors.Wrap(err, "apply")
+	}
+
+	return update, nil
+}
+
+// RatchetEncrypt encrypts the message for the group. If the group key is due
+// to be ratcheted it is ratcheted first, and the updates are returned for
+// delivery alongside the message.
+func (r *AsyncTripleRatchetParticipant) RatchetEncrypt(
+	message []byte,
+) (
+	*protobufs.P2PChannelEnvelope,
+	map[string]*protobufs.P2PChannelEnvelope,
+	error,
+) {
+	var updates map[string]*protobufs.P2PChannelEnvelope
+	if r.sendingChainLength >= GROUP_RATCHET_MESSAGES ||
+		time.Since(r.current().created) > GROUP_RATCHET_PERIOD {
+		var err error
+		updates, err = r.Ratchet()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "ratchet encrypt")
+		}
+	}
+
+	e := r.current()
+	envelope := &protobufs.P2PChannelEnvelope{
+		ProtocolIdentifier: ASYNC_TRIPLE_RATCHET_PROTOCOL,
+	}
+
+	newChainKey, messageKey, aeadKey := ratchetKeys(r.sendingChainKey)
+
+	header := r.encodeHeader(e)
+	var err error
+	envelope.MessageHeader, err = r.encrypt(header, e.headerKey, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not encrypt header")
+	}
+
+	signature, err := r.sign(header, message)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not sign message")
+	}
+
+	envelope.MessageBody, err = r.encrypt(
+		append(signature, message...),
+		messageKey,
+		append(append([]byte{}, aeadKey...), envelope.MessageHeader.Ciphertext...),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not encrypt message")
+	}
+
+	r.sendingChainKey = newChainKey
+	r.sendingChainLength++
+
+	return envelope, updates, nil
+}
+
+// RatchetDecrypt decrypts a group message, returning the public key of its
+// sender and the message. Messages may arrive in any order, as long as their
+// epoch is still retained. Messages of members no longer in the group, and
+// messages not signed by the member their header names, are rejected.
+func (r *AsyncTripleRatchetParticipant) RatchetDecrypt(
+	envelope *protobufs.P2PChannelEnvelope,
+) ([]byte, []byte, error) {
+	if envelope.ProtocolIdentifier != ASYNC_TRIPLE_RATCHET_PROTOCOL {
+		return nil, nil, errors.Wrap(
+			errors.New("unsupported protocol"),
+			"ratchet decrypt",
+		)
+	}
+
+	for i := len(r.epochs) - 1; i >= 0; i-- {
+		e := r.epochs[i]
+		header, err := r.decrypt(envelope.MessageHeader, e.headerKey, nil)
+		if err != nil {
+			continue
+		}
+
+		sender, epoch, messageNumber, err := r.decodeHeader(header)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "ratchet decrypt")
+		}
+
+		if epoch != e.epoch {
+			return nil, nil, errors.Wrap(
+				errors.New("epoch mismatch"),
+				"ratchet decrypt",
+			)
+		}
+
+		if _, ok := r.members[string(sender)]; !ok ||
+			bytes.Equal(sender, r.publicKey) {
+			return nil, nil, errors.Wrap(ErrNotGroupMember, "ratchet decrypt")
+		}
+
+		plaintext, err := r.decryptMessage(
+			envelope,
+			e,
+			header,
+			sender,
+			messageNumber,
+		)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "ratchet decrypt")
+		}
+
+		return sender, plaintext, nil
+	}
+
+	return nil, nil, errors.Wrap(ErrUnknownEpoch, "ratchet decrypt")
+}
+
+// decryptMessage decrypts the message body and verifies the signature of the
+// sender, only then advancing the receiving chain of the sender.
+func (r *AsyncTripleRatchetParticipant) decryptMessage(
+	envelope *protobufs.P2PChannelEnvelope,
+	e *groupEpoch,
+	header []byte,
+	sender []byte,
+	messageNumber uint32,
+) ([]byte, error) {
+	associatedData := envelope.MessageHeader.Ciphertext
+	headerKey := string(e.headerKey)
+	senderSkippedKeys := r.skippedKeysMap[string(sender)]
+	if skipped, ok := senderSkippedKeys[headerKey][messageNumber]; ok {
+		body, err := r.decrypt(
+			envelope.MessageBody,
+			skipped.key[:32],
+			append(append([]byte{}, skipped.key[32:]...), associatedData...),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "decrypt message")
+		}
+
+		plaintext, err := r.verify(header, sender, body)
+		if err != nil {
+			return nil, errors.Wrap(err, "decrypt message")
+		}
+
+		delete(senderSkippedKeys[headerKey], messageNumber)
+		if len(senderSkippedKeys[headerKey]) == 0 {
+			delete(senderSkippedKeys, headerKey)
+		}
+
+		return plaintext, nil
+	}
+
+	chain := r.receivingChains[string(sender)][headerKey]
+	if chain == nil {
+		chainKey, err := r.chainKey(e, sender)
+		if err != nil {
+			return nil, errors.Wrap(err, "decrypt message")
+		}
+		chain = &receivingChain{chainKey: chainKey}
+	}
+
+	if messageNumber < chain.length {
+		return nil, errors.Wrap(
+			errors.New("message key not available"),
+			"decrypt message",
+		)
+	}
+
+	if messageNumber-chain.length > MAX_MESSAGE_SKIP {
+		return nil, errors.Wrap(
+			errors.New("skip limit exceeded"),
+			"decrypt message",
+		)
+	}
+
+	// The chain only advances once the message is authenticated.
+	chainKey := chain.chainKey
+	skipped := map[uint32][]byte{}
+	for n := chain.length; n < messageNumber; n++ {
+		newChainKey, messageKey, aeadKey := ratchetKeys(chainKey)
+		skipped[n] = append(append([]byte{}, messageKey...), aeadKey...)
+		chainKey = newChainKey
+	}
+
+	newChainKey, messageKey, aeadKey := ratchetKeys(chainKey)
+	body, err := r.decrypt(
+		envelope.MessageBody,
+		messageKey,
+		append(append([]byte{}, aeadKey...), associatedData...),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt message")
+	}
+
+	plaintext, err := r.verify(header, sender, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt message")
+	}
+
+	if r.receivingChains[string(sender)] == nil {
+		r.receivingChains[string(sender)] = make(map[string]*receivingChain)
+	}
+	r.receivingChains[string(sender)][headerKey] = &receivingChain{
+		chainKey: newChainKey,
+		length:   messageNumber + 1,
+	}
+
+	if len(skipped) != 0 {
+		if senderSkippedKeys == nil {
+			senderSkippedKeys = make(map[string]map[uint32]*skippedKey)
+			r.skippedKeysMap[string(sender)] = senderSkippedKeys
+		}
+		if senderSkippedKeys[headerKey] == nil {
+			senderSkippedKeys[headerKey] = make(map[uint32]*skippedKey)
+		}
+
+		now := time.Now()
+		for n, k := range skipped {
+			senderSkippedKeys[headerKey][n] = &skippedKey{key: k, skipped: now}
+		}
+		pruneSkippedKeys(senderSkippedKeys, now)
+	}
+
+	return plaintext, nil
+}
+
+// advance starts a new epoch with the given members, returning the updates
+// announcing it to them.
+func (r *AsyncTripleRatchetParticipant) advance(
+	updateType protobufs.AsyncTripleRatchetUpdate_UpdateType,
+	subject []byte,
+	members map[string]*PeerInfo,
+) (map[string]*protobufs.P2PChannelEnvelope, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(err, "advance")
+	}
+
+	update := &protobufs.AsyncTripleRatchetUpdate{
+		GroupId:     r.groupId,
+		Type:        updateType,
+		Subject:     subject,
+		Epoch:       1,
+		EpochSecret: secret,
+		Timestamp:   time.Now().UnixMilli(),
+	}
+	if len(r.epochs) != 0 {
+		update.Epoch = r.current().epoch + 1
+		update.ParentEpochId = r.current().id
+	}
+
+	memberKeys := make([]string, 0, len(members))
+	for k := range members {
+		memberKeys = append(memberKeys, k)
+	}
+	sort.Strings(memberKeys)
+
+	for _, k := range memberKeys {
+		update.Members = append(update.Members, memberState(members[k]))
+	}
+
+	data, err := proto.Marshal(update)
+	if err != nil {
+		return nil, errors.Wrap(err, "advance")
+	}
+
+	// Channels with new members are set up from the members of the update.
+	previous := r.members
+	r.members = members
+	updates := make(map[string]*protobufs.P2PChannelEnvelope)
+	for _, k := range memberKeys {
+		if k == string(r.publicKey) {
+			continue
+		}
+
+		channel, err := r.channel(k)
+		if err == nil {
+			updates[k], err = channel.outgoing.RatchetEncrypt(data)
+		}
+		if err != nil {
+			r.members = previous
+			return nil, errors.Wrap(err, "advance")
+		}
+	}
+
+	e, err := r.epochFromUpdate(update)
+	if err != nil {
+		return nil, errors.Wrap(err, "advance")
+	}
+
+	if err := r.adopt(update, e); err != nil {
+		return nil, errors.Wrap(err, "advance")
+	}
+
+	if updateType != protobufs.AsyncTripleRatchetUpdate_RATCHET {
+		r.pending = update
+	}
+
+	return updates, nil
+}
+
+// adopt makes the epoch of the update the current one.
+func (r *AsyncTripleRatchetParticipant) adopt(
+	update *protobufs.AsyncTripleRatchetUpdate,
+	e *groupEpoch,
+) error {
+	members := make(map[string]*PeerInfo)
+	for _, m := range update.Members {
+		peer, err := r.peerInfoFromState(m)
+		if err != nil {
+			return errors.Wrap(err, "adopt")
+		}
+
+		// Keep the known keys of existing members, their channels are set up
+		// from them.
+		if existing, ok := r.members[string(m.PublicKey)]; ok {
+			peer = existing
+		}
+		members[string(m.PublicKey)] = peer
+	}
+
+	if _, ok := members[string(r.publicKey)]; !ok {
+		return errors.Wrap(ErrNotGroupMember, "adopt")
+	}
+
+	for k := range r.channels {
+		if _, ok := members[k]; !ok {
+			delete(r.channels, k)
+		}
+	}
+
+	sendingChainKey, err := r.chainKey(e, r.publicKey)
+	if err != nil {
+		return errors.Wrap(err, "adopt")
+	}
+
+	r.members = members
+	r.retain(e, true)
+	r.sendingChainKey = sendingChainKey
+	r.sendingChainLength = 0
+
+	return nil
+}
+
+// reapplyPending makes the participant's last membership change again if the
+// adopted update, made concurrently, overrode it.
+func (r *AsyncTripleRatchetParticipant) reapplyPending(
+	update *protobufs.AsyncTripleRatchetUpdate,
+) (map[string]*protobufs.P2PChannelEnvelope, error) {
+	pending := r.pending
+	if pending == nil {
+		return nil, nil
+	}
+
+	r.pending = nil
+	if bytes.Equal(update.Subject, pending.Subject) {
+		return nil, nil
+	}
+
+	_, isMember := r.members[string(pending.Subject)]
+	switch pending.Type {
+	case protobufs.AsyncTripleRatchetUpdate_ADD_MEMBER:
+		if isMember {
+			return nil, nil
+		}
+
+		for _, m := range pending.Members {
+			if bytes.Equal(m.PublicKey, pending.Subject) {
+				peer, err := r.peerInfoFromState(m)
+				if err != nil {
+					return nil, errors.Wrap(err, "reapply pending")
+				}
+
+				updates, err := r.AddMember(peer)
+				return updates, errors.Wrap(err, "reapply pending")
+			}
+		}
+	case protobufs.AsyncTripleRatchetUpdate_REMOVE_MEMBER:
+		if !isMember {
+			return nil, nil
+		}
+
+		updates, err := r.RemoveMember(pending.Subject)
+		return updates, errors.Wrap(err, "reapply pending")
+	}
+
+	return nil, nil
+}
+
+// retain records the epoch, as the current one or as one only kept to decrypt
+// delayed messages, and discards the oldest epochs past MAX_RETAINED_EPOCHS.
+func (r *AsyncTripleRatchetParticipant) retain(e *groupEpoch, current bool) {
+	if current || len(r.epochs) == 0 {
+		r.epochs = append(r.epochs, e)
+	} else {
+		last := len(r.epochs) - 1
+		r.epochs = append(r.epochs[:last], e, r.epochs[last])
+	}
+
+	for len(r.epochs) > MAX_RETAINED_EPOCHS {
+		headerKey := string(r.epochs[0].headerKey)
+		for _, chains := range r.receivingChains {
+			delete(chains, headerKey)
+		}
+		for _, skippedKeys := range r.skippedKeysMap {
+			delete(skippedKeys, headerKey)
+		}
+		r.epochs = r.epochs[1:]
+	}
+}
+
+func (r *AsyncTripleRatchetParticipant) current() *groupEpoch {
+	return r.epochs[len(r.epochs)-1]
+}
+
+// epochSupersedes reports whether the epoch wins over the current one. Later
+// epochs win, and of concurrent ones the lowest id.
+func epochSupersedes(e *groupEpoch, current *groupEpoch) bool {
+	if e.epoch != current.epoch {
+		return e.epoch > current.epoch
+	}
+
+	return bytes.Compare(e.id, current.id) < 0
+}
+
+func (r *AsyncTripleRatchetParticipant) epochFromUpdate(
+	update *protobufs.AsyncTripleRatchetUpdate,
+) (*groupEpoch, error) {
+	return r.newEpoch(
+		update.Epoch,
+		update.EpochSecret,
+		update.ParentEpochId,
+		update.Timestamp,
+	)
+}
+
+func (r *AsyncTripleRatchetParticipant) newEpoch(
+	epoch uint64,
+	secret []byte,
+	parentId []byte,
+	timestamp int64,
+) (*groupEpoch, error) {
+	if len(secret) != 32 {
+		return nil, errors.Wrap(errors.New("invalid epoch secret"), "new epoch")
+	}
+
+	id := sha512.Sum512_256(secret)
+	headerKey := make([]byte, 32)
+	if _, err := hkdf.New(
+		sha512.New,
+		secret,
+		r.groupId,
+		[]byte("quilibrium-async-triple-ratchet-header"),
+	).Read(headerKey); err != nil {
+		return nil, errors.Wrap(err, "new epoch")
+	}
+
+	return &groupEpoch{
+		epoch:     epoch,
+		id:        id[:],
+		secret:    secret,
+		headerKey: headerKey,
+		parentId:  parentId,
+		created:   time.UnixMilli(timestamp),
+	}, nil
+}
+
+// chainKey derives the first key of the member's sending chain in the epoch.
+func (r *AsyncTripleRatchetParticipant) chainKey(
+	e *groupEpoch,
+	sender []byte,
+) ([]byte, error) {
+	chainKey := make([]byte, 32)
+	_, err := hkdf.New(
+		sha512.New,
+		e.secret,
+		sender,
+		[]byte("quilibrium-async-triple-ratchet-chain"),
+	).Read(chainKey)
+	return chainKey, errors.Wrap(err, "chain key")
+}
+
+// channel returns the pairwise channels with the member, setting them up on
+// first use. The sessions are bound to the group and the peer keys of both
+// ends.
+func (r *AsyncTripleRatchetParticipant) channel(
+	publicKey string,
+) (*pairwiseChannel, error) {
+	if c, ok := r.channels[publicKey]; ok {
+		return c, nil
+	}
+
+	peer, ok := r.members[publicKey]
+	if !ok || publicKey == string(r.publicKey) {
+		return nil, errors.Wrap(ErrNotGroupMember, "channel")
+	}
+
+	outgoingKey, err := r.pairwiseSessionKey(
+		SenderX3DH(
+			r.identityKey,
+			r.signedPreKey,
+			peer.IdentityPublicKey,
+			peer.SignedPrePublicKey,
+			96,
+		),
+		r.publicKey,
+		[]byte(publicKey),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "channel")
+	}
+
+	incomingKey, err := r.pairwiseSessionKey(
+		ReceiverX3DH(
+			r.identityKey,
+			r.signedPreKey,
+			peer.IdentityPublicKey,
+			peer.SignedPrePublicKey,
+			96,
+		),
+		[]byte(publicKey),
+		r.publicKey,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "channel")
+	}
+
+	outgoing, err := NewDoubleRatchetParticipant(
+		outgoingKey[:32],
+		outgoingKey[32:64],
+		outgoingKey[64:],
+		true,
+		r.signedPreKey,
+		peer.SignedPrePublicKey,
+		r.curve,
+		r.keyManager,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "channel")
+	}
+
+	incoming, err := NewDoubleRatchetParticipant(
+		incomingKey[:32],
+		incomingKey[32:64],
+		incomingKey[64:],
+		false,
+		r.signedPreKey,
+		peer.SignedPrePublicKey,
+		r.curve,
+		r.keyManager,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "channel")
+	}
+
+	c := &pairwiseChannel{outgoing: outgoing, incoming: incoming}
+	r.channels[publicKey] = c
+	return c, nil
+}
+
+func (r *AsyncTripleRatchetParticipant) pairwiseSessionKey(
+	sharedSecret []byte,
+	from []byte,
+	to []byte,
+) ([]byte, error) {
+	info := []byte("quilibrium-async-triple-ratchet-pairwise")
+	info = append(append(info, from...), to...)
+	sessionKey := make([]byte, 96)
+	_, err := hkdf.New(sha512.New, sharedSecret, r.groupId, info).Read(sessionKey)
+	return sessionKey, errors.Wrap(err, "pairwise session key")
+}
+
+func (r *AsyncTripleRatchetParticipant) peerInfo() *PeerInfo {
+	return &PeerInfo{
+		PublicKey:          r.curve.NewGeneratorPoint().Mul(r.peerKey),
+		IdentityPublicKey:  r.curve.NewGeneratorPoint().Mul(r.identityKey),
+		SignedPrePublicKey: r.curve.NewGeneratorPoint().Mul(r.signedPreKey),
+	}
+}
+
+func memberState(peer *PeerInfo) *protobufs.AsyncTripleRatchetMember {
+	return &protobufs.AsyncTripleRatchetMember{
+		PublicKey:          peer.PublicKey.ToAffineCompressed(),
+		IdentityPublicKey:  peer.IdentityPublicKey.ToAffineCompressed(),
+		SignedPrePublicKey: peer.SignedPrePublicKey.ToAffineCompressed(),
+	}
+}
+
+func (r *AsyncTripleRatchetParticipant) peerInfoFromState(
+	m *protobufs.AsyncTripleRatchetMember,
+) (*PeerInfo, error) {
+	publicKey, err := r.curve.Point.FromAffineCompressed(m.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "public key")
+	}
+
+	identityPublicKey, err := r.curve.Point.FromAffineCompressed(
+		m.IdentityPublicKey,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "identity public key")
+	}
+
+	signedPrePublicKey, err := r.curve.Point.FromAffineCompressed(
+		m.SignedPrePublicKey,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "signed pre public key")
+	}
+
+	return &PeerInfo{
+		PublicKey:          publicKey,
+		IdentityPublicKey:  identityPublicKey,
+		SignedPrePublicKey: signedPrePublicKey,
+	}, nil
+}
+
+func (r *AsyncTripleRatchetParticipant) encodeHeader(e *groupEpoch) []byte {
+	header := append([]byte{}, r.publicKey...)
+	header = binary.BigEndian.AppendUint64(header, e.epoch)
+	header = binary.BigEndian.AppendUint32(header, r.sendingChainLength)
+	return header
+}
+
+func (r *AsyncTripleRatchetParticipant) decodeHeader(
+	header []byte,
+) ([]byte, uint64, uint32, error) {
+	keyLength := len(r.curve.Point.ToAffineCompressed())
+	if len(header) != keyLength+12 {
+		return nil, 0, 0, errors.Wrap(
+			errors.New("malformed header"),
+			"decode header",
+		)
+	}
+
+	return header[:keyLength],
+		binary.BigEndian.Uint64(header[keyLength : keyLength+8]),
+		binary.BigEndian.Uint32(header[keyLength+8:]),
+		nil
+}
+
+// sign signs the message and its header with the participant's peer key. The
+// header and group keys are known to all members, so only the signature shows
+// which member sent a message.
+func (r *AsyncTripleRatchetParticipant) sign(
+	header []byte,
+	message []byte,
+) ([]byte, error) {
+	proof, err := schnorr.NewProver(
+		r.curve,
+		nil,
+		sha3.New256(),
+		r.signedPayload(header, message),
+	).Prove(r.peerKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "sign")
+	}
+
+	return append(proof.C.Bytes(), proof.S.Bytes()...), nil
+}
+
+// verify checks the signature leading the decrypted body against the key of
+// the member named in the header, and returns the message following it.
+func (r *AsyncTripleRatchetParticipant) verify(
+	header []byte,
+	sender []byte,
+	body []byte,
+) ([]byte, error) {
+	member, ok := r.members[string(sender)]
+	if !ok {
+		return nil, errors.Wrap(ErrNotGroupMember, "verify")
+	}
+
+	scalarLength := len(r.curve.NewScalar().Bytes())
+	if len(body) < 2*scalarLength {
+		return nil, errors.Wrap(ErrInvalidSignature, "verify")
+	}
+
+	c, err := r.curve.NewScalar().SetBytes(body[:scalarLength])
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidSignature, "verify")
+	}
+
+	s, err := r.curve.NewScalar().SetBytes(body[scalarLength : 2*scalarLength])
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidSignature, "verify")
+	}
+
+	message := body[2*scalarLength:]
+	if err := schnorr.Verify(
+		&schnorr.Proof{C: c, S: s, Statement: member.PublicKey},
+		r.curve,
+		nil,
+		sha3.New256(),
+		r.signedPayload(header, message),
+	); err != nil {
+		return nil, errors.Wrap(ErrInvalidSignature, "verify")
+	}
+
+	return message, nil
+}
+
+// signedPayload binds the message to the group and its header, which names
+// the sender, epoch and message number.
+func (r *AsyncTripleRatchetParticipant) signedPayload(
+	header []byte,
+	message []byte,
+) []byte {
+	payload := []byte("quilibrium-async-triple-ratchet-message")
+	payload = binary.BigEndian.AppendUint32(payload, uint32(len(r.groupId)))
+	payload = append(payload, r.groupId...)
+	payload = append(payload, header...)
+	return append(payload, message...)
+}
+
+func (r *AsyncTripleRatchetParticipant) encrypt(
+	plaintext []byte,
+	key []byte,
+	associatedData []byte,
+) (*protobufs.MessageCiphertext, error) {
+	iv := [12]byte{}
+	if _, err := rand.Read(iv[:]); err != nil {
+		return nil, errors.Wrap(err, "encrypt")
+	}
+
+	aesCipher, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt")
+	}
+
+	gcm, err := cipher.NewGCM(aesCipher)
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt")
+	}
+
+	ciphertext := &protobufs.MessageCiphertext{}
+
+	if associatedData == nil {
+		associatedData = make([]byte, 32)
+		if _, err := rand.Read(associatedData); err != nil {
+			return nil, errors.Wrap(err, "encrypt")
+		}
+		ciphertext.AssociatedData = associatedData
+	}
+
+	ciphertext.Ciphertext = gcm.Seal(nil, iv[:], plaintext, associatedData)
+	ciphertext.InitializationVector = iv[:]
+
+	return ciphertext, nil
+}
+
+func (r *AsyncTripleRatchetParticipant) decrypt(
+	ciphertext *protobufs.MessageCiphertext,
+	key []byte,
+	associatedData []byte,
+) ([]byte, error) {
+	if ciphertext == nil {
+		return nil, errors.Wrap(errors.New("missing ciphertext"), "decrypt")
+	}
+
+	if associatedData == nil {
+		associatedData = ciphertext.AssociatedData
+	}
+
+	aesCipher, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt")
+	}
+
+	gcm, err := cipher.NewGCM(aesCipher)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt")
+	}
+
+	if len(ciphertext.InitializationVector) != gcm.NonceSize() {
+		return nil, errors.Wrap(errors.New("invalid nonce"), "decrypt")
+	}
+
+	plaintext, err := gcm.Open(
+		nil,
+		ciphertext.InitializationVector,
+		ciphertext.Ciphertext,
+		associatedData,
+	)
+
+	return plaintext, errors.Wrap(err, "decrypt")
+}
@@ -0,0 +1,80 @@
+package channel
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/nekryptology/pkg/core/curves"
+)
+
+func TestAsyncTripleRatchetRejectsForgedSender(t *testing.T) {
+	curve := curves.ED448()
+	type member struct {
+		peerKey      curves.Scalar
+		identityKey  curves.Scalar
+		signedPreKey curves.Scalar
+		info         *PeerInfo
+	}
+	newMember := func() *member {
+		m := &member{
+			peerKey:      curve.Scalar.Random(rand.Reader),
+			identityKey:  curve.Scalar.Random(rand.Reader),
+			signedPreKey: curve.Scalar.Random(rand.Reader),
+		}
+		m.info = &PeerInfo{
+			PublicKey:          curve.NewGeneratorPoint().Mul(m.peerKey),
+			IdentityPublicKey:  curve.NewGeneratorPoint().Mul(m.identityKey),
+			SignedPrePublicKey: curve.NewGeneratorPoint().Mul(m.signedPreKey),
+		}
+		return m
+	}
+	groupId := []byte("group")
+	ma, mb, mc := newMember(), newMember(), newMember()
+
+	a, updates, err := NewAsyncTripleRatchetParticipant(
+		groupId,
+		[]*PeerInfo{mb.info, mc.info},
+		curve,
+		nil,
+		ma.peerKey,
+		ma.identityKey,
+		ma.signedPreKey,
+	)
+	require.NoError(t, err)
+
+	join := func(m *member) *AsyncTripleRatchetParticipant {
+		p, err := JoinAsyncTripleRatchetGroup(
+			groupId,
+			ma.info,
+			updates[string(m.info.PublicKey.ToAffineCompressed())],
+			curve,
+			nil,
+			m.peerKey,
+			m.identityKey,
+			m.signedPreKey,
+		)
+		require.NoError(t, err)
+		return p
+	}
+	b, c := join(mb), join(mc)
+
+	// C holds the group keys, so it can name A in the header and derive the
+	// chain of A, but it can't sign as A.
+	c.publicKey = a.publicKey
+	c.sendingChainKey, err = c.chainKey(c.current(), a.publicKey)
+	require.NoError(t, err)
+	forged, _, err := c.RatchetEncrypt([]byte("forged"))
+	require.NoError(t, err)
+
+	_, _, err = b.RatchetDecrypt(forged)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	// The chain of A is left as it was.
+	genuine, _, err := a.RatchetEncrypt([]byte("genuine"))
+	require.NoError(t, err)
+	sender, plaintext, err := b.RatchetDecrypt(genuine)
+	require.NoError(t, err)
+	require.Equal(t, a.publicKey, sender)
+	require.Equal(t, []byte("genuine"), plaintext)
+}
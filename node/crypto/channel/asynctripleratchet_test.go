@@ -0,0 +1,368 @@
+package channel_test
+
+import (
+	"crypto/rand"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"source.quilibrium.com/quilibrium/monorepo/nekryptology/pkg/core/curves"
+	"source.quilibrium.com/quilibrium/monorepo/node/crypto/channel"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+type groupMember struct {
+	peerKey      curves.Scalar
+	identityKey  curves.Scalar
+	signedPreKey curves.Scalar
+	info         *channel.PeerInfo
+	participant  *channel.AsyncTripleRatchetParticipant
+}
+
+func newGroupMember() *groupMember {
+	curve := curves.ED448()
+	m := &groupMember{
+		peerKey:      curve.Scalar.Random(rand.Reader),
+		identityKey:  curve.Scalar.Random(rand.Reader),
+		signedPreKey: curve.Scalar.Random(rand.Reader),
+	}
+	m.info = &channel.PeerInfo{
+		PublicKey:          curve.NewGeneratorPoint().Mul(m.peerKey),
+		IdentityPublicKey:  curve.NewGeneratorPoint().Mul(m.identityKey),
+		SignedPrePublicKey: curve.NewGeneratorPoint().Mul(m.signedPreKey),
+	}
+	return m
+}
+
+func (m *groupMember) key() string {
+	return string(m.info.PublicKey.ToAffineCompressed())
+}
+
+func (m *groupMember) join(
+	t *testing.T,
+	groupId []byte,
+	inviter *groupMember,
+	welcome *protobufs.P2PChannelEnvelope,
+) {
+	var err error
+	m.participant, err = channel.JoinAsyncTripleRatchetGroup(
+		groupId,
+		inviter.info,
+		welcome,
+		curves.ED448(),
+		nil,
+		m.peerKey,
+		m.identityKey,
+		m.signedPreKey,
+	)
+	require.NoError(t, err)
+}
+
+// deliver hands the updates of the sender to the members, and any updates
+// produced in response on to theirs, until none are left.
+func deliver(
+	t *testing.T,
+	sender *groupMember,
+	updates map[string]*protobufs.P2PChannelEnvelope,
+	members ...*groupMember,
+) {
+	type pending struct {
+		sender  *groupMember
+		updates map[string]*protobufs.P2PChannelEnvelope
+	}
+
+	queue := []pending{{sender, updates}}
+	for len(queue) != 0 {
+		next := queue[0]
+		queue = queue[1:]
+		for _, m := range members {
+			update, ok := next.updates[m.key()]
+			if !ok {
+				continue
+			}
+
+			responses, err := m.participant.ReceiveUpdate(
+				next.sender.info.PublicKey.ToAffineCompressed(),
+				update,
+			)
+			require.NoError(t, err)
+			if len(responses) != 0 {
+				queue = append(queue, pending{m, responses})
+			}
+		}
+	}
+}
+
+func newGroup(t *testing.T, size int) ([]byte, []*groupMember) {
+	groupId := []byte("group")
+	members := []*groupMember{}
+	for i := 0; i < size; i++ {
+		members = append(members, newGroupMember())
+	}
+
+	peers := []*channel.PeerInfo{}
+	for _, m := range members[1:] {
+		peers = append(peers, m.info)
+	}
+
+	var updates map[string]*protobufs.P2PChannelEnvelope
+	var err error
+	members[0].participant, updates, err = channel.NewAsyncTripleRatchetParticipant(
+		groupId,
+		peers,
+		curves.ED448(),
+		nil,
+		members[0].peerKey,
+		members[0].identityKey,
+		members[0].signedPreKey,
+	)
+	require.NoError(t, err)
+	require.Len(t, updates, size-1)
+
+	for _, m := range members[1:] {
+		m.join(t, groupId, members[0], updates[m.key()])
+	}
+
+	return groupId, members
+}
+
+func encrypt(
+	t *testing.T,
+	m *groupMember,
+	message string,
+) *protobufs.P2PChannelEnvelope {
+	envelope, updates, err := m.participant.RatchetEncrypt([]byte(message))
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	return envelope
+}
+
+func requireDecrypts(
+	t *testing.T,
+	m *groupMember,
+	sender *groupMember,
+	envelope *protobufs.P2PChannelEnvelope,
+	message string,
+) {
+	from, plaintext, err := m.participant.RatchetDecrypt(envelope)
+	require.NoError(t, err)
+	require.Equal(t, sender.info.PublicKey.ToAffineCompressed(), from)
+	require.Equal(t, []byte(message), plaintext)
+}
+
+func TestAsyncTripleRatchetOutOfOrder(t *testing.T) {
+	_, members := newGroup(t, 3)
+	a, b, c := members[0], members[1], members[2]
+
+	envelopes := []*protobufs.P2PChannelEnvelope{}
+	for _, message := range []string{"one", "two", "three"} {
+		envelopes = append(envelopes, encrypt(t, a, message))
+	}
+
+	requireDecrypts(t, c, a, envelopes[2], "three")
+	requireDecrypts(t, c, a, envelopes[0], "one")
+	requireDecrypts(t, c, a, envelopes[1], "two")
+	requireDecrypts(t, b, a, envelopes[1], "two")
+
+	// Replays are rejected.
+	_, _, err := c.participant.RatchetDecrypt(envelopes[1])
+	require.Error(t, err)
+
+	reply := encrypt(t, b, "reply")
+	requireDecrypts(t, a, b, reply, "reply")
+	requireDecrypts(t, c, b, reply, "reply")
+
+	// A message sent before a ratchet arrives after it.
+	late := encrypt(t, a, "late")
+	updates, err := a.participant.Ratchet()
+	require.NoError(t, err)
+	early := encrypt(t, a, "early")
+
+	_, _, err = b.participant.RatchetDecrypt(early)
+	require.ErrorIs(t, err, channel.ErrUnknownEpoch)
+
+	deliver(t, a, updates, b, c)
+	require.Equal(t, uint64(2), b.participant.Epoch())
+	requireDecrypts(t, b, a, early, "early")
+	requireDecrypts(t, b, a, late, "late")
+	requireDecrypts(t, b, a, envelopes[0], "one")
+	requireDecrypts(t, c, a, late, "late")
+	requireDecrypts(t, c, a, early, "early")
+
+	// Updates themselves may arrive out of order.
+	first, err := a.participant.Ratchet()
+	require.NoError(t, err)
+	second, err := a.participant.Ratchet()
+	require.NoError(t, err)
+	deliver(t, a, second, b)
+	deliver(t, a, first, b)
+	require.Equal(t, uint64(4), b.participant.Epoch())
+	requireDecrypts(t, b, a, encrypt(t, a, "in sync"), "in sync")
+}
+
+func TestAsyncTripleRatchetMembership(t *testing.T) {
+	groupId, members := newGroup(t, 3)
+	a, b, c := members[0], members[1], members[2]
+
+	before := encrypt(t, a, "before")
+
+	// C is removed, the new group key is never sent to them.
+	updates, err := a.participant.RemoveMember(c.info.PublicKey.ToAffineCompressed())
+	require.NoError(t, err)
+	require.NotContains(t, updates, c.key())
+	deliver(t, a, updates, b)
+
+	after := encrypt(t, a, "after")
+	requireDecrypts(t, b, a, after, "after")
+	requireDecrypts(t, b, a, before, "before")
+	_, _, err = c.participant.RatchetDecrypt(after)
+	require.ErrorIs(t, err, channel.ErrUnknownEpoch)
+
+	fromB := encrypt(t, b, "from b")
+	requireDecrypts(t, a, b, fromB, "from b")
+	_, _, err = c.participant.RatchetDecrypt(fromB)
+	require.ErrorIs(t, err, channel.ErrUnknownEpoch)
+
+	// Messages of the removed member are rejected.
+	fromC := encrypt(t, c, "from c")
+	_, _, err = a.participant.RatchetDecrypt(fromC)
+	require.ErrorIs(t, err, channel.ErrNotGroupMember)
+
+	// D is added while B is offline, and can talk to B once B is back.
+	d := newGroupMember()
+	updates, err = a.participant.AddMember(d.info)
+	require.NoError(t, err)
+	d.join(t, groupId, a, updates[d.key()])
+	require.Len(t, d.participant.Members(), 3)
+
+	fromD := encrypt(t, d, "from d")
+	requireDecrypts(t, a, d, fromD, "from d")
+	_, _, err = b.participant.RatchetDecrypt(fromD)
+	require.ErrorIs(t, err, channel.ErrUnknownEpoch)
+
+	deliver(t, a, updates, b)
+	requireDecrypts(t, b, d, fromD, "from d")
+	requireDecrypts(t, d, b, encrypt(t, b, "welcome"), "welcome")
+
+	// D didn't get the group key of earlier epochs.
+	_, _, err = d.participant.RatchetDecrypt(after)
+	require.ErrorIs(t, err, channel.ErrUnknownEpoch)
+}
+
+func TestAsyncTripleRatchetConcurrentChanges(t *testing.T) {
+	for i := 0; i < 8; i++ {
+		_, members := newGroup(t, 3)
+		a, b, c := members[0], members[1], members[2]
+
+		// A removes C while B, not knowing of it, ratchets the group key.
+		removal, err := a.participant.RemoveMember(
+			c.info.PublicKey.ToAffineCompressed(),
+		)
+		require.NoError(t, err)
+		ratchet, err := b.participant.Ratchet()
+		require.NoError(t, err)
+
+		deliver(t, b, ratchet, a, b, c)
+		deliver(t, a, removal, a, b, c)
+
+		require.Equal(t, a.participant.Epoch(), b.participant.Epoch())
+		require.Equal(t, a.participant.Members(), b.participant.Members())
+		require.Len(t, a.participant.Members(), 2)
+
+		message := encrypt(t, a, "secret")
+		requireDecrypts(t, b, a, message, "secret")
+		_, _, err = c.participant.RatchetDecrypt(message)
+		require.Error(t, err)
+	}
+}
+
+func TestAsyncTripleRatchetPeriodicRatchet(t *testing.T) {
+	_, members := newGroup(t, 2)
+	a, b := members[0], members[1]
+
+	for i := 0; i < channel.GROUP_RATCHET_MESSAGES; i++ {
+		encrypt(t, a, "message")
+	}
+
+	envelope, updates, err := a.participant.RatchetEncrypt([]byte("ratcheted"))
+	require.NoError(t, err)
+	require.Contains(t, updates, b.key())
+	require.Equal(t, uint64(2), a.participant.Epoch())
+
+	deliver(t, a, updates, b)
+	requireDecrypts(t, b, a, envelope, "ratcheted")
+}
+
+func TestAsyncTripleRatchetMarshalState(t *testing.T) {
+	_, members := newGroup(t, 3)
+	a, b := members[0], members[1]
+
+	skipped := encrypt(t, a, "skipped")
+	requireDecrypts(t, b, a, encrypt(t, a, "received"), "received")
+
+	state, err := b.participant.MarshalState()
+	require.NoError(t, err)
+	b.participant, err = channel.UnmarshalAsyncTripleRatchetParticipant(state, nil)
+	require.NoError(t, err)
+
+	resumedState, err := b.participant.MarshalState()
+	require.NoError(t, err)
+	require.Equal(t, state, resumedState)
+
+	requireDecrypts(t, b, a, skipped, "skipped")
+
+	updates, err := a.participant.Ratchet()
+	require.NoError(t, err)
+	deliver(t, a, updates, b)
+	requireDecrypts(t, a, b, encrypt(t, b, "resumed"), "resumed")
+
+	// An update held for its parent epoch is kept across a restart.
+	first, err := a.participant.Ratchet()
+	require.NoError(t, err)
+	second, err := a.participant.Ratchet()
+	require.NoError(t, err)
+	deliver(t, a, second, b)
+	require.Equal(t, uint64(2), b.participant.Epoch())
+
+	state, err = b.participant.MarshalState()
+	require.NoError(t, err)
+	b.participant, err = channel.UnmarshalAsyncTripleRatchetParticipant(state, nil)
+	require.NoError(t, err)
+
+	deliver(t, a, first, b)
+	require.Equal(t, uint64(4), b.participant.Epoch())
+	requireDecrypts(t, b, a, encrypt(t, a, "caught up"), "caught up")
+}
+
+func TestAsyncTripleRatchetRejectsEpochJumps(t *testing.T) {
+	_, members := newGroup(t, 2)
+	a, b := members[0], members[1]
+
+	// A numbers its next update as the last epoch, which would leave no room
+	// for the epochs after it.
+	data, err := a.participant.MarshalState()
+	require.NoError(t, err)
+	state := &protobufs.AsyncTripleRatchetParticipantState{}
+	require.NoError(t, proto.Unmarshal(data, state))
+	state.Epochs[len(state.Epochs)-1].Epoch = math.MaxUint64 - 1
+	data, err = proto.Marshal(state)
+	require.NoError(t, err)
+	a.participant, err = channel.UnmarshalAsyncTripleRatchetParticipant(data, nil)
+	require.NoError(t, err)
+
+	updates, err := a.participant.Ratchet()
+	require.NoError(t, err)
+	_, err = b.participant.ReceiveUpdate(
+		a.info.PublicKey.ToAffineCompressed(),
+		updates[b.key()],
+	)
+	require.Error(t, err)
+	require.Equal(t, uint64(1), b.participant.Epoch())
+
+	updates, err = b.participant.Ratchet()
+	require.NoError(t, err)
+	require.Contains(t, updates, a.key())
+	require.Equal(t, uint64(2), b.participant.Epoch())
+}
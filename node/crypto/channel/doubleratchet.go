@@ -8,6 +8,7 @@ import (
 	"crypto/sha512"
 	"crypto/subtle"
 	"encoding/binary"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/hkdf"
@@ -42,7 +43,7 @@ type DoubleRatchetParticipant struct {
 	previousSendingChainLength   uint32
 	currentReceivingChainLength  uint32
 	previousReceivingChainLength uint32
-	skippedKeysMap               map[string]map[uint32][]byte
+	skippedKeysMap               map[string]map[uint32]*skippedKey
 }
 
 func NewDoubleRatchetParticipant(
@@ -57,7 +58,7 @@ func NewDoubleRatchetParticipant(
 ) (*DoubleRatchetParticipant, error) {
 	participant := &DoubleRatchetParticipant{}
 	participant.sendingEphemeralPrivateKey = sendingEphemeralPrivateKey
-	participant.skippedKeysMap = make(map[string]map[uint32][]byte)
+	participant.skippedKeysMap = make(map[string]map[uint32]*skippedKey)
 	participant.keyManager = keyManager
 	participant.currentSendingChainLength = 0
 	participant.previousSendingChainLength = 0
@@ -190,11 +191,14 @@ func (r *DoubleRatchetParticipant) RatchetDecrypt(
 			envelope.MessageHeader.Ciphertext...,
 		),
 	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt message")
+	}
 
 	r.receivingChainKey = newChainKey
 	r.currentReceivingChainLength++
 
-	return plaintext, errors.Wrap(err, "could not decrypt message")
+	return plaintext, nil
 }
 
 func (r *DoubleRatchetParticipant) ratchetEphemeralKeys(
@@ -259,8 +263,13 @@ func (r *DoubleRatchetParticipant) trySkippedMessageKeys(
 				return nil, errors.Wrap(err, "malformed header")
 			}
 
-			messageKey := skippedKeys[current][:32]
-			aeadKey := skippedKeys[current][32:]
+			skipped, ok := skippedKeys[current]
+			if !ok {
+				continue
+			}
+
+			messageKey := skipped.key[:32]
+			aeadKey := skipped.key[32:]
 			plaintext, err := r.decrypt(
 				envelope.MessageBody,
 				messageKey,
@@ -287,26 +296,29 @@ func (r *DoubleRatchetParticipant) trySkippedMessageKeys(
 }
 
 func (r *DoubleRatchetParticipant) skipMessageKeys(until uint32) error {
-	if r.currentReceivingChainLength+100 < until {
+	if r.currentReceivingChainLength+MAX_MESSAGE_SKIP < until {
 		return errors.New("skip limit exceeded")
 	}
 
-	if r.receivingChainKey != nil {
+	if r.receivingChainKey != nil && r.currentReceivingChainLength < until {
+		now := time.Now()
 		for r.currentReceivingChainLength < until {
 			newChainKey, messageKey, aeadKey := ratchetKeys(r.receivingChainKey)
 			skippedKeys := r.skippedKeysMap[string(r.currentReceivingHeaderKey)]
 			if skippedKeys == nil {
-				r.skippedKeysMap[string(r.currentReceivingHeaderKey)] =
-					make(map[uint32][]byte)
+				skippedKeys = make(map[uint32]*skippedKey)
+				r.skippedKeysMap[string(r.currentReceivingHeaderKey)] = skippedKeys
 			}
 
-			skippedKeys[r.currentReceivingChainLength] = append(
-				append([]byte{}, messageKey...),
-				aeadKey...,
-			)
+			skippedKeys[r.currentReceivingChainLength] = &skippedKey{
+				key:     append(append([]byte{}, messageKey...), aeadKey...),
+				skipped: now,
+			}
 			r.receivingChainKey = newChainKey
 			r.currentReceivingChainLength++
 		}
+
+		pruneSkippedKeys(r.skippedKeysMap, now)
 	}
 
 	return nil
@@ -0,0 +1,514 @@
+package channel
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/nekryptology/pkg/core/curves"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+const IDENTITY_KEY_ID = "q-ratchet-idk"
+
+// How long a signed prekey is offered before it is replaced.
+const SIGNED_PRE_KEY_ROTATION = 7 * 24 * time.Hour
+
+// How long a replaced signed prekey, or a one-time prekey handed out, is still
+// accepted from initiators who fetched it before.
+const PRE_KEY_RETENTION = 14 * 24 * time.Hour
+
+// The number of one-time prekeys kept available, the pool is refilled on
+// refresh rather than as it is drained, so peers fetching bundles can't make
+// the node generate keys at will.
+const ONE_TIME_PRE_KEY_POOL = 100
+
+// The number of one-time prekeys handed out and neither used nor expired, past
+// which bundles are handed out without one.
+const MAX_ISSUED_ONE_TIME_PRE_KEYS = 200
+
+var ErrUnknownPreKey = errors.New("unknown prekey")
+
+func signedPreKeyId(id uint32) string {
+	return fmt.Sprintf("q-ratchet-spk-%d", id)
+}
+
+// PreKeyManager generates, rotates and consumes the node's X3DH prekeys. The
+// identity and signed prekeys are held by the KeyManager, the one-time prekeys
+// and the bookkeeping of which prekeys are offered, handed out and used by the
+// PreKeyStore.
+type PreKeyManager struct {
+	keyManager  keys.KeyManager
+	preKeyStore store.PreKeyStore
+	curve       *curves.Curve
+	mx          sync.Mutex
+}
+
+func NewPreKeyManager(
+	keyManager keys.KeyManager,
+	preKeyStore store.PreKeyStore,
+) *PreKeyManager {
+	return &PreKeyManager{
+		keyManager:  keyManager,
+		preKeyStore: preKeyStore,
+		curve:       curves.ED448(),
+	}
+}
+
+// Refresh creates the identity key if missing, rotates the signed prekey when
+// due, discards prekeys past their retention and refills the one-time prekey
+// pool.
+func (m *PreKeyManager) Refresh() error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if _, err := m.agreementKey(IDENTITY_KEY_ID, true); err != nil {
+		return errors.Wrap(err, "refresh")
+	}
+
+	state, err := m.state()
+	if err != nil {
+		return errors.Wrap(err, "refresh")
+	}
+
+	now := time.Now()
+	if len(state.SignedPreKeys) == 0 || now.Sub(time.UnixMilli(
+		state.SignedPreKeys[len(state.SignedPreKeys)-1].Timestamp,
+	)) > SIGNED_PRE_KEY_ROTATION {
+		id := m.nextKeyId(state)
+		if _, err := m.keyManager.CreateAgreementKey(
+			signedPreKeyId(id),
+			keys.KeyTypeX448,
+		); err != nil {
+			return errors.Wrap(err, "refresh")
+		}
+
+		state.SignedPreKeys = append(state.SignedPreKeys, &protobufs.PreKeyRecord{
+			Id:        id,
+			Timestamp: now.UnixMilli(),
+		})
+	}
+
+	// A replaced signed prekey is retained from the time its successor was
+	// created.
+	for len(state.SignedPreKeys) > 1 && now.Sub(time.UnixMilli(
+		state.SignedPreKeys[1].Timestamp,
+	)) > PRE_KEY_RETENTION {
+		if err := m.deleteKey(signedPreKeyId(state.SignedPreKeys[0].Id)); err != nil {
+			return errors.Wrap(err, "refresh")
+		}
+		state.SignedPreKeys = state.SignedPreKeys[1:]
+	}
+
+	issued := []*protobufs.PreKeyRecord{}
+	expired := []uint32{}
+	for _, k := range state.IssuedOneTimePreKeys {
+		if now.Sub(time.UnixMilli(k.Timestamp)) > PRE_KEY_RETENTION {
+			expired = append(expired, k.Id)
+			continue
+		}
+
+		issued = append(issued, k)
+	}
+	state.IssuedOneTimePreKeys = issued
+
+	if err := m.refill(state); err != nil {
+		return errors.Wrap(err, "refresh")
+	}
+
+	if err := m.preKeyStore.PutPreKeyState(state); err != nil {
+		return errors.Wrap(err, "refresh")
+	}
+
+	return errors.Wrap(m.preKeyStore.DeleteOneTimePreKeys(expired), "refresh")
+}
+
+// Bundle returns the node's current, unsigned prekey bundle. If
+// issueOneTimePreKey is set, the pool is not empty and fewer than
+// MAX_ISSUED_ONE_TIME_PRE_KEYS are outstanding, the bundle carries a one-time
+// prekey, which is not handed out again.
+func (m *PreKeyManager) Bundle(
+	issueOneTimePreKey bool,
+) (*protobufs.PreKeyBundle, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	state, err := m.state()
+	if err != nil {
+		return nil, errors.Wrap(err, "bundle")
+	}
+
+	if len(state.SignedPreKeys) == 0 {
+		return nil, errors.Wrap(errors.New("prekeys not initialized"), "bundle")
+	}
+
+	identityKey, err := m.agreementKey(IDENTITY_KEY_ID, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "bundle")
+	}
+
+	current := state.SignedPreKeys[len(state.SignedPreKeys)-1]
+	signedPreKey, err := m.agreementKey(signedPreKeyId(current.Id), false)
+	if err != nil {
+		return nil, errors.Wrap(err, "bundle")
+	}
+
+	now := time.Now()
+	bundle := &protobufs.PreKeyBundle{
+		IdentityKey:    m.publicKey(identityKey),
+		SignedPreKeyId: current.Id,
+		SignedPreKey:   m.publicKey(signedPreKey),
+		Timestamp:      now.UnixMilli(),
+	}
+
+	if !issueOneTimePreKey || len(state.OneTimePreKeys) == 0 ||
+		len(state.IssuedOneTimePreKeys) >= MAX_ISSUED_ONE_TIME_PRE_KEYS {
+		return bundle, nil
+	}
+
+	id := state.OneTimePreKeys[0]
+	oneTimePreKey, err := m.oneTimePreKey(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "bundle")
+	}
+
+	state.OneTimePreKeys = state.OneTimePreKeys[1:]
+	state.IssuedOneTimePreKeys = append(
+		state.IssuedOneTimePreKeys,
+		&protobufs.PreKeyRecord{Id: id, Timestamp: now.UnixMilli()},
+	)
+
+	if err := m.preKeyStore.PutPreKeyState(state); err != nil {
+		return nil, errors.Wrap(err, "bundle")
+	}
+
+	bundle.OneTimePreKey = &protobufs.OneTimePreKey{
+		Id:  id,
+		Key: m.publicKey(oneTimePreKey),
+	}
+
+	return bundle, nil
+}
+
+// InitiateSession establishes a double ratchet session with the owner of the
+// bundle without it having to be online, and encrypts the first message. The
+// returned initial message must be delivered to the owner, who completes the
+// session with AcceptSession. The bundle must have been verified to belong to
+// the intended peer.
+func (m *PreKeyManager) InitiateSession(
+	bundle *protobufs.PreKeyBundle,
+	message []byte,
+) (*DoubleRatchetParticipant, *protobufs.X3DHInitialMessage, error) {
+	identityKey, err := m.agreementKey(IDENTITY_KEY_ID, false)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "initiate session")
+	}
+
+	receivingIdentityKey, err := m.curve.Point.FromAffineCompressed(
+		bundle.IdentityKey,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "initiate session")
+	}
+
+	receivingSignedPreKey, err := m.curve.Point.FromAffineCompressed(
+		bundle.SignedPreKey,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "initiate session")
+	}
+
+	var receivingOneTimePreKey curves.Point
+	oneTimePreKeyId := uint32(0)
+	if bundle.OneTimePreKey != nil {
+		receivingOneTimePreKey, err = m.curve.Point.FromAffineCompressed(
+			bundle.OneTimePreKey.Key,
+		)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "initiate session")
+		}
+		oneTimePreKeyId = bundle.OneTimePreKey.Id
+	}
+
+	ephemeralKey := m.curve.Scalar.Random(rand.Reader)
+	sessionKey := SenderX3DHWithOneTimePreKey(
+		identityKey,
+		ephemeralKey,
+		receivingIdentityKey,
+		receivingSignedPreKey,
+		receivingOneTimePreKey,
+		96,
+	)
+
+	participant, err := NewDoubleRatchetParticipant(
+		sessionKey[:32],
+		sessionKey[32:64],
+		sessionKey[64:],
+		true,
+		ephemeralKey,
+		receivingSignedPreKey,
+		m.curve,
+		m.keyManager,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "initiate session")
+	}
+
+	envelope, err := participant.RatchetEncrypt(message)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "initiate session")
+	}
+
+	return participant, &protobufs.X3DHInitialMessage{
+		IdentityKey:     m.publicKey(identityKey),
+		EphemeralKey:    m.publicKey(ephemeralKey),
+		SignedPreKeyId:  bundle.SignedPreKeyId,
+		OneTimePreKeyId: oneTimePreKeyId,
+		Envelope:        envelope,
+	}, nil
+}
+
+// AcceptSession completes a session initiated from the node's prekey bundle,
+// returning the session and the decrypted first message. A one-time prekey is
+// consumed by the first initial message using it, so the initial message can't
+// be replayed to establish the session again. The initiator's identity key
+// must be checked by the caller against the peer it claims to be.
+func (m *PreKeyManager) AcceptSession(
+	initial *protobufs.X3DHInitialMessage,
+) (*DoubleRatchetParticipant, []byte, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	state, err := m.state()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "accept session")
+	}
+
+	found := false
+	for _, k := range state.SignedPreKeys {
+		if k.Id == initial.SignedPreKeyId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, nil, errors.Wrap(ErrUnknownPreKey, "accept session")
+	}
+
+	identityKey, err := m.agreementKey(IDENTITY_KEY_ID, false)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "accept session")
+	}
+
+	signedPreKey, err := m.agreementKey(
+		signedPreKeyId(initial.SignedPreKeyId),
+		false,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "accept session")
+	}
+
+	var oneTimePreKey curves.Scalar
+	issued := -1
+	if initial.OneTimePreKeyId != 0 {
+		for i, k := range state.IssuedOneTimePreKeys {
+			if k.Id == initial.OneTimePreKeyId {
+				issued = i
+				break
+			}
+		}
+		if issued == -1 {
+			return nil, nil, errors.Wrap(ErrUnknownPreKey, "accept session")
+		}
+
+		oneTimePreKey, err = m.oneTimePreKey(initial.OneTimePreKeyId)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "accept session")
+		}
+	}
+
+	sendingIdentityKey, err := m.curve.Point.FromAffineCompressed(
+		initial.IdentityKey,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "accept session")
+	}
+
+	sendingEphemeralKey, err := m.curve.Point.FromAffineCompressed(
+		initial.EphemeralKey,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "accept session")
+	}
+
+	sessionKey := ReceiverX3DHWithOneTimePreKey(
+		identityKey,
+		signedPreKey,
+		oneTimePreKey,
+		sendingIdentityKey,
+		sendingEphemeralKey,
+		96,
+	)
+
+	participant, err := NewDoubleRatchetParticipant(
+		sessionKey[:32],
+		sessionKey[32:64],
+		sessionKey[64:],
+		false,
+		signedPreKey,
+		sendingEphemeralKey,
+		m.curve,
+		m.keyManager,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "accept session")
+	}
+
+	message, err := participant.RatchetDecrypt(initial.Envelope)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "accept session")
+	}
+
+	if issued != -1 {
+		state.IssuedOneTimePreKeys = append(
+			state.IssuedOneTimePreKeys[:issued],
+			state.IssuedOneTimePreKeys[issued+1:]...,
+		)
+		if err := m.preKeyStore.PutPreKeyState(state); err != nil {
+			return nil, nil, errors.Wrap(err, "accept session")
+		}
+
+		if err := m.preKeyStore.DeleteOneTimePreKeys(
+			[]uint32{initial.OneTimePreKeyId},
+		); err != nil {
+			return nil, nil, errors.Wrap(err, "accept session")
+		}
+	}
+
+	return participant, message, nil
+}
+
+// IdentityKey returns the node's identity key.
+func (m *PreKeyManager) IdentityKey() (curves.Scalar, error) {
+	key, err := m.agreementKey(IDENTITY_KEY_ID, false)
+	return key, errors.Wrap(err, "identity key")
+}
+
+// SignedPreKey returns the signed prekey with the id, if still accepted.
+func (m *PreKeyManager) SignedPreKey(id uint32) (curves.Scalar, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	state, err := m.state()
+	if err != nil {
+		return nil, errors.Wrap(err, "signed pre key")
+	}
+
+	for _, k := range state.SignedPreKeys {
+		if k.Id == id {
+			key, err := m.agreementKey(signedPreKeyId(id), false)
+			return key, errors.Wrap(err, "signed pre key")
+		}
+	}
+
+	return nil, errors.Wrap(ErrUnknownPreKey, "signed pre key")
+}
+
+// CurrentSignedPreKey returns the id and key of the signed prekey currently
+// offered.
+func (m *PreKeyManager) CurrentSignedPreKey() (uint32, curves.Scalar, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	state, err := m.state()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "current signed pre key")
+	}
+
+	if len(state.SignedPreKeys) == 0 {
+		return 0, nil, errors.Wrap(
+			errors.New("prekeys not initialized"),
+			"current signed pre key",
+		)
+	}
+
+	id := state.SignedPreKeys[len(state.SignedPreKeys)-1].Id
+	key, err := m.agreementKey(signedPreKeyId(id), false)
+	return id, key, errors.Wrap(err, "current signed pre key")
+}
+
+func (m *PreKeyManager) state() (*protobufs.PreKeyState, error) {
+	state, err := m.preKeyStore.GetPreKeyState()
+	if errors.Is(err, store.ErrNotFound) {
+		return &protobufs.PreKeyState{NextKeyId: 1}, nil
+	}
+
+	return state, err
+}
+
+func (m *PreKeyManager) nextKeyId(state *protobufs.PreKeyState) uint32 {
+	id := state.NextKeyId
+	state.NextKeyId++
+	return id
+}
+
+// refill tops the one-time prekey pool up, storing the new keys in one write.
+func (m *PreKeyManager) refill(state *protobufs.PreKeyState) error {
+	privateKeys := map[uint32][]byte{}
+	ids := []uint32{}
+	for len(state.OneTimePreKeys)+len(ids) < ONE_TIME_PRE_KEY_POOL {
+		id := m.nextKeyId(state)
+		privateKeys[id] = m.curve.Scalar.Random(rand.Reader).Bytes()
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := m.preKeyStore.PutOneTimePreKeys(privateKeys); err != nil {
+		return errors.Wrap(err, "refill")
+	}
+
+	state.OneTimePreKeys = append(state.OneTimePreKeys, ids...)
+	return nil
+}
+
+func (m *PreKeyManager) oneTimePreKey(id uint32) (curves.Scalar, error) {
+	privateKey, err := m.preKeyStore.GetOneTimePreKey(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "one time pre key")
+	}
+
+	key, err := m.curve.NewScalar().SetBytes(privateKey)
+	return key, errors.Wrap(err, "one time pre key")
+}
+
+func (m *PreKeyManager) agreementKey(
+	id string,
+	create bool,
+) (curves.Scalar, error) {
+	key, err := m.keyManager.GetAgreementKey(id)
+	if err != nil && create && errors.Is(err, keys.KeyNotFoundErr) {
+		key, err = m.keyManager.CreateAgreementKey(id, keys.KeyTypeX448)
+	}
+
+	return key, errors.Wrap(err, id)
+}
+
+func (m *PreKeyManager) deleteKey(id string) error {
+	err := m.keyManager.DeleteKey(id)
+	if errors.Is(err, keys.KeyNotFoundErr) {
+		return nil
+	}
+
+	return err
+}
+
+func (m *PreKeyManager) publicKey(privateKey curves.Scalar) []byte {
+	return m.curve.NewGeneratorPoint().Mul(privateKey).ToAffineCompressed()
+}
@@ -0,0 +1,186 @@
+package channel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"source.quilibrium.com/quilibrium/monorepo/node/crypto/channel"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func newPreKeyManager(t *testing.T) (
+	*channel.PreKeyManager,
+	keys.KeyManager,
+	store.PreKeyStore,
+) {
+	keyManager := keys.NewInMemoryKeyManager()
+	preKeyStore := store.NewPebblePreKeyStore(
+		store.NewInMemKVDB(),
+		keyManager,
+		zap.NewNop(),
+	)
+	manager := channel.NewPreKeyManager(keyManager, preKeyStore)
+	require.NoError(t, manager.Refresh())
+	return manager, keyManager, preKeyStore
+}
+
+func TestPreKeySessionSetup(t *testing.T) {
+	alice, _, _ := newPreKeyManager(t)
+	bob, _, bobStore := newPreKeyManager(t)
+
+	for _, issueOneTimePreKey := range []bool{true, false} {
+		bundle, err := bob.Bundle(issueOneTimePreKey)
+		require.NoError(t, err)
+		require.Equal(t, issueOneTimePreKey, bundle.OneTimePreKey != nil)
+
+		// Bob is offline while Alice sets up the session and sends to him.
+		aliceSession, initial, err := alice.InitiateSession(
+			bundle,
+			[]byte("hello there"),
+		)
+		require.NoError(t, err)
+		envelope, err := aliceSession.RatchetEncrypt([]byte("general kenobi"))
+		require.NoError(t, err)
+
+		bobSession, plaintext, err := bob.AcceptSession(initial)
+		require.NoError(t, err)
+		require.Equal(t, []byte("hello there"), plaintext)
+		plaintext, err = bobSession.RatchetDecrypt(envelope)
+		require.NoError(t, err)
+		require.Equal(t, []byte("general kenobi"), plaintext)
+
+		envelope, err = bobSession.RatchetEncrypt([]byte("you are a bold one"))
+		require.NoError(t, err)
+		plaintext, err = aliceSession.RatchetDecrypt(envelope)
+		require.NoError(t, err)
+		require.Equal(t, []byte("you are a bold one"), plaintext)
+
+		if !issueOneTimePreKey {
+			continue
+		}
+
+		// The one-time prekey was consumed, the initial message can't be
+		// replayed.
+		_, _, err = bob.AcceptSession(initial)
+		require.ErrorIs(t, err, channel.ErrUnknownPreKey)
+		_, err = bobStore.GetOneTimePreKey(bundle.OneTimePreKey.Id)
+		require.ErrorIs(t, err, store.ErrNotFound)
+
+		state, err := bobStore.GetPreKeyState()
+		require.NoError(t, err)
+		require.Empty(t, state.IssuedOneTimePreKeys)
+		require.Len(t, state.OneTimePreKeys, channel.ONE_TIME_PRE_KEY_POOL-1)
+	}
+}
+
+func TestPreKeyRotation(t *testing.T) {
+	alice, _, _ := newPreKeyManager(t)
+	bob, _, bobStore := newPreKeyManager(t)
+
+	oldBundle, err := bob.Bundle(false)
+	require.NoError(t, err)
+
+	// Age the signed prekey past its rotation.
+	state, err := bobStore.GetPreKeyState()
+	require.NoError(t, err)
+	state.SignedPreKeys[0].Timestamp -= (channel.SIGNED_PRE_KEY_ROTATION +
+		channel.PRE_KEY_RETENTION/2).Milliseconds()
+	require.NoError(t, bobStore.PutPreKeyState(state))
+	require.NoError(t, bob.Refresh())
+
+	newBundle, err := bob.Bundle(false)
+	require.NoError(t, err)
+	require.NotEqual(t, oldBundle.SignedPreKeyId, newBundle.SignedPreKeyId)
+	require.NotEqual(t, oldBundle.SignedPreKey, newBundle.SignedPreKey)
+	require.Equal(t, oldBundle.IdentityKey, newBundle.IdentityKey)
+
+	// Sessions set up from the previous bundle are still accepted.
+	_, initial, err := alice.InitiateSession(oldBundle, []byte("late"))
+	require.NoError(t, err)
+	_, plaintext, err := bob.AcceptSession(initial)
+	require.NoError(t, err)
+	require.Equal(t, []byte("late"), plaintext)
+
+	// Until the retention of the replaced key runs out.
+	state, err = bobStore.GetPreKeyState()
+	require.NoError(t, err)
+	state.SignedPreKeys[1].Timestamp -= (channel.PRE_KEY_RETENTION +
+		channel.SIGNED_PRE_KEY_ROTATION/2).Milliseconds()
+	require.NoError(t, bobStore.PutPreKeyState(state))
+	require.NoError(t, bob.Refresh())
+
+	_, initial, err = alice.InitiateSession(oldBundle, []byte("too late"))
+	require.NoError(t, err)
+	_, _, err = bob.AcceptSession(initial)
+	require.ErrorIs(t, err, channel.ErrUnknownPreKey)
+
+	_, initial, err = alice.InitiateSession(newBundle, []byte("on time"))
+	require.NoError(t, err)
+	_, _, err = bob.AcceptSession(initial)
+	require.NoError(t, err)
+}
+
+func TestPreKeyOneTimePreKeyLimits(t *testing.T) {
+	bob, bobKeys, bobStore := newPreKeyManager(t)
+
+	// One-time prekeys are kept out of the key manager.
+	stored, err := bobKeys.ListKeys()
+	require.NoError(t, err)
+	state, err := bobStore.GetPreKeyState()
+	require.NoError(t, err)
+	require.Len(t, state.OneTimePreKeys, channel.ONE_TIME_PRE_KEY_POOL)
+	require.Len(t, stored, 3)
+
+	// Draining the pool doesn't refill it, bundles go without a one-time
+	// prekey until the next refresh.
+	for i := 0; i < channel.ONE_TIME_PRE_KEY_POOL; i++ {
+		bundle, err := bob.Bundle(true)
+		require.NoError(t, err)
+		require.NotNil(t, bundle.OneTimePreKey)
+	}
+	bundle, err := bob.Bundle(true)
+	require.NoError(t, err)
+	require.Nil(t, bundle.OneTimePreKey)
+
+	// Refreshing refills the pool, until the prekeys handed out reach the cap.
+	for i := channel.ONE_TIME_PRE_KEY_POOL; i <
+		channel.MAX_ISSUED_ONE_TIME_PRE_KEYS; i++ {
+		if i%channel.ONE_TIME_PRE_KEY_POOL == 0 {
+			require.NoError(t, bob.Refresh())
+		}
+		bundle, err := bob.Bundle(true)
+		require.NoError(t, err)
+		require.NotNil(t, bundle.OneTimePreKey)
+	}
+	require.NoError(t, bob.Refresh())
+	bundle, err = bob.Bundle(true)
+	require.NoError(t, err)
+	require.Nil(t, bundle.OneTimePreKey)
+
+	state, err = bobStore.GetPreKeyState()
+	require.NoError(t, err)
+	require.Len(t, state.OneTimePreKeys, channel.ONE_TIME_PRE_KEY_POOL)
+	require.Len(
+		t,
+		state.IssuedOneTimePreKeys,
+		channel.MAX_ISSUED_ONE_TIME_PRE_KEYS,
+	)
+
+	// Prekeys handed out are discarded past their retention, making room
+	// again.
+	expired := state.IssuedOneTimePreKeys[0].Id
+	for _, k := range state.IssuedOneTimePreKeys {
+		k.Timestamp -= (channel.PRE_KEY_RETENTION * 2).Milliseconds()
+	}
+	require.NoError(t, bobStore.PutPreKeyState(state))
+	require.NoError(t, bob.Refresh())
+	_, err = bobStore.GetOneTimePreKey(expired)
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	bundle, err = bob.Bundle(true)
+	require.NoError(t, err)
+	require.NotNil(t, bundle.OneTimePreKey)
+}
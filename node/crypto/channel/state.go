@@ -0,0 +1,869 @@
+package channel
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/nekryptology/pkg/core/curves"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+const DOUBLE_RATCHET_STATE_VERSION = 1
+const TRIPLE_RATCHET_STATE_VERSION = 1
+const ASYNC_TRIPLE_RATCHET_STATE_VERSION = 1
+
+// The most messages a received message may skip ahead of in its chain.
+const MAX_MESSAGE_SKIP = 100
+
+// The most skipped message keys retained per sender, the oldest are discarded
+// first once exceeded.
+const MAX_SKIPPED_KEYS = 1000
+
+// How long a skipped message key is retained for a message that never
+// arrives.
+const SKIPPED_KEY_EXPIRY = 72 * time.Hour
+
+var ErrUnsupportedStateVersion = errors.New("unsupported state version")
+
+type skippedKey struct {
+	key     []byte
+	skipped time.Time
+}
+
+// pruneSkippedKeys discards the expired keys of a header key indexed set of
+// skipped keys, then the oldest keys in excess of MAX_SKIPPED_KEYS.
+func pruneSkippedKeys(
+	skippedKeysMap map[string]map[uint32]*skippedKey,
+	now time.Time,
+) {
+	type skippedKeyRef struct {
+		headerKey     string
+		messageNumber uint32
+		skipped       time.Time
+	}
+
+	refs := []skippedKeyRef{}
+	for headerKey, skippedKeys := range skippedKeysMap {
+		for messageNumber, k := range skippedKeys {
+			if now.Sub(k.skipped) > SKIPPED_KEY_EXPIRY {
+				delete(skippedKeys, messageNumber)
+				continue
+			}
+
+			refs = append(refs, skippedKeyRef{headerKey, messageNumber, k.skipped})
+		}
+
+		if len(skippedKeys) == 0 {
+			delete(skippedKeysMap, headerKey)
+		}
+	}
+
+	if len(refs) <= MAX_SKIPPED_KEYS {
+		return
+	}
+
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].skipped.Equal(refs[j].skipped) {
+			return refs[i].messageNumber < refs[j].messageNumber
+		}
+
+		return refs[i].skipped.Before(refs[j].skipped)
+	})
+
+	for _, ref := range refs[:len(refs)-MAX_SKIPPED_KEYS] {
+		delete(skippedKeysMap[ref.headerKey], ref.messageNumber)
+		if len(skippedKeysMap[ref.headerKey]) == 0 {
+			delete(skippedKeysMap, ref.headerKey)
+		}
+	}
+}
+
+// MarshalState serializes the participant, including its private keys, so the
+// session can be resumed with UnmarshalDoubleRatchetParticipant. The result
+// must only be persisted encrypted.
+func (r *DoubleRatchetParticipant) MarshalState() ([]byte, error) {
+	pruneSkippedKeys(r.skippedKeysMap, time.Now())
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(r.state())
+	return data, errors.Wrap(err, "marshal state")
+}
+
+// UnmarshalDoubleRatchetParticipant resumes a participant serialized with
+// MarshalState. Skipped message keys that expired in the meantime are
+// discarded.
+func UnmarshalDoubleRatchetParticipant(
+	data []byte,
+	keyManager keys.KeyManager,
+) (*DoubleRatchetParticipant, error) {
+	state := &protobufs.DoubleRatchetParticipantState{}
+	if err := proto.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrap(err, "unmarshal double ratchet participant")
+	}
+
+	participant, err := doubleRatchetParticipantFromState(state, keyManager)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal double ratchet participant")
+	}
+
+	return participant, nil
+}
+
+func (r *DoubleRatchetParticipant) state() *protobufs.DoubleRatchetParticipantState {
+	state := &protobufs.DoubleRatchetParticipantState{
+		Version:                      DOUBLE_RATCHET_STATE_VERSION,
+		Curve:                        r.curve.Name,
+		SendingEphemeralPrivateKey:   scalarBytes(r.sendingEphemeralPrivateKey),
+		ReceivingEphemeralKey:        pointBytes(r.receivingEphemeralKey),
+		RootKey:                      r.rootKey,
+		SendingChainKey:              r.sendingChainKey,
+		CurrentSendingHeaderKey:      r.currentSendingHeaderKey,
+		CurrentReceivingHeaderKey:    r.currentReceivingHeaderKey,
+		NextSendingHeaderKey:         r.nextSendingHeaderKey,
+		NextReceivingHeaderKey:       r.nextReceivingHeaderKey,
+		ReceivingChainKey:            r.receivingChainKey,
+		CurrentSendingChainLength:    r.currentSendingChainLength,
+		PreviousSendingChainLength:   r.previousSendingChainLength,
+		CurrentReceivingChainLength:  r.currentReceivingChainLength,
+		PreviousReceivingChainLength: r.previousReceivingChainLength,
+	}
+	state.SkippedKeys = skippedKeysState(nil, r.skippedKeysMap)
+
+	return state
+}
+
+func doubleRatchetParticipantFromState(
+	state *protobufs.DoubleRatchetParticipantState,
+	keyManager keys.KeyManager,
+) (*DoubleRatchetParticipant, error) {
+	if state.Version != DOUBLE_RATCHET_STATE_VERSION {
+		return nil, errors.Wrapf(
+			ErrUnsupportedStateVersion,
+			"double ratchet state version %d",
+			state.Version,
+		)
+	}
+
+	curve := curves.GetCurveByName(state.Curve)
+	if curve == nil {
+		return nil, errors.Errorf("unknown curve %s", state.Curve)
+	}
+
+	sendingEphemeralPrivateKey, err := decodeScalar(
+		curve,
+		state.SendingEphemeralPrivateKey,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending ephemeral private key")
+	}
+
+	receivingEphemeralKey, err := decodePoint(curve, state.ReceivingEphemeralKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "receiving ephemeral key")
+	}
+
+	participant := &DoubleRatchetParticipant{
+		sendingEphemeralPrivateKey:   sendingEphemeralPrivateKey,
+		receivingEphemeralKey:        receivingEphemeralKey,
+		curve:                        curve,
+		keyManager:                   keyManager,
+		rootKey:                      state.RootKey,
+		sendingChainKey:              state.SendingChainKey,
+		currentSendingHeaderKey:      state.CurrentSendingHeaderKey,
+		currentReceivingHeaderKey:    state.CurrentReceivingHeaderKey,
+		nextSendingHeaderKey:         state.NextSendingHeaderKey,
+		nextReceivingHeaderKey:       state.NextReceivingHeaderKey,
+		receivingChainKey:            state.ReceivingChainKey,
+		currentSendingChainLength:    state.CurrentSendingChainLength,
+		previousSendingChainLength:   state.PreviousSendingChainLength,
+		currentReceivingChainLength:  state.CurrentReceivingChainLength,
+		previousReceivingChainLength: state.PreviousReceivingChainLength,
+		skippedKeysMap:               make(map[string]map[uint32]*skippedKey),
+	}
+
+	for _, k := range state.SkippedKeys {
+		if err := addSkippedKey(participant.skippedKeysMap, k); err != nil {
+			return nil, err
+		}
+	}
+	pruneSkippedKeys(participant.skippedKeysMap, time.Now())
+
+	return participant, nil
+}
+
+// MarshalState serializes the participant, including its private keys, the
+// pairwise channels with the other members and the DKG state, so the session
+// can be resumed with UnmarshalTripleRatchetParticipant. The result must only
+// be persisted encrypted.
+func (r *TripleRatchetParticipant) MarshalState() ([]byte, error) {
+	now := time.Now()
+	for _, senderSkippedKeys := range r.skippedKeysMap {
+		pruneSkippedKeys(senderSkippedKeys, now)
+	}
+	for _, c := range r.peerChannels {
+		pruneSkippedKeys(c.skippedKeysMap, now)
+	}
+
+	state := &protobufs.TripleRatchetParticipantState{
+		Version:                    TRIPLE_RATCHET_STATE_VERSION,
+		Curve:                      r.curve.Name,
+		PeerKey:                    scalarBytes(r.peerKey),
+		SendingEphemeralPrivateKey: scalarBytes(r.sendingEphemeralPrivateKey),
+		ReceivingGroupKey:          pointBytes(r.receivingGroupKey),
+		RootKey:                    r.rootKey,
+		SendingChainKey:            r.sendingChainKey,
+		CurrentHeaderKey:           r.currentHeaderKey,
+		NextHeaderKey:              r.nextHeaderKey,
+		CurrentSendingChainLength:  r.currentSendingChainLength,
+		PreviousSendingChainLength: r.previousSendingChainLength,
+	}
+
+	ids := make([]int, 0, len(r.idPeerMap))
+	for id := range r.idPeerMap {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		peer := r.idPeerMap[id]
+		key := string(peer.PublicKey.ToAffineCompressed())
+		peerState := &protobufs.TripleRatchetPeerState{
+			Id:                           uint32(id),
+			PublicKey:                    pointBytes(peer.PublicKey),
+			IdentityPublicKey:            pointBytes(peer.IdentityPublicKey),
+			SignedPrePublicKey:           pointBytes(peer.SignedPrePublicKey),
+			ReceivingEphemeralKey:        scalarBytes(r.receivingEphemeralKeys[key]),
+			ReceivingChainKey:            r.receivingChainKey[key],
+			CurrentReceivingChainLength:  r.currentReceivingChainLength[key],
+			PreviousReceivingChainLength: r.previousReceivingChainLength[key],
+		}
+		if c, ok := r.peerChannels[key]; ok {
+			peerState.Channel = c.state()
+		}
+
+		state.Peers = append(state.Peers, peerState)
+		state.SkippedKeys = append(
+			state.SkippedKeys,
+			skippedKeysState([]byte(key), r.skippedKeysMap[key])...,
+		)
+	}
+
+	if r.dkgRatchet != nil {
+		state.DkgRatchet = r.dkgRatchet.state()
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(state)
+	return data, errors.Wrap(err, "marshal state")
+}
+
+// UnmarshalTripleRatchetParticipant resumes a participant serialized with
+// MarshalState. Skipped message keys that expired in the meantime are
+// discarded.
+func UnmarshalTripleRatchetParticipant(
+	data []byte,
+	keyManager keys.KeyManager,
+) (*TripleRatchetParticipant, error) {
+	state := &protobufs.TripleRatchetParticipantState{}
+	if err := proto.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrap(err, "unmarshal triple ratchet participant")
+	}
+
+	participant, err := tripleRatchetParticipantFromState(state, keyManager)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal triple ratchet participant")
+	}
+
+	return participant, nil
+}
+
+func tripleRatchetParticipantFromState(
+	state *protobufs.TripleRatchetParticipantState,
+	keyManager keys.KeyManager,
+) (*TripleRatchetParticipant, error) {
+	if state.Version != TRIPLE_RATCHET_STATE_VERSION {
+		return nil, errors.Wrapf(
+			ErrUnsupportedStateVersion,
+			"triple ratchet state version %d",
+			state.Version,
+		)
+	}
+
+	curve := curves.GetCurveByName(state.Curve)
+	if curve == nil {
+		return nil, errors.Errorf("unknown curve %s", state.Curve)
+	}
+
+	peerKey, err := decodeScalar(curve, state.PeerKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "peer key")
+	}
+
+	sendingEphemeralPrivateKey, err := decodeScalar(
+		curve,
+		state.SendingEphemeralPrivateKey,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending ephemeral private key")
+	}
+
+	receivingGroupKey, err := decodePoint(curve, state.ReceivingGroupKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "receiving group key")
+	}
+
+	participant := &TripleRatchetParticipant{
+		peerKey:                      peerKey,
+		sendingEphemeralPrivateKey:   sendingEphemeralPrivateKey,
+		receivingEphemeralKeys:       make(map[string]curves.Scalar),
+		receivingGroupKey:            receivingGroupKey,
+		curve:                        *curve,
+		keyManager:                   keyManager,
+		rootKey:                      state.RootKey,
+		sendingChainKey:              state.SendingChainKey,
+		currentHeaderKey:             state.CurrentHeaderKey,
+		nextHeaderKey:                state.NextHeaderKey,
+		receivingChainKey:            make(map[string][]byte),
+		currentSendingChainLength:    state.CurrentSendingChainLength,
+		previousSendingChainLength:   state.PreviousSendingChainLength,
+		currentReceivingChainLength:  make(map[string]uint32),
+		previousReceivingChainLength: make(map[string]uint32),
+		peerIdMap:                    make(map[string]int),
+		idPeerMap:                    make(map[int]*PeerInfo),
+		skippedKeysMap: make(
+			map[string]map[string]map[uint32]*skippedKey,
+		),
+		peerChannels: make(map[string]*DoubleRatchetParticipant),
+	}
+
+	for _, peerState := range state.Peers {
+		peer := &PeerInfo{}
+		if peer.PublicKey, err = decodePoint(curve, peerState.PublicKey); err != nil {
+			return nil, errors.Wrap(err, "peer public key")
+		}
+		if peer.PublicKey == nil {
+			return nil, errors.New("missing peer public key")
+		}
+		if peer.IdentityPublicKey, err = decodePoint(
+			curve,
+			peerState.IdentityPublicKey,
+		); err != nil {
+			return nil, errors.Wrap(err, "peer identity public key")
+		}
+		if peer.SignedPrePublicKey, err = decodePoint(
+			curve,
+			peerState.SignedPrePublicKey,
+		); err != nil {
+			return nil, errors.Wrap(err, "peer signed pre public key")
+		}
+
+		key := string(peerState.PublicKey)
+		participant.peerIdMap[key] = int(peerState.Id)
+		participant.idPeerMap[int(peerState.Id)] = peer
+
+		receivingEphemeralKey, err := decodeScalar(
+			curve,
+			peerState.ReceivingEphemeralKey,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "peer receiving ephemeral key")
+		}
+		if receivingEphemeralKey != nil {
+			participant.receivingEphemeralKeys[key] = receivingEphemeralKey
+		}
+		if peerState.ReceivingChainKey != nil {
+			participant.receivingChainKey[key] = peerState.ReceivingChainKey
+		}
+
+		if peerState.Channel == nil {
+			continue
+		}
+
+		participant.peerChannels[key], err = doubleRatchetParticipantFromState(
+			peerState.Channel,
+			keyManager,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "peer channel")
+		}
+		participant.currentReceivingChainLength[key] =
+			peerState.CurrentReceivingChainLength
+		participant.previousReceivingChainLength[key] =
+			peerState.PreviousReceivingChainLength
+		participant.skippedKeysMap[key] = make(map[string]map[uint32]*skippedKey)
+	}
+
+	for _, k := range state.SkippedKeys {
+		senderSkippedKeys, ok := participant.skippedKeysMap[string(k.Sender)]
+		if !ok {
+			return nil, errors.New("skipped key from unknown sender")
+		}
+
+		if err := addSkippedKey(senderSkippedKeys, k); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	for _, senderSkippedKeys := range participant.skippedKeysMap {
+		pruneSkippedKeys(senderSkippedKeys, now)
+	}
+
+	if state.DkgRatchet != nil {
+		participant.dkgRatchet, err = feldmanFromState(state.DkgRatchet)
+		if err != nil {
+			return nil, errors.Wrap(err, "dkg ratchet")
+		}
+	}
+
+	return participant, nil
+}
+
+func (f *Feldman) state() *protobufs.FeldmanState {
+	state := &protobufs.FeldmanState{
+		Curve:                       f.curve.Name,
+		Threshold:                   uint32(f.threshold),
+		Total:                       uint32(f.total),
+		Id:                          uint32(f.id),
+		FragsForCounterparties:      make(map[uint32][]byte),
+		FragsFromCounterparties:     make(map[uint32][]byte),
+		Zkpok:                       scalarBytes(f.zkpok),
+		Secret:                      scalarBytes(f.secret),
+		Scalar:                      scalarBytes(f.scalar),
+		Generator:                   pointBytes(f.generator),
+		PublicKey:                   pointBytes(f.publicKey),
+		Point:                       pointBytes(f.point),
+		RandomCommitmentPoint:       pointBytes(f.randomCommitmentPoint),
+		Round:                       uint32(f.round),
+		ZkcommitsFromCounterparties: make(map[uint32][]byte),
+		PointsFromCounterparties:    make(map[uint32][]byte),
+	}
+
+	for id, frag := range f.fragsForCounterparties {
+		state.FragsForCounterparties[uint32(id)] = frag
+	}
+	for id, frag := range f.fragsFromCounterparties {
+		state.FragsFromCounterparties[uint32(id)] = scalarBytes(frag)
+	}
+	for id, zkcommit := range f.zkcommitsFromCounterparties {
+		state.ZkcommitsFromCounterparties[uint32(id)] = zkcommit
+	}
+	for id, point := range f.pointsFromCounterparties {
+		state.PointsFromCounterparties[uint32(id)] = pointBytes(point)
+	}
+
+	return state
+}
+
+func feldmanFromState(state *protobufs.FeldmanState) (*Feldman, error) {
+	curve := curves.GetCurveByName(state.Curve)
+	if curve == nil {
+		return nil, errors.Errorf("unknown curve %s", state.Curve)
+	}
+
+	f := &Feldman{
+		threshold:                   int(state.Threshold),
+		total:                       int(state.Total),
+		id:                          int(state.Id),
+		fragsForCounterparties:      make(map[int][]byte),
+		fragsFromCounterparties:     make(map[int]curves.Scalar),
+		round:                       FeldmanRound(state.Round),
+		zkcommitsFromCounterparties: make(map[int][]byte),
+		pointsFromCounterparties:    make(map[int]curves.Point),
+		curve:                       *curve,
+	}
+
+	var err error
+	for _, s := range []struct {
+		scalar *curves.Scalar
+		data   []byte
+	}{
+		{&f.zkpok, state.Zkpok},
+		{&f.secret, state.Secret},
+		{&f.scalar, state.Scalar},
+	} {
+		if *s.scalar, err = decodeScalar(curve, s.data); err != nil {
+			return nil, errors.Wrap(err, "feldman scalar")
+		}
+	}
+
+	for _, p := range []struct {
+		point *curves.Point
+		data  []byte
+	}{
+		{&f.generator, state.Generator},
+		{&f.publicKey, state.PublicKey},
+		{&f.point, state.Point},
+		{&f.randomCommitmentPoint, state.RandomCommitmentPoint},
+	} {
+		if *p.point, err = decodePoint(curve, p.data); err != nil {
+			return nil, errors.Wrap(err, "feldman point")
+		}
+	}
+
+	for id, frag := range state.FragsForCounterparties {
+		f.fragsForCounterparties[int(id)] = frag
+	}
+	for id, frag := range state.FragsFromCounterparties {
+		if f.fragsFromCounterparties[int(id)], err = decodeScalar(
+			curve,
+			frag,
+		); err != nil {
+			return nil, errors.Wrap(err, "feldman frag")
+		}
+	}
+	for id, zkcommit := range state.ZkcommitsFromCounterparties {
+		f.zkcommitsFromCounterparties[int(id)] = zkcommit
+	}
+	for id, point := range state.PointsFromCounterparties {
+		if f.pointsFromCounterparties[int(id)], err = decodePoint(
+			curve,
+			point,
+		); err != nil {
+			return nil, errors.Wrap(err, "feldman counterparty point")
+		}
+	}
+
+	return f, nil
+}
+
+// skippedKeysState serializes the skipped keys of a header key indexed set,
+// ordered by header key and message number.
+func skippedKeysState(
+	sender []byte,
+	skippedKeysMap map[string]map[uint32]*skippedKey,
+) []*protobufs.SkippedMessageKey {
+	headerKeys := make([]string, 0, len(skippedKeysMap))
+	for headerKey := range skippedKeysMap {
+		headerKeys = append(headerKeys, headerKey)
+	}
+	sort.Strings(headerKeys)
+
+	result := []*protobufs.SkippedMessageKey{}
+	for _, headerKey := range headerKeys {
+		messageNumbers := make([]uint32, 0, len(skippedKeysMap[headerKey]))
+		for messageNumber := range skippedKeysMap[headerKey] {
+			messageNumbers = append(messageNumbers, messageNumber)
+		}
+		sort.Slice(messageNumbers, func(i, j int) bool {
+			return messageNumbers[i] < messageNumbers[j]
+		})
+
+		for _, messageNumber := range messageNumbers {
+			k := skippedKeysMap[headerKey][messageNumber]
+			result = append(result, &protobufs.SkippedMessageKey{
+				HeaderKey:     []byte(headerKey),
+				Sender:        sender,
+				MessageNumber: messageNumber,
+				Key:           k.key,
+				Timestamp:     k.skipped.UnixMilli(),
+			})
+		}
+	}
+
+	return result
+}
+
+func addSkippedKey(
+	skippedKeysMap map[string]map[uint32]*skippedKey,
+	k *protobufs.SkippedMessageKey,
+) error {
+	if len(k.Key) != 64 {
+		return errors.New("invalid skipped key")
+	}
+
+	skippedKeys, ok := skippedKeysMap[string(k.HeaderKey)]
+	if !ok {
+		skippedKeys = make(map[uint32]*skippedKey)
+		skippedKeysMap[string(k.HeaderKey)] = skippedKeys
+	}
+
+	skippedKeys[k.MessageNumber] = &skippedKey{
+		key:     k.Key,
+		skipped: time.UnixMilli(k.Timestamp),
+	}
+
+	return nil
+}
+
+func scalarBytes(s curves.Scalar) []byte {
+	if s == nil {
+		return nil
+	}
+
+	return s.Bytes()
+}
+
+func pointBytes(p curves.Point) []byte {
+	if p == nil {
+		return nil
+	}
+
+	return p.ToAffineCompressed()
+}
+
+func decodeScalar(curve *curves.Curve, data []byte) (curves.Scalar, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	return curve.NewScalar().SetBytes(data)
+}
+
+func decodePoint(curve *curves.Curve, data []byte) (curves.Point, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	return curve.Point.FromAffineCompressed(data)
+}
+
+// MarshalState serializes the participant, including its private keys, the
+// retained epochs and the pairwise channels with the other members, so the
+// session can be resumed with UnmarshalAsyncTripleRatchetParticipant. The
+// result must only be persisted encrypted.
+func (r *AsyncTripleRatchetParticipant) MarshalState() ([]byte, error) {
+	state := &protobufs.AsyncTripleRatchetParticipantState{
+		Version:            ASYNC_TRIPLE_RATCHET_STATE_VERSION,
+		Curve:              r.curve.Name,
+		GroupId:            r.groupId,
+		PeerKey:            scalarBytes(r.peerKey),
+		IdentityKey:        scalarBytes(r.identityKey),
+		SignedPreKey:       scalarBytes(r.signedPreKey),
+		SendingChainKey:    r.sendingChainKey,
+		SendingChainLength: r.sendingChainLength,
+		Pending:            r.pending,
+		HeldUpdates:        r.held,
+	}
+
+	for _, k := range r.Members() {
+		state.Members = append(state.Members, memberState(r.members[string(k)]))
+	}
+
+	for _, e := range r.epochs {
+		state.Epochs = append(state.Epochs, &protobufs.AsyncTripleRatchetEpoch{
+			Epoch:         e.epoch,
+			EpochSecret:   e.secret,
+			ParentEpochId: e.parentId,
+			Timestamp:     e.created.UnixMilli(),
+		})
+	}
+
+	channelKeys := make([]string, 0, len(r.channels))
+	for k := range r.channels {
+		channelKeys = append(channelKeys, k)
+	}
+	sort.Strings(channelKeys)
+
+	for _, k := range channelKeys {
+		state.Channels = append(
+			state.Channels,
+			&protobufs.AsyncTripleRatchetChannelState{
+				PublicKey: []byte(k),
+				Outgoing:  r.channels[k].outgoing.state(),
+				Incoming:  r.channels[k].incoming.state(),
+			},
+		)
+	}
+
+	senders := make([]string, 0, len(r.receivingChains))
+	for sender := range r.receivingChains {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	for _, sender := range senders {
+		headerKeys := make([]string, 0, len(r.receivingChains[sender]))
+		for headerKey := range r.receivingChains[sender] {
+			headerKeys = append(headerKeys, headerKey)
+		}
+		sort.Strings(headerKeys)
+
+		for _, headerKey := range headerKeys {
+			chain := r.receivingChains[sender][headerKey]
+			state.ReceivingChains = append(
+				state.ReceivingChains,
+				&protobufs.AsyncTripleRatchetChainState{
+					Sender:      []byte(sender),
+					HeaderKey:   []byte(headerKey),
+					ChainKey:    chain.chainKey,
+					ChainLength: chain.length,
+				},
+			)
+		}
+	}
+
+	now := time.Now()
+	senders = make([]string, 0, len(r.skippedKeysMap))
+	for sender := range r.skippedKeysMap {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	for _, sender := range senders {
+		pruneSkippedKeys(r.skippedKeysMap[sender], now)
+		state.SkippedKeys = append(
+			state.SkippedKeys,
+			skippedKeysState([]byte(sender), r.skippedKeysMap[sender])...,
+		)
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(state)
+	return data, errors.Wrap(err, "marshal state")
+}
+
+// UnmarshalAsyncTripleRatchetParticipant resumes a participant serialized
+// with MarshalState. Skipped message keys that expired in the meantime are
+// discarded.
+func UnmarshalAsyncTripleRatchetParticipant(
+	data []byte,
+	keyManager keys.KeyManager,
+) (*AsyncTripleRatchetParticipant, error) {
+	state := &protobufs.AsyncTripleRatchetParticipantState{}
+	if err := proto.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrap(
+			err,
+			"unmarshal async triple ratchet participant",
+		)
+	}
+
+	participant, err := asyncTripleRatchetParticipantFromState(
+		state,
+		keyManager,
+	)
+	if err != nil {
+		return nil, errors.Wrap(
+			err,
+			"unmarshal async triple ratchet participant",
+		)
+	}
+
+	return participant, nil
+}
+
+func asyncTripleRatchetParticipantFromState(
+	state *protobufs.AsyncTripleRatchetParticipantState,
+	keyManager keys.KeyManager,
+) (*AsyncTripleRatchetParticipant, error) {
+	if state.Version != ASYNC_TRIPLE_RATCHET_STATE_VERSION {
+		return nil, errors.Wrapf(
+			ErrUnsupportedStateVersion,
+			"async triple ratchet state version %d",
+			state.Version,
+		)
+	}
+
+	curve := curves.GetCurveByName(state.Curve)
+	if curve == nil {
+		return nil, errors.Errorf("unknown curve %s", state.Curve)
+	}
+
+	peerKey, err := decodeScalar(curve, state.PeerKey)
+	if err != nil || peerKey == nil {
+		return nil, errors.Wrap(errors.New("invalid peer key"), "peer key")
+	}
+
+	identityKey, err := decodeScalar(curve, state.IdentityKey)
+	if err != nil || identityKey == nil {
+		return nil, errors.Wrap(errors.New("invalid identity key"), "identity key")
+	}
+
+	signedPreKey, err := decodeScalar(curve, state.SignedPreKey)
+	if err != nil || signedPreKey == nil {
+		return nil, errors.Wrap(
+			errors.New("invalid signed pre key"),
+			"signed pre key",
+		)
+	}
+
+	participant := newAsyncTripleRatchetParticipant(
+		state.GroupId,
+		curve,
+		keyManager,
+		peerKey,
+		identityKey,
+		signedPreKey,
+	)
+	participant.sendingChainKey = state.SendingChainKey
+	participant.sendingChainLength = state.SendingChainLength
+	participant.pending = state.Pending
+	participant.held = state.HeldUpdates
+
+	for _, m := range state.Members {
+		peer, err := participant.peerInfoFromState(m)
+		if err != nil {
+			return nil, errors.Wrap(err, "member")
+		}
+		participant.members[string(m.PublicKey)] = peer
+	}
+
+	for _, e := range state.Epochs {
+		epoch, err := participant.newEpoch(
+			e.Epoch,
+			e.EpochSecret,
+			e.ParentEpochId,
+			e.Timestamp,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "epoch")
+		}
+		participant.epochs = append(participant.epochs, epoch)
+	}
+
+	if len(participant.epochs) == 0 {
+		return nil, errors.New("no epochs")
+	}
+
+	for _, c := range state.Channels {
+		if c.Outgoing == nil || c.Incoming == nil {
+			return nil, errors.New("incomplete channel")
+		}
+
+		outgoing, err := doubleRatchetParticipantFromState(c.Outgoing, keyManager)
+		if err != nil {
+			return nil, errors.Wrap(err, "channel")
+		}
+
+		incoming, err := doubleRatchetParticipantFromState(c.Incoming, keyManager)
+		if err != nil {
+			return nil, errors.Wrap(err, "channel")
+		}
+
+		participant.channels[string(c.PublicKey)] = &pairwiseChannel{
+			outgoing: outgoing,
+			incoming: incoming,
+		}
+	}
+
+	for _, c := range state.ReceivingChains {
+		chains, ok := participant.receivingChains[string(c.Sender)]
+		if !ok {
+			chains = make(map[string]*receivingChain)
+			participant.receivingChains[string(c.Sender)] = chains
+		}
+
+		chains[string(c.HeaderKey)] = &receivingChain{
+			chainKey: c.ChainKey,
+			length:   c.ChainLength,
+		}
+	}
+
+	now := time.Now()
+	for _, k := range state.SkippedKeys {
+		senderSkippedKeys, ok := participant.skippedKeysMap[string(k.Sender)]
+		if !ok {
+			senderSkippedKeys = make(map[string]map[uint32]*skippedKey)
+			participant.skippedKeysMap[string(k.Sender)] = senderSkippedKeys
+		}
+
+		if err := addSkippedKey(senderSkippedKeys, k); err != nil {
+			return nil, err
+		}
+	}
+	for _, senderSkippedKeys := range participant.skippedKeysMap {
+		pruneSkippedKeys(senderSkippedKeys, now)
+	}
+
+	return participant, nil
+}
@@ -0,0 +1,249 @@
+package channel_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"source.quilibrium.com/quilibrium/monorepo/nekryptology/pkg/core/curves"
+	"source.quilibrium.com/quilibrium/monorepo/node/crypto/channel"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func newRatchetPair(t *testing.T) (
+	*channel.DoubleRatchetParticipant,
+	*channel.DoubleRatchetParticipant,
+) {
+	sendingIdentityPrivateKey := curves.ED448().Scalar.Random(rand.Reader)
+	sendingEphemeralPrivateKey := curves.ED448().Scalar.Random(rand.Reader)
+	receivingIdentityPrivateKey := curves.ED448().Scalar.Random(rand.Reader)
+	receivingSignedPrePrivateKey := curves.ED448().Scalar.Random(rand.Reader)
+
+	senderResult := channel.SenderX3DH(
+		sendingIdentityPrivateKey,
+		sendingEphemeralPrivateKey,
+		curves.ED448().NewGeneratorPoint().Mul(receivingIdentityPrivateKey),
+		curves.ED448().NewGeneratorPoint().Mul(receivingSignedPrePrivateKey),
+		96,
+	)
+	receiverResult := channel.ReceiverX3DH(
+		receivingIdentityPrivateKey,
+		receivingSignedPrePrivateKey,
+		curves.ED448().NewGeneratorPoint().Mul(sendingIdentityPrivateKey),
+		curves.ED448().NewGeneratorPoint().Mul(sendingEphemeralPrivateKey),
+		96,
+	)
+
+	sender, err := channel.NewDoubleRatchetParticipant(
+		senderResult[:32],
+		senderResult[32:64],
+		senderResult[64:],
+		true,
+		sendingEphemeralPrivateKey,
+		curves.ED448().NewGeneratorPoint().Mul(receivingSignedPrePrivateKey),
+		curves.ED448(),
+		nil,
+	)
+	require.NoError(t, err)
+
+	receiver, err := channel.NewDoubleRatchetParticipant(
+		receiverResult[:32],
+		receiverResult[32:64],
+		receiverResult[64:],
+		false,
+		receivingSignedPrePrivateKey,
+		curves.ED448().NewGeneratorPoint().Mul(sendingEphemeralPrivateKey),
+		curves.ED448(),
+		nil,
+	)
+	require.NoError(t, err)
+
+	return sender, receiver
+}
+
+func TestDoubleRatchetSessionResume(t *testing.T) {
+	db := store.NewInMemKVDB()
+	keyManager := keys.NewInMemoryKeyManager()
+	channelStore := store.NewPebbleChannelStore(db, keyManager, zap.NewNop())
+
+	sender, receiver := newRatchetPair(t)
+
+	envelope1, err := sender.RatchetEncrypt([]byte("hello there"))
+	require.NoError(t, err)
+	envelope2, err := sender.RatchetEncrypt([]byte("general kenobi"))
+	require.NoError(t, err)
+
+	// Receive out of order, so the receiver persists a skipped key.
+	plaintext, err := receiver.RatchetDecrypt(envelope2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("general kenobi"), plaintext)
+
+	for id, p := range map[string]*channel.DoubleRatchetParticipant{
+		"sender":   sender,
+		"receiver": receiver,
+	} {
+		state, err := p.MarshalState()
+		require.NoError(t, err)
+		require.NoError(t, channelStore.PutSession([]byte(id), state))
+	}
+
+	// Restart, with the key manager and database surviving.
+	channelStore = store.NewPebbleChannelStore(db, keyManager, zap.NewNop())
+	senderState, err := channelStore.GetSession([]byte("sender"))
+	require.NoError(t, err)
+	sender, err = channel.UnmarshalDoubleRatchetParticipant(senderState, nil)
+	require.NoError(t, err)
+	receiverState, err := channelStore.GetSession([]byte("receiver"))
+	require.NoError(t, err)
+	receiver, err = channel.UnmarshalDoubleRatchetParticipant(receiverState, nil)
+	require.NoError(t, err)
+
+	plaintext, err = receiver.RatchetDecrypt(envelope1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello there"), plaintext)
+
+	envelope3, err := receiver.RatchetEncrypt([]byte("you are a bold one"))
+	require.NoError(t, err)
+	plaintext, err = sender.RatchetDecrypt(envelope3)
+	require.NoError(t, err)
+	require.Equal(t, []byte("you are a bold one"), plaintext)
+
+	envelope4, err := sender.RatchetEncrypt([]byte("[mechanical laughing]"))
+	require.NoError(t, err)
+	plaintext, err = receiver.RatchetDecrypt(envelope4)
+	require.NoError(t, err)
+	require.Equal(t, []byte("[mechanical laughing]"), plaintext)
+
+	// The sessions are encrypted at rest, and bound to their channel.
+	raw, closer, err := db.Get(
+		append([]byte{store.CHANNEL, store.CHANNEL_SESSION}, "sender"...),
+	)
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), string(senderState))
+	require.NoError(t, db.Set(
+		append([]byte{store.CHANNEL, store.CHANNEL_SESSION}, "receiver"...),
+		append([]byte{}, raw...),
+	))
+	require.NoError(t, closer.Close())
+	_, err = channelStore.GetSession([]byte("receiver"))
+	require.ErrorIs(t, err, store.ErrInvalidData)
+
+	// A different key can't read them.
+	otherStore := store.NewPebbleChannelStore(
+		db,
+		keys.NewInMemoryKeyManager(),
+		zap.NewNop(),
+	)
+	_, err = otherStore.GetSession([]byte("sender"))
+	require.ErrorIs(t, err, store.ErrInvalidData)
+
+	require.NoError(t, channelStore.DeleteSession([]byte("sender")))
+	_, err = channelStore.GetSession([]byte("sender"))
+	require.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestDoubleRatchetSkippedKeyLimits(t *testing.T) {
+	sender, receiver := newRatchetPair(t)
+
+	_, err := sender.RatchetEncrypt([]byte("lost"))
+	require.NoError(t, err)
+	for i := 0; i < channel.MAX_MESSAGE_SKIP; i++ {
+		_, err := sender.RatchetEncrypt([]byte("lost"))
+		require.NoError(t, err)
+	}
+	envelope, err := sender.RatchetEncrypt([]byte("too far"))
+	require.NoError(t, err)
+	_, err = receiver.RatchetDecrypt(envelope)
+	require.Error(t, err)
+
+	sender, receiver = newRatchetPair(t)
+	var first *protobufs.P2PChannelEnvelope
+	for i := 0; i <= channel.MAX_SKIPPED_KEYS+channel.MAX_MESSAGE_SKIP; i++ {
+		message := []byte(fmt.Sprintf("message %d", i))
+		envelope, err := sender.RatchetEncrypt(message)
+		require.NoError(t, err)
+
+		if i == 0 {
+			first = envelope
+		}
+		if i%channel.MAX_MESSAGE_SKIP == 0 && i != 0 {
+			plaintext, err := receiver.RatchetDecrypt(envelope)
+			require.NoError(t, err)
+			require.Equal(t, message, plaintext)
+		}
+	}
+
+	// The oldest skipped keys were discarded to stay within the limit.
+	_, err = receiver.RatchetDecrypt(first)
+	require.Error(t, err)
+
+	state, err := receiver.MarshalState()
+	require.NoError(t, err)
+	receiverState := &protobufs.DoubleRatchetParticipantState{}
+	require.NoError(t, proto.Unmarshal(state, receiverState))
+	require.Len(t, receiverState.SkippedKeys, channel.MAX_SKIPPED_KEYS)
+
+	// Skipped keys past their expiry are discarded when the session resumes.
+	for _, k := range receiverState.SkippedKeys {
+		k.Timestamp = time.Now().Add(-channel.SKIPPED_KEY_EXPIRY - time.Minute).
+			UnixMilli()
+	}
+	state, err = proto.Marshal(receiverState)
+	require.NoError(t, err)
+	receiver, err = channel.UnmarshalDoubleRatchetParticipant(state, nil)
+	require.NoError(t, err)
+	state, err = receiver.MarshalState()
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(state, receiverState))
+	require.Empty(t, receiverState.SkippedKeys)
+
+	receiverState.Version = channel.DOUBLE_RATCHET_STATE_VERSION + 1
+	state, err = proto.Marshal(receiverState)
+	require.NoError(t, err)
+	_, err = channel.UnmarshalDoubleRatchetParticipant(state, nil)
+	require.ErrorIs(t, err, channel.ErrUnsupportedStateVersion)
+}
+
+func TestTripleRatchetMarshalState(t *testing.T) {
+	curve := curves.ED448()
+	peerKeys := []curves.Scalar{}
+	peers := []*channel.PeerInfo{}
+	for i := 0; i < 3; i++ {
+		peerKey := curve.Scalar.Random(rand.Reader)
+		identityKey := curve.Scalar.Random(rand.Reader)
+		signedPreKey := curve.Scalar.Random(rand.Reader)
+		peerKeys = append(peerKeys, peerKey, identityKey, signedPreKey)
+		peers = append(peers, &channel.PeerInfo{
+			PublicKey:          curve.NewGeneratorPoint().Mul(peerKey),
+			IdentityPublicKey:  curve.NewGeneratorPoint().Mul(identityKey),
+			SignedPrePublicKey: curve.NewGeneratorPoint().Mul(signedPreKey),
+		})
+	}
+
+	participant, _, err := channel.NewTripleRatchetParticipant(
+		peers[1:],
+		*curve,
+		nil,
+		peerKeys[0],
+		peerKeys[1],
+		peerKeys[2],
+	)
+	require.NoError(t, err)
+
+	state, err := participant.MarshalState()
+	require.NoError(t, err)
+
+	resumed, err := channel.UnmarshalTripleRatchetParticipant(state, nil)
+	require.NoError(t, err)
+
+	resumedState, err := resumed.MarshalState()
+	require.NoError(t, err)
+	require.Equal(t, state, resumedState)
+}
@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/hkdf"
@@ -53,7 +54,7 @@ type TripleRatchetParticipant struct {
 	previousReceivingChainLength map[string]uint32
 	peerIdMap                    map[string]int
 	idPeerMap                    map[int]*PeerInfo
-	skippedKeysMap               map[string]map[string]map[uint32][]byte
+	skippedKeysMap               map[string]map[string]map[uint32]*skippedKey
 	peerChannels                 map[string]*DoubleRatchetParticipant
 	dkgRatchet                   *Feldman
 }
@@ -66,7 +67,8 @@ type PeerInfo struct {
 
 // Weak-mode synchronous group modification TR – this is not the asynchronous
 // TR, does not ratchet group key automatically, know what your use case is
-// before adopting this.
+// before adopting this. Groups with changing membership should use
+// AsyncTripleRatchetParticipant.
 func NewTripleRatchetParticipant(
 	peers []*PeerInfo,
 	curve curves.Curve,
@@ -80,7 +82,9 @@ func NewTripleRatchetParticipant(
 	error,
 ) {
 	participant := &TripleRatchetParticipant{}
-	participant.skippedKeysMap = make(map[string]map[string]map[uint32][]byte)
+	participant.skippedKeysMap = make(
+		map[string]map[string]map[uint32]*skippedKey,
+	)
 	participant.receivingEphemeralKeys = make(map[string]curves.Scalar)
 	participant.receivingChainKey = make(map[string][]byte)
 	participant.peerChannels = make(map[string]*DoubleRatchetParticipant)
@@ -119,7 +123,7 @@ func NewTripleRatchetParticipant(
 		} else {
 			participant.skippedKeysMap[string(
 				peerBasis[i].PublicKey.ToAffineCompressed(),
-			)] = make(map[string]map[uint32][]byte)
+			)] = make(map[string]map[uint32]*skippedKey)
 			participant.currentReceivingChainLength[string(
 				peerBasis[i].PublicKey.ToAffineCompressed(),
 			)] = 0
@@ -449,8 +453,6 @@ func (r *TripleRatchetParticipant) RatchetDecrypt(
 	newChainKey, messageKey, aeadKey := ratchetKeys(
 		r.receivingChainKey[string(senderKey.ToAffineCompressed())],
 	)
-	r.receivingChainKey[string(senderKey.ToAffineCompressed())] = newChainKey
-	r.currentReceivingChainLength[string(senderKey.ToAffineCompressed())]++
 
 	plaintext, err = r.decrypt(
 		envelope.MessageBody,
@@ -460,8 +462,14 @@ func (r *TripleRatchetParticipant) RatchetDecrypt(
 			envelope.MessageHeader.Ciphertext...,
 		),
 	)
+	if err != nil {
+		return nil, errors.Wrap(err, "ratchet decrypt")
+	}
 
-	return plaintext, errors.Wrap(err, "ratchet decrypt")
+	r.receivingChainKey[string(senderKey.ToAffineCompressed())] = newChainKey
+	r.currentReceivingChainLength[string(senderKey.ToAffineCompressed())]++
+
+	return plaintext, nil
 }
 
 func (r *TripleRatchetParticipant) ratchetSenderEphemeralKeys() error {
@@ -520,24 +528,32 @@ func (r *TripleRatchetParticipant) ratchetReceiverEphemeralKeys(
 func (r *TripleRatchetParticipant) trySkippedMessageKeys(
 	envelope *protobufs.P2PChannelEnvelope,
 ) ([]byte, error) {
-	for receivingHeaderKey, skippedKeys := range r.skippedKeysMap {
-		header, _, err := r.decryptHeader(
-			envelope.MessageHeader,
-			[]byte(receivingHeaderKey),
-		)
+	for sender, senderSkippedKeys := range r.skippedKeysMap {
+		for receivingHeaderKey, skippedKeys := range senderSkippedKeys {
+			header, _, err := r.decryptHeader(
+				envelope.MessageHeader,
+				[]byte(receivingHeaderKey),
+			)
+			if err != nil {
+				continue
+			}
 
-		if err == nil {
 			peerKey, _, _, current, err := r.decodeHeader(header)
 			if err != nil {
 				return nil, errors.Wrap(err, "try skipped message keys")
 			}
 
-			messageKey := skippedKeys[string(
-				peerKey.ToAffineCompressed(),
-			)][current][:32]
-			aeadKey := skippedKeys[string(
-				peerKey.ToAffineCompressed(),
-			)][current][32:]
+			if string(peerKey.ToAffineCompressed()) != sender {
+				continue
+			}
+
+			skipped, ok := skippedKeys[current]
+			if !ok {
+				continue
+			}
+
+			messageKey := skipped.key[:32]
+			aeadKey := skipped.key[32:]
 			plaintext, err := r.decrypt(
 				envelope.MessageBody,
 				messageKey,
@@ -551,15 +567,9 @@ func (r *TripleRatchetParticipant) trySkippedMessageKeys(
 				return nil, errors.Wrap(err, "try skipped message keys")
 			}
 
-			delete(r.skippedKeysMap[string(
-				peerKey.ToAffineCompressed(),
-			)][receivingHeaderKey], current)
-			if len(r.skippedKeysMap[string(
-				peerKey.ToAffineCompressed(),
-			)][receivingHeaderKey]) == 0 {
-				delete(r.skippedKeysMap[string(
-					peerKey.ToAffineCompressed(),
-				)], receivingHeaderKey)
+			delete(skippedKeys, current)
+			if len(skippedKeys) == 0 {
+				delete(senderSkippedKeys, receivingHeaderKey)
 			}
 
 			return plaintext, nil
@@ -573,44 +583,38 @@ func (r *TripleRatchetParticipant) skipMessageKeys(
 	senderKey curves.Point,
 	until uint32,
 ) error {
-	if r.currentReceivingChainLength[string(
-		senderKey.ToAffineCompressed(),
-	)]+100 < until {
+	sender := string(senderKey.ToAffineCompressed())
+	if r.currentReceivingChainLength[sender]+MAX_MESSAGE_SKIP < until {
 		return errors.Wrap(errors.New("skip limit exceeded"), "skip message keys")
 	}
 
-	if r.receivingChainKey != nil {
-		for r.currentReceivingChainLength[string(
-			senderKey.ToAffineCompressed(),
-		)] < until {
+	if r.receivingChainKey != nil && r.currentReceivingChainLength[sender] < until {
+		senderSkippedKeys := r.skippedKeysMap[sender]
+		if senderSkippedKeys == nil {
+			senderSkippedKeys = make(map[string]map[uint32]*skippedKey)
+			r.skippedKeysMap[sender] = senderSkippedKeys
+		}
+
+		now := time.Now()
+		for r.currentReceivingChainLength[sender] < until {
 			newChainKey, messageKey, aeadKey := ratchetKeys(
-				r.receivingChainKey[string(
-					senderKey.ToAffineCompressed(),
-				)],
+				r.receivingChainKey[sender],
 			)
-			skippedKeys := r.skippedKeysMap[string(
-				senderKey.ToAffineCompressed(),
-			)][string(r.currentHeaderKey)]
+			skippedKeys := senderSkippedKeys[string(r.currentHeaderKey)]
 			if skippedKeys == nil {
-				r.skippedKeysMap[string(
-					senderKey.ToAffineCompressed(),
-				)][string(r.currentHeaderKey)] =
-					make(map[uint32][]byte)
+				skippedKeys = make(map[uint32]*skippedKey)
+				senderSkippedKeys[string(r.currentHeaderKey)] = skippedKeys
 			}
 
-			skippedKeys[r.currentReceivingChainLength[string(
-				senderKey.ToAffineCompressed(),
-			)]] = append(
-				append([]byte{}, messageKey...),
-				aeadKey...,
-			)
-			r.receivingChainKey[string(
-				senderKey.ToAffineCompressed(),
-			)] = newChainKey
-			r.currentReceivingChainLength[string(
-				senderKey.ToAffineCompressed(),
-			)]++
+			skippedKeys[r.currentReceivingChainLength[sender]] = &skippedKey{
+				key:     append(append([]byte{}, messageKey...), aeadKey...),
+				skipped: now,
+			}
+			r.receivingChainKey[sender] = newChainKey
+			r.currentReceivingChainLength[sender]++
 		}
+
+		pruneSkippedKeys(senderSkippedKeys, now)
 	}
 
 	return nil
@@ -26,6 +26,26 @@ func SenderX3DH(
 	receivingIdentityKey curves.Point,
 	receivingSignedPreKey curves.Point,
 	sessionKeyLength uint8,
+) []byte {
+	return SenderX3DHWithOneTimePreKey(
+		sendingIdentityPrivateKey,
+		sendingEphemeralPrivateKey,
+		receivingIdentityKey,
+		receivingSignedPreKey,
+		nil,
+		sessionKeyLength,
+	)
+}
+
+// SenderX3DHWithOneTimePreKey is SenderX3DH, additionally mixing in the
+// receiver's one-time prekey if one is given.
+func SenderX3DHWithOneTimePreKey(
+	sendingIdentityPrivateKey curves.Scalar,
+	sendingEphemeralPrivateKey curves.Scalar,
+	receivingIdentityKey curves.Point,
+	receivingSignedPreKey curves.Point,
+	receivingOneTimePreKey curves.Point,
+	sessionKeyLength uint8,
 ) []byte {
 	xdh1 := receivingSignedPreKey.Mul(
 		sendingIdentityPrivateKey,
@@ -36,20 +56,21 @@ func SenderX3DH(
 	xdh3 := receivingSignedPreKey.Mul(
 		sendingEphemeralPrivateKey,
 	).ToAffineCompressed()
-	salt := make([]byte, sessionKeyLength)
-	x3dh := hkdf.New(sha512.New, append(
-		append(
-			append(
-				append([]byte{}, domainSeparators[receivingIdentityKey.CurveName()]...),
-				xdh1[:]...),
-			xdh2[:]...),
-		xdh3[:]...), salt, []byte("quilibrium-x3dh"))
-	sessionKey := make([]byte, sessionKeyLength)
-	if _, err := x3dh.Read(sessionKey[:]); err != nil {
-		return nil
+	var xdh4 []byte
+	if receivingOneTimePreKey != nil {
+		xdh4 = receivingOneTimePreKey.Mul(
+			sendingEphemeralPrivateKey,
+		).ToAffineCompressed()
 	}
 
-	return sessionKey
+	return deriveX3DH(
+		receivingIdentityKey.CurveName(),
+		sessionKeyLength,
+		xdh1,
+		xdh2,
+		xdh3,
+		xdh4,
+	)
 }
 
 func ReceiverX3DH(
@@ -58,6 +79,26 @@ func ReceiverX3DH(
 	receivingIdentityKey curves.Point,
 	receivingEphemeralKey curves.Point,
 	sessionKeyLength uint8,
+) []byte {
+	return ReceiverX3DHWithOneTimePreKey(
+		sendingIdentityPrivateKey,
+		sendingSignedPrePrivateKey,
+		nil,
+		receivingIdentityKey,
+		receivingEphemeralKey,
+		sessionKeyLength,
+	)
+}
+
+// ReceiverX3DHWithOneTimePreKey is ReceiverX3DH, additionally mixing in the
+// one-time prekey the sender used if one is given.
+func ReceiverX3DHWithOneTimePreKey(
+	sendingIdentityPrivateKey curves.Scalar,
+	sendingSignedPrePrivateKey curves.Scalar,
+	sendingOneTimePrePrivateKey curves.Scalar,
+	receivingIdentityKey curves.Point,
+	receivingEphemeralKey curves.Point,
+	sessionKeyLength uint8,
 ) []byte {
 	xdh1 := receivingIdentityKey.Mul(
 		sendingSignedPrePrivateKey,
@@ -68,14 +109,35 @@ func ReceiverX3DH(
 	xdh3 := receivingEphemeralKey.Mul(
 		sendingSignedPrePrivateKey,
 	).ToAffineCompressed()
+	var xdh4 []byte
+	if sendingOneTimePrePrivateKey != nil {
+		xdh4 = receivingEphemeralKey.Mul(
+			sendingOneTimePrePrivateKey,
+		).ToAffineCompressed()
+	}
+
+	return deriveX3DH(
+		receivingIdentityKey.CurveName(),
+		sessionKeyLength,
+		xdh1,
+		xdh2,
+		xdh3,
+		xdh4,
+	)
+}
+
+func deriveX3DH(
+	curveName string,
+	sessionKeyLength uint8,
+	xdhs ...[]byte,
+) []byte {
+	ikm := append([]byte{}, domainSeparators[curveName]...)
+	for _, xdh := range xdhs {
+		ikm = append(ikm, xdh...)
+	}
+
 	salt := make([]byte, sessionKeyLength)
-	x3dh := hkdf.New(sha512.New, append(
-		append(
-			append(
-				append([]byte{}, domainSeparators[receivingIdentityKey.CurveName()]...),
-				xdh1[:]...),
-			xdh2[:]...),
-		xdh3[:]...), salt, []byte("quilibrium-x3dh"))
+	x3dh := hkdf.New(sha512.New, ikm, salt, []byte("quilibrium-x3dh"))
 	sessionKey := make([]byte, sessionKeyLength)
 	if _, err := x3dh.Read(sessionKey[:]); err != nil {
 		return nil
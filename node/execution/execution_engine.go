@@ -15,4 +15,11 @@ type ExecutionEngine interface {
 	) ([]*protobufs.Message, error)
 	GetPeerInfo() *protobufs.PeerInfoResponse
 	GetFrame() *protobufs.ClockFrame
+	GetMempool(sender []byte) *protobufs.MempoolResponse
+	GetForks() *protobufs.ForksResponse
+	GetReorgHistory(limit int) (*protobufs.ReorgHistoryResponse, error)
+	GetRewardProofs() *protobufs.RewardProofsResponse
+	SimulateTokenRequests(
+		requests *protobufs.TokenRequests,
+	) (*protobufs.SimulateTokenRequestsResponse, error)
 }
@@ -110,109 +110,34 @@ func (a *TokenApplication) ApplyTransitions(
 	lockMap := map[string]struct{}{}
 
 	for _, transition := range transitions.Requests {
-	req:
-		switch t := transition.Request.(type) {
-		case *protobufs.TokenRequest_Announce:
-			success, err := a.handleAnnounce(currentFrameNumber, lockMap, t.Announce)
-			if err != nil {
-				if !skipFailures {
-					return nil, nil, nil, errors.Wrap(
-						err,
-						"apply transitions",
-					)
-				}
-				failedTransitions.Requests = append(
-					failedTransitions.Requests,
-					transition,
-				)
-				break req
-			}
-			outputs.Outputs = append(outputs.Outputs, success...)
-			finalizedTransitions.Requests = append(
-				finalizedTransitions.Requests,
-				transition,
-			)
-		case *protobufs.TokenRequest_Merge:
-			success, err := a.handleMerge(currentFrameNumber, lockMap, t.Merge)
-			if err != nil {
-				if !skipFailures {
-					return nil, nil, nil, errors.Wrap(
-						err,
-						"apply transitions",
-					)
-				}
-				failedTransitions.Requests = append(
-					failedTransitions.Requests,
-					transition,
-				)
-				break req
-			}
-			outputs.Outputs = append(outputs.Outputs, success...)
-			finalizedTransitions.Requests = append(
-				finalizedTransitions.Requests,
-				transition,
-			)
-		case *protobufs.TokenRequest_Split:
-			success, err := a.handleSplit(currentFrameNumber, lockMap, t.Split)
-			if err != nil {
-				if !skipFailures {
-					return nil, nil, nil, errors.Wrap(
-						err,
-						"apply transitions",
-					)
-				}
-				failedTransitions.Requests = append(
-					failedTransitions.Requests,
-					transition,
-				)
-				break req
-			}
-			outputs.Outputs = append(outputs.Outputs, success...)
-			finalizedTransitions.Requests = append(
-				finalizedTransitions.Requests,
-				transition,
-			)
-		case *protobufs.TokenRequest_Transfer:
-			success, err := a.handleTransfer(currentFrameNumber, lockMap, t.Transfer)
-			if err != nil {
-				if !skipFailures {
-					return nil, nil, nil, errors.Wrap(
-						err,
-						"apply transitions",
-					)
-				}
-				failedTransitions.Requests = append(
-					failedTransitions.Requests,
-					transition,
-				)
-				break req
-			}
-			outputs.Outputs = append(outputs.Outputs, success...)
-			finalizedTransitions.Requests = append(
-				finalizedTransitions.Requests,
-				transition,
-			)
-		case *protobufs.TokenRequest_Mint:
-			success, err := a.handleMint(currentFrameNumber, lockMap, t.Mint)
-			if err != nil {
-				if !skipFailures {
-					return nil, nil, nil, errors.Wrap(
-						err,
-						"apply transitions",
-					)
-				}
-				failedTransitions.Requests = append(
-					failedTransitions.Requests,
-					transition,
+		success, handled, err := a.applyTransition(
+			currentFrameNumber,
+			lockMap,
+			transition,
+		)
+		if !handled {
+			continue
+		}
+
+		if err != nil {
+			if !skipFailures {
+				return nil, nil, nil, errors.Wrap(
+					err,
+					"apply transitions",
 				)
-				break req
 			}
-			outputs.Outputs = append(outputs.Outputs, success...)
-			finalizedTransitions.Requests = append(
-				finalizedTransitions.Requests,
+			failedTransitions.Requests = append(
+				failedTransitions.Requests,
 				transition,
 			)
+			continue
 		}
+
+		outputs.Outputs = append(outputs.Outputs, success...)
+		finalizedTransitions.Requests = append(
+			finalizedTransitions.Requests,
+			transition,
+		)
 	}
 
 	a.TokenOutputs = outputs
@@ -220,6 +145,72 @@ func (a *TokenApplication) ApplyTransitions(
 	return a, finalizedTransitions, failedTransitions, nil
 }
 
+// TransitionResult is the outcome of applying a token request: the outputs
+// it produces, or why it is invalid.
+type TransitionResult struct {
+	Outputs []*protobufs.TokenOutput
+	Err     error
+}
+
+// SimulateTransitions applies the transitions in order as ApplyTransitions
+// does, returning the outcome of each instead of collecting the outputs.
+// Requests of unknown types are reported as invalid rather than ignored.
+func (a *TokenApplication) SimulateTransitions(
+	currentFrameNumber uint64,
+	transitions *protobufs.TokenRequests,
+) []*TransitionResult {
+	results := []*TransitionResult{}
+	lockMap := map[string]struct{}{}
+
+	for _, transition := range transitions.Requests {
+		outputs, handled, err := a.applyTransition(
+			currentFrameNumber,
+			lockMap,
+			transition,
+		)
+		if !handled {
+			err = errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "unknown request type"),
+				"simulate transitions",
+			)
+		}
+
+		results = append(results, &TransitionResult{
+			Outputs: outputs,
+			Err:     err,
+		})
+	}
+
+	return results
+}
+
+// applyTransition applies the transition, locking what it consumes in the
+// lock map. It returns whether the transition is of a known type.
+func (a *TokenApplication) applyTransition(
+	currentFrameNumber uint64,
+	lockMap map[string]struct{},
+	transition *protobufs.TokenRequest,
+) ([]*protobufs.TokenOutput, bool, error) {
+	var outputs []*protobufs.TokenOutput
+	var err error
+	switch t := transition.Request.(type) {
+	case *protobufs.TokenRequest_Announce:
+		outputs, err = a.handleAnnounce(currentFrameNumber, lockMap, t.Announce)
+	case *protobufs.TokenRequest_Merge:
+		outputs, err = a.handleMerge(currentFrameNumber, lockMap, t.Merge)
+	case *protobufs.TokenRequest_Split:
+		outputs, err = a.handleSplit(currentFrameNumber, lockMap, t.Split)
+	case *protobufs.TokenRequest_Transfer:
+		outputs, err = a.handleTransfer(currentFrameNumber, lockMap, t.Transfer)
+	case *protobufs.TokenRequest_Mint:
+		outputs, err = a.handleMint(currentFrameNumber, lockMap, t.Mint)
+	default:
+		return nil, false, nil
+	}
+
+	return outputs, true, err
+}
+
 func (a *TokenApplication) MaterializeStateFromApplication() (
 	*protobufs.TokenOutputs,
 	error,
@@ -17,27 +17,42 @@ func (a *TokenApplication) handleAnnounce(
 	payload := []byte{}
 
 	if t == nil || t.PublicKeySignaturesEd448 == nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle announce")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "malformed announce request"),
+			"handle announce",
+		)
 	}
 	for i, p := range t.PublicKeySignaturesEd448 {
 		if p.PublicKey == nil || p.Signature == nil ||
 			p.PublicKey.KeyValue == nil {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle announce")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "missing public key or signature"),
+				"handle announce",
+			)
 		}
 		if i == 0 {
 			primary = p
 		} else {
 			payload = append(payload, p.PublicKey.KeyValue...)
 			if err := p.Verify(primary.PublicKey.KeyValue); err != nil {
-				return nil, errors.Wrap(ErrInvalidStateTransition, "handle announce")
+				return nil, errors.Wrap(
+					errors.Wrap(ErrInvalidStateTransition, "invalid signature of key"),
+					"handle announce",
+				)
 			}
 		}
 	}
 	if primary == nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle announce")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "missing primary key"),
+			"handle announce",
+		)
 	}
 	if err := primary.Verify(payload); err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle announce")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid signature"),
+			"handle announce",
+		)
 	}
 
 	outputs := []*protobufs.TokenOutput{}
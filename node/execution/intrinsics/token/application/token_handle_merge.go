@@ -21,21 +21,33 @@ func (a *TokenApplication) handleMerge(
 	newIntersection := make([]byte, 1024)
 	payload := []byte("merge")
 	if t == nil || t.Coins == nil || t.Signature == nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "malformed merge request"),
+			"handle merge",
+		)
 	}
 	addresses := [][]byte{}
 	for _, c := range t.Coins {
 		if c.Address == nil {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "missing coin address"),
+				"handle merge",
+			)
 		}
 
 		if _, touched := lockMap[string(c.Address)]; touched {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "coin already spent in this frame"),
+				"handle merge",
+			)
 		}
 
 		for _, addr := range addresses {
 			if bytes.Equal(addr, c.Address) {
-				return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+				return nil, errors.Wrap(
+					errors.Wrap(ErrInvalidStateTransition, "duplicate coin"),
+					"handle merge",
+				)
 			}
 		}
 
@@ -44,31 +56,49 @@ func (a *TokenApplication) handleMerge(
 	}
 	if t.Signature.PublicKey == nil ||
 		t.Signature.Signature == nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "missing signature"),
+			"handle merge",
+		)
 	}
 	if err := t.Signature.Verify(payload); err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid signature"),
+			"handle merge",
+		)
 	}
 
 	addr, err := poseidon.HashBytes(t.Signature.PublicKey.KeyValue)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle merge",
+		)
 	}
 	pk, err := pcrypto.UnmarshalEd448PublicKey(
 		t.Signature.PublicKey.KeyValue,
 	)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle merge",
+		)
 	}
 
 	peerId, err := peer.IDFromPublicKey(pk)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle merge",
+		)
 	}
 
 	altAddr, err := poseidon.HashBytes([]byte(peerId))
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle merge",
+		)
 	}
 
 	owner := &protobufs.AccountRef{}
@@ -76,7 +106,10 @@ func (a *TokenApplication) handleMerge(
 	for _, c := range t.Coins {
 		coin, err := a.CoinStore.GetCoinByAddress(nil, c.Address)
 		if err != nil {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "coin not found"),
+				"handle merge",
+			)
 		}
 
 		if !bytes.Equal(
@@ -86,7 +119,10 @@ func (a *TokenApplication) handleMerge(
 			coin.Owner.GetImplicitAccount().Address,
 			altAddr.FillBytes(make([]byte, 32)),
 		) {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "coin not owned by signer"),
+				"handle merge",
+			)
 		}
 
 		newTotal.Add(newTotal, new(big.Int).SetBytes(coin.Amount))
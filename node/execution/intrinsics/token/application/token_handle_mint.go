@@ -21,7 +21,10 @@ func (a *TokenApplication) handleMint(
 	t *protobufs.MintCoinRequest,
 ) ([]*protobufs.TokenOutput, error) {
 	if t == nil || t.Proofs == nil || t.Signature == nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "malformed mint request"),
+			"handle mint",
+		)
 	}
 
 	payload := []byte("mint")
@@ -29,30 +32,45 @@ func (a *TokenApplication) handleMint(
 		payload = append(payload, p...)
 	}
 	if err := t.Signature.Verify(payload); err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid signature"),
+			"handle mint",
+		)
 	}
 	pk, err := pcrypto.UnmarshalEd448PublicKey(
 		t.Signature.PublicKey.KeyValue,
 	)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle mint",
+		)
 	}
 
 	peerId, err := peer.IDFromPublicKey(pk)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle mint",
+		)
 	}
 
 	addr, err := poseidon.HashBytes(
 		t.Signature.PublicKey.KeyValue,
 	)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle mint",
+		)
 	}
 
 	altAddr, err := poseidon.HashBytes([]byte(peerId))
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle mint",
+		)
 	}
 
 	// todo: set termination frame for this:
@@ -60,21 +78,33 @@ func (a *TokenApplication) handleMint(
 		addr.FillBytes(make([]byte, 32)),
 	) && bytes.Equal(t.Signature.PublicKey.KeyValue, a.Beacon) {
 		if len(t.Proofs[0]) != 64 {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "invalid proof length"),
+				"handle mint",
+			)
 		}
 
 		if _, touched := lockMap[string(t.Proofs[0][32:])]; touched {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "proof already minted in this frame"),
+				"handle mint",
+			)
 		}
 
 		_, pr, err := a.CoinStore.GetPreCoinProofsForOwner(t.Proofs[0][32:])
 		if err != nil && !errors.Is(err, store.ErrNotFound) {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "could not read pre-coin proofs"),
+				"handle mint",
+			)
 		}
 
 		for _, p := range pr {
 			if p.IndexProof == nil && bytes.Equal(p.Amount, t.Proofs[0][:32]) {
-				return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+				return nil, errors.Wrap(
+					errors.Wrap(ErrInvalidStateTransition, "proof already minted"),
+					"handle mint",
+				)
 			}
 		}
 
@@ -117,7 +147,10 @@ func (a *TokenApplication) handleMint(
 		return outputs, nil
 	} else if len(t.Proofs) != 3 && currentFrameNumber > 77000 {
 		if _, touched := lockMap[string(t.Signature.PublicKey.KeyValue)]; touched {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "signer already minted in this frame"),
+				"handle mint",
+			)
 		}
 		ring := -1
 		addrBytes := addr.FillBytes(make([]byte, 32))
@@ -128,16 +161,25 @@ func (a *TokenApplication) handleMint(
 			}
 		}
 		if ring == -1 {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "signer not in a prover ring"),
+				"handle mint",
+			)
 		}
 		outputs := []*protobufs.TokenOutput{}
 		for _, p := range t.Proofs {
 			if len(p) < 516+len(peerId)+8+32 {
-				return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+				return nil, errors.Wrap(
+					errors.Wrap(ErrInvalidStateTransition, "proof too short"),
+					"handle mint",
+				)
 			}
 
-			if !bytes.Equal(p[516:len(peerId)], []byte(peerId)) {
-				return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+			if !bytes.Equal(p[516:516+len(peerId)], []byte(peerId)) {
+				return nil, errors.Wrap(
+					errors.Wrap(ErrInvalidStateTransition, "proof not made by signer"),
+					"handle mint",
+				)
 			}
 
 			wesoProver := crypto.NewWesolowskiFrameProver(a.Logger)
@@ -146,7 +188,10 @@ func (a *TokenApplication) handleMint(
 				p[516+len(peerId) : 516+len(peerId)+8],
 			)
 			if frameNumber > currentFrameNumber {
-				return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+				return nil, errors.Wrap(
+					errors.Wrap(ErrInvalidStateTransition, "proof frame number in the future"),
+					"handle mint",
+				)
 			}
 
 			frames, proofs, err := a.CoinStore.GetPreCoinProofsForOwner(
@@ -164,19 +209,30 @@ func (a *TokenApplication) handleMint(
 				if !none {
 					for _, pr := range proofs {
 						if bytes.Equal(pr.Proof, p) {
-							return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+							return nil, errors.Wrap(
+								errors.Wrap(ErrInvalidStateTransition, "proof already minted"),
+								"handle mint",
+							)
 						}
 					}
 				}
 			}
 
 			if !wesoProver.VerifyChallengeProof(p[516:], a.Difficulty, p[:516]) {
-				return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+				return nil, errors.Wrap(
+					errors.Wrap(ErrInvalidStateTransition, "invalid challenge proof"),
+					"handle mint",
+				)
 			}
 
-			scale := len(p2p.GetOnesIndices(p[516+len(peerId)+8 : 32]))
+			scale := len(p2p.GetOnesIndices(
+				p[516+len(peerId)+8 : 516+len(peerId)+8+32],
+			))
 			if scale == 0 {
-				return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+				return nil, errors.Wrap(
+					errors.Wrap(ErrInvalidStateTransition, "proof has no storage"),
+					"handle mint",
+				)
 			}
 
 			ringFactor := big.NewInt(2)
@@ -227,5 +283,8 @@ func (a *TokenApplication) handleMint(
 		return outputs, nil
 	}
 
-	return nil, errors.Wrap(ErrInvalidStateTransition, "handle mint")
+	return nil, errors.Wrap(
+		errors.Wrap(ErrInvalidStateTransition, "unsupported mint request"),
+		"handle mint",
+	)
 }
@@ -20,55 +20,85 @@ func (a *TokenApplication) handleSplit(
 	newAmounts := []*big.Int{}
 	payload := []byte{}
 	if t.Signature == nil || t.OfCoin == nil || t.OfCoin.Address == nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "malformed split request"),
+			"handle split",
+		)
 	}
 	coin, err := a.CoinStore.GetCoinByAddress(nil, t.OfCoin.Address)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "coin not found"),
+			"handle split",
+		)
 	}
 
 	if _, touched := lockMap[string(t.OfCoin.Address)]; touched {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "coin already spent in this frame"),
+			"handle split",
+		)
 	}
 
 	payload = append(payload, []byte("split")...)
 	payload = append(payload, t.OfCoin.Address...)
 
 	if len(t.Amounts) > 100 {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "too many amounts"),
+			"handle split",
+		)
 	}
 
 	for _, a := range t.Amounts {
 		if len(a) > 32 {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "amount too large"),
+				"handle split",
+			)
 		}
 		payload = append(payload, a...)
 	}
 
 	if err := t.Signature.Verify(payload); err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid signature"),
+			"handle split",
+		)
 	}
 
 	addr, err := poseidon.HashBytes(t.Signature.PublicKey.KeyValue)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle split",
+		)
 	}
 
 	pk, err := pcrypto.UnmarshalEd448PublicKey(
 		t.Signature.PublicKey.KeyValue,
 	)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle split",
+		)
 	}
 
 	peerId, err := peer.IDFromPublicKey(pk)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle split",
+		)
 	}
 
 	altAddr, err := poseidon.HashBytes([]byte(peerId))
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle split",
+		)
 	}
 
 	if !bytes.Equal(
@@ -78,7 +108,10 @@ func (a *TokenApplication) handleSplit(
 		coin.Owner.GetImplicitAccount().Address,
 		altAddr.FillBytes(make([]byte, 32)),
 	) {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "coin not owned by signer"),
+			"handle split",
+		)
 	}
 
 	original := new(big.Int).SetBytes(coin.Amount)
@@ -87,7 +120,10 @@ func (a *TokenApplication) handleSplit(
 	for _, amount := range amounts {
 		amountBI := new(big.Int).SetBytes(amount)
 		if amountBI.Cmp(original) >= 0 {
-			return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+			return nil, errors.Wrap(
+				errors.Wrap(ErrInvalidStateTransition, "amount not less than the coin amount"),
+				"handle split",
+			)
 		}
 
 		newAmounts = append(newAmounts, amountBI)
@@ -99,7 +135,10 @@ func (a *TokenApplication) handleSplit(
 		})
 	}
 	if original.Cmp(total) != 0 {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "amounts do not sum to the coin amount"),
+			"handle split",
+		)
 	}
 
 	outputs := []*protobufs.TokenOutput{}
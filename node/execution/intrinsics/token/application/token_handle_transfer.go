@@ -22,16 +22,25 @@ func (a *TokenApplication) handleTransfer(
 		t.ToAccount == nil || t.ToAccount.GetImplicitAccount() == nil ||
 		t.ToAccount.GetImplicitAccount().Address == nil ||
 		len(t.ToAccount.GetImplicitAccount().Address) != 32 {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle transfer")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "malformed transfer request"),
+			"handle transfer",
+		)
 	}
 
 	if _, touched := lockMap[string(t.OfCoin.Address)]; touched {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle transfer")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "coin already spent in this frame"),
+			"handle transfer",
+		)
 	}
 
 	coin, err := a.CoinStore.GetCoinByAddress(nil, t.OfCoin.Address)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle transfer")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "coin not found"),
+			"handle transfer",
+		)
 	}
 
 	payload = append(payload, t.OfCoin.Address...)
@@ -41,29 +50,44 @@ func (a *TokenApplication) handleTransfer(
 	)
 
 	if err := t.Signature.Verify(payload); err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle transfer")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid signature"),
+			"handle transfer",
+		)
 	}
 
 	addr, err := poseidon.HashBytes(t.Signature.PublicKey.KeyValue)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle transfer")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle transfer",
+		)
 	}
 
 	pk, err := pcrypto.UnmarshalEd448PublicKey(
 		t.Signature.PublicKey.KeyValue,
 	)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle transfer")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle transfer",
+		)
 	}
 
 	peerId, err := peer.IDFromPublicKey(pk)
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle transfer")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle transfer",
+		)
 	}
 
 	altAddr, err := poseidon.HashBytes([]byte(peerId))
 	if err != nil {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle transfer")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "invalid public key"),
+			"handle transfer",
+		)
 	}
 
 	if !bytes.Equal(
@@ -73,7 +97,10 @@ func (a *TokenApplication) handleTransfer(
 		coin.Owner.GetImplicitAccount().Address,
 		altAddr.FillBytes(make([]byte, 32)),
 	) {
-		return nil, errors.Wrap(ErrInvalidStateTransition, "handle transfer")
+		return nil, errors.Wrap(
+			errors.Wrap(ErrInvalidStateTransition, "coin not owned by signer"),
+			"handle transfer",
+		)
 	}
 
 	newIntersection := coin.Intersection
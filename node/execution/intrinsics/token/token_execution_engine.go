@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto"
 	"encoding/hex"
+	"math/big"
 	"strings"
 	"sync"
 	gotime "time"
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
@@ -27,9 +29,14 @@ import (
 	"source.quilibrium.com/quilibrium/monorepo/node/store"
 )
 
+// journalDepth is how far behind the frame being processed a frame can be and
+// still be rolled back if a reorg drops it.
+const journalDepth = 360
+
 type TokenExecutionEngine struct {
 	logger                *zap.Logger
 	clock                 *data.DataClockConsensusEngine
+	dataTimeReel          *time.DataTimeReel
 	clockStore            store.ClockStore
 	coinStore             store.CoinStore
 	keyStore              store.KeyStore
@@ -62,7 +69,11 @@ func NewTokenExecutionEngine(
 	coinStore store.CoinStore,
 	masterTimeReel *time.MasterTimeReel,
 	peerInfoManager p2p.PeerInfoManager,
+	clock clock.Clock,
 	keyStore store.KeyStore,
+	mempoolStore store.MempoolStore,
+	peerReputationStore store.PeerReputationStore,
+	rewardProofStore store.RewardProofStore,
 	report *protobufs.SelfTestReport,
 ) *TokenExecutionEngine {
 	if logger == nil {
@@ -145,6 +156,7 @@ func NewTokenExecutionEngine(
 		clockStore,
 		cfg.Engine,
 		frameProver,
+		clock,
 		func(txn store.Transaction, frame *protobufs.ClockFrame) error {
 			if err := e.VerifyExecution(frame); err != nil {
 				return err
@@ -155,11 +167,13 @@ func NewTokenExecutionEngine(
 
 			return nil
 		},
+		e.unwindFrame,
 		origin,
 		inclusionProof,
 		proverKeys,
 	)
 
+	e.dataTimeReel = dataTimeReel
 	e.clock = data.NewDataClockConsensusEngine(
 		cfg,
 		logger,
@@ -168,12 +182,16 @@ func NewTokenExecutionEngine(
 		coinStore,
 		dataProofStore,
 		keyStore,
+		mempoolStore,
+		peerReputationStore,
+		rewardProofStore,
 		pubSub,
 		frameProver,
 		inclusionProver,
 		masterTimeReel,
 		dataTimeReel,
 		peerInfoManager,
+		clock,
 		report,
 		intrinsicFilter,
 		seed,
@@ -375,6 +393,8 @@ func (e *TokenExecutionEngine) Start() <-chan error {
 			panic(err)
 		}
 
+		go e.runRollbackHandler()
+
 		errChan <- nil
 	}()
 
@@ -445,6 +465,24 @@ func (e *TokenExecutionEngine) ProcessFrame(
 		return errors.Wrap(err, "process frame")
 	}
 
+	e.snapshotCheckpoint(f, frame)
+
+	selector, err := frame.GetSelector()
+	if err != nil {
+		return errors.Wrap(err, "process frame")
+	}
+
+	// Writes go through the journal of the frame, so they can be undone if
+	// the frame is dropped by a reorg.
+	journal, err := e.coinStore.JournalFrame(
+		txn,
+		frame.FrameNumber,
+		selector.FillBytes(make([]byte, 32)),
+	)
+	if err != nil {
+		return errors.Wrap(err, "process frame")
+	}
+
 	e.activeClockFrame = frame
 	e.logger.Info(
 		"evaluating next frame",
@@ -482,7 +520,7 @@ func (e *TokenExecutionEngine) ProcessFrame(
 				return errors.Wrap(err, "process frame")
 			}
 			err = e.coinStore.PutCoin(
-				txn,
+				journal,
 				frame.FrameNumber,
 				address,
 				o.Coin,
@@ -492,13 +530,13 @@ func (e *TokenExecutionEngine) ProcessFrame(
 				return errors.Wrap(err, "process frame")
 			}
 		case *protobufs.TokenOutput_DeletedCoin:
-			coin, err := e.coinStore.GetCoinByAddress(txn, o.DeletedCoin.Address)
+			coin, err := e.coinStore.GetCoinByAddress(journal, o.DeletedCoin.Address)
 			if err != nil {
 				txn.Abort()
 				return errors.Wrap(err, "process frame")
 			}
 			err = e.coinStore.DeleteCoin(
-				txn,
+				journal,
 				o.DeletedCoin.Address,
 				coin,
 			)
@@ -513,7 +551,7 @@ func (e *TokenExecutionEngine) ProcessFrame(
 				return errors.Wrap(err, "process frame")
 			}
 			err = e.coinStore.PutPreCoinProof(
-				txn,
+				journal,
 				frame.FrameNumber,
 				address,
 				o.Proof,
@@ -529,7 +567,7 @@ func (e *TokenExecutionEngine) ProcessFrame(
 				return errors.Wrap(err, "process frame")
 			}
 			err = e.coinStore.DeletePreCoinProof(
-				txn,
+				journal,
 				address,
 				o.DeletedProof,
 			)
@@ -546,9 +584,184 @@ func (e *TokenExecutionEngine) ProcessFrame(
 		return errors.Wrap(err, "process frame")
 	}
 
+	if frame.FrameNumber > journalDepth {
+		err = e.coinStore.PruneJournal(txn, frame.FrameNumber-journalDepth)
+		if err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "process frame")
+		}
+	}
+
 	return nil
 }
 
+// unwindFrame undoes the coin store writes of a processed frame dropped by a
+// reorg, rewinding the latest frame processed to its parent.
+func (e *TokenExecutionEngine) unwindFrame(
+	txn store.Transaction,
+	frame *protobufs.FrameRef,
+) error {
+	err := e.coinStore.RollbackFrame(txn, frame.FrameNumber, frame.Selector)
+	if err != nil {
+		return errors.Wrap(err, "unwind frame")
+	}
+
+	return errors.Wrap(
+		e.coinStore.SetLatestFrameProcessed(txn, frame.FrameNumber-1),
+		"unwind frame",
+	)
+}
+
+// checkpointSnapshots is the number of snapshots of frames at checkpoint
+// intervals kept besides those of configured checkpoints.
+const checkpointSnapshots = 2
+
+// snapshotCheckpoint keeps the coin state of a configured checkpoint frame, or
+// of a frame at a checkpoint interval, so it can be served to nodes
+// bootstrapping from the checkpoint. The state is taken when the frame after
+// the checkpoint is about to be processed, at which point the store holds
+// exactly the outcome of the checkpoint frame.
+func (e *TokenExecutionEngine) snapshotCheckpoint(
+	latest uint64,
+	frame *protobufs.ClockFrame,
+) {
+	if frame.FrameNumber != latest+1 || latest == 0 {
+		return
+	}
+
+	configured := map[uint64]struct{}{}
+	snapshot := latest%config.CheckpointInterval == 0
+	for _, checkpoint := range e.engineConfig.Checkpoints {
+		configured[checkpoint.FrameNumber] = struct{}{}
+		if checkpoint.FrameNumber != latest {
+			continue
+		}
+
+		selector, err := hex.DecodeString(checkpoint.Selector)
+		if err == nil &&
+			new(big.Int).SetBytes(selector).Cmp(
+				new(big.Int).SetBytes(frame.ParentSelector),
+			) == 0 {
+			snapshot = true
+		}
+	}
+
+	if !snapshot {
+		return
+	}
+
+	if err := e.coinStore.SnapshotCheckpointState(
+		latest,
+		frame.ParentSelector,
+	); err != nil {
+		e.logger.Error(
+			"could not snapshot checkpoint state",
+			zap.Uint64("frame_number", latest),
+			zap.Error(err),
+		)
+		return
+	}
+
+	frameNumbers, err := e.coinStore.GetCheckpointFrames()
+	if err != nil {
+		e.logger.Error("could not get checkpoint frames", zap.Error(err))
+		return
+	}
+
+	// Only the latest snapshots at intervals are kept, and those of configured
+	// checkpoints.
+	kept := 0
+	for i := len(frameNumbers) - 1; i >= 0; i-- {
+		if _, ok := configured[frameNumbers[i]]; ok {
+			continue
+		}
+
+		if kept < checkpointSnapshots {
+			kept++
+			continue
+		}
+
+		if err := e.coinStore.DeleteCheckpointState(frameNumbers[i]); err != nil {
+			e.logger.Error(
+				"could not delete checkpoint state",
+				zap.Uint64("frame_number", frameNumbers[i]),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// SimulateTokenRequests applies the requests to the state at the head frame
+// without publishing them, returning the outcome of each. Output addresses
+// are those the outputs would have if the requests were the only ones in the
+// next frame.
+func (e *TokenExecutionEngine) SimulateTokenRequests(
+	requests *protobufs.TokenRequests,
+) (*protobufs.SimulateTokenRequestsResponse, error) {
+	head, err := e.dataTimeReel.Head()
+	if err != nil {
+		return nil, errors.Wrap(err, "simulate token requests")
+	}
+
+	if head == nil {
+		return nil, errors.Wrap(
+			errors.New("no head frame"),
+			"simulate token requests",
+		)
+	}
+
+	app, err := application.MaterializeApplicationFromFrame(
+		e.provingKey,
+		head,
+		e.dataTimeReel.GetFrameProverTries(),
+		e.coinStore,
+		e.logger,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "simulate token requests")
+	}
+
+	resp := &protobufs.SimulateTokenRequestsResponse{
+		FrameNumber: head.FrameNumber,
+	}
+	seqno := uint64(0)
+	for _, result := range app.SimulateTransitions(head.FrameNumber, requests) {
+		outcome := &protobufs.TokenRequestOutcome{
+			Valid: result.Err == nil,
+		}
+		if result.Err != nil {
+			outcome.Error = result.Err.Error()
+			resp.Outcomes = append(resp.Outcomes, outcome)
+			continue
+		}
+
+		for _, output := range result.Outputs {
+			var address []byte
+			switch o := output.Output.(type) {
+			case *protobufs.TokenOutput_Coin:
+				address, err = GetAddressOfCoin(o.Coin, head.FrameNumber+1, seqno)
+			case *protobufs.TokenOutput_DeletedCoin:
+				address = o.DeletedCoin.Address
+			case *protobufs.TokenOutput_Proof:
+				address, err = GetAddressOfPreCoinProof(o.Proof)
+			case *protobufs.TokenOutput_DeletedProof:
+				address, err = GetAddressOfPreCoinProof(o.DeletedProof)
+			}
+			if err != nil {
+				return nil, errors.Wrap(err, "simulate token requests")
+			}
+
+			outcome.Outputs = append(outcome.Outputs, output)
+			outcome.Addresses = append(outcome.Addresses, address)
+			seqno++
+		}
+
+		resp.Outcomes = append(resp.Outcomes, outcome)
+	}
+
+	return resp, nil
+}
+
 func (e *TokenExecutionEngine) publishMessage(
 	filter []byte,
 	message proto.Message,
@@ -682,3 +895,76 @@ func (e *TokenExecutionEngine) GetPeerInfo() *protobufs.PeerInfoResponse {
 func (e *TokenExecutionEngine) GetFrame() *protobufs.ClockFrame {
 	return e.clock.GetFrame()
 }
+
+func (e *TokenExecutionEngine) GetMempool(
+	sender []byte,
+) *protobufs.MempoolResponse {
+	return e.clock.GetMempool(sender)
+}
+
+func (e *TokenExecutionEngine) GetForks() *protobufs.ForksResponse {
+	return e.clock.GetForks()
+}
+
+func (e *TokenExecutionEngine) GetReorgHistory(
+	limit int,
+) (*protobufs.ReorgHistoryResponse, error) {
+	return e.clock.GetReorgHistory(limit)
+}
+
+func (e *TokenExecutionEngine) GetRewardProofs() *protobufs.RewardProofsResponse {
+	return e.clock.GetRewardProofs()
+}
+
+func (e *TokenExecutionEngine) runRollbackHandler() {
+	for event := range e.dataTimeReel.ReorgCh() {
+		e.rollback(event)
+	}
+}
+
+// rollback returns the token requests included by the frames dropped by a
+// switch of the time reel head to the mempool. The coin store has already
+// been moved to the new branch by the time reel, through unwindFrame and
+// ProcessFrame.
+func (e *TokenExecutionEngine) rollback(event *protobufs.ReorgEvent) {
+	e.logger.Warn(
+		"rolling back dropped frames",
+		zap.Uint64(
+			"common_ancestor_frame_number",
+			event.CommonAncestor.FrameNumber,
+		),
+		zap.Uint64("depth", event.Depth),
+	)
+
+	requests := []*protobufs.TokenRequest{}
+	for _, ref := range event.DroppedFrames {
+		frame, err := e.clockStore.GetStagedDataClockFrame(
+			e.intrinsicFilter,
+			ref.FrameNumber,
+			ref.Selector,
+			false,
+		)
+		if err != nil {
+			e.logger.Error(
+				"could not load dropped frame",
+				zap.Uint64("frame_number", ref.FrameNumber),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		included, _, err := application.GetOutputsFromClockFrame(frame)
+		if err != nil {
+			e.logger.Error(
+				"could not get requests of dropped frame",
+				zap.Uint64("frame_number", ref.FrameNumber),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		requests = append(requests, included.Requests...)
+	}
+
+	e.clock.RestoreRequests(requests)
+}
@@ -0,0 +1,280 @@
+package token
+
+import (
+	"bytes"
+	gocrypto "crypto"
+	"crypto/rand"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/ed448"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
+	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+func implicitAccount(address []byte) *protobufs.AccountRef {
+	return &protobufs.AccountRef{
+		Account: &protobufs.AccountRef_ImplicitAccount{
+			ImplicitAccount: &protobufs.ImplicitAccount{Address: address},
+		},
+	}
+}
+
+func signedTransfer(
+	t *testing.T,
+	key ed448.PrivateKey,
+	coin []byte,
+	to []byte,
+) *protobufs.TokenRequest {
+	payload := append(append([]byte("transfer"), coin...), to...)
+	sig, err := key.Sign(rand.Reader, payload, gocrypto.Hash(0))
+	require.NoError(t, err)
+
+	return &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Transfer{
+			Transfer: &protobufs.TransferCoinRequest{
+				OfCoin:    &protobufs.CoinRef{Address: coin},
+				ToAccount: implicitAccount(to),
+				Signature: &protobufs.Ed448Signature{
+					PublicKey: &protobufs.Ed448PublicKey{
+						KeyValue: key.Public().(ed448.PublicKey),
+					},
+					Signature: sig,
+				},
+			},
+		},
+	}
+}
+
+func TestSimulateTokenRequests(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	db := store.NewInMemKVDB()
+	coinStore := store.NewPebbleCoinStore(db, logger)
+
+	pub, key, err := ed448.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	owner, err := poseidon.HashBytes(pub)
+	require.NoError(t, err)
+
+	coins := [][]byte{make([]byte, 32), make([]byte, 32)}
+	txn, err := coinStore.NewTransaction()
+	require.NoError(t, err)
+	for i, address := range coins {
+		address[0] = byte(i + 1)
+		require.NoError(t, coinStore.PutCoin(txn, 1, address, &protobufs.Coin{
+			Amount:       []byte{byte(i + 1)},
+			Intersection: make([]byte, 128),
+			Owner:        implicitAccount(owner.FillBytes(make([]byte, 32))),
+		}))
+	}
+	require.NoError(t, txn.Commit())
+
+	filter := make([]byte, 32)
+	dataTimeReel := time.NewDataTimeReel(
+		filter,
+		logger,
+		store.NewPebbleClockStore(db, logger),
+		&config.EngineConfig{},
+		qcrypto.NewWesolowskiFrameProver(logger),
+		clock.NewRealClock(),
+		func(txn store.Transaction, frame *protobufs.ClockFrame) error {
+			return nil
+		},
+		func(txn store.Transaction, frame *protobufs.FrameRef) error {
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+	dataTimeReel.SetHead(&protobufs.ClockFrame{Filter: filter, FrameNumber: 5})
+
+	e := &TokenExecutionEngine{
+		logger:       logger,
+		dataTimeReel: dataTimeReel,
+		coinStore:    coinStore,
+	}
+
+	to := make([]byte, 32)
+	to[0] = 0xff
+	resp, err := e.SimulateTokenRequests(&protobufs.TokenRequests{
+		Requests: []*protobufs.TokenRequest{
+			signedTransfer(t, key, coins[0], to),
+			&protobufs.TokenRequest{},
+			signedTransfer(t, key, coins[1], to),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), resp.FrameNumber)
+	require.Len(t, resp.Outcomes, 3)
+
+	unknown := resp.Outcomes[1]
+	assert.False(t, unknown.Valid)
+	assert.Contains(t, unknown.Error, "unknown request type")
+	assert.Empty(t, unknown.Outputs)
+
+	// Addresses are those of the next frame, numbered across the outcomes
+	// as the frame would number its outputs.
+	seqno := uint64(0)
+	for _, i := range []int{0, 2} {
+		outcome := resp.Outcomes[i]
+		assert.True(t, outcome.Valid)
+		assert.Empty(t, outcome.Error)
+		require.Len(t, outcome.Outputs, 2)
+		require.Len(t, outcome.Addresses, 2)
+
+		coin := outcome.Outputs[0].GetCoin()
+		require.NotNil(t, coin)
+		address, err := GetAddressOfCoin(coin, 6, seqno)
+		require.NoError(t, err)
+		assert.Equal(t, address, outcome.Addresses[0])
+		assert.Equal(t, to, coin.Owner.GetImplicitAccount().Address)
+
+		deleted := outcome.Outputs[1].GetDeletedCoin()
+		require.NotNil(t, deleted)
+		assert.Equal(t, coins[i/2], outcome.Addresses[1])
+		seqno += 2
+	}
+
+	// Nothing is applied to the coin store.
+	for _, address := range coins {
+		_, err := coinStore.GetCoinByAddress(nil, address)
+		assert.NoError(t, err)
+	}
+}
+
+func TestSimulateWorkerMint(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	coinStore := store.NewPebbleCoinStore(store.NewInMemKVDB(), logger)
+	prover := qcrypto.NewWesolowskiFrameProver(logger)
+
+	pub, key, err := ed448.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pk, err := pcrypto.UnmarshalEd448PublicKey(pub)
+	require.NoError(t, err)
+	peerId, err := peer.IDFromPublicKey(pk)
+	require.NoError(t, err)
+	addr, err := poseidon.HashBytes(pub)
+	require.NoError(t, err)
+	owner := addr.FillBytes(make([]byte, 32))
+
+	proverTrie := &tries.RollingFrecencyCritbitTrie{}
+	proverTrie.Add(owner, 0)
+
+	filter := bytes.Repeat([]byte{0xff}, 32)
+	dataTimeReel := time.NewDataTimeReel(
+		filter,
+		logger,
+		store.NewPebbleClockStore(store.NewInMemKVDB(), logger),
+		&config.EngineConfig{},
+		prover,
+		clock.NewRealClock(),
+		func(txn store.Transaction, frame *protobufs.ClockFrame) error {
+			return nil
+		},
+		func(txn store.Transaction, frame *protobufs.FrameRef) error {
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+	head := uint64(80000)
+	dataTimeReel.SetHead(&protobufs.ClockFrame{
+		Filter:      filter,
+		FrameNumber: head,
+		Difficulty:  10,
+	})
+	dataTimeReel.SetProverTries(
+		[]*tries.RollingFrecencyCritbitTrie{proverTrie},
+	)
+
+	e := &TokenExecutionEngine{
+		logger:       logger,
+		dataTimeReel: dataTimeReel,
+		coinStore:    coinStore,
+	}
+
+	// Worker proofs are followed by their challenge: the peer, the frame
+	// number, then the storage covered.
+	proofs := [][]byte{}
+	for i := uint64(0); i < 2; i++ {
+		challenge := append([]byte{}, []byte(peerId)...)
+		challenge = binary.BigEndian.AppendUint64(challenge, head-i)
+		challenge = append(challenge, filter...)
+		proof, err := prover.CalculateChallengeProof(challenge, 10)
+		require.NoError(t, err)
+		proofs = append(proofs, append(proof, challenge...))
+	}
+	forged := append([]byte{}, proofs[1]...)
+	forged[0] ^= 0xff
+
+	mint := func(proofs ...[]byte) *protobufs.TokenRequest {
+		payload := []byte("mint")
+		for _, p := range proofs {
+			payload = append(payload, p...)
+		}
+		sig, err := key.Sign(rand.Reader, payload, gocrypto.Hash(0))
+		require.NoError(t, err)
+
+		return &protobufs.TokenRequest{
+			Request: &protobufs.TokenRequest_Mint{
+				Mint: &protobufs.MintCoinRequest{
+					Proofs: proofs,
+					Signature: &protobufs.Ed448Signature{
+						PublicKey: &protobufs.Ed448PublicKey{KeyValue: pub},
+						Signature: sig,
+					},
+				},
+			},
+		}
+	}
+
+	resp, err := e.SimulateTokenRequests(&protobufs.TokenRequests{
+		Requests: []*protobufs.TokenRequest{
+			mint(proofs[0], forged),
+			mint(proofs...),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Outcomes, 2)
+
+	invalid := resp.Outcomes[0]
+	assert.False(t, invalid.Valid)
+	assert.Contains(t, invalid.Error, "invalid challenge proof")
+
+	// Each proof is credited for the storage its challenge covers, in the
+	// ring of the signer.
+	valid := resp.Outcomes[1]
+	assert.True(t, valid.Valid)
+	assert.Empty(t, valid.Error)
+	require.Len(t, valid.Outputs, 4)
+	amount := new(big.Int).Mul(
+		big.NewInt(1024),
+		big.NewInt(8000000000),
+	).FillBytes(make([]byte, 32))
+	for i, p := range proofs {
+		proof := valid.Outputs[2*i].GetProof()
+		require.NotNil(t, proof)
+		assert.Equal(t, p, proof.Proof)
+		assert.Equal(t, amount, proof.Amount)
+		assert.Equal(t, owner, proof.Owner.GetImplicitAccount().Address)
+
+		coin := valid.Outputs[2*i+1].GetCoin()
+		require.NotNil(t, coin)
+		assert.Equal(t, amount, coin.Amount)
+		assert.Equal(t, owner, coin.Owner.GetImplicitAccount().Address)
+	}
+}
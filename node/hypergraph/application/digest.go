@@ -0,0 +1,241 @@
+package application
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"hash"
+
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// HypergraphBuckets is the number of buckets a set's digest is split in,
+// atoms fall in the bucket of the first byte of the hash of their id.
+const HypergraphBuckets = 256
+
+// HypergraphSets are the add and remove sets of a shard, in digest order.
+var HypergraphSets = []byte{
+	store.HYPERGRAPH_VERTEX_ADDS,
+	store.HYPERGRAPH_VERTEX_REMOVES,
+	store.HYPERGRAPH_HYPEREDGE_ADDS,
+	store.HYPERGRAPH_HYPEREDGE_REMOVES,
+}
+
+func atomBucket(id []byte) uint32 {
+	h := sha256.Sum256(id)
+	return uint32(h[0])
+}
+
+// encodedAtomId returns the id of an atom encoded by MarshalAtom.
+func encodedAtomId(data []byte) ([]byte, error) {
+	if len(data) < 67 {
+		return nil, ErrInvalidAtom
+	}
+
+	return data[1:67], nil
+}
+
+func setAtomType(set byte) (AtomType, error) {
+	switch set {
+	case store.HYPERGRAPH_VERTEX_ADDS, store.HYPERGRAPH_VERTEX_REMOVES:
+		return "vertex", nil
+	case store.HYPERGRAPH_HYPEREDGE_ADDS, store.HYPERGRAPH_HYPEREDGE_REMOVES:
+		return "hyperedge", nil
+	default:
+		return "", ErrInvalidAtomType
+	}
+}
+
+// rangeSet calls the function with the id and encoded atom of each atom in
+// the set of the shard, in id order.
+func (hg *Hypergraph) rangeSet(
+	set byte,
+	shardAddr ShardAddress,
+	fn func(id []byte, data []byte) error,
+) error {
+	iter, err := hg.hypergraphStore.RangeAtoms(set, shardAddr.Bytes())
+	if err != nil {
+		return errors.Wrap(err, "range set")
+	}
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		data, err := iter.Value()
+		if err != nil {
+			return errors.Wrap(err, "range set")
+		}
+
+		id, err := encodedAtomId(data)
+		if err != nil {
+			return errors.Wrap(err, "range set")
+		}
+
+		if err := fn(id, data); err != nil {
+			return errors.Wrap(err, "range set")
+		}
+	}
+
+	return nil
+}
+
+// BucketDigests returns the hash of each bucket of the set in the shard, the
+// hash of the bucket's atom ids in order.
+func (hg *Hypergraph) BucketDigests(
+	set byte,
+	shardAddr ShardAddress,
+) ([][]byte, error) {
+	hashers := make([]hash.Hash, HypergraphBuckets)
+	for i := range hashers {
+		hashers[i] = sha256.New()
+	}
+
+	if err := hg.rangeSet(
+		set,
+		shardAddr,
+		func(id []byte, data []byte) error {
+			_, err := hashers[atomBucket(id)].Write(id)
+			return err
+		},
+	); err != nil {
+		return nil, errors.Wrap(err, "bucket digests")
+	}
+
+	digests := make([][]byte, HypergraphBuckets)
+	for i, h := range hashers {
+		digests[i] = h.Sum(nil)
+	}
+
+	return digests, nil
+}
+
+// SetRoot hashes the bucket digests of a set into its root.
+func SetRoot(bucketDigests [][]byte) []byte {
+	h := sha256.New()
+	for _, digest := range bucketDigests {
+		h.Write(digest)
+	}
+
+	return h.Sum(nil)
+}
+
+var emptySetRoot = func() []byte {
+	digests := make([][]byte, HypergraphBuckets)
+	for i := range digests {
+		h := sha256.Sum256(nil)
+		digests[i] = h[:]
+	}
+
+	return SetRoot(digests)
+}()
+
+// IsEmptySetRoot checks if the root is the root of a set without atoms.
+func IsEmptySetRoot(root []byte) bool {
+	return bytes.Equal(root, emptySetRoot)
+}
+
+// ShardDigest returns the root of each set of the shard, in HypergraphSets
+// order. Replicas holding the same shard state have the same digest.
+func (hg *Hypergraph) ShardDigest(shardAddr ShardAddress) ([][]byte, error) {
+	roots := [][]byte{}
+	for _, set := range HypergraphSets {
+		digests, err := hg.BucketDigests(set, shardAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "shard digest")
+		}
+
+		roots = append(roots, SetRoot(digests))
+	}
+
+	return roots, nil
+}
+
+// AtomIds returns the ids of the atoms of the set in the shard that fall in
+// the buckets, in id order.
+func (hg *Hypergraph) AtomIds(
+	set byte,
+	shardAddr ShardAddress,
+	buckets []uint32,
+) ([][]byte, error) {
+	wanted := map[uint32]bool{}
+	for _, bucket := range buckets {
+		wanted[bucket] = true
+	}
+
+	ids := [][]byte{}
+	if err := hg.rangeSet(
+		set,
+		shardAddr,
+		func(id []byte, data []byte) error {
+			if wanted[atomBucket(id)] {
+				ids = append(ids, id)
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, errors.Wrap(err, "atom ids")
+	}
+
+	return ids, nil
+}
+
+// GetEncodedAtoms returns the encoded atoms of the set in the shard with the
+// ids, skipping the ids the set doesn't hold.
+func (hg *Hypergraph) GetEncodedAtoms(
+	set byte,
+	shardAddr ShardAddress,
+	ids [][]byte,
+) ([][]byte, error) {
+	atoms := [][]byte{}
+	for _, id := range ids {
+		data, err := hg.hypergraphStore.GetAtom(nil, set, shardAddr.Bytes(), id)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				continue
+			}
+
+			return nil, errors.Wrap(err, "get encoded atoms")
+		}
+
+		atoms = append(atoms, data)
+	}
+
+	return atoms, nil
+}
+
+// MergeAtoms adds atoms received from another replica to the set of the
+// shard. Sets only grow, so merging is the union of the replicas' sets and
+// skips the checks local mutations make: a hyperedge whose extrinsics have
+// not arrived yet is added, and is found once they are.
+func (hg *Hypergraph) MergeAtoms(
+	set byte,
+	shardAddr ShardAddress,
+	atoms []Atom,
+) error {
+	atomType, err := setAtomType(set)
+	if err != nil {
+		return errors.Wrap(err, "merge atoms")
+	}
+
+	for _, atom := range atoms {
+		if atom.GetAtomType() != atomType {
+			return errors.Wrap(ErrInvalidAtomType, "merge atoms")
+		}
+
+		if GetShardAddress(atom) != shardAddr {
+			return errors.Wrap(ErrInvalidAtom, "merge atoms")
+		}
+	}
+
+	return errors.Wrap(
+		hg.update(func(txn *HypergraphTransaction) error {
+			for _, atom := range atoms {
+				if err := txn.apply(set, atom); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}),
+		"merge atoms",
+	)
+}
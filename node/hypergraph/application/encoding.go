@@ -0,0 +1,128 @@
+package application
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidAtom = errors.New("invalid atom")
+
+const (
+	vertexAtomTag    = 0x00
+	hyperedgeAtomTag = 0x01
+)
+
+// Hyperedges nest, bound how deep an encoded atom may go.
+const maxAtomDepth = 64
+
+// MarshalAtom encodes the atom as its type tag and id, followed for a
+// hyperedge by its extrinsics in id order, nested hyperedges with their own.
+func MarshalAtom(a Atom) ([]byte, error) {
+	return appendAtom(nil, a, 0)
+}
+
+func appendAtom(data []byte, a Atom, depth int) ([]byte, error) {
+	if depth > maxAtomDepth {
+		return nil, errors.Wrap(ErrInvalidAtom, "append atom")
+	}
+
+	id := a.GetID()
+	switch atom := a.(type) {
+	case *Vertex:
+		data = append(data, vertexAtomTag)
+		data = append(data, id[:]...)
+	case *Hyperedge:
+		data = append(data, hyperedgeAtomTag)
+		data = append(data, id[:]...)
+		data = binary.BigEndian.AppendUint32(data, uint32(len(atom.Extrinsics)))
+
+		ids := make([][66]byte, 0, len(atom.Extrinsics))
+		for extrinsicId := range atom.Extrinsics {
+			ids = append(ids, extrinsicId)
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			return bytes.Compare(ids[i][:], ids[j][:]) < 0
+		})
+
+		var err error
+		for _, extrinsicId := range ids {
+			data, err = appendAtom(data, atom.Extrinsics[extrinsicId], depth+1)
+			if err != nil {
+				return nil, errors.Wrap(err, "append atom")
+			}
+		}
+	default:
+		return nil, errors.Wrap(ErrInvalidAtomType, "append atom")
+	}
+
+	return data, nil
+}
+
+// UnmarshalAtom decodes an atom encoded by MarshalAtom.
+func UnmarshalAtom(data []byte) (Atom, error) {
+	atom, rest, err := readAtom(data, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal atom")
+	}
+
+	if len(rest) != 0 {
+		return nil, errors.Wrap(ErrInvalidAtom, "unmarshal atom")
+	}
+
+	return atom, nil
+}
+
+func readAtom(data []byte, depth int) (Atom, []byte, error) {
+	if depth > maxAtomDepth || len(data) < 67 {
+		return nil, nil, ErrInvalidAtom
+	}
+
+	tag := data[0]
+	id := [66]byte(data[1:67])
+	data = data[67:]
+
+	switch tag {
+	case vertexAtomTag:
+		return &Vertex{
+			AppAddress:   [32]byte(id[:32]),
+			DataAddress:  [32]byte(id[32:64]),
+			SegmentOrder: binary.BigEndian.Uint16(id[64:]),
+		}, data, nil
+	case hyperedgeAtomTag:
+		if len(data) < 4 {
+			return nil, nil, ErrInvalidAtom
+		}
+
+		count := binary.BigEndian.Uint32(data[:4])
+		data = data[4:]
+
+		// Every extrinsic takes at least a tag and an id.
+		if uint64(count)*67 > uint64(len(data)) {
+			return nil, nil, ErrInvalidAtom
+		}
+
+		h := &Hyperedge{
+			AppAddress:  [32]byte(id[:32]),
+			DataAddress: [32]byte(id[32:64]),
+			Index:       binary.BigEndian.Uint16(id[64:]),
+			Extrinsics:  make(map[[66]byte]Atom, count),
+		}
+		for i := uint32(0); i < count; i++ {
+			var extrinsic Atom
+			var err error
+			extrinsic, data, err = readAtom(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			h.Extrinsics[extrinsic.GetID()] = extrinsic
+		}
+
+		return h, data, nil
+	default:
+		return nil, nil, ErrInvalidAtom
+	}
+}
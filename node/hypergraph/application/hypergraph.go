@@ -2,9 +2,11 @@ package application
 
 import (
 	"encoding/binary"
-	"errors"
 
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
 )
 
 type AtomType string
@@ -105,6 +107,11 @@ func GetShardAddress(a Atom) ShardAddress {
 	}
 }
 
+// Bytes returns the shard address as laid out in the store's keys.
+func (s ShardAddress) Bytes() []byte {
+	return append(append([]byte{}, s.L1[:]...), s.L2[:]...)
+}
+
 type IdSet struct {
 	atomType AtomType
 	atoms    map[[66]byte]Atom
@@ -137,167 +144,521 @@ func (set *IdSet) Has(atom Atom) bool {
 	return exists
 }
 
+// Hypergraph is a two-phase set CRDT of vertices and hyperedges, whose add
+// and remove sets are kept per shard in a HypergraphStore.
 type Hypergraph struct {
-	vertexAdds       map[ShardAddress]*IdSet
-	vertexRemoves    map[ShardAddress]*IdSet
-	hyperedgeAdds    map[ShardAddress]*IdSet
-	hyperedgeRemoves map[ShardAddress]*IdSet
+	hypergraphStore store.HypergraphStore
 }
 
+// NewHypergraph returns a hypergraph held in memory.
 func NewHypergraph() *Hypergraph {
+	return NewPersistentHypergraph(
+		store.NewPebbleHypergraphStore(store.NewInMemKVDB(), zap.NewNop()),
+	)
+}
+
+// NewPersistentHypergraph returns a hypergraph kept in the store, with the
+// state the store already holds.
+func NewPersistentHypergraph(
+	hypergraphStore store.HypergraphStore,
+) *Hypergraph {
 	return &Hypergraph{
-		vertexAdds:       make(map[ShardAddress]*IdSet),
-		vertexRemoves:    make(map[ShardAddress]*IdSet),
-		hyperedgeAdds:    make(map[ShardAddress]*IdSet),
-		hyperedgeRemoves: make(map[ShardAddress]*IdSet),
+		hypergraphStore: hypergraphStore,
 	}
 }
 
-func (hg *Hypergraph) getOrCreateIdSet(shardAddr ShardAddress, addMap map[ShardAddress]*IdSet, removeMap map[ShardAddress]*IdSet, atomType AtomType) (*IdSet, *IdSet) {
-	if _, ok := addMap[shardAddr]; !ok {
-		addMap[shardAddr] = NewIdSet(atomType)
-	}
-	if _, ok := removeMap[shardAddr]; !ok {
-		removeMap[shardAddr] = NewIdSet(atomType)
+// HypergraphTransaction batches mutations of the hypergraph, applied
+// atomically on Commit. Lookups made by the mutations see the earlier
+// mutations of the transaction.
+type HypergraphTransaction struct {
+	hg  *Hypergraph
+	txn store.Transaction
+	// hyperedges added by the transaction, not yet visible to iteration.
+	hyperedges []*Hyperedge
+}
+
+func (hg *Hypergraph) NewTransaction() (*HypergraphTransaction, error) {
+	txn, err := hg.hypergraphStore.NewTransaction()
+	if err != nil {
+		return nil, errors.Wrap(err, "new transaction")
 	}
-	return addMap[shardAddr], removeMap[shardAddr]
+
+	return &HypergraphTransaction{hg: hg, txn: txn}, nil
 }
 
-func (hg *Hypergraph) AddVertex(v *Vertex) error {
-	shardAddr := GetShardAddress(v)
-	addSet, _ := hg.getOrCreateIdSet(shardAddr, hg.vertexAdds, hg.vertexRemoves, "vertex")
-	return addSet.Add(v)
+func (t *HypergraphTransaction) Commit() error {
+	return errors.Wrap(t.txn.Commit(), "commit")
 }
 
-func (hg *Hypergraph) AddHyperedge(h *Hyperedge) error {
-	if !hg.LookupAtomSet(h.Extrinsics) {
+func (t *HypergraphTransaction) Abort() error {
+	return errors.Wrap(t.txn.Abort(), "abort")
+}
+
+func (t *HypergraphTransaction) AddVertex(v *Vertex) error {
+	return t.apply(store.HYPERGRAPH_VERTEX_ADDS, v)
+}
+
+func (t *HypergraphTransaction) AddHyperedge(h *Hyperedge) error {
+	found, err := t.hg.lookupAtomSet(t.txn, h.Extrinsics)
+	if err != nil {
+		return errors.Wrap(err, "add hyperedge")
+	}
+	if !found {
 		return ErrMissingExtrinsics
 	}
-	shardAddr := GetShardAddress(h)
-	addSet, _ := hg.getOrCreateIdSet(shardAddr, hg.hyperedgeAdds, hg.hyperedgeRemoves, "hyperedge")
-	return addSet.Add(h)
-}
 
-func (hg *Hypergraph) RemoveVertex(v *Vertex) error {
-	shardAddr := GetShardAddress(v)
+	if err := t.apply(store.HYPERGRAPH_HYPEREDGE_ADDS, h); err != nil {
+		return err
+	}
+
+	t.hyperedges = append(t.hyperedges, h)
+	return nil
+}
 
-	if !hg.LookupVertex(v) {
-		_, removeSet := hg.getOrCreateIdSet(shardAddr, hg.vertexAdds, hg.vertexRemoves, "vertex")
-		return removeSet.Add(v)
+func (t *HypergraphTransaction) RemoveVertex(v *Vertex) error {
+	found, err := t.hg.lookupVertex(t.txn, v)
+	if err != nil {
+		return errors.Wrap(err, "remove vertex")
+	}
+	if found {
+		extrinsic, err := t.isExtrinsic(v)
+		if err != nil {
+			return err
+		}
+		if extrinsic {
+			return ErrIsExtrinsic
+		}
 	}
 
-	for _, hyperedgeAdds := range hg.hyperedgeAdds {
-		for _, atom := range hyperedgeAdds.atoms {
-			if he, ok := atom.(*Hyperedge); ok {
-				if _, ok := he.Extrinsics[v.GetID()]; ok {
-					return ErrIsExtrinsic
-				}
-			}
+	return t.apply(store.HYPERGRAPH_VERTEX_REMOVES, v)
+}
+
+func (t *HypergraphTransaction) RemoveHyperedge(h *Hyperedge) error {
+	found, err := t.hg.lookupHyperedge(t.txn, h)
+	if err != nil {
+		return errors.Wrap(err, "remove hyperedge")
+	}
+	if found {
+		extrinsic, err := t.isExtrinsic(h)
+		if err != nil {
+			return err
+		}
+		if extrinsic {
+			return ErrIsExtrinsic
 		}
 	}
-	_, removeSet := hg.getOrCreateIdSet(shardAddr, hg.vertexAdds, hg.vertexRemoves, "vertex")
-	return removeSet.Add(v)
+
+	return t.apply(store.HYPERGRAPH_HYPEREDGE_REMOVES, h)
 }
 
-func (hg *Hypergraph) RemoveHyperedge(h *Hyperedge) error {
-	shardAddr := GetShardAddress(h)
+func (t *HypergraphTransaction) put(set byte, a Atom) error {
+	data, err := MarshalAtom(a)
+	if err != nil {
+		return errors.Wrap(err, "put")
+	}
+
+	id := a.GetID()
+	return errors.Wrap(
+		t.hg.hypergraphStore.PutAtom(
+			t.txn,
+			set,
+			GetShardAddress(a).Bytes(),
+			id[:],
+			data,
+		),
+		"put",
+	)
+}
+
+// isExtrinsic checks if any hyperedge added and not removed has the atom as
+// an extrinsic. The incidence index only lists committed hyperedges, so the
+// ones added by the transaction are checked separately, and removals by the
+// transaction are checked against it.
+func (t *HypergraphTransaction) isExtrinsic(a Atom) (bool, error) {
+	for _, he := range t.hyperedges {
+		if _, ok := he.Extrinsics[a.GetID()]; !ok {
+			continue
+		}
 
-	if !hg.LookupHyperedge(h) {
-		_, removeSet := hg.getOrCreateIdSet(shardAddr, hg.hyperedgeAdds, hg.hyperedgeRemoves, "hyperedge")
-		return removeSet.Add(h)
+		removed, err := t.hg.has(t.txn, store.HYPERGRAPH_HYPEREDGE_REMOVES, he)
+		if err != nil {
+			return false, errors.Wrap(err, "is extrinsic")
+		}
+		if !removed {
+			return true, nil
+		}
 	}
 
-	for _, hyperedgeAdds := range hg.hyperedgeAdds {
-		for _, atom := range hyperedgeAdds.atoms {
-			if he, ok := atom.(*Hyperedge); ok {
-				if _, ok := he.Extrinsics[h.GetID()]; ok {
-					return ErrIsExtrinsic
-				}
-			}
+	id := a.GetID()
+	iter, err := t.hg.hypergraphStore.RangeIndex(
+		store.HYPERGRAPH_INCIDENCE_INDEX,
+		id[:],
+		nil,
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "is extrinsic")
+	}
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		hyperedgeId := [66]byte(iter.Key()[66:])
+		removed, err := t.hg.hasId(
+			t.txn,
+			store.HYPERGRAPH_HYPEREDGE_REMOVES,
+			hyperedgeId,
+		)
+		if err != nil {
+			return false, errors.Wrap(err, "is extrinsic")
+		}
+		if !removed {
+			return true, nil
 		}
 	}
-	_, removeSet := hg.getOrCreateIdSet(shardAddr, hg.hyperedgeAdds, hg.hyperedgeRemoves, "hyperedge")
-	return removeSet.Add(h)
+
+	return false, nil
+}
+
+// update applies the mutation in its own transaction.
+func (hg *Hypergraph) update(
+	mutate func(txn *HypergraphTransaction) error,
+) error {
+	txn, err := hg.NewTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := mutate(txn); err != nil {
+		txn.Abort()
+		return err
+	}
+
+	return txn.Commit()
+}
+
+func (hg *Hypergraph) AddVertex(v *Vertex) error {
+	return hg.update(func(txn *HypergraphTransaction) error {
+		return txn.AddVertex(v)
+	})
 }
 
-func (hg *Hypergraph) LookupVertex(v *Vertex) bool {
-	shardAddr := GetShardAddress(v)
-	addSet, removeSet := hg.getOrCreateIdSet(shardAddr, hg.vertexAdds, hg.vertexRemoves, "vertex")
-	return addSet.Has(v) && !removeSet.Has(v)
+func (hg *Hypergraph) AddHyperedge(h *Hyperedge) error {
+	return hg.update(func(txn *HypergraphTransaction) error {
+		return txn.AddHyperedge(h)
+	})
 }
 
-func (hg *Hypergraph) LookupHyperedge(h *Hyperedge) bool {
-	shardAddr := GetShardAddress(h)
-	addSet, removeSet := hg.getOrCreateIdSet(shardAddr, hg.hyperedgeAdds, hg.hyperedgeRemoves, "hyperedge")
-	return hg.LookupAtomSet(h.Extrinsics) && addSet.Has(h) && !removeSet.Has(h)
+func (hg *Hypergraph) RemoveVertex(v *Vertex) error {
+	return hg.update(func(txn *HypergraphTransaction) error {
+		return txn.RemoveVertex(v)
+	})
 }
 
-func (hg *Hypergraph) LookupAtom(a Atom) bool {
+func (hg *Hypergraph) RemoveHyperedge(h *Hyperedge) error {
+	return hg.update(func(txn *HypergraphTransaction) error {
+		return txn.RemoveHyperedge(h)
+	})
+}
+
+// has checks if the set holds the atom, through the transaction if given.
+func (hg *Hypergraph) has(
+	txn store.Transaction,
+	set byte,
+	a Atom,
+) (bool, error) {
+	return hg.hasId(txn, set, a.GetID())
+}
+
+// hasId checks if the set holds the atom with the id, through the transaction
+// if given.
+func (hg *Hypergraph) hasId(
+	txn store.Transaction,
+	set byte,
+	id [66]byte,
+) (bool, error) {
+	_, err := hg.hypergraphStore.GetAtom(
+		txn,
+		set,
+		shardAddressOfId(id).Bytes(),
+		id[:],
+	)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, "has")
+	}
+
+	return true, nil
+}
+
+// hasAdded checks if the atom is in the add set and not the remove set.
+func (hg *Hypergraph) hasAdded(
+	txn store.Transaction,
+	addSet byte,
+	removeSet byte,
+	a Atom,
+) (bool, error) {
+	added, err := hg.has(txn, addSet, a)
+	if err != nil || !added {
+		return false, err
+	}
+
+	removed, err := hg.has(txn, removeSet, a)
+	return !removed, err
+}
+
+func (hg *Hypergraph) lookupVertex(
+	txn store.Transaction,
+	v *Vertex,
+) (bool, error) {
+	found, err := hg.hasAdded(
+		txn,
+		store.HYPERGRAPH_VERTEX_ADDS,
+		store.HYPERGRAPH_VERTEX_REMOVES,
+		v,
+	)
+	return found, errors.Wrap(err, "lookup vertex")
+}
+
+func (hg *Hypergraph) lookupHyperedge(
+	txn store.Transaction,
+	h *Hyperedge,
+) (bool, error) {
+	found, err := hg.lookupAtomSet(txn, h.Extrinsics)
+	if err != nil || !found {
+		return false, errors.Wrap(err, "lookup hyperedge")
+	}
+
+	found, err = hg.hasAdded(
+		txn,
+		store.HYPERGRAPH_HYPEREDGE_ADDS,
+		store.HYPERGRAPH_HYPEREDGE_REMOVES,
+		h,
+	)
+	return found, errors.Wrap(err, "lookup hyperedge")
+}
+
+func (hg *Hypergraph) lookupAtom(
+	txn store.Transaction,
+	a Atom,
+) (bool, error) {
 	switch v := a.(type) {
 	case *Vertex:
-		return hg.LookupVertex(v)
+		return hg.lookupVertex(txn, v)
 	case *Hyperedge:
-		return hg.LookupHyperedge(v)
+		return hg.lookupHyperedge(txn, v)
 	default:
-		return false
+		return false, nil
 	}
 }
 
-func (hg *Hypergraph) LookupAtomSet(atomSet map[[66]byte]Atom) bool {
+func (hg *Hypergraph) lookupAtomSet(
+	txn store.Transaction,
+	atomSet map[[66]byte]Atom,
+) (bool, error) {
 	for _, atom := range atomSet {
-		if !hg.LookupAtom(atom) {
-			return false
+		found, err := hg.lookupAtom(txn, atom)
+		if err != nil || !found {
+			return false, err
 		}
 	}
-	return true
+	return true, nil
+}
+
+func (hg *Hypergraph) LookupVertex(v *Vertex) (bool, error) {
+	return hg.lookupVertex(nil, v)
 }
 
-func (hg *Hypergraph) Within(a, h Atom) bool {
+func (hg *Hypergraph) LookupHyperedge(h *Hyperedge) (bool, error) {
+	return hg.lookupHyperedge(nil, h)
+}
+
+func (hg *Hypergraph) LookupAtom(a Atom) (bool, error) {
+	return hg.lookupAtom(nil, a)
+}
+
+func (hg *Hypergraph) LookupAtomSet(atomSet map[[66]byte]Atom) (bool, error) {
+	return hg.lookupAtomSet(nil, atomSet)
+}
+
+func (hg *Hypergraph) Within(a, h Atom) (bool, error) {
 	if he, ok := h.(*Hyperedge); ok {
 		if _, ok := he.Extrinsics[a.GetID()]; ok || a.GetID() == h.GetID() {
-			return true
+			return true, nil
 		}
 		for _, extrinsic := range he.Extrinsics {
 			if nestedHe, ok := extrinsic.(*Hyperedge); ok {
-				if hg.LookupHyperedge(nestedHe) && hg.Within(a, nestedHe) {
-					return true
+				found, err := hg.LookupHyperedge(nestedHe)
+				if err != nil {
+					return false, errors.Wrap(err, "within")
+				}
+				if !found {
+					continue
+				}
+
+				within, err := hg.Within(a, nestedHe)
+				if err != nil || within {
+					return within, err
 				}
 			}
 		}
 	}
-	return false
+	return false, nil
+}
+
+// RangeReconciledVertices iterates the vertices of the shard that have been
+// added but not removed, in id order.
+func (hg *Hypergraph) RangeReconciledVertices(
+	shardAddr ShardAddress,
+) (*AtomIterator, error) {
+	iter, err := hg.rangeReconciled(
+		store.HYPERGRAPH_VERTEX_ADDS,
+		store.HYPERGRAPH_VERTEX_REMOVES,
+		shardAddr,
+	)
+	return iter, errors.Wrap(err, "range reconciled vertices")
+}
+
+// RangeReconciledHyperedges iterates the hyperedges of the shard that have
+// been added but not removed, in id order.
+func (hg *Hypergraph) RangeReconciledHyperedges(
+	shardAddr ShardAddress,
+) (*AtomIterator, error) {
+	iter, err := hg.rangeReconciled(
+		store.HYPERGRAPH_HYPEREDGE_ADDS,
+		store.HYPERGRAPH_HYPEREDGE_REMOVES,
+		shardAddr,
+	)
+	return iter, errors.Wrap(err, "range reconciled hyperedges")
+}
+
+func (hg *Hypergraph) rangeReconciled(
+	addSet byte,
+	removeSet byte,
+	shardAddr ShardAddress,
+) (*AtomIterator, error) {
+	iter, err := hg.hypergraphStore.RangeAtoms(addSet, shardAddr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &AtomIterator{
+		hg:        hg,
+		i:         iter,
+		removeSet: removeSet,
+	}, nil
 }
 
 // GetReconciledVertexSetForShard computes the set of vertices that have been added but
 // not removed for a specific shard.
-func (hg *Hypergraph) GetReconciledVertexSetForShard(shardAddr ShardAddress) *IdSet {
-	vertices := NewIdSet("vertex")
-
-	if addSet, ok := hg.vertexAdds[shardAddr]; ok {
-		removeSet := hg.vertexRemoves[shardAddr]
-		for _, v := range addSet.atoms {
-			if !removeSet.Has(v) {
-				vertices.Add(v)
-			}
-		}
+func (hg *Hypergraph) GetReconciledVertexSetForShard(
+	shardAddr ShardAddress,
+) (*IdSet, error) {
+	iter, err := hg.RangeReconciledVertices(shardAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "get reconciled vertex set for shard")
 	}
 
-	return vertices
+	set, err := collectIdSet("vertex", iter)
+	return set, errors.Wrap(err, "get reconciled vertex set for shard")
 }
 
 // GetReconciledHyperedgeSetForShard computes the set of hyperedges that have been added
 // but not removed for a specific shard.
-func (hg *Hypergraph) GetReconciledHyperedgeSetForShard(shardAddr ShardAddress) *IdSet {
-	hyperedges := NewIdSet("hyperedge")
-
-	if addSet, ok := hg.hyperedgeAdds[shardAddr]; ok {
-		removeSet := hg.hyperedgeRemoves[shardAddr]
-		for _, h := range addSet.atoms {
-			if !removeSet.Has(h) {
-				hyperedges.Add(h)
-			}
+func (hg *Hypergraph) GetReconciledHyperedgeSetForShard(
+	shardAddr ShardAddress,
+) (*IdSet, error) {
+	iter, err := hg.RangeReconciledHyperedges(shardAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "get reconciled hyperedge set for shard")
+	}
+
+	set, err := collectIdSet("hyperedge", iter)
+	return set, errors.Wrap(err, "get reconciled hyperedge set for shard")
+}
+
+func collectIdSet(atomType AtomType, iter *AtomIterator) (*IdSet, error) {
+	defer iter.Close()
+
+	set := NewIdSet(atomType)
+	for iter.First(); iter.Valid(); iter.Next() {
+		atom, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "collect id set")
+		}
+
+		set.Add(atom)
+	}
+
+	return set, nil
+}
+
+// AtomIterator iterates the atoms of a shard's add set, skipping the atoms in
+// the matching remove set.
+type AtomIterator struct {
+	hg        *Hypergraph
+	i         *store.PebbleHypergraphIterator
+	removeSet byte
+	atom      Atom
+	err       error
+}
+
+func (a *AtomIterator) First() bool {
+	a.i.First()
+	return a.skipRemoved()
+}
+
+func (a *AtomIterator) Next() bool {
+	a.i.Next()
+	return a.skipRemoved()
+}
+
+func (a *AtomIterator) Valid() bool {
+	return a.i.Valid()
+}
+
+func (a *AtomIterator) Value() (Atom, error) {
+	if !a.i.Valid() {
+		return nil, store.ErrNotFound
+	}
+
+	return a.atom, a.err
+}
+
+func (a *AtomIterator) Close() error {
+	return errors.Wrap(a.i.Close(), "closing atom iterator")
+}
+
+// skipRemoved advances to the first atom not removed, stopping at an atom
+// that can't be decoded so Value reports it.
+func (a *AtomIterator) skipRemoved() bool {
+	for ; a.i.Valid(); a.i.Next() {
+		a.atom, a.err = nil, nil
+
+		data, err := a.i.Value()
+		if err != nil {
+			a.err = err
+			return true
+		}
+
+		atom, err := UnmarshalAtom(data)
+		if err != nil {
+			a.err = err
+			return true
+		}
+
+		removed, err := a.hg.has(nil, a.removeSet, atom)
+		if err != nil {
+			a.err = err
+			return true
+		}
+
+		if !removed {
+			a.atom = atom
+			return true
 		}
 	}
 
-	return hyperedges
+	return false
 }
@@ -15,6 +15,17 @@ type Operation struct {
 }
 
 func TestConvergence(t *testing.T) {
+	for name, newHypergraph := range hypergraphBackends {
+		t.Run(name, func(t *testing.T) {
+			testConvergence(t, newHypergraph)
+		})
+	}
+}
+
+func testConvergence(
+	t *testing.T,
+	newHypergraph func(t *testing.T) *application.Hypergraph,
+) {
 	numParties := 3
 	numOperations := 100
 
@@ -67,7 +78,7 @@ func TestConvergence(t *testing.T) {
 	// Create CRDTs for each party
 	crdts := make([]*application.Hypergraph, numParties)
 	for i := 0; i < numParties; i++ {
-		crdts[i] = application.NewHypergraph()
+		crdts[i] = newHypergraph(t)
 	}
 
 	// Apply operations in different orders for each party
@@ -105,18 +116,18 @@ func TestConvergence(t *testing.T) {
 	// Verify that all CRDTs have converged to the same state
 	// Additional verification: check specific vertices and hyperedges
 	for _, v := range vertices {
-		state := crdts[0].LookupVertex(v)
+		state := lookupVertex(t, crdts[0], v)
 		for i := 1; i < numParties; i++ {
-			if crdts[i].LookupVertex(v) != state {
+			if lookupVertex(t, crdts[i], v) != state {
 				t.Errorf("Vertex %v has different state in CRDT %d", v, i)
 			}
 		}
 	}
 
 	for _, h := range hyperedges {
-		state := crdts[0].LookupHyperedge(h)
+		state := lookupHyperedge(t, crdts[0], h)
 		for i := 1; i < numParties; i++ {
-			if crdts[i].LookupHyperedge(h) != state {
+			if lookupHyperedge(t, crdts[i], h) != state {
 				t.Errorf("Hyperedge %v has different state in CRDT %d", h, i)
 			}
 		}
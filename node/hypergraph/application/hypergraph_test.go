@@ -4,11 +4,64 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/hypergraph/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
 )
 
+// hypergraphBackends builds a hypergraph on each store the tests run against.
+var hypergraphBackends = map[string]func(t *testing.T) *application.Hypergraph{
+	"InMem": func(t *testing.T) *application.Hypergraph {
+		return application.NewHypergraph()
+	},
+	"Pebble": func(t *testing.T) *application.Hypergraph {
+		return application.NewPersistentHypergraph(newPebbleHypergraphStore(t))
+	},
+}
+
+func newPebbleHypergraphStore(t *testing.T) *store.PebbleHypergraphStore {
+	db := store.NewPebbleDB(&config.DBConfig{Path: t.TempDir()})
+	t.Cleanup(func() { db.Close() })
+	return store.NewPebbleHypergraphStore(db, zap.NewNop())
+}
+
+func lookupVertex(
+	t *testing.T,
+	hg *application.Hypergraph,
+	v *application.Vertex,
+) bool {
+	found, err := hg.LookupVertex(v)
+	assert.NoError(t, err)
+	return found
+}
+
+func lookupHyperedge(
+	t *testing.T,
+	hg *application.Hypergraph,
+	h *application.Hyperedge,
+) bool {
+	found, err := hg.LookupHyperedge(h)
+	assert.NoError(t, err)
+	return found
+}
+
+func within(t *testing.T, hg *application.Hypergraph, a, h application.Atom) bool {
+	found, err := hg.Within(a, h)
+	assert.NoError(t, err)
+	return found
+}
+
 func TestHypergraph(t *testing.T) {
-	hg := application.NewHypergraph()
+	for name, newHypergraph := range hypergraphBackends {
+		t.Run(name, func(t *testing.T) {
+			testHypergraph(t, newHypergraph(t))
+		})
+	}
+}
+
+func testHypergraph(t *testing.T, hg *application.Hypergraph) {
 
 	// Test vertex operations
 	t.Run("Vertex Operations", func(t *testing.T) {
@@ -26,10 +79,10 @@ func TestHypergraph(t *testing.T) {
 		}
 
 		// Lookup vertices
-		if !hg.LookupVertex(v1) {
+		if !lookupVertex(t, hg, v1) {
 			t.Error("Failed to lookup vertex v1")
 		}
-		if !hg.LookupVertex(v2) {
+		if !lookupVertex(t, hg, v2) {
 			t.Error("Failed to lookup vertex v2")
 		}
 
@@ -38,10 +91,10 @@ func TestHypergraph(t *testing.T) {
 		if err != nil {
 			t.Errorf("Failed to remove vertex v1: %v", err)
 		}
-		if hg.LookupVertex(v1) {
+		if lookupVertex(t, hg, v1) {
 			t.Error("Vertex v1 still exists after removal")
 		}
-		if !hg.LookupVertex(v2) {
+		if !lookupVertex(t, hg, v2) {
 			t.Error("Vertex v2 was incorrectly removed")
 		}
 	})
@@ -66,7 +119,7 @@ func TestHypergraph(t *testing.T) {
 		}
 
 		// Lookup hyperedge
-		if !hg.LookupHyperedge(h1) {
+		if !lookupHyperedge(t, hg, h1) {
 			t.Error("Failed to lookup hyperedge h1")
 		}
 
@@ -75,7 +128,7 @@ func TestHypergraph(t *testing.T) {
 		if err != nil {
 			t.Errorf("Failed to remove hyperedge h1: %v", err)
 		}
-		if hg.LookupHyperedge(h1) {
+		if lookupHyperedge(t, hg, h1) {
 			t.Error("Hyperedge h1 still exists after removal")
 		}
 	})
@@ -94,16 +147,16 @@ func TestHypergraph(t *testing.T) {
 		}
 		hg.AddHyperedge(h2)
 
-		if !hg.Within(v5, h2) {
+		if !within(t, hg, v5, h2) {
 			t.Error("v5 should be within h2")
 		}
-		if !hg.Within(v6, h2) {
+		if !within(t, hg, v6, h2) {
 			t.Error("v6 should be within h2")
 		}
 
 		v7 := &application.Vertex{AppAddress: [32]byte{4}, DataAddress: [32]byte{3}, SegmentOrder: 1}
 		hg.AddVertex(v7)
-		if hg.Within(v7, h2) {
+		if within(t, hg, v7, h2) {
 			t.Error("v7 should not be within h2")
 		}
 	})
@@ -128,10 +181,10 @@ func TestHypergraph(t *testing.T) {
 		hg.AddHyperedge(h3)
 		hg.AddHyperedge(h4)
 
-		if !hg.Within(v8, h4) {
+		if !within(t, hg, v8, h4) {
 			t.Error("v8 should be within h4 (nested)")
 		}
-		if !hg.Within(v9, h4) {
+		if !within(t, hg, v9, h4) {
 			t.Error("v9 should be within h4 (direct)")
 		}
 	})
@@ -160,6 +213,14 @@ func TestHypergraph(t *testing.T) {
 		if err != application.ErrIsExtrinsic {
 			t.Errorf("Expected ErrIsExtrinsic, got %v", err)
 		}
+
+		// Once the hyperedge is removed the vertex no longer is an extrinsic
+		if err := hg.RemoveHyperedge(h5); err != nil {
+			t.Errorf("Failed to remove hyperedge h5: %v", err)
+		}
+		if err := hg.RemoveVertex(v10); err != nil {
+			t.Errorf("Failed to remove vertex v10: %v", err)
+		}
 	})
 
 	// Test sharding
@@ -178,3 +239,122 @@ func TestHypergraph(t *testing.T) {
 		}
 	})
 }
+
+func TestHypergraphPersistence(t *testing.T) {
+	path := t.TempDir()
+	db := store.NewPebbleDB(&config.DBConfig{Path: path})
+	hg := application.NewPersistentHypergraph(
+		store.NewPebbleHypergraphStore(db, zap.NewNop()),
+	)
+
+	v1 := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{1}, SegmentOrder: 1}
+	v2 := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{1}, SegmentOrder: 2}
+	v3 := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{1}, SegmentOrder: 3}
+	h1 := &application.Hyperedge{
+		AppAddress:  [32]byte{1},
+		DataAddress: [32]byte{1},
+		Extrinsics:  map[[66]byte]application.Atom{v1.GetID(): v1, v2.GetID(): v2},
+	}
+
+	txn, err := hg.NewTransaction()
+	assert.NoError(t, err)
+	assert.NoError(t, txn.AddVertex(v1))
+	assert.NoError(t, txn.AddVertex(v2))
+	assert.NoError(t, txn.AddVertex(v3))
+	// The hyperedge sees the vertices added earlier in the transaction.
+	assert.NoError(t, txn.AddHyperedge(h1))
+	assert.ErrorIs(t, txn.RemoveVertex(v1), application.ErrIsExtrinsic)
+	assert.NoError(t, txn.RemoveVertex(v3))
+	assert.False(t, lookupVertex(t, hg, v1))
+	assert.NoError(t, txn.Commit())
+
+	txn, err = hg.NewTransaction()
+	assert.NoError(t, err)
+	assert.NoError(t, txn.RemoveHyperedge(h1))
+	assert.NoError(t, txn.Abort())
+	assert.NoError(t, db.Close())
+
+	db = store.NewPebbleDB(&config.DBConfig{Path: path})
+	defer db.Close()
+	hg = application.NewPersistentHypergraph(
+		store.NewPebbleHypergraphStore(db, zap.NewNop()),
+	)
+
+	assert.True(t, lookupVertex(t, hg, v1))
+	assert.True(t, lookupVertex(t, hg, v2))
+	assert.False(t, lookupVertex(t, hg, v3))
+	assert.True(t, lookupHyperedge(t, hg, h1))
+	assert.True(t, within(t, hg, v2, h1))
+
+	iter, err := hg.RangeReconciledVertices(application.GetShardAddress(v1))
+	assert.NoError(t, err)
+	ids := [][66]byte{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		atom, err := iter.Value()
+		assert.NoError(t, err)
+		ids = append(ids, atom.GetID())
+	}
+	assert.NoError(t, iter.Close())
+	assert.Equal(t, [][66]byte{v1.GetID(), v2.GetID()}, ids)
+
+	hyperedges, err := hg.GetReconciledHyperedgeSetForShard(
+		application.GetShardAddress(h1),
+	)
+	assert.NoError(t, err)
+	assert.True(t, hyperedges.Has(h1))
+}
+
+func TestHypergraphStoreErrors(t *testing.T) {
+	db := store.NewInMemKVDB()
+	hg := application.NewPersistentHypergraph(
+		store.NewPebbleHypergraphStore(db, zap.NewNop()),
+	)
+
+	v1 := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{1}, SegmentOrder: 1}
+	h1 := &application.Hyperedge{
+		AppAddress:  [32]byte{1},
+		DataAddress: [32]byte{1},
+		Extrinsics:  map[[66]byte]application.Atom{v1.GetID(): v1},
+	}
+	assert.NoError(t, hg.AddVertex(v1))
+	assert.NoError(t, hg.AddHyperedge(h1))
+	assert.NoError(t, db.Close())
+
+	// Failing reads are reported rather than taken as a missing atom.
+	_, err := hg.LookupVertex(v1)
+	assert.Error(t, err)
+	_, err = hg.LookupHyperedge(h1)
+	assert.Error(t, err)
+	_, err = hg.Within(v1, &application.Hyperedge{
+		Extrinsics: map[[66]byte]application.Atom{h1.GetID(): h1},
+	})
+	assert.Error(t, err)
+	_, err = hg.GetReconciledVertexSetForShard(application.GetShardAddress(v1))
+	assert.Error(t, err)
+	_, err = hg.GetReconciledHyperedgeSetForShard(application.GetShardAddress(h1))
+	assert.Error(t, err)
+}
+
+func TestAtomEncoding(t *testing.T) {
+	v1 := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{2}, SegmentOrder: 3}
+	h1 := &application.Hyperedge{
+		AppAddress:  [32]byte{4},
+		DataAddress: [32]byte{5},
+		Index:       6,
+		Extrinsics:  map[[66]byte]application.Atom{v1.GetID(): v1},
+	}
+	h2 := &application.Hyperedge{
+		AppAddress:  [32]byte{7},
+		DataAddress: [32]byte{8},
+		Extrinsics:  map[[66]byte]application.Atom{h1.GetID(): h1, v1.GetID(): v1},
+	}
+
+	data, err := application.MarshalAtom(h2)
+	assert.NoError(t, err)
+	atom, err := application.UnmarshalAtom(data)
+	assert.NoError(t, err)
+	assert.Equal(t, h2, atom)
+
+	_, err = application.UnmarshalAtom(data[:len(data)-1])
+	assert.ErrorIs(t, err, application.ErrInvalidAtom)
+}
@@ -0,0 +1,152 @@
+package application
+
+import (
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// apply adds the atom to the set and keeps the indexes in step. The vertex
+// and hyperedge indexes hold the atoms added and not removed, keyed by id so
+// they list by app and data address. The incidence index maps each
+// extrinsic of those hyperedges to the hyperedge.
+func (t *HypergraphTransaction) apply(set byte, a Atom) error {
+	if err := t.put(set, a); err != nil {
+		return errors.Wrap(err, "apply")
+	}
+
+	id := a.GetID()
+	switch set {
+	case store.HYPERGRAPH_VERTEX_ADDS:
+		removed, err := t.hg.has(t.txn, store.HYPERGRAPH_VERTEX_REMOVES, a)
+		if err != nil {
+			return errors.Wrap(err, "apply")
+		}
+		if removed {
+			return nil
+		}
+
+		return errors.Wrap(
+			t.hg.hypergraphStore.PutIndexEntry(
+				t.txn,
+				store.HYPERGRAPH_VERTEX_INDEX,
+				id[:],
+				nil,
+			),
+			"apply",
+		)
+	case store.HYPERGRAPH_VERTEX_REMOVES:
+		return errors.Wrap(
+			t.hg.hypergraphStore.DeleteIndexEntry(
+				t.txn,
+				store.HYPERGRAPH_VERTEX_INDEX,
+				id[:],
+			),
+			"apply",
+		)
+	case store.HYPERGRAPH_HYPEREDGE_ADDS:
+		removed, err := t.hg.has(t.txn, store.HYPERGRAPH_HYPEREDGE_REMOVES, a)
+		if err != nil {
+			return errors.Wrap(err, "apply")
+		}
+		if removed {
+			return nil
+		}
+
+		return errors.Wrap(t.indexHyperedge(a.(*Hyperedge)), "apply")
+	case store.HYPERGRAPH_HYPEREDGE_REMOVES:
+		// The extrinsics were indexed from the added hyperedge.
+		added, err := t.hg.getAtom(t.txn, store.HYPERGRAPH_HYPEREDGE_ADDS, id)
+		if errors.Is(err, store.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "apply")
+		}
+
+		return errors.Wrap(t.unindexHyperedge(added.(*Hyperedge)), "apply")
+	}
+
+	return nil
+}
+
+func (t *HypergraphTransaction) indexHyperedge(h *Hyperedge) error {
+	id := h.GetID()
+	if err := t.hg.hypergraphStore.PutIndexEntry(
+		t.txn,
+		store.HYPERGRAPH_HYPEREDGE_INDEX,
+		id[:],
+		nil,
+	); err != nil {
+		return errors.Wrap(err, "index hyperedge")
+	}
+
+	for extrinsicId := range h.Extrinsics {
+		if err := t.hg.hypergraphStore.PutIndexEntry(
+			t.txn,
+			store.HYPERGRAPH_INCIDENCE_INDEX,
+			incidenceKey(extrinsicId, id),
+			nil,
+		); err != nil {
+			return errors.Wrap(err, "index hyperedge")
+		}
+	}
+
+	return nil
+}
+
+func (t *HypergraphTransaction) unindexHyperedge(h *Hyperedge) error {
+	id := h.GetID()
+	if err := t.hg.hypergraphStore.DeleteIndexEntry(
+		t.txn,
+		store.HYPERGRAPH_HYPEREDGE_INDEX,
+		id[:],
+	); err != nil {
+		return errors.Wrap(err, "unindex hyperedge")
+	}
+
+	for extrinsicId := range h.Extrinsics {
+		if err := t.hg.hypergraphStore.DeleteIndexEntry(
+			t.txn,
+			store.HYPERGRAPH_INCIDENCE_INDEX,
+			incidenceKey(extrinsicId, id),
+		); err != nil {
+			return errors.Wrap(err, "unindex hyperedge")
+		}
+	}
+
+	return nil
+}
+
+func incidenceKey(extrinsicId [66]byte, hyperedgeId [66]byte) []byte {
+	return append(append([]byte{}, extrinsicId[:]...), hyperedgeId[:]...)
+}
+
+// shardAddressOfId returns the shard address of the atom with the id, which
+// only depends on the app and data address the id starts with.
+func shardAddressOfId(id [66]byte) ShardAddress {
+	return GetShardAddress(&Vertex{
+		AppAddress:  [32]byte(id[:32]),
+		DataAddress: [32]byte(id[32:64]),
+	})
+}
+
+// getAtom returns the atom with the id held by the set, through the
+// transaction if given.
+func (hg *Hypergraph) getAtom(
+	txn store.Transaction,
+	set byte,
+	id [66]byte,
+) (Atom, error) {
+	data, err := hg.hypergraphStore.GetAtom(
+		txn,
+		set,
+		shardAddressOfId(id).Bytes(),
+		id[:],
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "get atom")
+	}
+
+	atom, err := UnmarshalAtom(data)
+	return atom, errors.Wrap(err, "get atom")
+}
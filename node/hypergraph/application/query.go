@@ -0,0 +1,298 @@
+package application
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+var ErrInvalidPrefix = errors.New("invalid prefix")
+
+// Neighborhood is the part of the hypergraph within some hops of an atom.
+type Neighborhood struct {
+	// Atoms are the atoms reached, in the order they were reached, and Hops
+	// the number of hops each took. The atom expanded from is not included.
+	Atoms []Atom
+	Hops  []int
+	// Hyperedges are the hyperedges containing the atoms expanded.
+	Hyperedges []*Hyperedge
+	// Truncated is set if the expansion stopped at the limit before reaching
+	// every atom within the hops.
+	Truncated bool
+}
+
+// atomKey tells apart a vertex and a hyperedge with the same id.
+type atomKey struct {
+	atomType AtomType
+	id       [66]byte
+}
+
+func keyOf(a Atom) atomKey {
+	return atomKey{atomType: a.GetAtomType(), id: a.GetID()}
+}
+
+// vertexOfId returns the vertex with the id, which a vertex is fully
+// described by.
+func vertexOfId(id [66]byte) *Vertex {
+	return &Vertex{
+		AppAddress:   [32]byte(id[:32]),
+		DataAddress:  [32]byte(id[32:64]),
+		SegmentOrder: binary.BigEndian.Uint16(id[64:]),
+	}
+}
+
+// IncidentHyperedges returns the hyperedges that directly contain the atom
+// with the id, in id order. Only hyperedges that can be looked up are
+// returned.
+func (hg *Hypergraph) IncidentHyperedges(id [66]byte) ([]*Hyperedge, error) {
+	iter, err := hg.hypergraphStore.RangeIndex(
+		store.HYPERGRAPH_INCIDENCE_INDEX,
+		id[:],
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "incident hyperedges")
+	}
+	defer iter.Close()
+
+	hyperedges := []*Hyperedge{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		key := iter.Key()
+		atom, err := hg.getAtom(
+			nil,
+			store.HYPERGRAPH_HYPEREDGE_ADDS,
+			[66]byte(key[66:]),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "incident hyperedges")
+		}
+
+		h := atom.(*Hyperedge)
+		found, err := hg.LookupHyperedge(h)
+		if err != nil {
+			return nil, errors.Wrap(err, "incident hyperedges")
+		}
+		if found {
+			hyperedges = append(hyperedges, h)
+		}
+	}
+
+	return hyperedges, nil
+}
+
+// Neighbors returns the atoms one hop from the atom: the atoms sharing a
+// hyperedge with it and, for a hyperedge, its extrinsics.
+func (hg *Hypergraph) Neighbors(a Atom) ([]Atom, error) {
+	atoms, _, err := hg.adjacent(a)
+	return atoms, errors.Wrap(err, "neighbors")
+}
+
+// adjacent returns the atoms one hop from the atom, in id order, and the
+// hyperedges containing it.
+func (hg *Hypergraph) adjacent(a Atom) ([]Atom, []*Hyperedge, error) {
+	incident, err := hg.IncidentHyperedges(a.GetID())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "adjacent")
+	}
+
+	self := keyOf(a)
+	seen := map[atomKey]bool{self: true}
+	atoms := []Atom{}
+	add := func(extrinsics map[[66]byte]Atom) {
+		for _, extrinsic := range extrinsics {
+			key := keyOf(extrinsic)
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+			atoms = append(atoms, extrinsic)
+		}
+	}
+
+	for _, h := range incident {
+		add(h.Extrinsics)
+	}
+
+	// The extrinsics of a hyperedge that can be looked up can be as well.
+	if h, ok := a.(*Hyperedge); ok {
+		found, err := hg.LookupHyperedge(h)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "adjacent")
+		}
+		if found {
+			add(h.Extrinsics)
+		}
+	}
+
+	sort.Slice(atoms, func(i, j int) bool {
+		a, b := atoms[i].GetID(), atoms[j].GetID()
+		return bytes.Compare(a[:], b[:]) < 0
+	})
+
+	return atoms, incident, nil
+}
+
+// Expand returns the atoms within the hops of the atom, breadth first,
+// stopping once it has reached the limit of atoms.
+func (hg *Hypergraph) Expand(
+	a Atom,
+	hops int,
+	limit int,
+) (*Neighborhood, error) {
+	neighborhood := &Neighborhood{
+		Atoms:      []Atom{},
+		Hops:       []int{},
+		Hyperedges: []*Hyperedge{},
+	}
+	seen := map[atomKey]bool{keyOf(a): true}
+	seenHyperedges := map[[66]byte]bool{}
+
+	frontier := []Atom{a}
+	for hop := 1; hop <= hops && len(frontier) > 0; hop++ {
+		next := []Atom{}
+		for _, atom := range frontier {
+			atoms, incident, err := hg.adjacent(atom)
+			if err != nil {
+				return nil, errors.Wrap(err, "expand")
+			}
+
+			for _, h := range incident {
+				if !seenHyperedges[h.GetID()] {
+					seenHyperedges[h.GetID()] = true
+					neighborhood.Hyperedges = append(neighborhood.Hyperedges, h)
+				}
+			}
+
+			for _, neighbor := range atoms {
+				key := keyOf(neighbor)
+				if seen[key] {
+					continue
+				}
+
+				if len(neighborhood.Atoms) >= limit {
+					neighborhood.Truncated = true
+					return neighborhood, nil
+				}
+
+				seen[key] = true
+				neighborhood.Atoms = append(neighborhood.Atoms, neighbor)
+				neighborhood.Hops = append(neighborhood.Hops, hop)
+				next = append(next, neighbor)
+			}
+		}
+
+		frontier = next
+	}
+
+	return neighborhood, nil
+}
+
+// ListVertices returns a page of the vertices that can be looked up under
+// the prefix, an app address or an app and data address, in id order. The
+// cursor is the next cursor of the previous page, nil for the first page,
+// and the next cursor is nil on the last page.
+func (hg *Hypergraph) ListVertices(
+	prefix []byte,
+	cursor []byte,
+	limit int,
+) ([]*Vertex, []byte, error) {
+	vertices := []*Vertex{}
+	nextCursor, err := hg.listIndex(
+		store.HYPERGRAPH_VERTEX_INDEX,
+		prefix,
+		cursor,
+		limit,
+		func(id [66]byte) (bool, error) {
+			vertices = append(vertices, vertexOfId(id))
+			return true, nil
+		},
+	)
+
+	return vertices, nextCursor, errors.Wrap(err, "list vertices")
+}
+
+// ListHyperedges returns a page of the hyperedges that can be looked up
+// under the prefix, as ListVertices does.
+func (hg *Hypergraph) ListHyperedges(
+	prefix []byte,
+	cursor []byte,
+	limit int,
+) ([]*Hyperedge, []byte, error) {
+	hyperedges := []*Hyperedge{}
+	nextCursor, err := hg.listIndex(
+		store.HYPERGRAPH_HYPEREDGE_INDEX,
+		prefix,
+		cursor,
+		limit,
+		func(id [66]byte) (bool, error) {
+			atom, err := hg.getAtom(nil, store.HYPERGRAPH_HYPEREDGE_ADDS, id)
+			if err != nil {
+				return false, err
+			}
+
+			// Hyperedges merged before their extrinsics are skipped.
+			h := atom.(*Hyperedge)
+			found, err := hg.LookupHyperedge(h)
+			if err != nil || !found {
+				return false, err
+			}
+
+			hyperedges = append(hyperedges, h)
+			return true, nil
+		},
+	)
+
+	return hyperedges, nextCursor, errors.Wrap(err, "list hyperedges")
+}
+
+// listIndex calls the function with the ids in the index under the prefix
+// after the cursor until it has taken the limit of them, and returns the
+// cursor of the next page.
+func (hg *Hypergraph) listIndex(
+	index byte,
+	prefix []byte,
+	cursor []byte,
+	limit int,
+	take func(id [66]byte) (bool, error),
+) ([]byte, error) {
+	if len(prefix) != 32 && len(prefix) != 64 {
+		return nil, errors.Wrap(ErrInvalidPrefix, "list index")
+	}
+
+	if cursor != nil &&
+		(len(cursor) != 66 || !bytes.HasPrefix(cursor, prefix)) {
+		return nil, errors.Wrap(ErrInvalidCursor, "list index")
+	}
+
+	iter, err := hg.hypergraphStore.RangeIndex(index, prefix, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "list index")
+	}
+	defer iter.Close()
+
+	taken := 0
+	var last []byte
+	for iter.First(); iter.Valid(); iter.Next() {
+		if taken == limit {
+			return last, nil
+		}
+
+		key := iter.Key()
+		ok, err := take([66]byte(key))
+		if err != nil {
+			return nil, errors.Wrap(err, "list index")
+		}
+
+		if ok {
+			taken++
+		}
+		last = key
+	}
+
+	return nil, nil
+}
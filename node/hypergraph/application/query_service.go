@@ -0,0 +1,252 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+const defaultListLimit = 100
+const maxListLimit = 1000
+
+const maxNeighborHops = 4
+
+// The most atoms a neighbors query returns.
+const maxNeighbors = 1000
+
+// HypergraphQueryService serves queries over the node's hypergraph.
+type HypergraphQueryService struct {
+	protobufs.UnimplementedHypergraphQueryServiceServer
+	logger *zap.Logger
+	hg     *Hypergraph
+}
+
+func NewHypergraphQueryService(
+	logger *zap.Logger,
+	hg *Hypergraph,
+) *HypergraphQueryService {
+	return &HypergraphQueryService{
+		logger: logger,
+		hg:     hg,
+	}
+}
+
+func (s *HypergraphQueryService) GetIncidentHyperedges(
+	ctx context.Context,
+	req *protobufs.GetIncidentHyperedgesRequest,
+) (*protobufs.GetIncidentHyperedgesResponse, error) {
+	id, err := parseAtomId(req.AtomId)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperedges, err := s.hg.IncidentHyperedges(id)
+	if err != nil {
+		s.logger.Error("could not get incident hyperedges", zap.Error(err))
+		return nil, status.Error(
+			codes.Internal,
+			"could not get incident hyperedges",
+		)
+	}
+
+	resp := &protobufs.GetIncidentHyperedgesResponse{
+		Hyperedges: []*protobufs.HypergraphAtom{},
+	}
+	for _, h := range hyperedges {
+		resp.Hyperedges = append(resp.Hyperedges, atomToProto(h))
+	}
+
+	return resp, nil
+}
+
+func (s *HypergraphQueryService) GetNeighbors(
+	ctx context.Context,
+	req *protobufs.GetNeighborsRequest,
+) (*protobufs.GetNeighborsResponse, error) {
+	id, err := parseAtomId(req.AtomId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Hops == 0 || req.Hops > maxNeighborHops {
+		return nil, status.Error(codes.InvalidArgument, "invalid hops")
+	}
+
+	var atom Atom
+	if req.IsHyperedge {
+		atom, err = s.hg.getAtom(nil, store.HYPERGRAPH_HYPEREDGE_ADDS, id)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			s.logger.Error("could not get hyperedge", zap.Error(err))
+			return nil, status.Error(codes.Internal, "could not get hyperedge")
+		}
+	} else {
+		atom = vertexOfId(id)
+	}
+
+	found := false
+	if atom != nil {
+		found, err = s.hg.LookupAtom(atom)
+		if err != nil {
+			s.logger.Error("could not look up atom", zap.Error(err))
+			return nil, status.Error(codes.Internal, "could not look up atom")
+		}
+	}
+
+	if !found {
+		return nil, status.Error(codes.NotFound, "atom not found")
+	}
+
+	neighborhood, err := s.hg.Expand(atom, int(req.Hops), maxNeighbors)
+	if err != nil {
+		s.logger.Error("could not get neighbors", zap.Error(err))
+		return nil, status.Error(codes.Internal, "could not get neighbors")
+	}
+
+	resp := &protobufs.GetNeighborsResponse{
+		Neighbors:  []*protobufs.HypergraphNeighbor{},
+		Hyperedges: []*protobufs.HypergraphAtom{},
+		Truncated:  neighborhood.Truncated,
+	}
+	for i, neighbor := range neighborhood.Atoms {
+		resp.Neighbors = append(resp.Neighbors, &protobufs.HypergraphNeighbor{
+			Atom: atomToProto(neighbor),
+			Hops: uint32(neighborhood.Hops[i]),
+		})
+	}
+	for _, h := range neighborhood.Hyperedges {
+		resp.Hyperedges = append(resp.Hyperedges, atomToProto(h))
+	}
+
+	return resp, nil
+}
+
+func (s *HypergraphQueryService) ListVertices(
+	ctx context.Context,
+	req *protobufs.ListAtomsRequest,
+) (*protobufs.ListAtomsResponse, error) {
+	prefix, limit, err := parseListRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	vertices, nextCursor, err := s.hg.ListVertices(prefix, req.Cursor, limit)
+	if err != nil {
+		return nil, listError(s.logger, err)
+	}
+
+	resp := &protobufs.ListAtomsResponse{
+		Atoms:      []*protobufs.HypergraphAtom{},
+		NextCursor: nextCursor,
+	}
+	for _, v := range vertices {
+		resp.Atoms = append(resp.Atoms, atomToProto(v))
+	}
+
+	return resp, nil
+}
+
+func (s *HypergraphQueryService) ListHyperedges(
+	ctx context.Context,
+	req *protobufs.ListAtomsRequest,
+) (*protobufs.ListAtomsResponse, error) {
+	prefix, limit, err := parseListRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperedges, nextCursor, err := s.hg.ListHyperedges(
+		prefix,
+		req.Cursor,
+		limit,
+	)
+	if err != nil {
+		return nil, listError(s.logger, err)
+	}
+
+	resp := &protobufs.ListAtomsResponse{
+		Atoms:      []*protobufs.HypergraphAtom{},
+		NextCursor: nextCursor,
+	}
+	for _, h := range hyperedges {
+		resp.Atoms = append(resp.Atoms, atomToProto(h))
+	}
+
+	return resp, nil
+}
+
+func parseAtomId(data []byte) ([66]byte, error) {
+	if len(data) != 66 {
+		return [66]byte{}, status.Error(codes.InvalidArgument, "invalid atom id")
+	}
+
+	return [66]byte(data), nil
+}
+
+// parseListRequest returns the prefix to list under and the page size.
+func parseListRequest(req *protobufs.ListAtomsRequest) ([]byte, int, error) {
+	if len(req.AppAddress) != 32 {
+		return nil, 0, status.Error(codes.InvalidArgument, "invalid app address")
+	}
+
+	if len(req.DataAddress) != 0 && len(req.DataAddress) != 32 {
+		return nil, 0, status.Error(
+			codes.InvalidArgument,
+			"invalid data address",
+		)
+	}
+
+	if req.Limit > maxListLimit {
+		return nil, 0, status.Error(codes.InvalidArgument, "invalid limit")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+
+	prefix := append(
+		append([]byte{}, req.AppAddress...),
+		req.DataAddress...,
+	)
+	return prefix, limit, nil
+}
+
+func listError(logger *zap.Logger, err error) error {
+	if errors.Is(err, ErrInvalidCursor) {
+		return status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	logger.Error("could not list atoms", zap.Error(err))
+	return status.Error(codes.Internal, "could not list atoms")
+}
+
+func atomToProto(a Atom) *protobufs.HypergraphAtom {
+	id := a.GetID()
+	atom := &protobufs.HypergraphAtom{
+		Id:           id[:],
+		ExtrinsicIds: [][]byte{},
+	}
+
+	if h, ok := a.(*Hyperedge); ok {
+		atom.IsHyperedge = true
+		for extrinsicId := range h.Extrinsics {
+			atom.ExtrinsicIds = append(
+				atom.ExtrinsicIds,
+				append([]byte{}, extrinsicId[:]...),
+			)
+		}
+
+		sort.Slice(atom.ExtrinsicIds, func(i, j int) bool {
+			return bytes.Compare(atom.ExtrinsicIds[i], atom.ExtrinsicIds[j]) < 0
+		})
+	}
+
+	return atom
+}
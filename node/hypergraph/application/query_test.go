@@ -0,0 +1,265 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"source.quilibrium.com/quilibrium/monorepo/node/hypergraph/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// newHyperedge builds a hyperedge of app 1, at a data address of its own so
+// its id differs from the test vertices'.
+func newHyperedge(index uint16, atoms ...application.Atom) *application.Hyperedge {
+	h := &application.Hyperedge{
+		AppAddress:  [32]byte{1},
+		DataAddress: [32]byte{3},
+		Index:       index,
+		Extrinsics:  map[[66]byte]application.Atom{},
+	}
+	for _, a := range atoms {
+		h.Extrinsics[a.GetID()] = a
+	}
+	return h
+}
+
+func ids[A application.Atom](atoms []A) [][66]byte {
+	result := [][66]byte{}
+	for _, a := range atoms {
+		result = append(result, a.GetID())
+	}
+	return result
+}
+
+func TestQuery(t *testing.T) {
+	for name, newHypergraph := range hypergraphBackends {
+		t.Run(name, func(t *testing.T) {
+			testQuery(t, newHypergraph(t))
+		})
+	}
+}
+
+func testQuery(t *testing.T, hg *application.Hypergraph) {
+	vertices := []*application.Vertex{}
+	for i := 0; i < 4; i++ {
+		v := &application.Vertex{
+			AppAddress:   [32]byte{1},
+			DataAddress:  [32]byte{1},
+			SegmentOrder: uint16(i),
+		}
+		require.NoError(t, hg.AddVertex(v))
+		vertices = append(vertices, v)
+	}
+	v0, v1, v2, v3 := vertices[0], vertices[1], vertices[2], vertices[3]
+
+	// v0 - h0 - v1 - h1 - v2 - h2 - v3
+	h0 := newHyperedge(0, v0, v1)
+	h1 := newHyperedge(1, v1, v2)
+	h2 := newHyperedge(2, v2, v3)
+	for _, h := range []*application.Hyperedge{h0, h1, h2} {
+		require.NoError(t, hg.AddHyperedge(h))
+	}
+
+	t.Run("Incident Hyperedges", func(t *testing.T) {
+		incident, err := hg.IncidentHyperedges(v1.GetID())
+		require.NoError(t, err)
+		assert.Equal(t, ids([]*application.Hyperedge{h0, h1}), ids(incident))
+
+		incident, err = hg.IncidentHyperedges(v3.GetID())
+		require.NoError(t, err)
+		assert.Equal(t, ids([]*application.Hyperedge{h2}), ids(incident))
+	})
+
+	t.Run("Neighbors", func(t *testing.T) {
+		neighbors, err := hg.Neighbors(v1)
+		require.NoError(t, err)
+		assert.Equal(t, ids([]application.Atom{v0, v2}), ids(neighbors))
+
+		// The extrinsics of a hyperedge are its neighbors.
+		neighbors, err = hg.Neighbors(h2)
+		require.NoError(t, err)
+		assert.Equal(t, ids([]application.Atom{v2, v3}), ids(neighbors))
+	})
+
+	t.Run("Expand", func(t *testing.T) {
+		neighborhood, err := hg.Expand(v0, 2, 100)
+		require.NoError(t, err)
+		assert.Equal(t, ids([]application.Atom{v1, v2}), ids(neighborhood.Atoms))
+		assert.Equal(t, []int{1, 2}, neighborhood.Hops)
+		assert.Equal(
+			t,
+			ids([]*application.Hyperedge{h0, h1}),
+			ids(neighborhood.Hyperedges),
+		)
+		assert.False(t, neighborhood.Truncated)
+
+		neighborhood, err = hg.Expand(v0, 5, 100)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, neighborhood.Hops)
+		assert.False(t, neighborhood.Truncated)
+
+		neighborhood, err = hg.Expand(v0, 5, 2)
+		require.NoError(t, err)
+		assert.Len(t, neighborhood.Atoms, 2)
+		assert.True(t, neighborhood.Truncated)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		other := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{2}}
+		require.NoError(t, hg.AddVertex(other))
+
+		app := make([]byte, 32)
+		app[0] = 1
+		listed := []*application.Vertex{}
+		var cursor []byte
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 3)
+			page, next, err := hg.ListVertices(app, cursor, 2)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(page), 2)
+			listed = append(listed, page...)
+			if next == nil {
+				break
+			}
+			cursor = next
+		}
+		assert.Equal(
+			t,
+			ids([]*application.Vertex{v0, v1, v2, v3, other}),
+			ids(listed),
+		)
+
+		data := append(append([]byte{}, app...), make([]byte, 32)...)
+		data[32] = 2
+		listed, next, err := hg.ListVertices(data, nil, 10)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+		assert.Equal(t, ids([]*application.Vertex{other}), ids(listed))
+
+		require.NoError(t, hg.RemoveVertex(other))
+		listed, _, err = hg.ListVertices(data, nil, 10)
+		require.NoError(t, err)
+		assert.Empty(t, listed)
+
+		hyperedges, _, err := hg.ListHyperedges(app, nil, 10)
+		require.NoError(t, err)
+		assert.Equal(t, ids([]*application.Hyperedge{h0, h1, h2}), ids(hyperedges))
+
+		_, _, err = hg.ListVertices(app, make([]byte, 66), 10)
+		assert.ErrorIs(t, err, application.ErrInvalidCursor)
+	})
+
+	t.Run("Removal", func(t *testing.T) {
+		require.NoError(t, hg.RemoveHyperedge(h1))
+
+		incident, err := hg.IncidentHyperedges(v1.GetID())
+		require.NoError(t, err)
+		assert.Equal(t, ids([]*application.Hyperedge{h0}), ids(incident))
+
+		neighborhood, err := hg.Expand(v0, 5, 100)
+		require.NoError(t, err)
+		assert.Equal(t, ids([]application.Atom{v1}), ids(neighborhood.Atoms))
+	})
+}
+
+func TestQueryMergedRemoval(t *testing.T) {
+	hg := application.NewHypergraph()
+	v0 := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{1}}
+	v1 := &application.Vertex{
+		AppAddress:   [32]byte{1},
+		DataAddress:  [32]byte{1},
+		SegmentOrder: 1,
+	}
+	h := newHyperedge(0, v0, v1)
+	shard := application.GetShardAddress(h)
+
+	// A replica can receive the removal of a hyperedge before its addition.
+	require.NoError(t, hg.MergeAtoms(
+		store.HYPERGRAPH_VERTEX_ADDS,
+		application.GetShardAddress(v0),
+		[]application.Atom{v0, v1},
+	))
+	require.NoError(t, hg.MergeAtoms(
+		store.HYPERGRAPH_HYPEREDGE_REMOVES,
+		shard,
+		[]application.Atom{h},
+	))
+	require.NoError(t, hg.MergeAtoms(
+		store.HYPERGRAPH_HYPEREDGE_ADDS,
+		shard,
+		[]application.Atom{h},
+	))
+
+	incident, err := hg.IncidentHyperedges(v0.GetID())
+	require.NoError(t, err)
+	assert.Empty(t, incident)
+
+	app := make([]byte, 32)
+	app[0] = 1
+	hyperedges, _, err := hg.ListHyperedges(app, nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, hyperedges)
+}
+
+func TestQueryService(t *testing.T) {
+	hg := application.NewHypergraph()
+	v0 := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{1}}
+	v1 := &application.Vertex{
+		AppAddress:   [32]byte{1},
+		DataAddress:  [32]byte{1},
+		SegmentOrder: 1,
+	}
+	require.NoError(t, hg.AddVertex(v0))
+	require.NoError(t, hg.AddVertex(v1))
+	h := newHyperedge(0, v0, v1)
+	require.NoError(t, hg.AddHyperedge(h))
+
+	service := application.NewHypergraphQueryService(zap.NewNop(), hg)
+	id := v0.GetID()
+
+	resp, err := service.GetNeighbors(
+		context.Background(),
+		&protobufs.GetNeighborsRequest{AtomId: id[:], Hops: 1},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Neighbors, 1)
+	neighborId := v1.GetID()
+	assert.Equal(t, neighborId[:], resp.Neighbors[0].Atom.Id)
+	assert.Equal(t, uint32(1), resp.Neighbors[0].Hops)
+	require.Len(t, resp.Hyperedges, 1)
+	assert.True(t, resp.Hyperedges[0].IsHyperedge)
+	assert.Len(t, resp.Hyperedges[0].ExtrinsicIds, 2)
+
+	hid := h.GetID()
+	resp, err = service.GetNeighbors(
+		context.Background(),
+		&protobufs.GetNeighborsRequest{AtomId: hid[:], Hops: 1, IsHyperedge: true},
+	)
+	require.NoError(t, err)
+	assert.Len(t, resp.Neighbors, 2)
+
+	_, err = service.GetNeighbors(
+		context.Background(),
+		&protobufs.GetNeighborsRequest{AtomId: id[:], Hops: 100},
+	)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	missing := [66]byte{9}
+	_, err = service.GetNeighbors(
+		context.Background(),
+		&protobufs.GetNeighborsRequest{AtomId: missing[:], Hops: 1},
+	)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = service.ListVertices(
+		context.Background(),
+		&protobufs.ListAtomsRequest{AppAddress: []byte{1}},
+	)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,537 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+const hypergraphSyncPurpose = "hypergraph-sync"
+
+// The address replicas subscribe to, so they can find each other.
+var HypergraphSyncAddress = []byte("q-hypergraph-sync")
+
+// How often the replica pulls its shards from a random replica, and how long
+// a pull may take.
+const hypergraphSyncInterval = time.Minute
+const hypergraphSyncTimeout = 5 * time.Minute
+
+// The most atoms requested or served in one call.
+const maxSyncAtoms = 1024
+
+const shardAddressLength = 51
+
+// HypergraphReplicator keeps a hypergraph's shards in sync with peers by
+// anti-entropy over a direct channel. It serves the shard digests and atoms
+// of its replica, and pulls from a peer the atoms it is missing: the shard
+// digests are compared, sets that differ are narrowed down to buckets, and
+// only the atoms of differing buckets the replica doesn't hold are fetched.
+// A replica without any atom of a set, like a node newly assigned to the
+// shard, skips the comparison and fetches the whole set.
+//
+// Syncing only pulls, two replicas converge once each has synced with the
+// other.
+type HypergraphReplicator struct {
+	protobufs.UnimplementedHypergraphSyncServiceServer
+	logger       *zap.Logger
+	engineConfig *config.EngineConfig
+	pubSub       p2p.PubSub
+	hg           *Hypergraph
+	clock        clock.Clock
+	bitmask      []byte
+	subscription *p2p.Subscription
+	cancel       context.CancelFunc
+	mx           sync.Mutex
+}
+
+func NewHypergraphReplicator(
+	logger *zap.Logger,
+	engineConfig *config.EngineConfig,
+	pubSub p2p.PubSub,
+	hg *Hypergraph,
+	clock clock.Clock,
+) *HypergraphReplicator {
+	return &HypergraphReplicator{
+		logger:       logger,
+		engineConfig: engineConfig,
+		pubSub:       pubSub,
+		hg:           hg,
+		clock:        clock,
+		bitmask:      p2p.GetBloomFilter(HypergraphSyncAddress, 256, 3),
+	}
+}
+
+// Start serves the replica's shards to peers and pulls the configured shards
+// from them.
+func (r *HypergraphReplicator) Start() error {
+	shardAddrs, err := r.configuredShards()
+	if err != nil {
+		return errors.Wrap(err, "start")
+	}
+
+	subscription, err := r.pubSub.Subscribe(
+		r.bitmask,
+		func(message *pb.Message) error { return nil },
+	)
+	if err != nil {
+		return errors.Wrap(err, "start")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.mx.Lock()
+	r.subscription = subscription
+	r.cancel = cancel
+	r.mx.Unlock()
+
+	go func() {
+		if err := r.pubSub.StartDirectChannelListener(
+			r.pubSub.GetPeerID(),
+			hypergraphSyncPurpose,
+			p2p.DirectChannelPolicy{
+				Access:                p2p.DirectChannelAllowAnyone,
+				RequestsPerSecond:     20,
+				RequestBurst:          100,
+				MaxConcurrentRequests: 4,
+				MaxMessageSize:        100 * 1024 * 1024,
+			},
+			func(server *grpc.Server) {
+				protobufs.RegisterHypergraphSyncServiceServer(server, r)
+			},
+		); err != nil {
+			panic(err)
+		}
+	}()
+
+	if len(shardAddrs) != 0 {
+		go r.runSync(ctx, shardAddrs)
+	}
+
+	return nil
+}
+
+func (r *HypergraphReplicator) Stop() {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+
+	if r.subscription != nil {
+		r.subscription.Cancel()
+		r.subscription = nil
+	}
+}
+
+func (r *HypergraphReplicator) configuredShards() ([]ShardAddress, error) {
+	if r.engineConfig == nil {
+		return nil, nil
+	}
+
+	shardAddrs := []ShardAddress{}
+	for _, shard := range r.engineConfig.HypergraphShards {
+		data, err := hex.DecodeString(shard)
+		if err != nil {
+			return nil, errors.Wrap(err, "configured shards")
+		}
+
+		shardAddr, err := parseShardAddress(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "configured shards")
+		}
+
+		shardAddrs = append(shardAddrs, shardAddr)
+	}
+
+	return shardAddrs, nil
+}
+
+func (r *HypergraphReplicator) runSync(
+	ctx context.Context,
+	shardAddrs []ShardAddress,
+) {
+	for {
+		if err := r.syncWithRandomPeer(ctx, shardAddrs); err != nil {
+			r.logger.Debug("could not sync hypergraph", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.clock.After(hypergraphSyncInterval):
+		}
+	}
+}
+
+// syncWithRandomPeer pulls the shards from a replica found on the sync
+// address.
+func (r *HypergraphReplicator) syncWithRandomPeer(
+	ctx context.Context,
+	shardAddrs []ShardAddress,
+) error {
+	peerId, err := r.pubSub.GetRandomPeer(r.bitmask)
+	if err != nil {
+		return errors.Wrap(err, "sync with random peer")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, hypergraphSyncTimeout)
+	defer cancel()
+
+	return errors.Wrap(
+		r.SyncWithPeer(ctx, peerId, shardAddrs),
+		"sync with random peer",
+	)
+}
+
+// SyncWithPeer pulls the shards from the peer.
+func (r *HypergraphReplicator) SyncWithPeer(
+	ctx context.Context,
+	peerId []byte,
+	shardAddrs []ShardAddress,
+) error {
+	cc, err := r.pubSub.GetDirectChannel(peerId, hypergraphSyncPurpose)
+	if err != nil {
+		return errors.Wrap(err, "sync with peer")
+	}
+	defer cc.Close()
+
+	client := protobufs.NewHypergraphSyncServiceClient(cc)
+	for _, shardAddr := range shardAddrs {
+		if err := r.SyncShard(ctx, client, shardAddr); err != nil {
+			return errors.Wrap(err, "sync with peer")
+		}
+	}
+
+	return nil
+}
+
+// SyncShard pulls the atoms of the shard the replica is missing from the
+// peer at the other end of the client.
+func (r *HypergraphReplicator) SyncShard(
+	ctx context.Context,
+	client protobufs.HypergraphSyncServiceClient,
+	shardAddr ShardAddress,
+) error {
+	remote, err := client.GetShardDigest(
+		ctx,
+		&protobufs.HypergraphShardDigestRequest{
+			ShardAddress: shardAddr.Bytes(),
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "sync shard")
+	}
+
+	if len(remote.SetRoots) != len(HypergraphSets) {
+		return errors.Wrap(errors.New("invalid shard digest"), "sync shard")
+	}
+
+	for i, set := range HypergraphSets {
+		if IsEmptySetRoot(remote.SetRoots[i]) {
+			continue
+		}
+
+		digests, err := r.hg.BucketDigests(set, shardAddr)
+		if err != nil {
+			return errors.Wrap(err, "sync shard")
+		}
+
+		root := SetRoot(digests)
+		if bytes.Equal(root, remote.SetRoots[i]) {
+			continue
+		}
+
+		buckets, err := r.differingBuckets(ctx, client, set, shardAddr, digests)
+		if err != nil {
+			return errors.Wrap(err, "sync shard")
+		}
+
+		if err := r.syncBuckets(ctx, client, set, shardAddr, buckets); err != nil {
+			return errors.Wrap(err, "sync shard")
+		}
+	}
+
+	return nil
+}
+
+// differingBuckets returns the buckets of the set whose digests differ from
+// the peer's, or every bucket if the replica holds none of the set.
+func (r *HypergraphReplicator) differingBuckets(
+	ctx context.Context,
+	client protobufs.HypergraphSyncServiceClient,
+	set byte,
+	shardAddr ShardAddress,
+	digests [][]byte,
+) ([]uint32, error) {
+	buckets := []uint32{}
+	if IsEmptySetRoot(SetRoot(digests)) {
+		for i := uint32(0); i < HypergraphBuckets; i++ {
+			buckets = append(buckets, i)
+		}
+
+		return buckets, nil
+	}
+
+	remote, err := client.GetBucketDigests(
+		ctx,
+		&protobufs.HypergraphBucketDigestsRequest{
+			ShardAddress: shardAddr.Bytes(),
+			Set:          protobufs.HypergraphSet(set),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "differing buckets")
+	}
+
+	if len(remote.BucketHashes) != HypergraphBuckets {
+		return nil, errors.Wrap(
+			errors.New("invalid bucket digests"),
+			"differing buckets",
+		)
+	}
+
+	for i, digest := range digests {
+		if !bytes.Equal(digest, remote.BucketHashes[i]) {
+			buckets = append(buckets, uint32(i))
+		}
+	}
+
+	return buckets, nil
+}
+
+// syncBuckets fetches the atoms of the buckets the replica doesn't hold.
+func (r *HypergraphReplicator) syncBuckets(
+	ctx context.Context,
+	client protobufs.HypergraphSyncServiceClient,
+	set byte,
+	shardAddr ShardAddress,
+	buckets []uint32,
+) error {
+	remote, err := client.GetAtomIds(ctx, &protobufs.HypergraphAtomIdsRequest{
+		ShardAddress: shardAddr.Bytes(),
+		Set:          protobufs.HypergraphSet(set),
+		Buckets:      buckets,
+	})
+	if err != nil {
+		return errors.Wrap(err, "sync buckets")
+	}
+
+	local, err := r.hg.AtomIds(set, shardAddr, buckets)
+	if err != nil {
+		return errors.Wrap(err, "sync buckets")
+	}
+
+	held := map[string]bool{}
+	for _, id := range local {
+		held[string(id)] = true
+	}
+
+	missing := [][]byte{}
+	for _, id := range remote.AtomIds {
+		if !held[string(id)] {
+			missing = append(missing, id)
+		}
+	}
+
+	for len(missing) > 0 {
+		n := min(len(missing), maxSyncAtoms)
+		if err := r.fetchAtoms(
+			ctx,
+			client,
+			set,
+			shardAddr,
+			missing[:n],
+		); err != nil {
+			return errors.Wrap(err, "sync buckets")
+		}
+
+		missing = missing[n:]
+	}
+
+	return nil
+}
+
+func (r *HypergraphReplicator) fetchAtoms(
+	ctx context.Context,
+	client protobufs.HypergraphSyncServiceClient,
+	set byte,
+	shardAddr ShardAddress,
+	ids [][]byte,
+) error {
+	resp, err := client.GetAtoms(ctx, &protobufs.HypergraphAtomsRequest{
+		ShardAddress: shardAddr.Bytes(),
+		Set:          protobufs.HypergraphSet(set),
+		AtomIds:      ids,
+	})
+	if err != nil {
+		return errors.Wrap(err, "fetch atoms")
+	}
+
+	requested := map[[66]byte]bool{}
+	for _, id := range ids {
+		if len(id) == 66 {
+			requested[[66]byte(id)] = true
+		}
+	}
+
+	atoms := []Atom{}
+	for _, data := range resp.Atoms {
+		atom, err := UnmarshalAtom(data)
+		if err != nil {
+			return errors.Wrap(err, "fetch atoms")
+		}
+
+		if !requested[atom.GetID()] {
+			return errors.Wrap(
+				errors.New("received unrequested atom"),
+				"fetch atoms",
+			)
+		}
+
+		atoms = append(atoms, atom)
+	}
+
+	return errors.Wrap(r.hg.MergeAtoms(set, shardAddr, atoms), "fetch atoms")
+}
+
+// GetShardDigest implements protobufs.HypergraphSyncServiceServer.
+func (r *HypergraphReplicator) GetShardDigest(
+	ctx context.Context,
+	req *protobufs.HypergraphShardDigestRequest,
+) (*protobufs.HypergraphShardDigestResponse, error) {
+	shardAddr, err := parseShardAddress(req.ShardAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, err := r.hg.ShardDigest(shardAddr)
+	if err != nil {
+		r.logger.Error("could not compute shard digest", zap.Error(err))
+		return nil, status.Error(codes.Internal, "could not compute digest")
+	}
+
+	return &protobufs.HypergraphShardDigestResponse{SetRoots: roots}, nil
+}
+
+// GetBucketDigests implements protobufs.HypergraphSyncServiceServer.
+func (r *HypergraphReplicator) GetBucketDigests(
+	ctx context.Context,
+	req *protobufs.HypergraphBucketDigestsRequest,
+) (*protobufs.HypergraphBucketDigestsResponse, error) {
+	shardAddr, set, err := parseShardSet(req.ShardAddress, req.Set)
+	if err != nil {
+		return nil, err
+	}
+
+	digests, err := r.hg.BucketDigests(set, shardAddr)
+	if err != nil {
+		r.logger.Error("could not compute bucket digests", zap.Error(err))
+		return nil, status.Error(codes.Internal, "could not compute digests")
+	}
+
+	return &protobufs.HypergraphBucketDigestsResponse{
+		BucketHashes: digests,
+	}, nil
+}
+
+// GetAtomIds implements protobufs.HypergraphSyncServiceServer.
+func (r *HypergraphReplicator) GetAtomIds(
+	ctx context.Context,
+	req *protobufs.HypergraphAtomIdsRequest,
+) (*protobufs.HypergraphAtomIdsResponse, error) {
+	shardAddr, set, err := parseShardSet(req.ShardAddress, req.Set)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bucket := range req.Buckets {
+		if bucket >= HypergraphBuckets {
+			return nil, status.Error(codes.InvalidArgument, "invalid bucket")
+		}
+	}
+
+	ids, err := r.hg.AtomIds(set, shardAddr, req.Buckets)
+	if err != nil {
+		r.logger.Error("could not list atom ids", zap.Error(err))
+		return nil, status.Error(codes.Internal, "could not list atom ids")
+	}
+
+	return &protobufs.HypergraphAtomIdsResponse{AtomIds: ids}, nil
+}
+
+// GetAtoms implements protobufs.HypergraphSyncServiceServer.
+func (r *HypergraphReplicator) GetAtoms(
+	ctx context.Context,
+	req *protobufs.HypergraphAtomsRequest,
+) (*protobufs.HypergraphAtomsResponse, error) {
+	shardAddr, set, err := parseShardSet(req.ShardAddress, req.Set)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.AtomIds) > maxSyncAtoms {
+		return nil, status.Error(codes.InvalidArgument, "too many atoms")
+	}
+
+	for _, id := range req.AtomIds {
+		if len(id) != 66 {
+			return nil, status.Error(codes.InvalidArgument, "invalid atom id")
+		}
+	}
+
+	atoms, err := r.hg.GetEncodedAtoms(set, shardAddr, req.AtomIds)
+	if err != nil {
+		r.logger.Error("could not get atoms", zap.Error(err))
+		return nil, status.Error(codes.Internal, "could not get atoms")
+	}
+
+	return &protobufs.HypergraphAtomsResponse{Atoms: atoms}, nil
+}
+
+func parseShardAddress(data []byte) (ShardAddress, error) {
+	if len(data) != shardAddressLength {
+		return ShardAddress{}, status.Error(
+			codes.InvalidArgument,
+			"invalid shard address",
+		)
+	}
+
+	return ShardAddress{
+		L1: [3]byte(data[:3]),
+		L2: [48]byte(data[3:]),
+	}, nil
+}
+
+func parseShardSet(
+	data []byte,
+	set protobufs.HypergraphSet,
+) (ShardAddress, byte, error) {
+	shardAddr, err := parseShardAddress(data)
+	if err != nil {
+		return ShardAddress{}, 0, err
+	}
+
+	if set < protobufs.HypergraphSet_HYPERGRAPH_SET_VERTEX_ADDS ||
+		set > protobufs.HypergraphSet_HYPERGRAPH_SET_HYPEREDGE_REMOVES {
+		return ShardAddress{}, 0, status.Error(
+			codes.InvalidArgument,
+			"invalid set",
+		)
+	}
+
+	return shardAddr, byte(set), nil
+}
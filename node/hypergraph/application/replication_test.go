@@ -0,0 +1,407 @@
+package application_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/hypergraph/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// lossyClient calls a replica's server in process, dropping a share of the
+// requests and responses.
+type lossyClient struct {
+	server   protobufs.HypergraphSyncServiceServer
+	rng      *rand.Rand
+	dropRate float64
+}
+
+var _ protobufs.HypergraphSyncServiceClient = (*lossyClient)(nil)
+
+func (c *lossyClient) drop() error {
+	if c.rng.Float64() < c.dropRate {
+		return status.Error(codes.Unavailable, "dropped")
+	}
+
+	return nil
+}
+
+func lossyCall[Req any, Resp any](
+	c *lossyClient,
+	ctx context.Context,
+	req Req,
+	call func(context.Context, Req) (Resp, error),
+) (Resp, error) {
+	var none Resp
+	if err := c.drop(); err != nil {
+		return none, err
+	}
+
+	resp, err := call(ctx, req)
+	if err != nil {
+		return none, err
+	}
+
+	if err := c.drop(); err != nil {
+		return none, err
+	}
+
+	return resp, nil
+}
+
+func (c *lossyClient) GetShardDigest(
+	ctx context.Context,
+	req *protobufs.HypergraphShardDigestRequest,
+	opts ...grpc.CallOption,
+) (*protobufs.HypergraphShardDigestResponse, error) {
+	return lossyCall(c, ctx, req, c.server.GetShardDigest)
+}
+
+func (c *lossyClient) GetBucketDigests(
+	ctx context.Context,
+	req *protobufs.HypergraphBucketDigestsRequest,
+	opts ...grpc.CallOption,
+) (*protobufs.HypergraphBucketDigestsResponse, error) {
+	return lossyCall(c, ctx, req, c.server.GetBucketDigests)
+}
+
+func (c *lossyClient) GetAtomIds(
+	ctx context.Context,
+	req *protobufs.HypergraphAtomIdsRequest,
+	opts ...grpc.CallOption,
+) (*protobufs.HypergraphAtomIdsResponse, error) {
+	return lossyCall(c, ctx, req, c.server.GetAtomIds)
+}
+
+func (c *lossyClient) GetAtoms(
+	ctx context.Context,
+	req *protobufs.HypergraphAtomsRequest,
+	opts ...grpc.CallOption,
+) (*protobufs.HypergraphAtomsResponse, error) {
+	return lossyCall(c, ctx, req, c.server.GetAtoms)
+}
+
+type replica struct {
+	hg         *application.Hypergraph
+	replicator *application.HypergraphReplicator
+}
+
+func newReplica(hg *application.Hypergraph) *replica {
+	return &replica{
+		hg: hg,
+		replicator: application.NewHypergraphReplicator(
+			zap.NewNop(),
+			nil,
+			nil,
+			hg,
+			clock.NewRealClock(),
+		),
+	}
+}
+
+func applyOperation(hg *application.Hypergraph, op Operation) {
+	switch op.Type {
+	case "AddVertex":
+		hg.AddVertex(op.Vertex)
+	case "RemoveVertex":
+		hg.RemoveVertex(op.Vertex)
+	case "AddHyperedge":
+		hg.AddHyperedge(op.Hyperedge)
+	case "RemoveHyperedge":
+		hg.RemoveHyperedge(op.Hyperedge)
+	}
+}
+
+func TestReplicationConvergence(t *testing.T) {
+	for name, newHypergraph := range hypergraphBackends {
+		t.Run(name, func(t *testing.T) {
+			testReplicationConvergence(t, newHypergraph)
+		})
+	}
+}
+
+func testReplicationConvergence(
+	t *testing.T,
+	newHypergraph func(t *testing.T) *application.Hypergraph,
+) {
+	rng := rand.New(rand.NewSource(1))
+	numReplicas := 3
+
+	// Few addresses, so shards hold several atoms each.
+	vertices := []*application.Vertex{}
+	for i := 0; i < 60; i++ {
+		vertices = append(vertices, &application.Vertex{
+			AppAddress:   [32]byte{byte(i % 3)},
+			DataAddress:  [32]byte{byte(i % 2)},
+			SegmentOrder: uint16(i),
+		})
+	}
+
+	hyperedges := []*application.Hyperedge{}
+	for i := 0; i < 12; i++ {
+		h := &application.Hyperedge{
+			AppAddress:  [32]byte{byte(i % 3)},
+			DataAddress: [32]byte{byte(i % 2)},
+			Index:       uint16(i),
+			Extrinsics:  map[[66]byte]application.Atom{},
+		}
+		for j := 0; j < 2; j++ {
+			v := vertices[rng.Intn(len(vertices))]
+			h.Extrinsics[v.GetID()] = v
+		}
+		hyperedges = append(hyperedges, h)
+	}
+
+	shards := map[application.ShardAddress]struct{}{}
+	for _, v := range vertices {
+		shards[application.GetShardAddress(v)] = struct{}{}
+	}
+	for _, h := range hyperedges {
+		shards[application.GetShardAddress(h)] = struct{}{}
+	}
+
+	// Each replica applies its own operations, in its own order.
+	replicas := []*replica{}
+	for i := 0; i < numReplicas; i++ {
+		r := newReplica(newHypergraph(t))
+		for j := 0; j < 80; j++ {
+			var op Operation
+			switch rng.Intn(4) {
+			case 0:
+				op = Operation{Type: "AddVertex", Vertex: vertices[rng.Intn(len(vertices))]}
+			case 1:
+				op = Operation{Type: "RemoveVertex", Vertex: vertices[rng.Intn(len(vertices))]}
+			case 2:
+				op = Operation{Type: "AddHyperedge", Hyperedge: hyperedges[rng.Intn(len(hyperedges))]}
+			case 3:
+				op = Operation{Type: "RemoveHyperedge", Hyperedge: hyperedges[rng.Intn(len(hyperedges))]}
+			}
+			applyOperation(r.hg, op)
+		}
+		replicas = append(replicas, r)
+	}
+
+	// Replicas pull from each other in random pairs over lossy links, until
+	// all hold the same state.
+	converged := func() bool {
+		for shard := range shards {
+			digest, err := replicas[0].hg.ShardDigest(shard)
+			require.NoError(t, err)
+			for _, r := range replicas[1:] {
+				other, err := r.hg.ShardDigest(shard)
+				require.NoError(t, err)
+				if !assert.ObjectsAreEqual(digest, other) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	require.False(t, converged())
+	for round := 0; !converged(); round++ {
+		require.Less(t, round, 100, "replicas did not converge")
+
+		for _, k := range rng.Perm(numReplicas * numReplicas) {
+			i, j := k/numReplicas, k%numReplicas
+			if i == j {
+				continue
+			}
+
+			client := &lossyClient{
+				server:   replicas[j].replicator,
+				rng:      rng,
+				dropRate: 0.2,
+			}
+			for shard := range shards {
+				// A dropped message fails the pull, a later round retries.
+				replicas[i].replicator.SyncShard(context.Background(), client, shard)
+			}
+		}
+	}
+
+	for _, v := range vertices {
+		for _, r := range replicas[1:] {
+			assert.Equal(t, lookupVertex(t, replicas[0].hg, v), lookupVertex(t, r.hg, v))
+		}
+	}
+	for _, h := range hyperedges {
+		for _, r := range replicas[1:] {
+			assert.Equal(
+				t,
+				lookupHyperedge(t, replicas[0].hg, h),
+				lookupHyperedge(t, r.hg, h),
+			)
+		}
+	}
+
+	// A replica newly assigned to the shards fetches their whole state.
+	fresh := newReplica(newHypergraph(t))
+	for shard := range shards {
+		require.NoError(t, fresh.replicator.SyncShard(
+			context.Background(),
+			&lossyClient{server: replicas[0].replicator, rng: rng},
+			shard,
+		))
+
+		digest, err := replicas[0].hg.ShardDigest(shard)
+		require.NoError(t, err)
+		other, err := fresh.hg.ShardDigest(shard)
+		require.NoError(t, err)
+		assert.Equal(t, digest, other)
+	}
+}
+
+func TestReplicationRejectsForeignAtoms(t *testing.T) {
+	hg := application.NewHypergraph()
+	v1 := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{1}}
+	v2 := &application.Vertex{AppAddress: [32]byte{2}, DataAddress: [32]byte{2}}
+
+	err := hg.MergeAtoms(
+		application.HypergraphSets[0],
+		application.GetShardAddress(v1),
+		[]application.Atom{v2},
+	)
+	assert.ErrorIs(t, err, application.ErrInvalidAtom)
+
+	err = hg.MergeAtoms(
+		application.HypergraphSets[2],
+		application.GetShardAddress(v1),
+		[]application.Atom{v1},
+	)
+	assert.ErrorIs(t, err, application.ErrInvalidAtomType)
+	assert.False(t, lookupVertex(t, hg, v1))
+}
+
+// directNetwork connects the direct channels of in-process peers.
+type directNetwork struct {
+	listeners map[string]*bufconn.Listener
+}
+
+// directPubSub is a peer of a directNetwork, whose random peer is the other
+// peer given.
+type directPubSub struct {
+	p2p.PubSub
+	network *directNetwork
+	peerId  []byte
+	other   []byte
+}
+
+func (p *directPubSub) GetPeerID() []byte {
+	return p.peerId
+}
+
+func (p *directPubSub) Subscribe(
+	bitmask []byte,
+	handler func(message *pb.Message) error,
+	opts ...p2p.SubscribeOption,
+) (*p2p.Subscription, error) {
+	return nil, nil
+}
+
+func (p *directPubSub) GetRandomPeer(bitmask []byte) ([]byte, error) {
+	return p.other, nil
+}
+
+func (p *directPubSub) StartDirectChannelListener(
+	key []byte,
+	purpose string,
+	policy p2p.DirectChannelPolicy,
+	register func(server *grpc.Server),
+) error {
+	server := grpc.NewServer()
+	register(server)
+	return server.Serve(p.network.listeners[string(key)])
+}
+
+func (p *directPubSub) GetDirectChannel(
+	peerId []byte,
+	purpose string,
+) (*grpc.ClientConn, error) {
+	listener := p.network.listeners[string(peerId)]
+	return grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(
+			func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			},
+		),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+}
+
+func TestReplicatorSyncsConfiguredShards(t *testing.T) {
+	network := &directNetwork{listeners: map[string]*bufconn.Listener{
+		"a": bufconn.Listen(1 << 20),
+		"b": bufconn.Listen(1 << 20),
+	}}
+
+	v1 := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{1}, SegmentOrder: 1}
+	v2 := &application.Vertex{AppAddress: [32]byte{1}, DataAddress: [32]byte{1}, SegmentOrder: 2}
+	shard := application.GetShardAddress(v1)
+
+	source := application.NewHypergraph()
+	require.NoError(t, source.AddVertex(v1))
+	sourceReplicator := application.NewHypergraphReplicator(
+		zap.NewNop(),
+		&config.EngineConfig{},
+		&directPubSub{network: network, peerId: []byte("a"), other: []byte("b")},
+		source,
+		clock.NewRealClock(),
+	)
+	require.NoError(t, sourceReplicator.Start())
+	defer sourceReplicator.Stop()
+
+	fakeClock := clock.NewFakeClock(time.Unix(0, 0))
+	replica := application.NewHypergraph()
+	replicator := application.NewHypergraphReplicator(
+		zap.NewNop(),
+		&config.EngineConfig{
+			HypergraphShards: []string{hex.EncodeToString(shard.Bytes())},
+		},
+		&directPubSub{network: network, peerId: []byte("b"), other: []byte("a")},
+		replica,
+		fakeClock,
+	)
+	require.NoError(t, replicator.Start())
+	defer replicator.Stop()
+
+	// The configured shard is pulled on start, and again every interval.
+	fakeClock.BlockUntil(1)
+	assert.True(t, lookupVertex(t, replica, v1))
+	assert.False(t, lookupVertex(t, replica, v2))
+
+	require.NoError(t, source.AddVertex(v2))
+	fakeClock.Advance(time.Minute)
+	fakeClock.BlockUntil(1)
+	assert.True(t, lookupVertex(t, replica, v2))
+}
+
+func TestReplicatorRejectsInvalidShards(t *testing.T) {
+	for _, shard := range []string{"zz", "0102"} {
+		replicator := application.NewHypergraphReplicator(
+			zap.NewNop(),
+			&config.EngineConfig{HypergraphShards: []string{shard}},
+			&directPubSub{},
+			application.NewHypergraph(),
+			clock.NewRealClock(),
+		)
+		assert.Error(t, replicator.Start())
+	}
+}
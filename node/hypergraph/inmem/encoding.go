@@ -0,0 +1,133 @@
+package inmem
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidAtom = errors.New("invalid atom")
+
+const (
+	vertexAtomTag    = 0x00
+	hyperedgeAtomTag = 0x01
+)
+
+// Hyperedges nest, bound how deep an encoded atom may go.
+const maxAtomDepth = 64
+
+// MarshalAtom encodes the atom as its type tag, id and location, followed for
+// a hyperedge by its extrinsics in id order, nested hyperedges with their own.
+func MarshalAtom(a Atom) ([]byte, error) {
+	return appendAtom(nil, a, 0)
+}
+
+func appendString(data []byte, s string) []byte {
+	data = binary.AppendUvarint(data, uint64(len(s)))
+	return append(data, s...)
+}
+
+func appendAtom(data []byte, a Atom, depth int) ([]byte, error) {
+	if depth > maxAtomDepth {
+		return nil, errors.Wrap(ErrInvalidAtom, "append atom")
+	}
+
+	switch atom := a.(type) {
+	case *Vertex:
+		data = append(data, vertexAtomTag)
+		data = appendString(data, atom.id)
+		data = appendString(data, string(atom.location))
+	case *Hyperedge:
+		data = append(data, hyperedgeAtomTag)
+		data = appendString(data, atom.id)
+		data = appendString(data, string(atom.location))
+		data = binary.AppendUvarint(data, uint64(len(atom.extrinsics)))
+
+		ids := make([]string, 0, len(atom.extrinsics))
+		for id := range atom.extrinsics {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		var err error
+		for _, id := range ids {
+			data, err = appendAtom(data, atom.extrinsics[id], depth+1)
+			if err != nil {
+				return nil, errors.Wrap(err, "append atom")
+			}
+		}
+	default:
+		return nil, errors.Wrap(ErrInvalidAtomType, "append atom")
+	}
+
+	return data, nil
+}
+
+// UnmarshalAtom decodes an atom encoded by MarshalAtom.
+func UnmarshalAtom(data []byte) (Atom, error) {
+	atom, rest, err := readAtom(data, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal atom")
+	}
+
+	if len(rest) != 0 {
+		return nil, errors.Wrap(ErrInvalidAtom, "unmarshal atom")
+	}
+
+	return atom, nil
+}
+
+func readString(data []byte) (string, []byte, error) {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || length > uint64(len(data)-n) {
+		return "", nil, ErrInvalidAtom
+	}
+
+	data = data[n:]
+	return string(data[:length]), data[length:], nil
+}
+
+func readAtom(data []byte, depth int) (Atom, []byte, error) {
+	if depth > maxAtomDepth || len(data) < 1 {
+		return nil, nil, ErrInvalidAtom
+	}
+
+	tag := data[0]
+	id, data, err := readString(data[1:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	location, data, err := readString(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch tag {
+	case vertexAtomTag:
+		return NewVertex(id, Location(location)), data, nil
+	case hyperedgeAtomTag:
+		count, n := binary.Uvarint(data)
+		// Every extrinsic takes at least a tag and two lengths.
+		if n <= 0 || count > uint64(len(data)-n)/3 {
+			return nil, nil, ErrInvalidAtom
+		}
+		data = data[n:]
+
+		extrinsics := make(map[string]Atom, count)
+		for i := uint64(0); i < count; i++ {
+			var extrinsic Atom
+			extrinsic, data, err = readAtom(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			extrinsics[extrinsic.GetID()] = extrinsic
+		}
+
+		return NewHyperedge(id, Location(location), extrinsics), data, nil
+	default:
+		return nil, nil, ErrInvalidAtom
+	}
+}
@@ -1,13 +1,22 @@
 package inmem
 
-import "errors"
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
 
 var ErrInvalidLocation error = errors.New("invalid location")
 var ErrMissingExtrinsics error = errors.New("missing extrinsics")
 var ErrIsExtrinsic error = errors.New("is extrinsic")
 
+// HypergraphCRDT is a two-phase set CRDT of vertices and hyperedges, with
+// add and remove sets per location. The sets are held in memory and, for a
+// persistent hypergraph, written through to a HypergraphStore.
 type HypergraphCRDT struct {
-	locations map[Location]struct{}
+	locations       map[Location]struct{}
+	hypergraphStore store.HypergraphStore
 
 	vertexAdds       map[Location]*IdSet
 	vertexRemoves    map[Location]*IdSet
@@ -35,11 +44,126 @@ func NewHypergraphCRDT(locations []Location) *HypergraphCRDT {
 	return hypergraph
 }
 
+// NewPersistentHypergraphCRDT returns a hypergraph kept in the store, with the
+// state the store already holds for the locations.
+func NewPersistentHypergraphCRDT(
+	hypergraphStore store.HypergraphStore,
+	locations []Location,
+) (*HypergraphCRDT, error) {
+	if hypergraphStore == nil {
+		panic("hypergraph store is nil")
+	}
+
+	hypergraph := NewHypergraphCRDT(locations)
+	hypergraph.hypergraphStore = hypergraphStore
+
+	for _, l := range locations {
+		for set, ids := range map[byte]*IdSet{
+			store.HYPERGRAPH_VERTEX_ADDS:       hypergraph.vertexAdds[l],
+			store.HYPERGRAPH_VERTEX_REMOVES:    hypergraph.vertexRemoves[l],
+			store.HYPERGRAPH_HYPEREDGE_ADDS:    hypergraph.hyperedgeAdds[l],
+			store.HYPERGRAPH_HYPEREDGE_REMOVES: hypergraph.hyperedgeRemoves[l],
+		} {
+			if err := hypergraph.load(set, l, ids); err != nil {
+				return nil, errors.Wrap(err, "new persistent hypergraph crdt")
+			}
+		}
+	}
+
+	return hypergraph, nil
+}
+
+// locationKey is the shard address the atoms of the location are stored
+// under. It is length prefixed, so no location's key prefixes another's.
+func locationKey(l Location) []byte {
+	key := binary.AppendUvarint(nil, uint64(len(l)))
+	return append(key, l...)
+}
+
+func (hg *HypergraphCRDT) load(set byte, l Location, ids *IdSet) error {
+	iter, err := hg.hypergraphStore.RangeAtoms(set, locationKey(l))
+	if err != nil {
+		return errors.Wrap(err, "load")
+	}
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		data, err := iter.Value()
+		if err != nil {
+			return errors.Wrap(err, "load")
+		}
+
+		atom, err := UnmarshalAtom(data)
+		if err != nil {
+			return errors.Wrap(err, "load")
+		}
+
+		if err := ids.Add(atom); err != nil {
+			return errors.Wrap(err, "load")
+		}
+	}
+
+	return nil
+}
+
+// setAdd is an atom to add to one of the sets of a location.
+type setAdd struct {
+	set      byte
+	ids      *IdSet
+	location Location
+	atom     Atom
+}
+
+// add adds the atoms to their sets, persisting them first in a single
+// transaction for a persistent hypergraph.
+func (hg *HypergraphCRDT) add(adds []setAdd) error {
+	if hg.hypergraphStore != nil {
+		txn, err := hg.hypergraphStore.NewTransaction()
+		if err != nil {
+			return errors.Wrap(err, "add")
+		}
+
+		for _, a := range adds {
+			if a.ids.Has(a.atom) {
+				continue
+			}
+
+			data, err := MarshalAtom(a.atom)
+			if err != nil {
+				txn.Abort()
+				return errors.Wrap(err, "add")
+			}
+
+			if err := hg.hypergraphStore.PutAtom(
+				txn,
+				a.set,
+				locationKey(a.location),
+				[]byte(a.atom.GetID()),
+				data,
+			); err != nil {
+				txn.Abort()
+				return errors.Wrap(err, "add")
+			}
+		}
+
+		if err := txn.Commit(); err != nil {
+			return errors.Wrap(err, "add")
+		}
+	}
+
+	for _, a := range adds {
+		if err := a.ids.Add(a.atom); err != nil {
+			return errors.Wrap(err, "add")
+		}
+	}
+
+	return nil
+}
+
 func (hg *HypergraphCRDT) AddAtom(a Atom) error {
 	switch v := a.(type) {
 	case *Vertex:
-		hg.AddVertex(v)
-		return nil
+		return hg.AddVertex(v)
 	case *Hyperedge:
 		return hg.AddHyperedge(v)
 	}
@@ -47,15 +171,23 @@ func (hg *HypergraphCRDT) AddAtom(a Atom) error {
 	return nil
 }
 
-func (hg *HypergraphCRDT) AddVertex(v *Vertex) {
+func (hg *HypergraphCRDT) AddVertex(v *Vertex) error {
+	adds := []setAdd{}
 	shardMap := ShardVertex(v)
 	for location, vertices := range shardMap {
 		for _, vertex := range vertices.VertexSet.atoms {
 			if vert, ok := vertex.(*Vertex); ok {
-				hg.vertexAdds[location].Add(vert)
+				adds = append(adds, setAdd{
+					store.HYPERGRAPH_VERTEX_ADDS,
+					hg.vertexAdds[location],
+					location,
+					vert,
+				})
 			}
 		}
 	}
+
+	return hg.add(adds)
 }
 
 func (hg *HypergraphCRDT) AddVertexSet(vertices *IdSet) error {
@@ -63,26 +195,38 @@ func (hg *HypergraphCRDT) AddVertexSet(vertices *IdSet) error {
 		return ErrInvalidAtomType
 	}
 
+	adds := []setAdd{}
 	shardMap := ShardAtomSet(vertices.atoms)
 	for location, vertices := range shardMap {
 		for _, vertex := range vertices.VertexSet.atoms {
 			if vert, ok := vertex.(*Vertex); ok {
-				hg.vertexAdds[location].Add(vert)
+				adds = append(adds, setAdd{
+					store.HYPERGRAPH_VERTEX_ADDS,
+					hg.vertexAdds[location],
+					location,
+					vert,
+				})
 			}
 		}
 	}
 
-	return nil
+	return hg.add(adds)
 }
 
 func (hg *HypergraphCRDT) AddHyperedge(h *Hyperedge) error {
 	if hg.LookupAtomSet(h.extrinsics) {
+		adds := []setAdd{}
 		shardMap := ShardHyperedge(h)
 
 		for location, set := range shardMap {
 			for _, hyperedge := range set.HyperedgeSet.atoms {
 				if he, ok := hyperedge.(*Hyperedge); ok {
-					hg.hyperedgeAdds[location].Add(he)
+					adds = append(adds, setAdd{
+						store.HYPERGRAPH_HYPEREDGE_ADDS,
+						hg.hyperedgeAdds[location],
+						location,
+						he,
+					})
 				}
 			}
 			for _, vertex := range set.VertexSet.atoms {
@@ -92,7 +236,7 @@ func (hg *HypergraphCRDT) AddHyperedge(h *Hyperedge) error {
 			}
 		}
 
-		return nil
+		return hg.add(adds)
 	} else {
 		return ErrMissingExtrinsics
 	}
@@ -125,7 +269,12 @@ func (hg *HypergraphCRDT) RemoveVertex(v *Vertex) error {
 			}
 		}
 
-		hg.vertexRemoves[v.location].Add(v)
+		return hg.add([]setAdd{{
+			store.HYPERGRAPH_VERTEX_REMOVES,
+			hg.vertexRemoves[v.location],
+			v.location,
+			v,
+		}})
 	}
 
 	return nil
@@ -145,7 +294,12 @@ func (hg *HypergraphCRDT) RemoveHyperedge(h *Hyperedge) error {
 			}
 		}
 
-		hg.hyperedgeRemoves[h.location].Add(h)
+		return hg.add([]setAdd{{
+			store.HYPERGRAPH_HYPEREDGE_REMOVES,
+			hg.hyperedgeRemoves[h.location],
+			h.location,
+			h,
+		}})
 	}
 
 	return nil
@@ -4,9 +4,39 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	hypergraph "source.quilibrium.com/quilibrium/monorepo/node/hypergraph/inmem"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
 )
 
+// crdtBackends builds a hypergraph on each backend the tests run against.
+var crdtBackends = map[string]func(
+	t *testing.T,
+	locations []hypergraph.Location,
+) *hypergraph.HypergraphCRDT{
+	"InMem": func(
+		t *testing.T,
+		locations []hypergraph.Location,
+	) *hypergraph.HypergraphCRDT {
+		return hypergraph.NewHypergraphCRDT(locations)
+	},
+	"Pebble": func(
+		t *testing.T,
+		locations []hypergraph.Location,
+	) *hypergraph.HypergraphCRDT {
+		db := store.NewPebbleDB(&config.DBConfig{Path: t.TempDir()})
+		t.Cleanup(func() { db.Close() })
+		hg, err := hypergraph.NewPersistentHypergraphCRDT(
+			store.NewPebbleHypergraphStore(db, zap.NewNop()),
+			locations,
+		)
+		require.NoError(t, err)
+		return hg
+	},
+}
+
 func TestIdSet(t *testing.T) {
 	v := hypergraph.NewVertex("1", "here")
 	h := hypergraph.NewHyperedge("2", "here", make(map[string]hypergraph.Atom))
@@ -24,9 +54,23 @@ func TestIdSet(t *testing.T) {
 }
 
 func TestCRDT(t *testing.T) {
+	for name, newCRDT := range crdtBackends {
+		t.Run(name, func(t *testing.T) {
+			testCRDT(t, newCRDT)
+		})
+	}
+}
+
+func testCRDT(
+	t *testing.T,
+	newCRDT func(
+		t *testing.T,
+		locations []hypergraph.Location,
+	) *hypergraph.HypergraphCRDT,
+) {
 	loc1 := hypergraph.Location("here1")
 	loc2 := hypergraph.Location("here2")
-	hg := hypergraph.NewHypergraphCRDT([]hypergraph.Location{loc1, loc2})
+	hg := newCRDT(t, []hypergraph.Location{loc1, loc2})
 
 	v1 := hypergraph.NewVertex("1", loc1)
 	v2 := hypergraph.NewVertex("2", loc2)
@@ -73,3 +117,76 @@ func TestCRDT(t *testing.T) {
 	assert.NoError(t, hg.RemoveVertex(v2))
 	assert.False(t, hg.GetReconciledVertexSet(v2.GetLocation()).Has(v2))
 }
+
+func TestPersistentCRDT(t *testing.T) {
+	path := t.TempDir()
+	loc1 := hypergraph.Location("here1")
+	// A location whose key starts like the other's doesn't see its atoms.
+	loc10 := hypergraph.Location("here10")
+	locations := []hypergraph.Location{loc1, loc10}
+
+	db := store.NewPebbleDB(&config.DBConfig{Path: path})
+	hg, err := hypergraph.NewPersistentHypergraphCRDT(
+		store.NewPebbleHypergraphStore(db, zap.NewNop()),
+		locations,
+	)
+	require.NoError(t, err)
+
+	v1 := hypergraph.NewVertex("1", loc1)
+	v2 := hypergraph.NewVertex("2", loc10)
+	v3 := hypergraph.NewVertex("3", loc1)
+	h1 := hypergraph.NewHyperedge("h1", loc10, map[string]hypergraph.Atom{
+		"1": v1,
+		"2": v2,
+	})
+	require.NoError(t, hg.AddVertex(v1))
+	require.NoError(t, hg.AddVertex(v2))
+	require.NoError(t, hg.AddVertex(v3))
+	require.NoError(t, hg.AddHyperedge(h1))
+	require.NoError(t, hg.RemoveVertex(v3))
+	require.NoError(t, db.Close())
+
+	db = store.NewPebbleDB(&config.DBConfig{Path: path})
+	defer db.Close()
+	hg, err = hypergraph.NewPersistentHypergraphCRDT(
+		store.NewPebbleHypergraphStore(db, zap.NewNop()),
+		locations,
+	)
+	require.NoError(t, err)
+
+	assert.True(t, hg.LookupVertex(v1))
+	assert.True(t, hg.LookupVertex(v2))
+	assert.False(t, hg.LookupVertex(v3))
+	assert.True(t, hg.LookupHyperedge(h1))
+	assert.ErrorIs(t, hg.RemoveVertex(v1), hypergraph.ErrIsExtrinsic)
+
+	vertices := hg.GetReconciledVertexSet(loc1)
+	assert.True(t, vertices.Has(v1))
+	assert.False(t, vertices.Has(v2))
+	assert.False(t, vertices.Has(v3))
+	assert.True(t, hg.GetReconciledVertexSet(loc10).Has(v2))
+	assert.True(t, hg.GetReconciledHyperedgeSet(loc10).Has(h1))
+	assert.False(t, hg.GetReconciledHyperedgeSet(loc1).Has(h1))
+}
+
+func TestCRDTAtomEncoding(t *testing.T) {
+	v1 := hypergraph.NewVertex("1", "here")
+	h1 := hypergraph.NewHyperedge("h1", "there", map[string]hypergraph.Atom{
+		"1": v1,
+	})
+	h2 := hypergraph.NewHyperedge("h2", "here", map[string]hypergraph.Atom{
+		"1":  v1,
+		"h1": h1,
+	})
+
+	data, err := hypergraph.MarshalAtom(h2)
+	require.NoError(t, err)
+	atom, err := hypergraph.UnmarshalAtom(data)
+	require.NoError(t, err)
+	assert.Equal(t, h2, atom)
+
+	_, err = hypergraph.UnmarshalAtom(data[:len(data)-1])
+	assert.ErrorIs(t, err, hypergraph.ErrInvalidAtom)
+	_, err = hypergraph.UnmarshalAtom(append(data, 0))
+	assert.ErrorIs(t, err, hypergraph.ErrInvalidAtom)
+}
@@ -16,6 +16,7 @@ const (
 	KeyTypeBLS48581G1
 	KeyTypeBLS48581G2
 	KeyTypePCAS
+	KeyTypeAES256
 )
 
 type KeyManager interface {
@@ -4,6 +4,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/hex"
 	"flag"
@@ -40,6 +41,7 @@ import (
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/crypto/kzg"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/tracing"
 	"source.quilibrium.com/quilibrium/monorepo/node/rpc"
 )
 
@@ -124,6 +126,21 @@ var (
 		false,
 		"runs an integrity check on the store, helpful for confirming backups are not corrupted (defaults to false)",
 	)
+	traceCollector = flag.String(
+		"trace-collector",
+		"",
+		"runs a standalone blossomsub trace collector, storing traces sent by nodes' remote tracers in the specified directory",
+	)
+	traceCollectorListen = flag.String(
+		"trace-collector-listen",
+		"/ip4/0.0.0.0/tcp/8339",
+		"the multiaddr the trace collector listens on",
+	)
+	traceAnalyze = flag.String(
+		"trace-analyze",
+		"",
+		"prints a propagation report for a blossomsub trace file or trace collector directory and exits",
+	)
 )
 
 func signatureCheckDefault() bool {
@@ -249,6 +266,16 @@ func main() {
 		}()
 	}
 
+	if *traceAnalyze != "" {
+		analyzeTraces(*traceAnalyze)
+		return
+	}
+
+	if *traceCollector != "" {
+		runTraceCollector(*traceCollector, *traceCollectorListen)
+		return
+	}
+
 	if *balance {
 		config, err := config.LoadConfig(*configDirectory, "", false)
 		if err != nil {
@@ -463,6 +490,8 @@ func main() {
 			node.GetPubSub(),
 			node.GetMasterClock(),
 			node.GetExecutionEngines(),
+			node.GetMessenger(),
+			node.GetHypergraphQueryService(),
 		)
 		if err != nil {
 			panic(err)
@@ -478,11 +507,35 @@ func main() {
 
 	node.Start()
 
+	go reloadAllowlistOnHangup(*configDirectory, node)
+
 	<-done
 	stopDataWorkers()
 	node.Stop()
 }
 
+// reloadAllowlistOnHangup re-reads the peer allowlist from the config file
+// whenever the node receives SIGHUP, so private network membership can change
+// without a restart.
+func reloadAllowlistOnHangup(configDirectory string, node *app.Node) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	for range hangup {
+		nodeConfig, err := config.LoadConfig(configDirectory, "", false)
+		if err != nil {
+			node.GetLogger().Error("could not reload config", zap.Error(err))
+			continue
+		}
+
+		if err := node.GetPubSub().ReloadAllowlist(nodeConfig.P2P); err != nil {
+			node.GetLogger().Error("could not reload allowlist", zap.Error(err))
+			continue
+		}
+
+		node.GetLogger().Info("reloaded peer allowlist")
+	}
+}
+
 var dataWorkers []*exec.Cmd
 
 func spawnDataWorkers(nodeConfig *config.Config) {
@@ -665,6 +718,53 @@ func getPeerID(p2pConfig *config.P2PConfig) peer.ID {
 	return id
 }
 
+func analyzeTraces(path string) {
+	analyzer := tracing.NewAnalyzer()
+	if err := tracing.ReadPath(path, analyzer.Add); err != nil {
+		panic(err)
+	}
+
+	analyzer.Report(10).Print(os.Stdout)
+}
+
+func runTraceCollector(dir string, listenMultiaddr string) {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(err)
+	}
+
+	h, err := tracing.NewCollectorHost(dir, listenMultiaddr)
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
+
+	collector, err := tracing.NewCollector(
+		logger,
+		h,
+		dir,
+		tracing.DefaultMaxFileSize,
+		tracing.DefaultMaxFileAge,
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer collector.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go collector.Run(ctx)
+
+	fmt.Println("Trace collector listening, set traceRemotePeer to one of:")
+	for _, addr := range h.Addrs() {
+		fmt.Println(addr.String() + "/p2p/" + h.ID().String())
+	}
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
+	<-done
+}
+
 func printPeerID(p2pConfig *config.P2PConfig) {
 	id := getPeerID(p2pConfig)
 
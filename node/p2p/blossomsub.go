@@ -5,15 +5,10 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"io"
 	"math/big"
 	"math/bits"
 	"net"
-	"net/http"
-	"strconv"
-	"strings"
 	"sync"
 	"time"
 
@@ -35,16 +30,16 @@ import (
 	"github.com/mr-tron/base58"
 	ma "github.com/multiformats/go-multiaddr"
 	madns "github.com/multiformats/go-multiaddr-dns"
-	mn "github.com/multiformats/go-multiaddr/net"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
 	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
 )
 
 type BlossomSub struct {
@@ -57,8 +52,16 @@ type BlossomSub struct {
 	signKey         crypto.PrivKey
 	peerScore       map[string]int64
 	peerScoreMx     sync.Mutex
+	bitmaskMx       sync.Mutex
+	subscriptions   map[string]map[*Subscription]struct{}
+	subscribeOpts   subscribeOptions
+	scoreSnapshots  map[peer.ID]*blossomsub.PeerScoreSnapshot
 	isBootstrapPeer bool
 	network         uint8
+	gater           *ConnectionGater
+	alwaysAllowed   []peer.AddrInfo
+	directChannels  map[string]*config.DirectChannelConfig
+	clock           clock.Clock
 }
 
 var _ PubSub = (*BlossomSub)(nil)
@@ -131,10 +134,12 @@ func NewBlossomSubStreamer(
 		ctx:             ctx,
 		logger:          logger,
 		bitmaskMap:      make(map[string]*blossomsub.Bitmask),
+		subscriptions:   make(map[string]map[*Subscription]struct{}),
 		signKey:         privKey,
 		peerScore:       make(map[string]int64),
 		isBootstrapPeer: false,
 		network:         p2pConfig.Network,
+		clock:           clock.NewRealClock(),
 	}
 
 	h, err := libp2p.New(opts...)
@@ -149,6 +154,7 @@ func NewBlossomSubStreamer(
 		p2pConfig,
 		logger,
 		h,
+		bs.clock,
 		false,
 		bootstrappers,
 	)
@@ -169,7 +175,9 @@ func NewBlossomSubStreamer(
 
 func NewBlossomSub(
 	p2pConfig *config.P2PConfig,
+	peerstore store.Peerstore,
 	logger *zap.Logger,
+	clock clock.Clock,
 ) *BlossomSub {
 	ctx := context.Background()
 
@@ -255,6 +263,18 @@ func NewBlossomSub(
 	}
 	allowedPeers = append(allowedPeers, directPeers...)
 
+	pnetOpts, err := privateNetworkOptions(p2pConfig)
+	if err != nil {
+		panic(err)
+	}
+	opts = append(opts, pnetOpts...)
+
+	gater, err := NewConnectionGater(p2pConfig, allowedPeers)
+	if err != nil {
+		panic(err)
+	}
+	opts = append(opts, libp2p.ConnectionGater(gater))
+
 	if p2pConfig.LowWatermarkConnections != 0 &&
 		p2pConfig.HighWatermarkConnections != 0 {
 		cm, err := connmgr.NewConnManager(
@@ -269,6 +289,7 @@ func NewBlossomSub(
 		rm, err := resourceManager(
 			p2pConfig.HighWatermarkConnections,
 			allowedPeers,
+			gater,
 		)
 		if err != nil {
 			panic(err)
@@ -285,14 +306,43 @@ func NewBlossomSub(
 		opts = append(opts, libp2p.ResourceManager(rm))
 	}
 
+	if p2pConfig.PeerstoreRetention == 0 {
+		p2pConfig.PeerstoreRetention = defaultPeerstoreRetention
+	}
+
+	if p2pConfig.PeerstoreRedialCount == 0 {
+		p2pConfig.PeerstoreRedialCount = defaultPeerstoreRedialCount
+	}
+
+	ps, err := newPersistentPeerstore(
+		ctx,
+		peerstore,
+		p2pConfig.PeerstoreRetention,
+	)
+	if err != nil {
+		panic(err)
+	}
+	opts = append(opts, libp2p.Peerstore(ps))
+
+	natOpts, setRelayHost, err := natOptions(p2pConfig, clock)
+	if err != nil {
+		panic(err)
+	}
+	opts = append(opts, natOpts...)
+
 	bs := &BlossomSub{
 		ctx:             ctx,
 		logger:          logger,
 		bitmaskMap:      make(map[string]*blossomsub.Bitmask),
+		subscriptions:   make(map[string]map[*Subscription]struct{}),
 		signKey:         privKey,
 		peerScore:       make(map[string]int64),
 		isBootstrapPeer: isBootstrapPeer,
 		network:         p2pConfig.Network,
+		gater:           gater,
+		alwaysAllowed:   allowedPeers,
+		directChannels:  p2pConfig.DirectChannels,
+		clock:           clock,
 	}
 
 	h, err := libp2p.New(opts...)
@@ -302,74 +352,102 @@ func NewBlossomSub(
 
 	logger.Info("established peer id", zap.String("peer_id", h.ID().String()))
 
+	setRelayHost(h)
+	go monitorReachability(ctx, logger, h)
+	go maintainPeerstore(ctx, logger, h, clock, p2pConfig.PeerstoreRetention)
+	go redialKnownPeers(ctx, logger, h, p2pConfig.PeerstoreRedialCount)
+
 	kademliaDHT := initDHT(
 		ctx,
 		p2pConfig,
 		logger,
 		h,
+		clock,
 		isBootstrapPeer,
 		bootstrappers,
 	)
 	routingDiscovery := routing.NewRoutingDiscovery(kademliaDHT)
 	util.Advertise(ctx, routingDiscovery, getNetworkNamespace(p2pConfig.Network))
 
-	verifyReachability(p2pConfig)
-
 	discoverPeers(p2pConfig, ctx, logger, h, routingDiscovery, true)
 
-	go monitorPeers(ctx, logger, h)
+	go monitorPeers(ctx, logger, h, clock)
 
 	// TODO: turn into an option flag for console logging, this is too noisy for
 	// default logging behavior
-	var tracer *blossomsub.JSONTracer
+	tracers := multiEventTracer{}
 	if p2pConfig.TraceLogFile == "" {
 		// tracer, err = blossomsub.NewStdoutJSONTracer()
 		// if err != nil {
 		// 	panic(errors.Wrap(err, "error building stdout tracer"))
 		// }
 	} else {
-		tracer, err = blossomsub.NewJSONTracer(p2pConfig.TraceLogFile)
+		tracer, err := blossomsub.NewJSONTracer(p2pConfig.TraceLogFile)
 		if err != nil {
 			panic(errors.Wrap(err, "error building file tracer"))
 		}
+		tracers = append(tracers, tracer)
+	}
+
+	if p2pConfig.TraceRemotePeer != "" {
+		collector, err := peer.AddrInfoFromString(p2pConfig.TraceRemotePeer)
+		if err != nil {
+			panic(errors.Wrap(err, "error parsing remote tracer peer"))
+		}
+
+		tracer, err := blossomsub.NewRemoteTracer(ctx, h, *collector)
+		if err != nil {
+			panic(errors.Wrap(err, "error building remote tracer"))
+		}
+		tracers = append(tracers, tracer)
 	}
 
 	blossomOpts := []blossomsub.Option{
 		blossomsub.WithStrictSignatureVerification(true),
 	}
 
+	overflowPolicy, err := ParseOverflowPolicy(
+		p2pConfig.SubscriptionOverflow,
+	)
+	if err != nil {
+		panic(err)
+	}
+	bs.subscribeOpts = subscribeOptions{
+		queueSize:      p2pConfig.SubscriptionQueueSize,
+		overflowPolicy: overflowPolicy,
+	}
+
+	priorityOpts, err := priorityOptions(p2pConfig)
+	if err != nil {
+		panic(errors.Wrap(err, "error parsing bitmask priorities"))
+	}
+	blossomOpts = append(blossomOpts, priorityOpts...)
+
 	if len(directPeers) > 0 {
 		blossomOpts = append(blossomOpts, blossomsub.WithDirectPeers(directPeers))
 	}
 
-	if tracer != nil {
-		blossomOpts = append(blossomOpts, blossomsub.WithEventTracer(tracer))
-	}
-	blossomOpts = append(blossomOpts, blossomsub.WithPeerScore(
-		&blossomsub.PeerScoreParams{
-			SkipAtomicValidation:        false,
-			BitmaskScoreCap:             0,
-			IPColocationFactorWeight:    0,
-			IPColocationFactorThreshold: 6,
-			BehaviourPenaltyWeight:      0,
-			BehaviourPenaltyThreshold:   100,
-			BehaviourPenaltyDecay:       .5,
-			DecayInterval:               10 * time.Second,
-			DecayToZero:                 .1,
-			RetainScore:                 60 * time.Minute,
-			AppSpecificScore: func(p peer.ID) float64 {
-				return float64(bs.GetPeerScore([]byte(p)))
-			},
-			AppSpecificWeight: 10.0,
+	switch len(tracers) {
+	case 0:
+	case 1:
+		blossomOpts = append(blossomOpts, blossomsub.WithEventTracer(tracers[0]))
+	default:
+		blossomOpts = append(blossomOpts, blossomsub.WithEventTracer(tracers))
+	}
+	peerScoreParams, peerScoreThresholds, err := scoreParams(
+		p2pConfig.Score,
+		func(p peer.ID) float64 {
+			return float64(bs.GetPeerScore([]byte(p)))
 		},
-		&blossomsub.PeerScoreThresholds{
-			SkipAtomicValidation:        false,
-			GossipThreshold:             -2000,
-			PublishThreshold:            -5000,
-			GraylistThreshold:           -10000,
-			AcceptPXThreshold:           1,
-			OpportunisticGraftThreshold: 2,
-		}))
+	)
+	if err != nil {
+		panic(err)
+	}
+	blossomOpts = append(
+		blossomOpts,
+		blossomsub.WithPeerScore(peerScoreParams, peerScoreThresholds),
+		blossomsub.WithPeerScoreInspect(bs.inspectPeerScores, scoreInspectPeriod),
+	)
 
 	params := mergeDefaults(p2pConfig)
 	rt := blossomsub.NewBlossomSubRouter(h, params)
@@ -390,8 +468,14 @@ func NewBlossomSub(
 	}
 	go func() {
 		for {
-			time.Sleep(30 * time.Second)
+			bs.clock.Sleep(30 * time.Second)
+			bs.bitmaskMx.Lock()
+			bitmasks := make([]*blossomsub.Bitmask, 0, len(bs.bitmaskMap))
 			for _, b := range bs.bitmaskMap {
+				bitmasks = append(bitmasks, b)
+			}
+			bs.bitmaskMx.Unlock()
+			for _, b := range bitmasks {
 				bitmaskPeers := b.ListPeers()
 				peerCount := len(bitmaskPeers)
 				for _, p := range bitmaskPeers {
@@ -410,9 +494,23 @@ func NewBlossomSub(
 	return bs
 }
 
+// multiEventTracer fans trace events out to several tracers, as blossomsub
+// only accepts a single event tracer.
+type multiEventTracer []blossomsub.EventTracer
+
+func (m multiEventTracer) Trace(evt *pb.TraceEvent) {
+	for _, t := range m {
+		t.Trace(evt)
+	}
+}
+
 // adjusted from Lotus' reference implementation, addressing
 // https://github.com/libp2p/go-libp2p/issues/1640
-func resourceManager(highWatermark uint, allowed []peer.AddrInfo) (
+func resourceManager(
+	highWatermark uint,
+	allowed []peer.AddrInfo,
+	gater *ConnectionGater,
+) (
 	network.ResourceManager,
 	error,
 ) {
@@ -497,6 +595,8 @@ func resourceManager(highWatermark uint, allowed []peer.AddrInfo) (
 		return nil, errors.Wrap(err, "resource manager")
 	}
 
+	gater.attachAllowlist(rcmgr.GetAllowlist(mgr))
+
 	return mgr, nil
 }
 
@@ -509,71 +609,154 @@ func (b *BlossomSub) Publish(address []byte, data []byte) error {
 	return b.PublishToBitmask(bitmask, data)
 }
 
+// Subscribe joins the bitmask and runs the handler on the messages published
+// to it until the returned subscription is cancelled. Messages wait for the
+// handler in a bounded queue, sized and handling overflow as configured unless
+// overridden by the options.
 func (b *BlossomSub) Subscribe(
 	bitmask []byte,
 	handler func(message *pb.Message) error,
-) error {
+	opts ...SubscribeOption,
+) (*Subscription, error) {
+	options := b.subscribeOpts
+	if options.queueSize == 0 {
+		options.queueSize = defaultSubscriptionQueueSize
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	b.bitmaskMx.Lock()
+	defer b.bitmaskMx.Unlock()
+
 	b.logger.Info("joining broadcast")
-	bm, err := b.ps.Join(bitmask)
-	if err != nil {
-		b.logger.Error("join failed", zap.Error(err))
-		return errors.Wrap(err, "subscribe")
+	bits := []*blossomsub.Bitmask{}
+	for _, slice := range blossomsub.SliceBitmask(bitmask) {
+		bit, ok := b.bitmaskMap[string(slice)]
+		if !ok {
+			bm, err := b.ps.Join(slice)
+			if err != nil {
+				b.logger.Error("join failed", zap.Error(err))
+				return nil, errors.Wrap(err, "subscribe")
+			}
+			bit = bm[0]
+			b.bitmaskMap[string(slice)] = bit
+		}
+		bits = append(bits, bit)
 	}
 
 	b.logger.Info("subscribe to bitmask", zap.Binary("bitmask", bitmask))
-	subs := []*blossomsub.Subscription{}
-	for _, bit := range bm {
-		sub, err := bit.Subscribe()
+	copiedBitmask := make([]byte, len(bitmask))
+	copy(copiedBitmask[:], bitmask[:])
+	ctx, cancel := context.WithCancel(b.ctx)
+	sub := &Subscription{
+		bitmask: copiedBitmask,
+		queue: newHandlerQueue(
+			copiedBitmask,
+			options.queueSize,
+			options.overflowPolicy,
+		),
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		onClose: b.removeSubscription,
+	}
+	for _, bit := range bits {
+		s, err := bit.Subscribe()
 		if err != nil {
 			b.logger.Error("subscription failed", zap.Error(err))
-			return errors.Wrap(err, "subscribe")
-		}
-		_, ok := b.bitmaskMap[string(bit.Bitmask())]
-		if !ok {
-			b.bitmaskMap[string(bit.Bitmask())] = bit
+			cancel()
+			for _, s := range sub.subs {
+				s.Cancel()
+			}
+			return nil, errors.Wrap(err, "subscribe")
 		}
-		subs = append(subs, sub)
+		sub.subs = append(sub.subs, s)
+	}
+
+	if _, ok := b.subscriptions[string(copiedBitmask)]; !ok {
+		b.subscriptions[string(copiedBitmask)] = map[*Subscription]struct{}{}
 	}
+	b.subscriptions[string(copiedBitmask)][sub] = struct{}{}
 
 	b.logger.Info(
 		"begin streaming from bitmask",
 		zap.Binary("bitmask", bitmask),
 	)
+	sub.run(b.logger, handler)
 
-	for _, sub := range subs {
-		copiedBitmask := make([]byte, len(bitmask))
-		copy(copiedBitmask[:], bitmask[:])
-		sub := sub
+	return sub, nil
+}
 
-		go func() {
-			for {
-				m, err := sub.Next(b.ctx)
-				if err != nil {
-					b.logger.Error(
-						"got error when fetching the next message",
-						zap.Error(err),
-					)
-				}
-				if bytes.Equal(m.Bitmask, copiedBitmask) {
-					if err = handler(m.Message); err != nil {
-						b.logger.Debug("message handler returned error", zap.Error(err))
-					}
-				}
-			}
-		}()
+func (b *BlossomSub) removeSubscription(sub *Subscription) {
+	b.bitmaskMx.Lock()
+	defer b.bitmaskMx.Unlock()
+
+	subs, ok := b.subscriptions[string(sub.bitmask)]
+	if !ok {
+		return
 	}
 
-	return nil
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(b.subscriptions, string(sub.bitmask))
+	}
 }
 
-func (b *BlossomSub) Unsubscribe(bitmask []byte, raw bool) {
-	networkBitmask := append([]byte{b.network}, bitmask...)
-	bm, ok := b.bitmaskMap[string(networkBitmask)]
-	if !ok {
-		return
+// Unsubscribe cancels all subscriptions to the bitmask.
+func (b *BlossomSub) Unsubscribe(bitmask []byte) {
+	b.bitmaskMx.Lock()
+	subs := []*Subscription{}
+	for sub := range b.subscriptions[string(bitmask)] {
+		subs = append(subs, sub)
 	}
+	b.bitmaskMx.Unlock()
 
-	bm.Close()
+	for _, sub := range subs {
+		sub.Cancel()
+	}
+}
+
+// RegisterValidator installs a validator for the bitmask that runs before
+// messages are delivered or relayed. Synchronous validators run inline on the
+// validation pipeline and should be cheap, expensive checks such as frame
+// proof verification should be registered asynchronously so they are
+// throttled by the router.
+func (b *BlossomSub) RegisterValidator(
+	bitmask []byte,
+	validator func(peerID []byte, message *pb.Message) ValidationResult,
+	sync bool,
+) error {
+	validatorEx := func(
+		ctx context.Context,
+		peerID peer.ID,
+		message *blossomsub.Message,
+	) blossomsub.ValidationResult {
+		switch validator([]byte(peerID), message.Message) {
+		case ValidationResultAccept:
+			return blossomsub.ValidationAccept
+		case ValidationResultReject:
+			return blossomsub.ValidationReject
+		default:
+			return blossomsub.ValidationIgnore
+		}
+	}
+
+	return errors.Wrap(
+		b.ps.RegisterBitmaskValidator(
+			bitmask,
+			validatorEx,
+			blossomsub.WithValidatorInline(sync),
+		),
+		"register validator",
+	)
+}
+
+func (b *BlossomSub) UnregisterValidator(bitmask []byte) error {
+	return errors.Wrap(
+		b.ps.UnregisterBitmaskValidator(bitmask),
+		"unregister validator",
+	)
 }
 
 func (b *BlossomSub) GetPeerID() []byte {
@@ -601,7 +784,12 @@ func (b *BlossomSub) GetRandomPeer(bitmask []byte) ([]byte, error) {
 // monitorPeers periodically looks up the peers connected to the host and pings them
 // up to 3 times to ensure they are still reachable. If the peer is not reachable after
 // 3 attempts, the connections to the peer are closed.
-func monitorPeers(ctx context.Context, logger *zap.Logger, h host.Host) {
+func monitorPeers(
+	ctx context.Context,
+	logger *zap.Logger,
+	h host.Host,
+	clock clock.Clock,
+) {
 	const timeout, period, attempts = time.Minute, time.Minute, 3
 	// Do not allow the pings to dial new connections. Adding new peers is a separate
 	// process and should not be done during the ping process.
@@ -645,7 +833,7 @@ func monitorPeers(ctx context.Context, logger *zap.Logger, h host.Host) {
 		select {
 		case <-ctx.Done():
 			return
-		case <-time.After(period):
+		case <-clock.After(period):
 			// This is once again a snapshot of the peers at the time of the ping. If new peers
 			// are added between the snapshot and the ping, they will be pinged in the next iteration.
 			peers := h.Network().Peers()
@@ -676,6 +864,7 @@ func initDHT(
 	p2pConfig *config.P2PConfig,
 	logger *zap.Logger,
 	h host.Host,
+	clock clock.Clock,
 	isBootstrapPeer bool,
 	bootstrappers []peer.AddrInfo,
 ) *dht.IpfsDHT {
@@ -739,7 +928,7 @@ func initDHT(
 	}
 	go func() {
 		for {
-			time.Sleep(30 * time.Second)
+			clock.Sleep(30 * time.Second)
 			found := false
 			for _, p := range h.Network().Peers() {
 				if _, ok := bootstrapPeerIDs[p]; ok {
@@ -760,7 +949,7 @@ func (b *BlossomSub) Reconnect(peerId []byte) error {
 	peer := peer.ID(peerId)
 	info := b.h.Peerstore().PeerInfo(peer)
 	b.h.ConnManager().Unprotect(info.ID, "bootstrap")
-	time.Sleep(10 * time.Second)
+	b.clock.Sleep(10 * time.Second)
 	if err := b.h.Connect(b.ctx, info); err != nil {
 		return errors.Wrap(err, "reconnect")
 	}
@@ -769,6 +958,36 @@ func (b *BlossomSub) Reconnect(peerId []byte) error {
 	return nil
 }
 
+func (b *BlossomSub) ReloadAllowlist(p2pConfig *config.P2PConfig) error {
+	if err := b.gater.Reload(p2pConfig, b.alwaysAllowed); err != nil {
+		return errors.Wrap(err, "reload allowlist")
+	}
+
+	// The gater only sees new connections, so peers dropped from the allowlist
+	// are disconnected here.
+	for _, p := range b.h.Network().Peers() {
+		allowed := false
+		for _, c := range b.h.Network().ConnsToPeer(p) {
+			if b.gater.InterceptSecured(c.Stat().Direction, p, c) {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			b.logger.Info(
+				"disconnecting peer no longer in allowlist",
+				zap.String("peer_id", p.String()),
+			)
+			if err := b.h.Network().ClosePeer(p); err != nil {
+				b.logger.Debug("could not close peer", zap.Error(err))
+			}
+		}
+	}
+
+	return nil
+}
+
 func (b *BlossomSub) GetPeerScore(peerId []byte) int64 {
 	b.peerScoreMx.Lock()
 	score := b.peerScore[string(peerId)]
@@ -795,6 +1014,8 @@ func (b *BlossomSub) AddPeerScore(peerId []byte, scoreDelta int64) {
 func (b *BlossomSub) GetBitmaskPeers() map[string][]string {
 	peers := map[string][]string{}
 
+	b.bitmaskMx.Lock()
+	defer b.bitmaskMx.Unlock()
 	for _, k := range b.bitmaskMap {
 		peers[fmt.Sprintf("%+x", k.Bitmask()[1:])] = []string{}
 
@@ -850,11 +1071,21 @@ func (b *BlossomSub) GetMultiaddrOfPeer(peerId []byte) string {
 	return addrs[0].String()
 }
 
+// StartDirectChannelListener serves the services registered by register to
+// peers dialing the direct channel of the purpose, enforcing the policy with
+// any overrides configured for the purpose. It blocks until the listener
+// fails.
 func (b *BlossomSub) StartDirectChannelListener(
 	key []byte,
 	purpose string,
-	server *grpc.Server,
+	policy DirectChannelPolicy,
+	register func(server *grpc.Server),
 ) error {
+	policy, err := policy.withConfig(b.directChannels[purpose])
+	if err != nil {
+		return errors.Wrap(err, "start direct channel listener")
+	}
+
 	bind, err := gostream.Listen(
 		b.h,
 		protocol.ID(
@@ -865,6 +1096,9 @@ func (b *BlossomSub) StartDirectChannelListener(
 		return errors.Wrap(err, "start direct channel listener")
 	}
 
+	server := b.newDirectChannelServer(policy)
+	register(server)
+
 	return errors.Wrap(server.Serve(bind), "start direct channel listener")
 }
 
@@ -908,7 +1142,9 @@ func (b *BlossomSub) GetDirectChannel(key []byte, purpose string) (
 				return c, errors.Wrap(err, "dial context")
 			},
 		),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(
+			&directChannelCredentials{expected: peer.ID(key)},
+		),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "get direct channel")
@@ -927,101 +1163,6 @@ func (b *BlossomSub) SignMessage(msg []byte) ([]byte, error) {
 	return sig, errors.Wrap(err, "sign message")
 }
 
-type ReachabilityRequest struct {
-	Port uint16 `json:"port"`
-	Type string `json:"type"`
-}
-
-type ReachabilityResponse struct {
-	Reachable bool   `json:"reachable"`
-	Error     string `json:"error"`
-}
-
-func verifyReachability(cfg *config.P2PConfig) bool {
-	a, err := ma.NewMultiaddr(cfg.ListenMultiaddr)
-	if err != nil {
-		return false
-	}
-
-	transport, addr, err := mn.DialArgs(a)
-	if err != nil {
-		return false
-	}
-
-	addrparts := strings.Split(addr, ":")
-	if len(addrparts) != 2 {
-		return false
-	}
-
-	port, err := strconv.ParseUint(addrparts[1], 10, 0)
-	if err != nil {
-		return false
-	}
-
-	if !strings.Contains(transport, "tcp") {
-		transport = "quic"
-	} else {
-		transport = "tcp"
-	}
-
-	req := &ReachabilityRequest{
-		Port: uint16(port),
-		Type: transport,
-	}
-
-	b, err := json.Marshal(req)
-	if err != nil {
-		return false
-	}
-
-	resp, err := http.Post(
-		"https://rpc.quilibrium.com/connectivity-check",
-		"application/json",
-		bytes.NewBuffer(b),
-	)
-	if err != nil {
-		fmt.Println("Reachability check not currently available, skipping test.")
-		return true
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		fmt.Println("Reachability check not currently available, skipping test.")
-		return true
-	}
-
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		fmt.Println("Reachability check not currently available, skipping test.")
-		return true
-	}
-
-	r := &ReachabilityResponse{}
-	err = json.Unmarshal(bodyBytes, r)
-	if err != nil {
-		fmt.Println("Reachability check not currently available, skipping test.")
-		return true
-	}
-
-	if r.Error != "" {
-		fmt.Println("Reachability check failed: " + r.Error)
-		if transport == "quic" {
-			fmt.Println("WARNING!")
-			fmt.Println("WARNING!")
-			fmt.Println("WARNING!")
-			fmt.Println("You failed reachability with QUIC enabled. Consider switching to TCP")
-			fmt.Println("WARNING!")
-			fmt.Println("WARNING!")
-			fmt.Println("WARNING!")
-			time.Sleep(5 * time.Second)
-		}
-		return false
-	}
-
-	fmt.Println("Node passed reachability check.")
-	return true
-}
-
 func discoverPeers(
 	p2pConfig *config.P2PConfig,
 	ctx context.Context,
@@ -1167,6 +1308,19 @@ func mergeDefaults(p2pConfig *config.P2PConfig) blossomsub.BlossomSubParams {
 	if p2pConfig.IWantFollowupTime == 0 {
 		p2pConfig.IWantFollowupTime = blossomsub.BlossomSubIWantFollowupTime
 	}
+	if p2pConfig.IDontWantMessageThreshold == 0 {
+		p2pConfig.IDontWantMessageThreshold =
+			blossomsub.BlossomSubIDontWantMessageThreshold
+	}
+	if p2pConfig.IDontWantMessageTTL == 0 {
+		p2pConfig.IDontWantMessageTTL = blossomsub.BlossomSubIDontWantMessageTTL
+	}
+	if p2pConfig.MaxIDontWantMessages == 0 {
+		p2pConfig.MaxIDontWantMessages = blossomsub.BlossomSubMaxIDontWantMessages
+	}
+	if p2pConfig.MaxIDontWantLength == 0 {
+		p2pConfig.MaxIDontWantLength = blossomsub.BlossomSubMaxIDontWantLength
+	}
 
 	return blossomsub.BlossomSubParams{
 		D:                         p2pConfig.D,
@@ -1195,6 +1349,10 @@ func mergeDefaults(p2pConfig *config.P2PConfig) blossomsub.BlossomSubParams {
 		MaxIHaveLength:            p2pConfig.MaxIHaveLength,
 		MaxIHaveMessages:          p2pConfig.MaxIHaveMessages,
 		IWantFollowupTime:         p2pConfig.IWantFollowupTime,
+		IDontWantMessageThreshold: p2pConfig.IDontWantMessageThreshold,
+		IDontWantMessageTTL:       p2pConfig.IDontWantMessageTTL,
+		MaxIDontWantMessages:      p2pConfig.MaxIDontWantMessages,
+		MaxIDontWantLength:        p2pConfig.MaxIDontWantLength,
 		SlowHeartbeatWarning:      0.1,
 	}
 }
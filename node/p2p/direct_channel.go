@@ -0,0 +1,435 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	grpcpeer "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+// DefaultDirectChannelMaxMessageSize bounds the messages exchanged over a
+// direct channel when its policy does not set a size.
+const DefaultDirectChannelMaxMessageSize = 64 * 1024 * 1024
+
+// maxTrackedDirectChannelPeers is the number of peers whose limits are kept
+// before idle peers are forgotten.
+const maxTrackedDirectChannelPeers = 4096
+
+// DirectChannelAccess decides which peers may open a direct channel.
+type DirectChannelAccess int
+
+const (
+	// DirectChannelAllowAnyone admits any connected peer.
+	DirectChannelAllowAnyone DirectChannelAccess = iota
+	// DirectChannelAllowKnownPeers admits peers sharing a bitmask with the node
+	// and allowlisted peers.
+	DirectChannelAllowKnownPeers
+	// DirectChannelAllowProvers admits peers the policy's IsProver accepts.
+	DirectChannelAllowProvers
+)
+
+func (a DirectChannelAccess) String() string {
+	switch a {
+	case DirectChannelAllowAnyone:
+		return "anyone"
+	case DirectChannelAllowKnownPeers:
+		return "known-peers"
+	case DirectChannelAllowProvers:
+		return "provers"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseDirectChannelAccess parses the access names used in the config.
+func ParseDirectChannelAccess(access string) (DirectChannelAccess, error) {
+	switch access {
+	case "anyone":
+		return DirectChannelAllowAnyone, nil
+	case "known-peers":
+		return DirectChannelAllowKnownPeers, nil
+	case "provers":
+		return DirectChannelAllowProvers, nil
+	default:
+		return 0, errors.Wrap(
+			errors.Errorf("unknown direct channel access %q", access),
+			"parse direct channel access",
+		)
+	}
+}
+
+// DirectChannelPolicy restricts who may use a direct channel and how much.
+// Limits left at zero are not enforced, except for the message size which
+// falls back to DefaultDirectChannelMaxMessageSize.
+type DirectChannelPolicy struct {
+	Access DirectChannelAccess
+	// IsProver reports whether the peer is a prover, it is required by
+	// DirectChannelAllowProvers.
+	IsProver func(peerID []byte) bool
+	// RequestsPerSecond and RequestBurst limit the rate of calls per peer.
+	RequestsPerSecond float64
+	RequestBurst      int
+	// MaxConcurrentRequests limits the calls and streams a peer has in flight.
+	MaxConcurrentRequests int
+	MaxMessageSize        int
+}
+
+// withConfig applies the overrides configured for the purpose.
+func (p DirectChannelPolicy) withConfig(
+	channelConfig *config.DirectChannelConfig,
+) (DirectChannelPolicy, error) {
+	if channelConfig == nil {
+		return p, nil
+	}
+
+	if channelConfig.Access != "" {
+		access, err := ParseDirectChannelAccess(channelConfig.Access)
+		if err != nil {
+			return p, errors.Wrap(err, "with config")
+		}
+		p.Access = access
+	}
+	if channelConfig.RequestsPerSecond != 0 {
+		p.RequestsPerSecond = channelConfig.RequestsPerSecond
+	}
+	if channelConfig.RequestBurst != 0 {
+		p.RequestBurst = channelConfig.RequestBurst
+	}
+	if channelConfig.MaxConcurrentRequests != 0 {
+		p.MaxConcurrentRequests = channelConfig.MaxConcurrentRequests
+	}
+	if channelConfig.MaxMessageSize != 0 {
+		p.MaxMessageSize = channelConfig.MaxMessageSize
+	}
+
+	if p.Access == DirectChannelAllowProvers && p.IsProver == nil {
+		return p, errors.Wrap(
+			errors.New("prover access requires a prover check"),
+			"with config",
+		)
+	}
+
+	return p, nil
+}
+
+// DirectChannelAuthInfo is the gRPC auth info of a direct channel, binding the
+// gRPC peer to the libp2p peer at the other end of the stream. The stream is
+// already authenticated and encrypted by the libp2p connection.
+type DirectChannelAuthInfo struct {
+	credentials.CommonAuthInfo
+	PeerID peer.ID
+}
+
+// AuthType implements credentials.AuthInfo.
+func (DirectChannelAuthInfo) AuthType() string {
+	return "libp2p"
+}
+
+// DirectChannelPeerID returns the libp2p peer ID of the caller of a direct
+// channel handler.
+func DirectChannelPeerID(ctx context.Context) (peer.ID, error) {
+	p, ok := grpcpeer.FromContext(ctx)
+	if !ok {
+		return "", errors.Wrap(
+			errors.New("no peer in context"),
+			"direct channel peer id",
+		)
+	}
+
+	info, ok := p.AuthInfo.(DirectChannelAuthInfo)
+	if !ok {
+		return "", errors.Wrap(
+			errors.New("not a direct channel"),
+			"direct channel peer id",
+		)
+	}
+
+	return info.PeerID, nil
+}
+
+// directChannelCredentials authenticates gostream connections by their
+// remote libp2p peer. On the client side it checks the remote peer is the one
+// dialed, on the server side it checks the remote peer is admitted.
+type directChannelCredentials struct {
+	expected peer.ID
+	admit    func(peer.ID) error
+}
+
+var _ credentials.TransportCredentials = (*directChannelCredentials)(nil)
+
+func remotePeer(conn net.Conn) (peer.ID, error) {
+	id, err := peer.Decode(conn.RemoteAddr().String())
+	return id, errors.Wrap(err, "remote peer")
+}
+
+func (c *directChannelCredentials) authInfo(id peer.ID) DirectChannelAuthInfo {
+	return DirectChannelAuthInfo{
+		CommonAuthInfo: credentials.CommonAuthInfo{
+			SecurityLevel: credentials.PrivacyAndIntegrity,
+		},
+		PeerID: id,
+	}
+}
+
+// ClientHandshake implements credentials.TransportCredentials.
+func (c *directChannelCredentials) ClientHandshake(
+	ctx context.Context,
+	authority string,
+	conn net.Conn,
+) (net.Conn, credentials.AuthInfo, error) {
+	id, err := remotePeer(conn)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "client handshake")
+	}
+
+	if c.expected != "" && id != c.expected {
+		return nil, nil, errors.Wrap(
+			errors.New("unexpected remote peer"),
+			"client handshake",
+		)
+	}
+
+	return conn, c.authInfo(id), nil
+}
+
+// ServerHandshake implements credentials.TransportCredentials.
+func (c *directChannelCredentials) ServerHandshake(
+	conn net.Conn,
+) (net.Conn, credentials.AuthInfo, error) {
+	id, err := remotePeer(conn)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "server handshake")
+	}
+
+	if c.admit != nil {
+		if err := c.admit(id); err != nil {
+			return nil, nil, errors.Wrap(err, "server handshake")
+		}
+	}
+
+	return conn, c.authInfo(id), nil
+}
+
+// Info implements credentials.TransportCredentials.
+func (c *directChannelCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "libp2p"}
+}
+
+// Clone implements credentials.TransportCredentials.
+func (c *directChannelCredentials) Clone() credentials.TransportCredentials {
+	return &directChannelCredentials{expected: c.expected, admit: c.admit}
+}
+
+// OverrideServerName implements credentials.TransportCredentials.
+func (c *directChannelCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+type directChannelPeer struct {
+	limiter  *rate.Limiter
+	inFlight int
+}
+
+// directChannelLimiter enforces the per-peer limits of a direct channel
+// policy.
+type directChannelLimiter struct {
+	mx     sync.Mutex
+	policy DirectChannelPolicy
+	peers  map[peer.ID]*directChannelPeer
+}
+
+func newDirectChannelLimiter(policy DirectChannelPolicy) *directChannelLimiter {
+	return &directChannelLimiter{
+		policy: policy,
+		peers:  make(map[peer.ID]*directChannelPeer),
+	}
+}
+
+// acquire admits a call from the peer, returning the function releasing it
+// once the call has completed.
+func (l *directChannelLimiter) acquire(id peer.ID) (func(), error) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	p, ok := l.peers[id]
+	if !ok {
+		if len(l.peers) >= maxTrackedDirectChannelPeers {
+			l.prune()
+		}
+
+		p = &directChannelPeer{}
+		if l.policy.RequestsPerSecond > 0 {
+			burst := l.policy.RequestBurst
+			if burst < 1 {
+				burst = 1
+			}
+			p.limiter = rate.NewLimiter(rate.Limit(l.policy.RequestsPerSecond), burst)
+		}
+		l.peers[id] = p
+	}
+
+	if l.policy.MaxConcurrentRequests > 0 &&
+		p.inFlight >= l.policy.MaxConcurrentRequests {
+		return nil, status.Error(codes.ResourceExhausted, "too many requests")
+	}
+
+	if p.limiter != nil && !p.limiter.Allow() {
+		return nil, status.Error(codes.ResourceExhausted, "rate limited")
+	}
+
+	p.inFlight++
+	released := false
+	return func() {
+		l.mx.Lock()
+		defer l.mx.Unlock()
+
+		if !released {
+			released = true
+			p.inFlight--
+		}
+	}, nil
+}
+
+// prune forgets peers with nothing in flight and a full rate limit budget, it
+// must be called with the lock held.
+func (l *directChannelLimiter) prune() {
+	for id, p := range l.peers {
+		if p.inFlight > 0 {
+			continue
+		}
+		if p.limiter != nil && p.limiter.Tokens() < float64(p.limiter.Burst()) {
+			continue
+		}
+		delete(l.peers, id)
+	}
+}
+
+func (l *directChannelLimiter) unaryInterceptor(
+	admit func(peer.ID) error,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		release, err := l.admitCall(ctx, admit)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+func (l *directChannelLimiter) streamInterceptor(
+	admit func(peer.ID) error,
+) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		release, err := l.admitCall(ss.Context(), admit)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return handler(srv, ss)
+	}
+}
+
+// admitCall checks the caller is still admitted, prover membership changes
+// over the lifetime of a connection, and applies the per-peer limits.
+func (l *directChannelLimiter) admitCall(
+	ctx context.Context,
+	admit func(peer.ID) error,
+) (func(), error) {
+	id, err := DirectChannelPeerID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := admit(id); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return l.acquire(id)
+}
+
+// admitter returns the access check of the policy.
+func (b *BlossomSub) admitter(policy DirectChannelPolicy) func(peer.ID) error {
+	return func(id peer.ID) error {
+		switch policy.Access {
+		case DirectChannelAllowAnyone:
+			return nil
+		case DirectChannelAllowKnownPeers:
+			if b.isKnownPeer(id) {
+				return nil
+			}
+		case DirectChannelAllowProvers:
+			if policy.IsProver != nil && policy.IsProver([]byte(id)) {
+				return nil
+			}
+		}
+
+		return errors.Errorf("peer %s is not allowed", id)
+	}
+}
+
+// isKnownPeer reports whether the peer is an allowlisted or direct peer, or
+// shares a bitmask with the node.
+func (b *BlossomSub) isKnownPeer(id peer.ID) bool {
+	for _, p := range b.alwaysAllowed {
+		if p.ID == id {
+			return true
+		}
+	}
+
+	b.bitmaskMx.Lock()
+	defer b.bitmaskMx.Unlock()
+
+	for _, bitmask := range b.bitmaskMap {
+		for _, p := range bitmask.ListPeers() {
+			if p == id {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// newDirectChannelServer creates the gRPC server of a direct channel,
+// enforcing the policy on every connection and call.
+func (b *BlossomSub) newDirectChannelServer(
+	policy DirectChannelPolicy,
+) *grpc.Server {
+	maxMessageSize := policy.MaxMessageSize
+	if maxMessageSize <= 0 {
+		maxMessageSize = DefaultDirectChannelMaxMessageSize
+	}
+
+	admit := b.admitter(policy)
+	limiter := newDirectChannelLimiter(policy)
+
+	return grpc.NewServer(
+		grpc.Creds(&directChannelCredentials{admit: admit}),
+		grpc.MaxRecvMsgSize(maxMessageSize),
+		grpc.MaxSendMsgSize(maxMessageSize),
+		grpc.ChainUnaryInterceptor(limiter.unaryInterceptor(admit)),
+		grpc.ChainStreamInterceptor(limiter.streamInterceptor(admit)),
+	)
+}
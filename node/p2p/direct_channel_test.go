@@ -0,0 +1,173 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+type peerRecordingHealth struct {
+	healthpb.UnimplementedHealthServer
+	peers chan peer.ID
+}
+
+func (h *peerRecordingHealth) Check(
+	ctx context.Context,
+	req *healthpb.HealthCheckRequest,
+) (*healthpb.HealthCheckResponse, error) {
+	id, err := DirectChannelPeerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	h.peers <- id
+	return &healthpb.HealthCheckResponse{
+		Status: healthpb.HealthCheckResponse_SERVING,
+	}, nil
+}
+
+func newTestHost(t *testing.T) host.Host {
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() { h.Close() })
+	return h
+}
+
+func TestParseDirectChannelAccess(t *testing.T) {
+	for _, access := range []DirectChannelAccess{
+		DirectChannelAllowAnyone,
+		DirectChannelAllowKnownPeers,
+		DirectChannelAllowProvers,
+	} {
+		parsed, err := ParseDirectChannelAccess(access.String())
+		require.NoError(t, err)
+		assert.Equal(t, access, parsed)
+	}
+
+	_, err := ParseDirectChannelAccess("everyone")
+	assert.Error(t, err)
+}
+
+func TestDirectChannelPolicyWithConfig(t *testing.T) {
+	policy, err := DirectChannelPolicy{
+		RequestsPerSecond: 5,
+		MaxMessageSize:    1024,
+	}.withConfig(&config.DirectChannelConfig{
+		Access:       "known-peers",
+		RequestBurst: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, DirectChannelAllowKnownPeers, policy.Access)
+	assert.Equal(t, 5.0, policy.RequestsPerSecond)
+	assert.Equal(t, 10, policy.RequestBurst)
+	assert.Equal(t, 1024, policy.MaxMessageSize)
+
+	_, err = DirectChannelPolicy{}.withConfig(
+		&config.DirectChannelConfig{Access: "provers"},
+	)
+	assert.Error(t, err)
+}
+
+func TestDirectChannelLimiter(t *testing.T) {
+	l := newDirectChannelLimiter(DirectChannelPolicy{
+		MaxConcurrentRequests: 2,
+	})
+	first, err := l.acquire("a")
+	require.NoError(t, err)
+	_, err = l.acquire("a")
+	require.NoError(t, err)
+	_, err = l.acquire("a")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// limits are per peer
+	_, err = l.acquire("b")
+	require.NoError(t, err)
+
+	first()
+	first()
+	_, err = l.acquire("a")
+	require.NoError(t, err)
+
+	l = newDirectChannelLimiter(DirectChannelPolicy{
+		RequestsPerSecond: 0.001,
+		RequestBurst:      2,
+	})
+	for i := 0; i < 2; i++ {
+		release, err := l.acquire("a")
+		require.NoError(t, err)
+		release()
+	}
+	_, err = l.acquire("a")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestDirectChannelPolicy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	serverHost := newTestHost(t)
+	allowedHost := newTestHost(t)
+	deniedHost := newTestHost(t)
+
+	server := &BlossomSub{ctx: ctx, logger: zap.NewNop(), h: serverHost}
+	health := &peerRecordingHealth{peers: make(chan peer.ID, 4)}
+	go server.StartDirectChannelListener(
+		[]byte(serverHost.ID()),
+		"test",
+		DirectChannelPolicy{
+			Access: DirectChannelAllowProvers,
+			IsProver: func(peerID []byte) bool {
+				return peer.ID(peerID) == allowedHost.ID()
+			},
+			RequestsPerSecond: 0.001,
+			RequestBurst:      1,
+		},
+		func(s *grpc.Server) {
+			healthpb.RegisterHealthServer(s, health)
+		},
+	)
+
+	call := func(h host.Host) error {
+		require.NoError(t, h.Connect(ctx, peer.AddrInfo{
+			ID:    serverHost.ID(),
+			Addrs: serverHost.Addrs(),
+		}))
+
+		client := &BlossomSub{ctx: ctx, logger: zap.NewNop(), h: h}
+		cc, err := client.GetDirectChannel([]byte(serverHost.ID()), "test")
+		require.NoError(t, err)
+		defer cc.Close()
+
+		callCtx, callCancel := context.WithTimeout(ctx, 5*time.Second)
+		defer callCancel()
+		_, err = healthpb.NewHealthClient(cc).Check(
+			callCtx,
+			&healthpb.HealthCheckRequest{},
+		)
+		return err
+	}
+
+	// the listener is started asynchronously
+	require.Eventually(t, func() bool {
+		return call(allowedHost) == nil
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.Equal(t, allowedHost.ID(), <-health.peers)
+
+	err := call(allowedHost)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	assert.Error(t, call(deniedHost))
+	assert.Empty(t, health.peers)
+}
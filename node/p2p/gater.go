@@ -0,0 +1,279 @@
+package p2p
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/libp2p/go-libp2p"
+	libp2pconfig "github.com/libp2p/go-libp2p/config"
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/pnet"
+	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	ma "github.com/multiformats/go-multiaddr"
+	mn "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+// privateNetworkOptions returns the libp2p options for running on a private
+// network, in which every connection is additionally encrypted with a key
+// shared by the members. QUIC does not support pre-shared keys, so private
+// networks are limited to TCP.
+func privateNetworkOptions(p2pConfig *config.P2PConfig) (
+	[]libp2pconfig.Option,
+	error,
+) {
+	if p2pConfig.PrivateNetworkPSK == "" {
+		return nil, nil
+	}
+
+	if p2pConfig.Network == 0 {
+		return nil, errors.Wrap(
+			errors.New("private network key cannot be used on mainnet"),
+			"private network options",
+		)
+	}
+
+	psk, err := hex.DecodeString(p2pConfig.PrivateNetworkPSK)
+	if err != nil {
+		return nil, errors.Wrap(err, "private network options")
+	}
+
+	if len(psk) != 32 {
+		return nil, errors.Wrap(
+			errors.New("private network key must be 32 bytes"),
+			"private network options",
+		)
+	}
+
+	if !strings.Contains(p2pConfig.ListenMultiaddr, "/tcp/") {
+		return nil, errors.Wrap(
+			errors.New("private network requires a tcp listen multiaddr"),
+			"private network options",
+		)
+	}
+
+	return []libp2pconfig.Option{
+		libp2p.PrivateNetwork(pnet.PSK(psk)),
+		libp2p.Transport(tcp.NewTCPTransport),
+	}, nil
+}
+
+// ConnectionGater restricts connections to an allowlist of peer IDs and CIDR
+// ranges. An empty allowlist admits everyone. The allowlist can be reloaded at
+// runtime, and is mirrored into the resource manager's allowlist so members
+// are not starved by the limits applied to everyone else.
+type ConnectionGater struct {
+	mx        sync.RWMutex
+	peers     map[peer.ID]struct{}
+	networks  []*net.IPNet
+	allowlist *rcmgr.Allowlist
+	entries   []ma.Multiaddr
+}
+
+var _ connmgr.ConnectionGater = (*ConnectionGater)(nil)
+
+// NewConnectionGater creates a gater admitting the configured peers and CIDR
+// ranges, along with the given always allowed peers (bootstrap and direct
+// peers).
+func NewConnectionGater(
+	p2pConfig *config.P2PConfig,
+	always []peer.AddrInfo,
+) (*ConnectionGater, error) {
+	g := &ConnectionGater{}
+	if err := g.Reload(p2pConfig, always); err != nil {
+		return nil, errors.Wrap(err, "new connection gater")
+	}
+
+	return g, nil
+}
+
+// Enabled returns whether the gater restricts connections at all.
+func (g *ConnectionGater) Enabled() bool {
+	g.mx.RLock()
+	defer g.mx.RUnlock()
+
+	return len(g.peers) != 0 || len(g.networks) != 0
+}
+
+// Reload replaces the allowlist with the one in the given config. The
+// allowlist is left unchanged if the config cannot be parsed.
+func (g *ConnectionGater) Reload(
+	p2pConfig *config.P2PConfig,
+	always []peer.AddrInfo,
+) error {
+	peers := map[peer.ID]struct{}{}
+	networks := []*net.IPNet{}
+	entries := []ma.Multiaddr{}
+
+	for _, entry := range p2pConfig.AllowedPeers {
+		if info, err := peer.AddrInfoFromString(entry); err == nil {
+			peers[info.ID] = struct{}{}
+			for _, addr := range info.Addrs {
+				entry, err := ma.NewMultiaddr(
+					addr.String() + "/p2p/" + info.ID.String(),
+				)
+				if err != nil {
+					return errors.Wrap(err, "reload")
+				}
+				entries = append(entries, entry)
+			}
+			continue
+		}
+
+		id, err := peer.Decode(entry)
+		if err != nil {
+			return errors.Wrap(err, "reload")
+		}
+		peers[id] = struct{}{}
+	}
+
+	for _, cidr := range p2pConfig.AllowedCIDRs {
+		ip, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return errors.Wrap(err, "reload")
+		}
+		networks = append(networks, ipnet)
+
+		proto := "ip6"
+		if ip.To4() != nil {
+			proto = "ip4"
+		}
+		ones, _ := ipnet.Mask.Size()
+		entry, err := ma.NewMultiaddr(
+			fmt.Sprintf("/%s/%s/ipcidr/%d", proto, ipnet.IP.String(), ones),
+		)
+		if err != nil {
+			return errors.Wrap(err, "reload")
+		}
+		entries = append(entries, entry)
+	}
+
+	// Bootstrap and direct peers only need to be admitted when an allowlist is
+	// in effect, otherwise they would turn an open node into a closed one.
+	if len(peers) != 0 || len(networks) != 0 {
+		for _, info := range always {
+			peers[info.ID] = struct{}{}
+		}
+	}
+
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	g.peers = peers
+	g.networks = networks
+	if g.allowlist != nil {
+		g.syncAllowlist(entries)
+	}
+	g.entries = entries
+
+	return nil
+}
+
+// attachAllowlist mirrors the gater's allowlist into the resource manager's.
+func (g *ConnectionGater) attachAllowlist(allowlist *rcmgr.Allowlist) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	g.allowlist = allowlist
+	entries := g.entries
+	g.entries = nil
+	g.syncAllowlist(entries)
+	g.entries = entries
+}
+
+// syncAllowlist must be called with the lock held, and before g.entries is
+// replaced.
+func (g *ConnectionGater) syncAllowlist(entries []ma.Multiaddr) {
+	for _, entry := range g.entries {
+		_ = g.allowlist.Remove(entry)
+	}
+	for _, entry := range entries {
+		_ = g.allowlist.Add(entry)
+	}
+}
+
+func (g *ConnectionGater) allowed(p peer.ID, addr ma.Multiaddr) bool {
+	g.mx.RLock()
+	defer g.mx.RUnlock()
+
+	if len(g.peers) == 0 && len(g.networks) == 0 {
+		return true
+	}
+
+	if _, ok := g.peers[p]; ok {
+		return true
+	}
+
+	return g.allowedAddr(addr)
+}
+
+// allowedAddr must be called with the lock held.
+func (g *ConnectionGater) allowedAddr(addr ma.Multiaddr) bool {
+	if addr == nil || len(g.networks) == 0 {
+		return false
+	}
+
+	ip, err := mn.ToIP(addr)
+	if err != nil {
+		return false
+	}
+
+	for _, n := range g.networks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *ConnectionGater) InterceptPeerDial(p peer.ID) bool {
+	g.mx.RLock()
+	defer g.mx.RUnlock()
+
+	if len(g.peers) == 0 || len(g.networks) != 0 {
+		return true
+	}
+
+	_, ok := g.peers[p]
+	return ok
+}
+
+func (g *ConnectionGater) InterceptAddrDial(p peer.ID, addr ma.Multiaddr) bool {
+	return g.allowed(p, addr)
+}
+
+func (g *ConnectionGater) InterceptAccept(addrs network.ConnMultiaddrs) bool {
+	g.mx.RLock()
+	defer g.mx.RUnlock()
+
+	// The remote peer ID is not known until the connection is secured, so any
+	// peer ID based allowlist has to be checked later.
+	if len(g.peers) != 0 || len(g.networks) == 0 {
+		return true
+	}
+
+	return g.allowedAddr(addrs.RemoteMultiaddr())
+}
+
+func (g *ConnectionGater) InterceptSecured(
+	_ network.Direction,
+	p peer.ID,
+	addrs network.ConnMultiaddrs,
+) bool {
+	return g.allowed(p, addrs.RemoteMultiaddr())
+}
+
+func (g *ConnectionGater) InterceptUpgraded(
+	network.Conn,
+) (bool, control.DisconnectReason) {
+	return true, 0
+}
@@ -0,0 +1,100 @@
+package p2p
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+type connAddrs struct {
+	remote ma.Multiaddr
+}
+
+func (c connAddrs) LocalMultiaddr() ma.Multiaddr  { return nil }
+func (c connAddrs) RemoteMultiaddr() ma.Multiaddr { return c.remote }
+
+func randomPeerID(t *testing.T) peer.ID {
+	_, pub, err := crypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+	return id
+}
+
+func TestConnectionGater(t *testing.T) {
+	member := randomPeerID(t)
+	bootstrap := randomPeerID(t)
+	stranger := randomPeerID(t)
+	insideAddr, err := ma.NewMultiaddr("/ip4/10.1.2.3/tcp/8336")
+	require.NoError(t, err)
+	outsideAddr, err := ma.NewMultiaddr("/ip4/192.0.2.1/tcp/8336")
+	require.NoError(t, err)
+	inside := connAddrs{insideAddr}
+	outside := connAddrs{outsideAddr}
+
+	g, err := NewConnectionGater(&config.P2PConfig{}, nil)
+	require.NoError(t, err)
+	assert.False(t, g.Enabled())
+	assert.True(t, g.InterceptSecured(network.DirInbound, stranger, outside))
+
+	always := []peer.AddrInfo{{ID: bootstrap}}
+	require.NoError(t, g.Reload(&config.P2PConfig{
+		AllowedPeers: []string{member.String()},
+		AllowedCIDRs: []string{"10.0.0.0/8"},
+	}, always))
+	assert.True(t, g.Enabled())
+	assert.True(t, g.InterceptSecured(network.DirInbound, member, outside))
+	assert.True(t, g.InterceptSecured(network.DirInbound, bootstrap, outside))
+	assert.True(t, g.InterceptSecured(network.DirInbound, stranger, inside))
+	assert.False(t, g.InterceptSecured(network.DirInbound, stranger, outside))
+	assert.False(t, g.InterceptAddrDial(stranger, outside.remote))
+
+	assert.Error(t, g.Reload(&config.P2PConfig{
+		AllowedCIDRs: []string{"not a cidr"},
+	}, always))
+	assert.True(t, g.InterceptSecured(network.DirInbound, member, outside))
+
+	require.NoError(t, g.Reload(&config.P2PConfig{
+		AllowedPeers: []string{stranger.String()},
+	}, always))
+	assert.False(t, g.InterceptSecured(network.DirInbound, member, inside))
+	assert.True(t, g.InterceptSecured(network.DirInbound, stranger, outside))
+	assert.True(t, g.InterceptPeerDial(stranger))
+	assert.False(t, g.InterceptPeerDial(member))
+}
+
+func TestPrivateNetworkOptions(t *testing.T) {
+	psk := "0000000000000000000000000000000000000000000000000000000000000001"
+
+	opts, err := privateNetworkOptions(&config.P2PConfig{})
+	require.NoError(t, err)
+	assert.Empty(t, opts)
+
+	_, err = privateNetworkOptions(&config.P2PConfig{
+		PrivateNetworkPSK: psk,
+		ListenMultiaddr:   "/ip4/0.0.0.0/tcp/8336",
+	})
+	assert.Error(t, err)
+
+	_, err = privateNetworkOptions(&config.P2PConfig{
+		Network:           1,
+		PrivateNetworkPSK: psk,
+		ListenMultiaddr:   "/ip4/0.0.0.0/udp/8336/quic-v1",
+	})
+	assert.Error(t, err)
+
+	opts, err = privateNetworkOptions(&config.P2PConfig{
+		Network:           1,
+		PrivateNetworkPSK: psk,
+		ListenMultiaddr:   "/ip4/0.0.0.0/tcp/8336",
+	})
+	require.NoError(t, err)
+	assert.Len(t, opts, 2)
+}
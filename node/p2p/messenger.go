@@ -0,0 +1,1190 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/nekryptology/pkg/core/curves"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/crypto/channel"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+const messagingPurpose = "messaging"
+
+// How often undelivered messages are retried, sending a message retries
+// immediately.
+const messageDeliveryInterval = 30 * time.Second
+
+const messageDeliveryTimeout = 10 * time.Second
+
+// How long a message is retried before it is dropped.
+const messageExpiry = 7 * 24 * time.Hour
+
+const maxMessageLength = 4096
+
+const defaultMessageLimit = 50
+const maxMessageLimit = 1000
+
+// Messenger is the node's end-to-end encrypted messaging service between
+// operators. Direct messages are encrypted with a double ratchet session per
+// direction, started from the recipient's prekey bundle, so either side can
+// write first while the other is offline. Group messages are encrypted with
+// the group's asynchronous triple ratchet. Messages are queued encrypted at
+// rest and delivered over a direct channel once the recipient is reachable.
+type Messenger struct {
+	protobufs.UnimplementedMessagingServiceServer
+	protobufs.UnimplementedMessageDeliveryServiceServer
+	logger       *zap.Logger
+	pubSub       PubSub
+	keyManager   keys.KeyManager
+	preKeys      *PreKeyDirectory
+	channelStore store.ChannelStore
+	messageStore store.MessageStore
+	clock        clock.Clock
+	curve        *curves.Curve
+	wake         chan struct{}
+	cancel       context.CancelFunc
+	// mx guards the incoming sessions and the groups, outgoing sessions are
+	// only used by the delivery loop.
+	mx sync.Mutex
+}
+
+func NewMessenger(
+	logger *zap.Logger,
+	pubSub PubSub,
+	keyManager keys.KeyManager,
+	preKeys *PreKeyDirectory,
+	channelStore store.ChannelStore,
+	messageStore store.MessageStore,
+	clock clock.Clock,
+) *Messenger {
+	return &Messenger{
+		logger:       logger,
+		pubSub:       pubSub,
+		keyManager:   keyManager,
+		preKeys:      preKeys,
+		channelStore: channelStore,
+		messageStore: messageStore,
+		clock:        clock,
+		curve:        curves.ED448(),
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+func (m *Messenger) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	go func() {
+		if err := m.pubSub.StartDirectChannelListener(
+			m.pubSub.GetPeerID(),
+			messagingPurpose,
+			DirectChannelPolicy{
+				Access:                DirectChannelAllowAnyone,
+				RequestsPerSecond:     5,
+				RequestBurst:          20,
+				MaxConcurrentRequests: 4,
+				MaxMessageSize:        1 * 1024 * 1024,
+			},
+			func(server *grpc.Server) {
+				protobufs.RegisterMessageDeliveryServiceServer(server, m)
+			},
+		); err != nil {
+			panic(err)
+		}
+	}()
+
+	go m.runDelivery(ctx)
+}
+
+func (m *Messenger) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+// Send implements protobufs.MessagingServiceServer.
+func (m *Messenger) Send(
+	ctx context.Context,
+	req *protobufs.SendMessageRequest,
+) (*protobufs.SendMessageResult, error) {
+	if len(req.Text) == 0 || len(req.Text) > maxMessageLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid message length")
+	}
+
+	text := &protobufs.TextMessage{
+		MessageId: newMessageId(),
+		Text:      req.Text,
+		Timestamp: m.clock.Now().UnixMilli(),
+	}
+
+	var err error
+	switch recipient := req.Recipient.(type) {
+	case *protobufs.SendMessageRequest_PeerId:
+		err = m.sendDirect(recipient.PeerId, text)
+	case *protobufs.SendMessageRequest_GroupId:
+		err = m.sendGroup(recipient.GroupId, text)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "missing recipient")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	m.wakeDelivery()
+
+	return &protobufs.SendMessageResult{MessageId: text.MessageId}, nil
+}
+
+func (m *Messenger) sendDirect(
+	peerId []byte,
+	text *protobufs.TextMessage,
+) error {
+	if _, err := peer.IDFromBytes(peerId); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid peer id")
+	}
+
+	if bytes.Equal(peerId, m.pubSub.GetPeerID()) {
+		return status.Error(codes.InvalidArgument, "can't message self")
+	}
+
+	if err := m.messageStore.PutMessage(&protobufs.StoredMessage{
+		MessageId: text.MessageId,
+		PeerId:    peerId,
+		Text:      text.Text,
+		Timestamp: text.Timestamp,
+		Outgoing:  true,
+	}); err != nil {
+		return errors.Wrap(err, "send direct")
+	}
+
+	return errors.Wrap(
+		m.queue(peerId, &protobufs.MessagingPayload{
+			Payload: &protobufs.MessagingPayload_Text{Text: text},
+		}),
+		"send direct",
+	)
+}
+
+func (m *Messenger) sendGroup(
+	groupId []byte,
+	text *protobufs.TextMessage,
+) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	group, participant, err := m.loadGroup(groupId)
+	if err != nil {
+		return err
+	}
+
+	data, err := proto.Marshal(text)
+	if err != nil {
+		return errors.Wrap(err, "send group")
+	}
+
+	envelope, updates, err := participant.RatchetEncrypt(data)
+	if err != nil {
+		return errors.Wrap(err, "send group")
+	}
+
+	if err := m.saveGroup(group, participant); err != nil {
+		return errors.Wrap(err, "send group")
+	}
+
+	if err := m.queueGroupUpdates(group, updates); err != nil {
+		return errors.Wrap(err, "send group")
+	}
+
+	for _, member := range group.Members {
+		if bytes.Equal(member.PeerId, m.pubSub.GetPeerID()) {
+			continue
+		}
+
+		if err := m.queue(member.PeerId, &protobufs.MessagingPayload{
+			Payload: &protobufs.MessagingPayload_GroupMessage{
+				GroupMessage: &protobufs.GroupMessage{
+					GroupId:  groupId,
+					Envelope: envelope,
+				},
+			},
+		}); err != nil {
+			return errors.Wrap(err, "send group")
+		}
+	}
+
+	return errors.Wrap(
+		m.messageStore.PutMessage(&protobufs.StoredMessage{
+			MessageId: text.MessageId,
+			PeerId:    m.pubSub.GetPeerID(),
+			GroupId:   groupId,
+			Text:      text.Text,
+			Timestamp: text.Timestamp,
+			Outgoing:  true,
+		}),
+		"send group",
+	)
+}
+
+// GetInbox implements protobufs.MessagingServiceServer.
+func (m *Messenger) GetInbox(
+	ctx context.Context,
+	req *protobufs.GetInboxRequest,
+) (*protobufs.MessagesResponse, error) {
+	messages, err := m.messageStore.GetInbox(messageLimit(req.Limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "get inbox")
+	}
+
+	return &protobufs.MessagesResponse{Messages: messages}, nil
+}
+
+// GetHistory implements protobufs.MessagingServiceServer.
+func (m *Messenger) GetHistory(
+	ctx context.Context,
+	req *protobufs.GetHistoryRequest,
+) (*protobufs.MessagesResponse, error) {
+	var conversationId []byte
+	switch conversation := req.Conversation.(type) {
+	case *protobufs.GetHistoryRequest_PeerId:
+		conversationId = conversation.PeerId
+	case *protobufs.GetHistoryRequest_GroupId:
+		conversationId = conversation.GroupId
+	default:
+		return nil, status.Error(codes.InvalidArgument, "missing conversation")
+	}
+
+	messages, err := m.messageStore.GetHistory(
+		conversationId,
+		messageLimit(req.Limit),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "get history")
+	}
+
+	return &protobufs.MessagesResponse{Messages: messages}, nil
+}
+
+// CreateGroup implements protobufs.MessagingServiceServer. The peers are
+// invited right away, their prekey bundles must be available.
+func (m *Messenger) CreateGroup(
+	ctx context.Context,
+	req *protobufs.CreateGroupRequest,
+) (*protobufs.CreateGroupResponse, error) {
+	if len(req.PeerIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no peers")
+	}
+
+	bundles := []*protobufs.PreKeyBundle{}
+	peers := []*channel.PeerInfo{}
+	for _, peerId := range req.PeerIds {
+		if bytes.Equal(peerId, m.pubSub.GetPeerID()) {
+			return nil, status.Error(codes.InvalidArgument, "can't invite self")
+		}
+
+		bundle, info, err := m.fetchPeerInfo(ctx, peerId)
+		if err != nil {
+			return nil, err
+		}
+
+		bundles = append(bundles, bundle)
+		peers = append(peers, info)
+	}
+
+	identityKey, err := m.preKeys.Manager().IdentityKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "create group")
+	}
+
+	_, signedPreKey, err := m.preKeys.Manager().CurrentSignedPreKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "create group")
+	}
+
+	groupId := make([]byte, 32)
+	if _, err := rand.Read(groupId); err != nil {
+		return nil, errors.Wrap(err, "create group")
+	}
+
+	participant, welcomes, err := channel.NewAsyncTripleRatchetParticipant(
+		groupId,
+		peers,
+		m.curve,
+		m.keyManager,
+		identityKey,
+		identityKey,
+		signedPreKey,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "create group")
+	}
+
+	group := &protobufs.MessagingGroup{
+		GroupId: groupId,
+		Name:    req.Name,
+		Members: []*protobufs.MessagingGroupMember{{
+			PublicKey: participant.PublicKey(),
+			PeerId:    m.pubSub.GetPeerID(),
+		}},
+	}
+	for i, peerId := range req.PeerIds {
+		group.Members = append(group.Members, &protobufs.MessagingGroupMember{
+			PublicKey: bundles[i].IdentityKey,
+			PeerId:    peerId,
+		})
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if err := m.saveGroup(group, participant); err != nil {
+		return nil, errors.Wrap(err, "create group")
+	}
+
+	for i, peerId := range req.PeerIds {
+		if err := m.queueInvite(
+			group,
+			participant,
+			peerId,
+			bundles[i],
+			welcomes[string(bundles[i].IdentityKey)],
+		); err != nil {
+			return nil, errors.Wrap(err, "create group")
+		}
+	}
+
+	m.wakeDelivery()
+
+	return &protobufs.CreateGroupResponse{GroupId: groupId}, nil
+}
+
+// InviteToGroup implements protobufs.MessagingServiceServer. The peer's
+// prekey bundle must be available.
+func (m *Messenger) InviteToGroup(
+	ctx context.Context,
+	req *protobufs.InviteToGroupRequest,
+) (*protobufs.InviteToGroupResponse, error) {
+	bundle, info, err := m.fetchPeerInfo(ctx, req.PeerId)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	group, participant, err := m.loadGroup(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	updates, err := participant.AddMember(info)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	welcome := updates[string(bundle.IdentityKey)]
+	delete(updates, string(bundle.IdentityKey))
+	group.Members = append(group.Members, &protobufs.MessagingGroupMember{
+		PublicKey: bundle.IdentityKey,
+		PeerId:    req.PeerId,
+	})
+
+	if err := m.saveGroup(group, participant); err != nil {
+		return nil, errors.Wrap(err, "invite to group")
+	}
+
+	if err := m.queueGroupUpdates(group, updates); err != nil {
+		return nil, errors.Wrap(err, "invite to group")
+	}
+
+	if err := m.queueInvite(
+		group,
+		participant,
+		req.PeerId,
+		bundle,
+		welcome,
+	); err != nil {
+		return nil, errors.Wrap(err, "invite to group")
+	}
+
+	m.wakeDelivery()
+
+	return &protobufs.InviteToGroupResponse{}, nil
+}
+
+// Deliver implements protobufs.MessageDeliveryServiceServer, receiving a
+// message from the peer at the other end of the direct channel.
+func (m *Messenger) Deliver(
+	ctx context.Context,
+	req *protobufs.DeliverMessageRequest,
+) (*protobufs.DeliverMessageResponse, error) {
+	peerId, err := DirectChannelPeerID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	switch message := req.Message.(type) {
+	case *protobufs.DeliverMessageRequest_Initial:
+		err = m.receiveInitial([]byte(peerId), message.Initial)
+	case *protobufs.DeliverMessageRequest_Envelope:
+		err = m.receiveEnvelope([]byte(peerId), message.Envelope)
+	case *protobufs.DeliverMessageRequest_GroupUpdate:
+		err = m.receiveGroupUpdate([]byte(peerId), message.GroupUpdate)
+	case *protobufs.DeliverMessageRequest_GroupMessage:
+		err = m.receiveGroupMessage([]byte(peerId), message.GroupMessage)
+	default:
+		err = status.Error(codes.InvalidArgument, "empty message")
+	}
+	if err != nil {
+		m.logger.Debug(
+			"could not receive message",
+			zap.String("peer_id", peerId.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &protobufs.DeliverMessageResponse{}, nil
+}
+
+func (m *Messenger) receiveInitial(
+	peerId []byte,
+	initial *protobufs.X3DHInitialMessage,
+) error {
+	session, plaintext, err := m.preKeys.Manager().AcceptSession(initial)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := m.saveSession(incomingSessionId(peerId), session); err != nil {
+		return errors.Wrap(err, "receive initial")
+	}
+
+	return m.receivePayload(peerId, plaintext)
+}
+
+func (m *Messenger) receiveEnvelope(
+	peerId []byte,
+	envelope *protobufs.P2PChannelEnvelope,
+) error {
+	state, err := m.channelStore.GetSession(incomingSessionId(peerId))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return status.Error(codes.FailedPrecondition, "no session")
+		}
+
+		return errors.Wrap(err, "receive envelope")
+	}
+
+	session, err := channel.UnmarshalDoubleRatchetParticipant(
+		state,
+		m.keyManager,
+	)
+	if err != nil {
+		return errors.Wrap(err, "receive envelope")
+	}
+
+	plaintext, err := session.RatchetDecrypt(envelope)
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if err := m.saveSession(incomingSessionId(peerId), session); err != nil {
+		return errors.Wrap(err, "receive envelope")
+	}
+
+	return m.receivePayload(peerId, plaintext)
+}
+
+func (m *Messenger) receivePayload(peerId []byte, plaintext []byte) error {
+	payload := &protobufs.MessagingPayload{}
+	if err := proto.Unmarshal(plaintext, payload); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	switch p := payload.Payload.(type) {
+	case *protobufs.MessagingPayload_Text:
+		return errors.Wrap(
+			m.messageStore.PutMessage(&protobufs.StoredMessage{
+				MessageId: p.Text.MessageId,
+				PeerId:    peerId,
+				Text:      p.Text.Text,
+				Timestamp: m.receivedTimestamp(p.Text.Timestamp),
+			}),
+			"receive payload",
+		)
+	case *protobufs.MessagingPayload_GroupInvite:
+		return m.receiveInvite(peerId, p.GroupInvite)
+	default:
+		return status.Error(codes.InvalidArgument, "unexpected payload")
+	}
+}
+
+func (m *Messenger) receiveInvite(
+	peerId []byte,
+	invite *protobufs.GroupInvite,
+) error {
+	if _, err := m.messageStore.GetGroup(invite.GroupId); err == nil {
+		return nil
+	} else if !errors.Is(err, store.ErrNotFound) {
+		return errors.Wrap(err, "receive invite")
+	}
+
+	inviter, err := m.memberPeerInfo(invite.Inviter)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	identityKey, err := m.preKeys.Manager().IdentityKey()
+	if err != nil {
+		return errors.Wrap(err, "receive invite")
+	}
+
+	signedPreKey, err := m.preKeys.Manager().SignedPreKey(
+		invite.SignedPreKeyId,
+	)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	participant, err := channel.JoinAsyncTripleRatchetGroup(
+		invite.GroupId,
+		inviter,
+		invite.Welcome,
+		m.curve,
+		m.keyManager,
+		identityKey,
+		identityKey,
+		signedPreKey,
+	)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	group := &protobufs.MessagingGroup{
+		GroupId: invite.GroupId,
+		Name:    invite.Name,
+	}
+	m.mergeMembers(group, participant, invite.Members)
+	// The inviter is who delivered the invite, whatever the directory says.
+	for _, member := range group.Members {
+		if bytes.Equal(member.PublicKey, invite.Inviter.PublicKey) {
+			member.PeerId = peerId
+		}
+	}
+
+	m.logger.Info(
+		"joined messaging group",
+		zap.Binary("group_id", invite.GroupId),
+		zap.String("name", invite.Name),
+		zap.String("inviter", peer.ID(peerId).String()),
+	)
+
+	return errors.Wrap(m.saveGroup(group, participant), "receive invite")
+}
+
+func (m *Messenger) receiveGroupUpdate(
+	peerId []byte,
+	update *protobufs.GroupUpdate,
+) error {
+	group, participant, err := m.loadGroup(update.GroupId)
+	if err != nil {
+		return err
+	}
+
+	sender := groupMemberKey(group, peerId)
+	if sender == nil {
+		return status.Error(codes.PermissionDenied, "not a group member")
+	}
+
+	responses, err := participant.ReceiveUpdate(sender, update.Update)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	m.mergeMembers(group, participant, update.Members)
+	if err := m.saveGroup(group, participant); err != nil {
+		return errors.Wrap(err, "receive group update")
+	}
+
+	if err := m.queueGroupUpdates(group, responses); err != nil {
+		return errors.Wrap(err, "receive group update")
+	}
+
+	if len(responses) != 0 {
+		m.wakeDelivery()
+	}
+
+	return nil
+}
+
+func (m *Messenger) receiveGroupMessage(
+	peerId []byte,
+	message *protobufs.GroupMessage,
+) error {
+	group, participant, err := m.loadGroup(message.GroupId)
+	if err != nil {
+		return err
+	}
+
+	sender, plaintext, err := participant.RatchetDecrypt(message.Envelope)
+	if err != nil {
+		if errors.Is(err, channel.ErrUnknownEpoch) {
+			// The update of the epoch is still on its way.
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		if errors.Is(err, channel.ErrNotGroupMember) {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !bytes.Equal(groupMemberKey(group, peerId), sender) {
+		return status.Error(codes.PermissionDenied, "sender mismatch")
+	}
+
+	text := &protobufs.TextMessage{}
+	if err := proto.Unmarshal(plaintext, text); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := m.saveGroup(group, participant); err != nil {
+		return errors.Wrap(err, "receive group message")
+	}
+
+	return errors.Wrap(
+		m.messageStore.PutMessage(&protobufs.StoredMessage{
+			MessageId: text.MessageId,
+			PeerId:    peerId,
+			GroupId:   message.GroupId,
+			Text:      text.Text,
+			Timestamp: m.receivedTimestamp(text.Timestamp),
+		}),
+		"receive group message",
+	)
+}
+
+// receivedTimestamp bounds the timestamp the sender claims by the local clock,
+// so a received message can't stay the newest, and escape being dropped from
+// a full inbox, by claiming to be from the future.
+func (m *Messenger) receivedTimestamp(timestamp int64) int64 {
+	now := m.clock.Now().UnixMilli()
+	if timestamp > now {
+		return now
+	}
+
+	return timestamp
+}
+
+func (m *Messenger) runDelivery(ctx context.Context) {
+	ticker := m.clock.NewTicker(messageDeliveryInterval)
+	defer ticker.Stop()
+
+	for {
+		m.deliverOutbound(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		case <-m.wake:
+		}
+	}
+}
+
+func (m *Messenger) wakeDelivery() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+// deliverOutbound delivers the queued messages of each reachable peer, in the
+// order they were queued.
+func (m *Messenger) deliverOutbound(ctx context.Context) {
+	messages, err := m.messageStore.GetOutbound()
+	if err != nil {
+		m.logger.Error("could not get outbound messages", zap.Error(err))
+		return
+	}
+
+	peers := [][]byte{}
+	byPeer := map[string][]*protobufs.OutboundMessage{}
+	for _, message := range messages {
+		if _, ok := byPeer[string(message.PeerId)]; !ok {
+			peers = append(peers, message.PeerId)
+		}
+		byPeer[string(message.PeerId)] = append(
+			byPeer[string(message.PeerId)],
+			message,
+		)
+	}
+
+	for _, peerId := range peers {
+		if ctx.Err() != nil {
+			return
+		}
+
+		m.deliverToPeer(ctx, peerId, byPeer[string(peerId)])
+	}
+}
+
+func (m *Messenger) deliverToPeer(
+	ctx context.Context,
+	peerId []byte,
+	messages []*protobufs.OutboundMessage,
+) {
+	cc, err := m.pubSub.GetDirectChannel(peerId, messagingPurpose)
+	if err != nil {
+		m.logger.Debug(
+			"could not reach peer for message delivery",
+			zap.String("peer_id", peer.ID(peerId).String()),
+			zap.Error(err),
+		)
+		return
+	}
+	defer cc.Close()
+
+	client := protobufs.NewMessageDeliveryServiceClient(cc)
+	for _, message := range messages {
+		if m.clock.Since(time.UnixMilli(message.Timestamp)) > messageExpiry {
+			m.logger.Warn(
+				"dropping expired message",
+				zap.String("peer_id", peer.ID(peerId).String()),
+			)
+			m.deleteOutbound(message)
+			continue
+		}
+
+		err := m.deliver(ctx, client, message)
+		switch status.Code(errors.Cause(err)) {
+		case codes.OK:
+			m.deleteOutbound(message)
+			continue
+		case codes.PermissionDenied, codes.InvalidArgument:
+			m.logger.Warn(
+				"message rejected by peer",
+				zap.String("peer_id", peer.ID(peerId).String()),
+				zap.Error(err),
+			)
+			m.deleteOutbound(message)
+			continue
+		case codes.FailedPrecondition:
+			// The peer lost or can't follow the session, start over.
+			if err := m.channelStore.DeleteSession(
+				outgoingSessionId(peerId),
+			); err != nil {
+				m.logger.Error("could not reset session", zap.Error(err))
+			}
+		}
+
+		m.logger.Debug(
+			"could not deliver message",
+			zap.String("peer_id", peer.ID(peerId).String()),
+			zap.Error(err),
+		)
+		message.Attempts++
+		if err := m.messageStore.PutOutbound(message); err != nil {
+			m.logger.Error("could not update outbound message", zap.Error(err))
+		}
+		return
+	}
+}
+
+func (m *Messenger) deliver(
+	ctx context.Context,
+	client protobufs.MessageDeliveryServiceClient,
+	message *protobufs.OutboundMessage,
+) error {
+	req := &protobufs.DeliverMessageRequest{}
+	var session *channel.DoubleRatchetParticipant
+	switch p := message.Payload.Payload.(type) {
+	case *protobufs.MessagingPayload_GroupUpdate:
+		req.Message = &protobufs.DeliverMessageRequest_GroupUpdate{
+			GroupUpdate: p.GroupUpdate,
+		}
+	case *protobufs.MessagingPayload_GroupMessage:
+		req.Message = &protobufs.DeliverMessageRequest_GroupMessage{
+			GroupMessage: p.GroupMessage,
+		}
+	default:
+		var err error
+		session, err = m.encryptDirect(ctx, message, req)
+		if err != nil {
+			return errors.Wrap(err, "deliver")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, messageDeliveryTimeout)
+	defer cancel()
+
+	if _, err := client.Deliver(ctx, req); err != nil {
+		return errors.Wrap(err, "deliver")
+	}
+
+	// A new session is only kept once the peer has it.
+	if session != nil {
+		if err := m.saveSession(
+			outgoingSessionId(message.PeerId),
+			session,
+		); err != nil {
+			return errors.Wrap(err, "deliver")
+		}
+	}
+
+	if text := message.Payload.GetText(); text != nil {
+		if err := m.messageStore.PutMessage(&protobufs.StoredMessage{
+			MessageId: text.MessageId,
+			PeerId:    message.PeerId,
+			Text:      text.Text,
+			Timestamp: text.Timestamp,
+			Outgoing:  true,
+			Delivered: true,
+		}); err != nil {
+			return errors.Wrap(err, "deliver")
+		}
+	}
+
+	return nil
+}
+
+// encryptDirect encrypts the payload with the session with the peer, or
+// starts a session from the peer's prekey bundle. A started session is
+// returned, to be kept once delivered.
+func (m *Messenger) encryptDirect(
+	ctx context.Context,
+	message *protobufs.OutboundMessage,
+	req *protobufs.DeliverMessageRequest,
+) (*channel.DoubleRatchetParticipant, error) {
+	data, err := proto.Marshal(message.Payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt direct")
+	}
+
+	sessionId := outgoingSessionId(message.PeerId)
+	state, err := m.channelStore.GetSession(sessionId)
+	if err == nil {
+		session, err := channel.UnmarshalDoubleRatchetParticipant(
+			state,
+			m.keyManager,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "encrypt direct")
+		}
+
+		envelope, err := session.RatchetEncrypt(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "encrypt direct")
+		}
+
+		// The message key is used, whether or not the message arrives.
+		if err := m.saveSession(sessionId, session); err != nil {
+			return nil, errors.Wrap(err, "encrypt direct")
+		}
+
+		req.Message = &protobufs.DeliverMessageRequest_Envelope{
+			Envelope: envelope,
+		}
+		return nil, nil
+	}
+	if !errors.Is(err, store.ErrNotFound) {
+		return nil, errors.Wrap(err, "encrypt direct")
+	}
+
+	bundle, err := m.preKeys.FetchPreKeyBundle(ctx, message.PeerId)
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt direct")
+	}
+
+	session, initial, err := m.preKeys.Manager().InitiateSession(bundle, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt direct")
+	}
+
+	req.Message = &protobufs.DeliverMessageRequest_Initial{Initial: initial}
+	return session, nil
+}
+
+func (m *Messenger) queue(
+	peerId []byte,
+	payload *protobufs.MessagingPayload,
+) error {
+	return errors.Wrap(
+		m.messageStore.PutOutbound(&protobufs.OutboundMessage{
+			MessageId: newMessageId(),
+			PeerId:    peerId,
+			Payload:   payload,
+			Timestamp: m.clock.Now().UnixMilli(),
+		}),
+		"queue",
+	)
+}
+
+func (m *Messenger) queueInvite(
+	group *protobufs.MessagingGroup,
+	participant *channel.AsyncTripleRatchetParticipant,
+	peerId []byte,
+	bundle *protobufs.PreKeyBundle,
+	welcome *protobufs.P2PChannelEnvelope,
+) error {
+	if welcome == nil {
+		return errors.Wrap(errors.New("missing welcome"), "queue invite")
+	}
+
+	inviter := participant.PeerInfo()
+	return errors.Wrap(
+		m.queue(peerId, &protobufs.MessagingPayload{
+			Payload: &protobufs.MessagingPayload_GroupInvite{
+				GroupInvite: &protobufs.GroupInvite{
+					GroupId: group.GroupId,
+					Name:    group.Name,
+					Inviter: &protobufs.AsyncTripleRatchetMember{
+						PublicKey: inviter.PublicKey.ToAffineCompressed(),
+						IdentityPublicKey: inviter.IdentityPublicKey.
+							ToAffineCompressed(),
+						SignedPrePublicKey: inviter.SignedPrePublicKey.
+							ToAffineCompressed(),
+					},
+					SignedPreKeyId: bundle.SignedPreKeyId,
+					Welcome:        welcome,
+					Members:        group.Members,
+				},
+			},
+		}),
+		"queue invite",
+	)
+}
+
+func (m *Messenger) queueGroupUpdates(
+	group *protobufs.MessagingGroup,
+	updates map[string]*protobufs.P2PChannelEnvelope,
+) error {
+	for _, member := range group.Members {
+		update, ok := updates[string(member.PublicKey)]
+		if !ok {
+			continue
+		}
+
+		if err := m.queue(member.PeerId, &protobufs.MessagingPayload{
+			Payload: &protobufs.MessagingPayload_GroupUpdate{
+				GroupUpdate: &protobufs.GroupUpdate{
+					GroupId: group.GroupId,
+					Update:  update,
+					Members: group.Members,
+				},
+			},
+		}); err != nil {
+			return errors.Wrap(err, "queue group updates")
+		}
+	}
+
+	return nil
+}
+
+// mergeMembers updates the peers of the group's members from a directory sent
+// by a member, keeping the peers already known, and drops the peers of
+// members no longer in the group.
+func (m *Messenger) mergeMembers(
+	group *protobufs.MessagingGroup,
+	participant *channel.AsyncTripleRatchetParticipant,
+	directory []*protobufs.MessagingGroupMember,
+) {
+	known := map[string][]byte{}
+	for _, member := range directory {
+		known[string(member.PublicKey)] = member.PeerId
+	}
+	for _, member := range group.Members {
+		known[string(member.PublicKey)] = member.PeerId
+	}
+
+	group.Members = []*protobufs.MessagingGroupMember{}
+	for _, publicKey := range participant.Members() {
+		peerId, ok := known[string(publicKey)]
+		if !ok {
+			continue
+		}
+
+		group.Members = append(group.Members, &protobufs.MessagingGroupMember{
+			PublicKey: publicKey,
+			PeerId:    peerId,
+		})
+	}
+}
+
+func (m *Messenger) loadGroup(groupId []byte) (
+	*protobufs.MessagingGroup,
+	*channel.AsyncTripleRatchetParticipant,
+	error,
+) {
+	group, err := m.messageStore.GetGroup(groupId)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, nil, status.Error(codes.NotFound, "unknown group")
+		}
+
+		return nil, nil, errors.Wrap(err, "load group")
+	}
+
+	participant, err := channel.UnmarshalAsyncTripleRatchetParticipant(
+		group.State,
+		m.keyManager,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "load group")
+	}
+
+	return group, participant, nil
+}
+
+func (m *Messenger) saveGroup(
+	group *protobufs.MessagingGroup,
+	participant *channel.AsyncTripleRatchetParticipant,
+) error {
+	state, err := participant.MarshalState()
+	if err != nil {
+		return errors.Wrap(err, "save group")
+	}
+
+	group.State = state
+	return errors.Wrap(m.messageStore.PutGroup(group), "save group")
+}
+
+func (m *Messenger) saveSession(
+	sessionId []byte,
+	session *channel.DoubleRatchetParticipant,
+) error {
+	state, err := session.MarshalState()
+	if err != nil {
+		return errors.Wrap(err, "save session")
+	}
+
+	return errors.Wrap(
+		m.channelStore.PutSession(sessionId, state),
+		"save session",
+	)
+}
+
+func (m *Messenger) deleteOutbound(message *protobufs.OutboundMessage) {
+	if err := m.messageStore.DeleteOutbound(message); err != nil {
+		m.logger.Error("could not delete outbound message", zap.Error(err))
+	}
+}
+
+// fetchPeerInfo returns the peer's prekey bundle and the keys it joins
+// groups with.
+func (m *Messenger) fetchPeerInfo(
+	ctx context.Context,
+	peerId []byte,
+) (*protobufs.PreKeyBundle, *channel.PeerInfo, error) {
+	if _, err := peer.IDFromBytes(peerId); err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid peer id")
+	}
+
+	bundle, err := m.preKeys.FetchPreKeyBundle(ctx, peerId)
+	if err != nil {
+		return nil, nil, status.Error(
+			codes.Unavailable,
+			"no prekey bundle for peer",
+		)
+	}
+
+	info, err := m.memberPeerInfo(&protobufs.AsyncTripleRatchetMember{
+		PublicKey:          bundle.IdentityKey,
+		IdentityPublicKey:  bundle.IdentityKey,
+		SignedPrePublicKey: bundle.SignedPreKey,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "fetch peer info")
+	}
+
+	return bundle, info, nil
+}
+
+func (m *Messenger) memberPeerInfo(
+	member *protobufs.AsyncTripleRatchetMember,
+) (*channel.PeerInfo, error) {
+	if member == nil {
+		return nil, errors.New("missing member")
+	}
+
+	publicKey, err := m.curve.Point.FromAffineCompressed(member.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "member peer info")
+	}
+
+	identityPublicKey, err := m.curve.Point.FromAffineCompressed(
+		member.IdentityPublicKey,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "member peer info")
+	}
+
+	signedPrePublicKey, err := m.curve.Point.FromAffineCompressed(
+		member.SignedPrePublicKey,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "member peer info")
+	}
+
+	return &channel.PeerInfo{
+		PublicKey:          publicKey,
+		IdentityPublicKey:  identityPublicKey,
+		SignedPrePublicKey: signedPrePublicKey,
+	}, nil
+}
+
+func groupMemberKey(group *protobufs.MessagingGroup, peerId []byte) []byte {
+	for _, member := range group.Members {
+		if bytes.Equal(member.PeerId, peerId) {
+			return member.PublicKey
+		}
+	}
+
+	return nil
+}
+
+func outgoingSessionId(peerId []byte) []byte {
+	return append([]byte("messaging-out/"), peerId...)
+}
+
+func incomingSessionId(peerId []byte) []byte {
+	return append([]byte("messaging-in/"), peerId...)
+}
+
+func newMessageId() []byte {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+func messageLimit(limit uint32) int {
+	if limit == 0 {
+		return defaultMessageLimit
+	}
+
+	if limit > maxMessageLimit {
+		return maxMessageLimit
+	}
+
+	return int(limit)
+}
@@ -0,0 +1,254 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+type testMessenger struct {
+	*Messenger
+	host        host.Host
+	preKeyStore store.PreKeyStore
+	clock       *clock.FakeClock
+}
+
+// newTestMessenger creates a messenger on the fake clock, which is shared by
+// the messengers of a test so their timestamps are comparable.
+func newTestMessenger(
+	t *testing.T,
+	ctx context.Context,
+	clk *clock.FakeClock,
+) *testMessenger {
+	h := newTestHost(t)
+	pubSub := &BlossomSub{
+		ctx:    ctx,
+		logger: zap.NewNop(),
+		h:      h,
+		peerID: h.ID(),
+	}
+
+	db := store.NewInMemKVDB()
+	keyManager := keys.NewInMemoryKeyManager()
+	preKeyStore := store.NewPebblePreKeyStore(db, keyManager, zap.NewNop())
+	preKeys := NewPreKeyDirectory(
+		zap.NewNop(),
+		pubSub,
+		keyManager,
+		preKeyStore,
+		clk,
+	)
+	require.NoError(t, preKeys.Manager().Refresh())
+
+	m := NewMessenger(
+		zap.NewNop(),
+		pubSub,
+		keyManager,
+		preKeys,
+		store.NewPebbleChannelStore(db, keyManager, zap.NewNop()),
+		store.NewPebbleMessageStore(db, keyManager, zap.NewNop()),
+		clk,
+	)
+	m.Start()
+	t.Cleanup(m.Stop)
+
+	return &testMessenger{m, h, preKeyStore, clk}
+}
+
+func (m *testMessenger) peerId() []byte {
+	return []byte(m.host.ID())
+}
+
+// knows gives the messenger the prekey bundles of the others, as the prekey
+// directory would have received them.
+func (m *testMessenger) knows(t *testing.T, others ...*testMessenger) {
+	for _, other := range others {
+		bundle, err := other.preKeys.Manager().Bundle(false)
+		require.NoError(t, err)
+		require.NoError(t, m.preKeyStore.PutPreKeyBundle(other.peerId(), bundle))
+	}
+}
+
+func (m *testMessenger) connect(t *testing.T, other *testMessenger) {
+	require.NoError(t, m.host.Connect(context.Background(), peer.AddrInfo{
+		ID:    other.host.ID(),
+		Addrs: other.host.Addrs(),
+	}))
+}
+
+func (m *testMessenger) send(t *testing.T, recipient []byte, text string) {
+	_, err := m.Send(context.Background(), &protobufs.SendMessageRequest{
+		Recipient: &protobufs.SendMessageRequest_PeerId{PeerId: recipient},
+		Text:      text,
+	})
+	require.NoError(t, err)
+
+	// Messages are ordered by timestamp, each is sent a millisecond apart.
+	m.clock.Advance(time.Millisecond)
+}
+
+func (m *testMessenger) sendGroup(t *testing.T, groupId []byte, text string) {
+	_, err := m.Send(context.Background(), &protobufs.SendMessageRequest{
+		Recipient: &protobufs.SendMessageRequest_GroupId{GroupId: groupId},
+		Text:      text,
+	})
+	require.NoError(t, err)
+	m.clock.Advance(time.Millisecond)
+}
+
+// requireReceives waits for the messages to arrive in the inbox, in order.
+func (m *testMessenger) requireReceives(
+	t *testing.T,
+	from *testMessenger,
+	groupId []byte,
+	texts ...string,
+) {
+	var history []*protobufs.StoredMessage
+	require.Eventually(t, func() bool {
+		conversation := &protobufs.GetHistoryRequest{}
+		if groupId != nil {
+			conversation.Conversation = &protobufs.GetHistoryRequest_GroupId{
+				GroupId: groupId,
+			}
+		} else {
+			conversation.Conversation = &protobufs.GetHistoryRequest_PeerId{
+				PeerId: from.peerId(),
+			}
+		}
+
+		resp, err := m.GetHistory(context.Background(), conversation)
+		require.NoError(t, err)
+
+		history = []*protobufs.StoredMessage{}
+		for _, message := range resp.Messages {
+			if !message.Outgoing && string(message.PeerId) == string(from.peerId()) {
+				history = append(history, message)
+			}
+		}
+		return len(history) >= len(texts)
+	}, 10*time.Second, 50*time.Millisecond)
+
+	received := []string{}
+	for _, message := range history {
+		received = append(received, message.Text)
+	}
+	require.Equal(t, texts, received)
+}
+
+func TestMessengerDirectMessages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clk := clock.NewFakeClock(time.UnixMilli(1_700_000_000_000))
+	a := newTestMessenger(t, ctx, clk)
+	b := newTestMessenger(t, ctx, clk)
+	c := newTestMessenger(t, ctx, clk)
+	a.knows(t, b, c)
+	b.knows(t, a)
+	a.connect(t, b)
+
+	a.send(t, b.peerId(), "hello")
+	a.send(t, b.peerId(), "are you there?")
+	b.requireReceives(t, a, nil, "hello", "are you there?")
+
+	// B writes back over a session of its own.
+	b.send(t, a.peerId(), "hi")
+	a.requireReceives(t, b, nil, "hi")
+
+	require.Eventually(t, func() bool {
+		resp, err := a.GetHistory(context.Background(), &protobufs.GetHistoryRequest{
+			Conversation: &protobufs.GetHistoryRequest_PeerId{PeerId: b.peerId()},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Messages, 3)
+		return resp.Messages[0].Delivered && resp.Messages[1].Delivered
+	}, 10*time.Second, 50*time.Millisecond)
+
+	inbox, err := b.GetInbox(context.Background(), &protobufs.GetInboxRequest{})
+	require.NoError(t, err)
+	require.Len(t, inbox.Messages, 2)
+	require.Equal(t, "are you there?", inbox.Messages[0].Text)
+
+	// C is offline, the message waits in the outbox until it is reachable.
+	a.send(t, c.peerId(), "see you later")
+	time.Sleep(200 * time.Millisecond)
+	outbound, err := a.messageStore.GetOutbound()
+	require.NoError(t, err)
+	require.Len(t, outbound, 1)
+
+	a.connect(t, c)
+	a.wakeDelivery()
+	c.requireReceives(t, a, nil, "see you later")
+}
+
+func TestMessengerGroups(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clk := clock.NewFakeClock(time.UnixMilli(1_700_000_000_000))
+	a := newTestMessenger(t, ctx, clk)
+	b := newTestMessenger(t, ctx, clk)
+	c := newTestMessenger(t, ctx, clk)
+	d := newTestMessenger(t, ctx, clk)
+	a.knows(t, b, c, d)
+	for _, m := range []*testMessenger{b, c, d} {
+		a.connect(t, m)
+	}
+	b.connect(t, c)
+	b.connect(t, d)
+	c.connect(t, d)
+
+	resp, err := a.CreateGroup(context.Background(), &protobufs.CreateGroupRequest{
+		Name:    "operators",
+		PeerIds: [][]byte{b.peerId(), c.peerId()},
+	})
+	require.NoError(t, err)
+	groupId := resp.GroupId
+
+	for _, m := range []*testMessenger{b, c} {
+		require.Eventually(t, func() bool {
+			_, err := m.messageStore.GetGroup(groupId)
+			return err == nil
+		}, 10*time.Second, 50*time.Millisecond)
+	}
+
+	a.sendGroup(t, groupId, "welcome")
+	b.requireReceives(t, a, groupId, "welcome")
+	c.requireReceives(t, a, groupId, "welcome")
+
+	c.sendGroup(t, groupId, "thanks")
+	a.requireReceives(t, c, groupId, "thanks")
+	b.requireReceives(t, c, groupId, "thanks")
+
+	// B invites D, who learns the other members from the invite.
+	b.knows(t, d)
+	_, err = b.InviteToGroup(context.Background(), &protobufs.InviteToGroupRequest{
+		GroupId: groupId,
+		PeerId:  d.peerId(),
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, err := d.messageStore.GetGroup(groupId)
+		return err == nil
+	}, 10*time.Second, 50*time.Millisecond)
+
+	d.sendGroup(t, groupId, "hello all")
+	a.requireReceives(t, d, groupId, "hello all")
+	b.requireReceives(t, d, groupId, "hello all")
+	c.requireReceives(t, d, groupId, "hello all")
+
+	group, err := a.messageStore.GetGroup(groupId)
+	require.NoError(t, err)
+	require.Equal(t, "operators", group.Name)
+	require.Len(t, group.Members, 4)
+}
@@ -0,0 +1,245 @@
+package p2p
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	libp2pconfig "github.com/libp2p/go-libp2p/config"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/host/autorelay"
+	"github.com/libp2p/go-libp2p/p2p/protocol/circuitv2/proto"
+	"github.com/libp2p/go-libp2p/p2p/protocol/circuitv2/relay"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+const (
+	defaultAutoNATGlobalLimit     = 30
+	defaultAutoNATPeerLimit       = 3
+	defaultAutoNATThrottle        = time.Minute
+	defaultRelayMaxReservations   = 64
+	defaultRelayMaxCircuits       = 8
+	defaultRelayReservationTTL    = time.Hour
+	defaultRelayLimitDuration     = 2 * time.Minute
+	defaultRelayLimitData         = 1 << 20
+	defaultAutoRelayNumRelays     = 2
+	defaultAutoRelayBootDelay     = time.Minute
+	relayCandidateRefreshInterval = 30 * time.Second
+)
+
+// natOptions returns the libp2p options for reachability detection and NAT
+// traversal. AutoNAT determines reachability by asking connected peers to dial
+// back, nodes found to be private reserve slots on relaying peers and use
+// DCUtR to upgrade relayed connections to direct ones, and public nodes may
+// opt in to serving as resource limited relays themselves. The returned
+// function must be called with the constructed host, as relay candidates are
+// drawn from its connected peers.
+func natOptions(p2pConfig *config.P2PConfig, clock clock.Clock) (
+	[]libp2pconfig.Option,
+	func(h host.Host),
+	error,
+) {
+	opts := []libp2pconfig.Option{}
+
+	switch strings.ToLower(p2pConfig.ForceReachability) {
+	case "":
+	case "public":
+		opts = append(opts, libp2p.ForceReachabilityPublic())
+	case "private":
+		opts = append(opts, libp2p.ForceReachabilityPrivate())
+	default:
+		return nil, nil, errors.Wrap(
+			errors.New("force reachability must be public or private"),
+			"nat options",
+		)
+	}
+
+	if !p2pConfig.DisableAutoNATService {
+		global := p2pConfig.AutoNATServiceGlobalLimit
+		if global == 0 {
+			global = defaultAutoNATGlobalLimit
+		}
+		perPeer := p2pConfig.AutoNATServicePeerLimit
+		if perPeer == 0 {
+			perPeer = defaultAutoNATPeerLimit
+		}
+		opts = append(
+			opts,
+			libp2p.EnableNATService(),
+			libp2p.AutoNATServiceRateLimit(global, perPeer, defaultAutoNATThrottle),
+		)
+	}
+
+	if p2pConfig.EnableRelayService {
+		opts = append(opts, libp2p.EnableRelayService(
+			relay.WithResources(relayResources(p2pConfig)),
+		))
+	}
+
+	if !p2pConfig.DisableHolePunching {
+		opts = append(opts, libp2p.EnableHolePunching())
+	}
+
+	if p2pConfig.DisableAutoRelay {
+		return opts, func(h host.Host) {}, nil
+	}
+
+	hosts := make(chan host.Host, 1)
+	numRelays := p2pConfig.AutoRelayNumRelays
+	if numRelays == 0 {
+		numRelays = defaultAutoRelayNumRelays
+	}
+	opts = append(opts, libp2p.EnableAutoRelayWithPeerSource(
+		relayPeerSource(hosts, clock),
+		autorelay.WithNumRelays(numRelays),
+		autorelay.WithBootDelay(defaultAutoRelayBootDelay),
+	))
+
+	return opts, func(h host.Host) { hosts <- h }, nil
+}
+
+func relayResources(p2pConfig *config.P2PConfig) relay.Resources {
+	resources := relay.DefaultResources()
+	resources.MaxReservations = defaultRelayMaxReservations
+	resources.MaxCircuits = defaultRelayMaxCircuits
+	resources.ReservationTTL = defaultRelayReservationTTL
+	resources.Limit = &relay.RelayLimit{
+		Duration: defaultRelayLimitDuration,
+		Data:     defaultRelayLimitData,
+	}
+
+	if p2pConfig.RelayMaxReservations != 0 {
+		resources.MaxReservations = p2pConfig.RelayMaxReservations
+	}
+	if p2pConfig.RelayMaxCircuits != 0 {
+		resources.MaxCircuits = p2pConfig.RelayMaxCircuits
+	}
+	if p2pConfig.RelayReservationTTL != 0 {
+		resources.ReservationTTL = p2pConfig.RelayReservationTTL
+	}
+	if p2pConfig.RelayLimitDuration != 0 {
+		resources.Limit.Duration = p2pConfig.RelayLimitDuration
+	}
+	if p2pConfig.RelayLimitData != 0 {
+		resources.Limit.Data = p2pConfig.RelayLimitData
+	}
+
+	return resources
+}
+
+// relayPeerSource offers connected peers that advertise the circuit v2 hop
+// protocol as relay candidates. AutoRelay may ask for candidates before the
+// host has been constructed, so the host is handed over through a channel.
+func relayPeerSource(
+	hosts <-chan host.Host,
+	clock clock.Clock,
+) autorelay.PeerSource {
+	var h host.Host
+	return func(ctx context.Context, num int) <-chan peer.AddrInfo {
+		out := make(chan peer.AddrInfo, num)
+		if h == nil {
+			select {
+			case h = <-hosts:
+			case <-ctx.Done():
+				close(out)
+				return out
+			}
+		}
+
+		go func() {
+			defer close(out)
+			for {
+				found := 0
+				for _, p := range h.Network().Peers() {
+					if found >= num {
+						return
+					}
+
+					supported, err := h.Peerstore().SupportsProtocols(
+						p,
+						proto.ProtoIDv2Hop,
+					)
+					if err != nil || len(supported) == 0 {
+						continue
+					}
+
+					select {
+					case out <- h.Peerstore().PeerInfo(p):
+						found++
+					case <-ctx.Done():
+						return
+					}
+				}
+
+				if found > 0 {
+					return
+				}
+
+				select {
+				case <-clock.After(relayCandidateRefreshInterval):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		return out
+	}
+}
+
+// monitorReachability logs the reachability reported by AutoNAT, which
+// replaces the one-off check against a central endpoint.
+func monitorReachability(
+	ctx context.Context,
+	logger *zap.Logger,
+	h host.Host,
+) {
+	sub, err := h.EventBus().Subscribe([]interface{}{
+		new(event.EvtLocalReachabilityChanged),
+		new(event.EvtNATDeviceTypeChanged),
+	})
+	if err != nil {
+		logger.Error("could not subscribe to reachability events", zap.Error(err))
+		return
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-sub.Out():
+			if !ok {
+				return
+			}
+
+			switch evt := e.(type) {
+			case event.EvtLocalReachabilityChanged:
+				switch evt.Reachability {
+				case network.ReachabilityPublic:
+					logger.Info("node is publicly reachable")
+				case network.ReachabilityPrivate:
+					logger.Warn(
+						"node is not publicly reachable, connections will be " +
+							"relayed and upgraded with hole punching where possible",
+					)
+				default:
+					logger.Info("node reachability is unknown")
+				}
+			case event.EvtNATDeviceTypeChanged:
+				logger.Info(
+					"detected nat device type",
+					zap.String("transport", evt.TransportProtocol.String()),
+					zap.String("type", evt.NatDeviceType.String()),
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	libp2pconfig "github.com/libp2p/go-libp2p/config"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+func TestNATOptions(t *testing.T) {
+	_, _, err := natOptions(
+		&config.P2PConfig{ForceReachability: "sometimes"},
+		clock.NewRealClock(),
+	)
+	assert.Error(t, err)
+
+	opts, setRelayHost, err := natOptions(&config.P2PConfig{
+		ForceReachability: "Private",
+		DisableAutoRelay:  true,
+	}, clock.NewRealClock())
+	require.NoError(t, err)
+	assert.NotNil(t, setRelayHost)
+
+	cfg := &libp2pconfig.Config{}
+	require.NoError(t, cfg.Apply(opts...))
+	require.NotNil(t, cfg.ForceReachability)
+	assert.Equal(t, network.ReachabilityPrivate, *cfg.ForceReachability)
+	assert.True(t, cfg.AutoNATConfig.EnableService)
+	assert.Equal(t, defaultAutoNATGlobalLimit, cfg.ThrottleGlobalLimit)
+	assert.Equal(t, defaultAutoNATPeerLimit, cfg.ThrottlePeerLimit)
+	assert.True(t, cfg.EnableHolePunching)
+	assert.False(t, cfg.EnableRelayService)
+	assert.False(t, cfg.EnableAutoRelay)
+
+	opts, _, err = natOptions(&config.P2PConfig{
+		DisableAutoNATService: true,
+		DisableHolePunching:   true,
+		EnableRelayService:    true,
+	}, clock.NewRealClock())
+	require.NoError(t, err)
+
+	cfg = &libp2pconfig.Config{}
+	require.NoError(t, cfg.Apply(opts...))
+	assert.Nil(t, cfg.ForceReachability)
+	assert.False(t, cfg.AutoNATConfig.EnableService)
+	assert.False(t, cfg.EnableHolePunching)
+	assert.True(t, cfg.EnableRelayService)
+	assert.True(t, cfg.EnableAutoRelay)
+}
+
+func TestRelayResources(t *testing.T) {
+	resources := relayResources(&config.P2PConfig{})
+	assert.Equal(t, defaultRelayMaxReservations, resources.MaxReservations)
+	assert.Equal(t, defaultRelayMaxCircuits, resources.MaxCircuits)
+	assert.Equal(t, int64(defaultRelayLimitData), resources.Limit.Data)
+
+	resources = relayResources(&config.P2PConfig{
+		RelayMaxReservations: 16,
+		RelayLimitDuration:   time.Minute,
+	})
+	assert.Equal(t, 16, resources.MaxReservations)
+	assert.Equal(t, time.Minute, resources.Limit.Duration)
+}
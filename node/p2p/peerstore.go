@@ -0,0 +1,225 @@
+package p2p
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoreds"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+const (
+	defaultPeerstoreRetention   = 7 * 24 * time.Hour
+	defaultPeerstoreRedialCount = 8
+	peerstoreSweepInterval      = 10 * time.Minute
+	peerstoreRedialTimeout      = 10 * time.Second
+	peerstoreLastSeenKey        = "quil/lastSeen"
+	peerstoreLatencyKey         = "quil/latency"
+)
+
+// newPersistentPeerstore builds a datastore-backed peerstore over the node's
+// database, so that addresses, keys and protocols of peers survive restarts.
+// Addresses are purged by the peerstore's own garbage collector once their TTL
+// lapses, the retention period is applied to addresses of peers this node has
+// actually been connected to.
+func newPersistentPeerstore(
+	ctx context.Context,
+	datastore store.Peerstore,
+	retention time.Duration,
+) (peerstore.Peerstore, error) {
+	opts := pstoreds.DefaultOpts()
+	opts.GCPurgeInterval = peerstoreSweepInterval
+	if retention < opts.GCPurgeInterval {
+		opts.GCPurgeInterval = retention
+	}
+
+	ps, err := pstoreds.NewPeerstore(ctx, datastore, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "new persistent peerstore")
+	}
+
+	restorePeerLatencies(ps)
+
+	return ps, nil
+}
+
+// restorePeerLatencies seeds the in-memory latency metrics from the values
+// persisted during the previous run, latency is otherwise lost on restart.
+func restorePeerLatencies(ps peerstore.Peerstore) {
+	for _, p := range ps.PeersWithAddrs() {
+		v, err := ps.Get(p, peerstoreLatencyKey)
+		if err != nil {
+			continue
+		}
+
+		if latency, ok := v.(int64); ok && latency > 0 {
+			ps.RecordLatency(p, time.Duration(latency))
+		}
+	}
+}
+
+// maintainPeerstore periodically extends the address TTL of connected peers to
+// the retention period, persists their latency and last seen time, and drops
+// peers that have not been seen within the retention period. Addresses of
+// disconnected peers are set to the retention period, as identify would
+// otherwise lower them to the recently connected TTL.
+func maintainPeerstore(
+	ctx context.Context,
+	logger *zap.Logger,
+	h host.Host,
+	clock clock.Clock,
+	retention time.Duration,
+) {
+	ps := h.Peerstore()
+	record := func(p peer.ID) {
+		ps.AddAddrs(p, ps.Addrs(p), retention)
+		if err := ps.Put(
+			p,
+			peerstoreLastSeenKey,
+			clock.Now().UnixMilli(),
+		); err != nil {
+			logger.Debug("could not record last seen", zap.Error(err))
+		}
+		if latency := ps.LatencyEWMA(p); latency > 0 {
+			if err := ps.Put(
+				p,
+				peerstoreLatencyKey,
+				int64(latency),
+			); err != nil {
+				logger.Debug("could not record latency", zap.Error(err))
+			}
+		}
+	}
+
+	h.Network().Notify(&network.NotifyBundle{
+		DisconnectedF: func(n network.Network, c network.Conn) {
+			if n.Connectedness(c.RemotePeer()) != network.Connected {
+				ps.SetAddrs(c.RemotePeer(), ps.Addrs(c.RemotePeer()), retention)
+				record(c.RemotePeer())
+			}
+		},
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-clock.After(peerstoreSweepInterval):
+		}
+
+		for _, p := range h.Network().Peers() {
+			record(p)
+		}
+
+		cutoff := clock.Now().Add(-retention).UnixMilli()
+		removed := 0
+		for _, p := range ps.Peers() {
+			if p == h.ID() ||
+				h.Network().Connectedness(p) == network.Connected ||
+				h.Network().Connectedness(p) == network.Limited {
+				continue
+			}
+
+			lastSeen := int64(0)
+			if v, err := ps.Get(p, peerstoreLastSeenKey); err == nil {
+				lastSeen, _ = v.(int64)
+			}
+
+			if lastSeen < cutoff && len(ps.Addrs(p)) == 0 {
+				ps.RemovePeer(p)
+				removed++
+			}
+		}
+
+		logger.Debug(
+			"swept peerstore",
+			zap.Int("removed", removed),
+			zap.Int("remaining", len(ps.Peers())),
+		)
+	}
+}
+
+// redialKnownPeers reconnects to the lowest latency peers remembered from the
+// previous run, so the node does not depend solely on the bootstrap peers and
+// the DHT to rebuild its connections.
+func redialKnownPeers(
+	ctx context.Context,
+	logger *zap.Logger,
+	h host.Host,
+	count int,
+) {
+	ps := h.Peerstore()
+	type candidate struct {
+		id       peer.ID
+		latency  time.Duration
+		lastSeen int64
+	}
+
+	candidates := []candidate{}
+	for _, p := range ps.PeersWithAddrs() {
+		if p == h.ID() {
+			continue
+		}
+
+		v, err := ps.Get(p, peerstoreLastSeenKey)
+		if err != nil {
+			continue
+		}
+
+		lastSeen, _ := v.(int64)
+		candidates = append(candidates, candidate{
+			id:       p,
+			latency:  ps.LatencyEWMA(p),
+			lastSeen: lastSeen,
+		})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		li, lj := candidates[i].latency, candidates[j].latency
+		if (li == 0) != (lj == 0) {
+			return lj == 0
+		}
+		if li != lj {
+			return li < lj
+		}
+		return candidates[i].lastSeen > candidates[j].lastSeen
+	})
+
+	if len(candidates) > count {
+		candidates = candidates[:count]
+	}
+
+	logger.Info(
+		"redialing previously known peers",
+		zap.Int("peer_count", len(candidates)),
+	)
+
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	for _, c := range candidates {
+		c := c
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dialCtx, cancel := context.WithTimeout(ctx, peerstoreRedialTimeout)
+			defer cancel()
+
+			if err := h.Connect(dialCtx, ps.PeerInfo(c.id)); err != nil {
+				logger.Debug(
+					"could not redial known peer",
+					zap.String("peer_id", c.id.String()),
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+}
@@ -0,0 +1,128 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func TestPersistentPeerstoreSurvivesRestart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := store.NewInMemKVDB()
+	datastore, err := store.NewPeerstoreDatastore(db)
+	require.NoError(t, err)
+
+	_, pub, err := crypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/udp/8336/quic-v1")
+	require.NoError(t, err)
+
+	ps, err := newPersistentPeerstore(ctx, datastore, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, ps.AddPubKey(id, pub))
+	ps.AddAddrs(id, []ma.Multiaddr{addr}, time.Hour)
+	require.NoError(t, ps.AddProtocols(id, "/blossomsub/2.0.0"))
+	require.NoError(t, ps.Put(id, peerstoreLatencyKey, int64(25*time.Millisecond)))
+	require.NoError(t, ps.Put(id, peerstoreLastSeenKey, time.Now().UnixMilli()))
+
+	restored, err := newPersistentPeerstore(ctx, datastore, time.Hour)
+	require.NoError(t, err)
+
+	assert.Contains(t, restored.PeersWithAddrs(), id)
+	assert.Equal(t, []ma.Multiaddr{addr}, restored.Addrs(id))
+	assert.True(t, restored.PubKey(id).Equals(pub))
+	protocols, err := restored.GetProtocols(id)
+	require.NoError(t, err)
+	assert.Len(t, protocols, 1)
+	assert.Equal(t, 25*time.Millisecond, restored.LatencyEWMA(id))
+}
+
+func TestMaintainPeerstoreSweepsStalePeers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h := newTestHost(t)
+	fake := clock.NewFakeClock(time.UnixMilli(1_700_000_000_000))
+
+	addPeer := func(lastSeen time.Time) peer.ID {
+		_, pub, err := crypto.GenerateEd448Key(rand.Reader)
+		require.NoError(t, err)
+		id, err := peer.IDFromPublicKey(pub)
+		require.NoError(t, err)
+		require.NoError(t, h.Peerstore().AddPubKey(id, pub))
+		require.NoError(t, h.Peerstore().Put(
+			id,
+			peerstoreLastSeenKey,
+			lastSeen.UnixMilli(),
+		))
+		return id
+	}
+
+	stale := addPeer(fake.Now().Add(-2 * time.Hour))
+	recent := addPeer(fake.Now().Add(-30 * time.Minute))
+
+	go maintainPeerstore(ctx, zap.NewNop(), h, fake, time.Hour)
+
+	// The sweep runs once the interval has passed on the fake clock, and the
+	// loop waits on the clock again once it is done.
+	fake.BlockUntil(1)
+	assert.Contains(t, h.Peerstore().Peers(), stale)
+	fake.Advance(peerstoreSweepInterval)
+	fake.BlockUntil(1)
+
+	assert.NotContains(t, h.Peerstore().Peers(), stale)
+	assert.Contains(t, h.Peerstore().Peers(), recent)
+
+	// The recent peer has fallen out of the retention period by the next sweep,
+	// 40 minutes later.
+	fake.Advance(peerstoreSweepInterval * 4)
+	fake.BlockUntil(1)
+
+	assert.NotContains(t, h.Peerstore().Peers(), recent)
+}
+
+func TestMaintainPeerstoreRetainsDisconnectedAddrs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h := newTestHost(t)
+	other := newTestHost(t)
+	go maintainPeerstore(ctx, zap.NewNop(), h, clock.NewRealClock(), time.Hour)
+
+	require.NoError(t, h.Connect(ctx, peer.AddrInfo{
+		ID:    other.ID(),
+		Addrs: other.Addrs(),
+	}))
+
+	// Once identified, the addresses of the peer carry the connected TTL.
+	require.Eventually(t, func() bool {
+		protocols, err := h.Peerstore().GetProtocols(other.ID())
+		return err == nil && len(protocols) != 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, h.Network().ClosePeer(other.ID()))
+	require.Eventually(t, func() bool {
+		_, err := h.Peerstore().Get(other.ID(), peerstoreLastSeenKey)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Whatever identify does with the connected and recently connected TTLs,
+	// the addresses are kept for the retention period.
+	h.Peerstore().UpdateAddrs(other.ID(), peerstore.ConnectedAddrTTL, 0)
+	h.Peerstore().UpdateAddrs(other.ID(), peerstore.RecentlyConnectedAddrTTL, 0)
+	assert.NotEmpty(t, h.Peerstore().Addrs(other.ID()))
+}
@@ -0,0 +1,405 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/crypto/channel"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// The address the prekey bundles of all peers are gossiped on.
+var PreKeyDirectoryAddress = []byte("q-prekey-directory")
+
+const preKeyDirectoryPurpose = "prekeys"
+
+// How often the node refreshes and republishes its prekey bundle.
+const preKeyPublishInterval = time.Hour
+
+// How far in the future a bundle timestamp may be before it is rejected.
+const preKeyMaxClockSkew = 5 * time.Minute
+
+// How many one-time prekeys are handed out to all peers together, a direct
+// channel only limits the requests of each peer.
+const preKeyOneTimeRate = rate.Limit(1.0 / 60)
+const preKeyOneTimeBurst = 10
+
+// How many one-time prekeys are handed out to each peer, so a single peer
+// can't drain the budget of all peers.
+const preKeyOneTimePeerRate = rate.Limit(1.0 / 600)
+const preKeyOneTimePeerBurst = 2
+
+// How many gossiped bundles the directory keeps, the least recently updated
+// are dropped beyond that.
+const maxPreKeyBundles = 10000
+
+// PreKeyDirectory publishes the node's signed prekey bundle and collects the
+// bundles of other peers, so a double ratchet session can be set up with a
+// peer that is offline. Bundles fetched from the peer directly over a direct
+// channel may carry a one-time prekey, gossiped bundles never do.
+type PreKeyDirectory struct {
+	protobufs.UnimplementedPreKeyServiceServer
+	logger       *zap.Logger
+	pubSub       PubSub
+	manager      *channel.PreKeyManager
+	preKeyStore  store.PreKeyStore
+	bundles      *lru.Cache[string, struct{}]
+	oneTime      *rate.Limiter
+	oneTimePeers map[peer.ID]*rate.Limiter
+	oneTimeMx    sync.Mutex
+	bitmask      []byte
+	subscription *Subscription
+	clock        clock.Clock
+	cancel       context.CancelFunc
+	mx           sync.Mutex
+}
+
+func NewPreKeyDirectory(
+	logger *zap.Logger,
+	pubSub PubSub,
+	keyManager keys.KeyManager,
+	preKeyStore store.PreKeyStore,
+	clock clock.Clock,
+) *PreKeyDirectory {
+	return &PreKeyDirectory{
+		logger:       logger,
+		pubSub:       pubSub,
+		manager:      channel.NewPreKeyManager(keyManager, preKeyStore),
+		preKeyStore:  preKeyStore,
+		bundles:      newPreKeyBundleCache(logger, preKeyStore, maxPreKeyBundles),
+		oneTime:      rate.NewLimiter(preKeyOneTimeRate, preKeyOneTimeBurst),
+		oneTimePeers: make(map[peer.ID]*rate.Limiter),
+		bitmask:      GetBloomFilter(PreKeyDirectoryAddress, 256, 3),
+		clock:        clock,
+	}
+}
+
+// newPreKeyBundleCache tracks the peers whose bundles are held, dropping the
+// bundle of the least recently updated peer from the store beyond the size.
+func newPreKeyBundleCache(
+	logger *zap.Logger,
+	preKeyStore store.PreKeyStore,
+	size int,
+) *lru.Cache[string, struct{}] {
+	bundles, err := lru.NewWithEvict(
+		size,
+		func(peerId string, _ struct{}) {
+			if err := preKeyStore.DeletePreKeyBundle([]byte(peerId)); err != nil {
+				logger.Error("could not drop prekey bundle", zap.Error(err))
+			}
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return bundles
+}
+
+// Manager returns the manager of the node's own prekeys.
+func (d *PreKeyDirectory) Manager() *channel.PreKeyManager {
+	return d.manager
+}
+
+func (d *PreKeyDirectory) Start() error {
+	if err := d.manager.Refresh(); err != nil {
+		return errors.Wrap(err, "start")
+	}
+
+	peerIds, err := d.preKeyStore.GetPreKeyBundlePeers()
+	if err != nil {
+		return errors.Wrap(err, "start")
+	}
+
+	for _, peerId := range peerIds {
+		d.bundles.Add(string(peerId), struct{}{})
+	}
+
+	subscription, err := d.pubSub.Subscribe(d.bitmask, d.handleBundle)
+	if err != nil {
+		return errors.Wrap(err, "start")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d.mx.Lock()
+	d.subscription = subscription
+	d.cancel = cancel
+	d.mx.Unlock()
+
+	go func() {
+		if err := d.pubSub.StartDirectChannelListener(
+			d.pubSub.GetPeerID(),
+			preKeyDirectoryPurpose,
+			DirectChannelPolicy{
+				Access:                DirectChannelAllowAnyone,
+				RequestsPerSecond:     1,
+				RequestBurst:          5,
+				MaxConcurrentRequests: 2,
+			},
+			func(server *grpc.Server) {
+				protobufs.RegisterPreKeyServiceServer(server, d)
+			},
+		); err != nil {
+			panic(err)
+		}
+	}()
+
+	go d.runPublisher(ctx)
+
+	return nil
+}
+
+func (d *PreKeyDirectory) Stop() {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	if d.cancel != nil {
+		d.cancel()
+		d.cancel = nil
+	}
+
+	if d.subscription != nil {
+		d.subscription.Cancel()
+		d.subscription = nil
+	}
+}
+
+func (d *PreKeyDirectory) runPublisher(ctx context.Context) {
+	ticker := d.clock.NewTicker(preKeyPublishInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.publish(); err != nil {
+			d.logger.Error("could not publish prekey bundle", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			if err := d.manager.Refresh(); err != nil {
+				d.logger.Error("could not refresh prekeys", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (d *PreKeyDirectory) publish() error {
+	bundle, err := d.signedBundle(false)
+	if err != nil {
+		return errors.Wrap(err, "publish")
+	}
+
+	data, err := proto.Marshal(bundle)
+	if err != nil {
+		return errors.Wrap(err, "publish")
+	}
+
+	return errors.Wrap(d.pubSub.Publish(PreKeyDirectoryAddress, data), "publish")
+}
+
+func (d *PreKeyDirectory) signedBundle(
+	issueOneTimePreKey bool,
+) (*protobufs.PreKeyBundle, error) {
+	bundle, err := d.manager.Bundle(issueOneTimePreKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "signed bundle")
+	}
+
+	sig, err := d.pubSub.SignMessage(bundle.SignatureMessage())
+	if err != nil {
+		return nil, errors.Wrap(err, "signed bundle")
+	}
+
+	bundle.Signature = &protobufs.Ed448Signature{
+		PublicKey: &protobufs.Ed448PublicKey{
+			KeyValue: d.pubSub.GetPublicKey(),
+		},
+		Signature: sig,
+	}
+
+	return bundle, nil
+}
+
+// GetPreKeyBundle implements protobufs.PreKeyServiceServer. A call hands out
+// one of the node's one-time prekeys while any remain and the rates of the
+// calling peer and of all peers allow, otherwise the bundle has none.
+func (d *PreKeyDirectory) GetPreKeyBundle(
+	ctx context.Context,
+	req *protobufs.GetPreKeyBundleRequest,
+) (*protobufs.PreKeyBundle, error) {
+	bundle, err := d.signedBundle(d.allowOneTime(ctx))
+	return bundle, errors.Wrap(err, "get prekey bundle")
+}
+
+// allowOneTime reports whether a one-time prekey may be handed out to the peer
+// of the direct channel call. The budget of the peer is only spent if the
+// budget of all peers allows it too.
+func (d *PreKeyDirectory) allowOneTime(ctx context.Context) bool {
+	id, err := DirectChannelPeerID(ctx)
+	if err != nil {
+		return false
+	}
+
+	d.oneTimeMx.Lock()
+	defer d.oneTimeMx.Unlock()
+
+	now := d.clock.Now()
+	limiter, ok := d.oneTimePeers[id]
+	if !ok {
+		// Peers with a full budget are indistinguishable from new peers.
+		if len(d.oneTimePeers) >= maxTrackedDirectChannelPeers {
+			for id, l := range d.oneTimePeers {
+				if l.TokensAt(now) >= float64(l.Burst()) {
+					delete(d.oneTimePeers, id)
+				}
+			}
+		}
+
+		limiter = rate.NewLimiter(preKeyOneTimePeerRate, preKeyOneTimePeerBurst)
+		d.oneTimePeers[id] = limiter
+	}
+
+	if limiter.TokensAt(now) < 1 || !d.oneTime.AllowN(now, 1) {
+		return false
+	}
+
+	return limiter.AllowN(now, 1)
+}
+
+// FetchPreKeyBundle returns a verified prekey bundle of the peer. It asks the
+// peer directly first, for a bundle with a one-time prekey, and falls back to
+// the last bundle the peer gossiped if it can't be reached.
+func (d *PreKeyDirectory) FetchPreKeyBundle(
+	ctx context.Context,
+	peerId []byte,
+) (*protobufs.PreKeyBundle, error) {
+	bundle, err := d.fetchDirect(ctx, peerId)
+	if err == nil {
+		return bundle, nil
+	}
+
+	d.logger.Debug(
+		"could not fetch prekey bundle from peer, using directory",
+		zap.String("peer_id", peer.ID(peerId).String()),
+		zap.Error(err),
+	)
+
+	bundle, err = d.preKeyStore.GetPreKeyBundle(peerId)
+	return bundle, errors.Wrap(err, "fetch prekey bundle")
+}
+
+func (d *PreKeyDirectory) fetchDirect(
+	ctx context.Context,
+	peerId []byte,
+) (*protobufs.PreKeyBundle, error) {
+	cc, err := d.pubSub.GetDirectChannel(peerId, preKeyDirectoryPurpose)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch direct")
+	}
+	defer cc.Close()
+
+	bundle, err := protobufs.NewPreKeyServiceClient(cc).GetPreKeyBundle(
+		ctx,
+		&protobufs.GetPreKeyBundleRequest{},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch direct")
+	}
+
+	signer, err := bundleSigner(bundle)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch direct")
+	}
+
+	if !bytes.Equal(signer, peerId) {
+		return nil, errors.Wrap(
+			errors.New("bundle not signed by peer"),
+			"fetch direct",
+		)
+	}
+
+	return bundle, nil
+}
+
+func (d *PreKeyDirectory) handleBundle(message *pb.Message) error {
+	bundle := &protobufs.PreKeyBundle{}
+	if err := proto.Unmarshal(message.Data, bundle); err != nil {
+		return errors.Wrap(err, "handle bundle")
+	}
+
+	peerId, err := bundleSigner(bundle)
+	if err != nil {
+		return errors.Wrap(err, "handle bundle")
+	}
+
+	if bytes.Equal(peerId, d.pubSub.GetPeerID()) {
+		return nil
+	}
+
+	// One-time prekeys are only handed out by the peer itself, a gossiped one
+	// would be used by everyone.
+	if bundle.OneTimePreKey != nil {
+		return errors.Wrap(
+			errors.New("gossiped bundle carries one-time prekey"),
+			"handle bundle",
+		)
+	}
+
+	if time.UnixMilli(bundle.Timestamp).After(
+		d.clock.Now().Add(preKeyMaxClockSkew),
+	) {
+		return errors.Wrap(errors.New("bundle from the future"), "handle bundle")
+	}
+
+	existing, err := d.preKeyStore.GetPreKeyBundle(peerId)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return errors.Wrap(err, "handle bundle")
+	}
+
+	if existing != nil && existing.Timestamp >= bundle.Timestamp {
+		return nil
+	}
+
+	if err := d.preKeyStore.PutPreKeyBundle(peerId, bundle); err != nil {
+		return errors.Wrap(err, "handle bundle")
+	}
+
+	d.bundles.Add(string(peerId), struct{}{})
+	return nil
+}
+
+// bundleSigner verifies the bundle and returns the peer ID of its signer.
+func bundleSigner(bundle *protobufs.PreKeyBundle) ([]byte, error) {
+	if err := bundle.Verify(); err != nil {
+		return nil, errors.Wrap(err, "bundle signer")
+	}
+
+	pub, err := pcrypto.UnmarshalEd448PublicKey(
+		bundle.Signature.PublicKey.KeyValue,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "bundle signer")
+	}
+
+	peerId, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		return nil, errors.Wrap(err, "bundle signer")
+	}
+
+	return []byte(peerId), nil
+}
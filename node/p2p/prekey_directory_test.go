@@ -0,0 +1,127 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	grpcpeer "google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func newTestPreKeyDirectory(
+	t *testing.T,
+	clock clock.Clock,
+) (*PreKeyDirectory, peer.ID) {
+	signKey, _, err := pcrypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	peerId, err := peer.IDFromPrivateKey(signKey)
+	require.NoError(t, err)
+
+	keyManager := keys.NewInMemoryKeyManager()
+	d := NewPreKeyDirectory(
+		zap.NewNop(),
+		&BlossomSub{logger: zap.NewNop(), signKey: signKey, peerID: peerId},
+		keyManager,
+		store.NewPebblePreKeyStore(store.NewInMemKVDB(), keyManager, zap.NewNop()),
+		clock,
+	)
+	require.NoError(t, d.Manager().Refresh())
+
+	return d, peerId
+}
+
+// callFrom returns the context of a direct channel call from the peer.
+func callFrom(id peer.ID) context.Context {
+	return grpcpeer.NewContext(
+		context.Background(),
+		&grpcpeer.Peer{AuthInfo: DirectChannelAuthInfo{PeerID: id}},
+	)
+}
+
+func TestPreKeyDirectoryLimitsOneTimePreKeys(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Unix(1700000000, 0))
+	d, peerId := newTestPreKeyDirectory(t, fakeClock)
+
+	getBundle := func(ctx context.Context) *protobufs.PreKeyBundle {
+		bundle, err := d.GetPreKeyBundle(ctx, &protobufs.GetPreKeyBundleRequest{})
+		require.NoError(t, err)
+		signer, err := bundleSigner(bundle)
+		require.NoError(t, err)
+		require.Equal(t, []byte(peerId), signer)
+		return bundle
+	}
+
+	// Calls outside a direct channel can't be attributed to a peer.
+	require.Nil(t, getBundle(context.Background()).OneTimePreKey)
+
+	// Each peer gets its own burst, then bundles come without a one-time
+	// prekey, still signed by the node.
+	peers := []peer.ID{}
+	for i := 0; i < preKeyOneTimeBurst/preKeyOneTimePeerBurst; i++ {
+		peers = append(peers, peer.ID([]byte{byte(i)}))
+	}
+	for _, id := range peers {
+		for i := 0; i < preKeyOneTimePeerBurst; i++ {
+			require.NotNil(t, getBundle(callFrom(id)).OneTimePreKey)
+		}
+		require.Nil(t, getBundle(callFrom(id)).OneTimePreKey)
+	}
+
+	// The burst of all peers together is spent, so a new peer gets none, and
+	// its own budget is left intact.
+	newcomer := peer.ID([]byte{0xff})
+	require.Nil(t, getBundle(callFrom(newcomer)).OneTimePreKey)
+
+	fakeClock.Advance(time.Duration(float64(time.Second) / float64(
+		preKeyOneTimeRate,
+	)))
+	require.NotNil(t, getBundle(callFrom(newcomer)).OneTimePreKey)
+	require.Nil(t, getBundle(callFrom(peers[0])).OneTimePreKey)
+}
+
+func TestPreKeyDirectoryDropsLeastRecentBundles(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	d, _ := newTestPreKeyDirectory(t, fakeClock)
+	d.bundles = newPreKeyBundleCache(zap.NewNop(), d.preKeyStore, 2)
+
+	others := []peer.ID{}
+	publish := func() {
+		other, _ := newTestPreKeyDirectory(t, fakeClock)
+		bundle, err := other.signedBundle(false)
+		require.NoError(t, err)
+		data, err := proto.Marshal(bundle)
+		require.NoError(t, err)
+		require.NoError(t, d.handleBundle(&pb.Message{Data: data}))
+		others = append(others, other.pubSub.(*BlossomSub).peerID)
+	}
+	for i := 0; i < 3; i++ {
+		publish()
+	}
+
+	_, err := d.preKeyStore.GetPreKeyBundle([]byte(others[0]))
+	require.ErrorIs(t, err, store.ErrNotFound)
+	for _, id := range others[1:] {
+		_, err := d.preKeyStore.GetPreKeyBundle([]byte(id))
+		require.NoError(t, err)
+	}
+
+	// The bundles held are tracked again on restart.
+	peerIds, err := d.preKeyStore.GetPreKeyBundlePeers()
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t,
+		[][]byte{[]byte(others[1]), []byte(others[2])},
+		peerIds,
+	)
+}
@@ -0,0 +1,147 @@
+package p2p
+
+import (
+	"encoding/hex"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/protobuf/encoding/protowire"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var (
+	outboundQueued = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "blossomsub",
+			Name:      "outbound_rpcs_queued_total",
+			Help:      "Number of RPCs queued to peers, by lane.",
+		},
+		[]string{"priority"},
+	)
+	outboundDropped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "blossomsub",
+			Name:      "outbound_rpcs_dropped_total",
+			Help:      "Number of RPCs dropped because the peer's lane was full.",
+		},
+		[]string{"priority"},
+	)
+	outboundQueueDepth = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "blossomsub",
+			Name:      "outbound_queue_depth",
+			Help:      "Depth of the peer's lane after queueing an RPC.",
+			Buckets:   []float64{1, 2, 4, 8, 16, 32, 64, 128, 256},
+		},
+		[]string{"priority"},
+	)
+)
+
+// queueMetrics exports the depth and drops of the per-peer outbound queues.
+type queueMetrics struct{}
+
+var _ blossomsub.QueueTracer = queueMetrics{}
+
+func (queueMetrics) QueueRPC(
+	_ *blossomsub.RPC,
+	_ peer.ID,
+	priority blossomsub.Priority,
+	depth int,
+) {
+	outboundQueued.WithLabelValues(priority.String()).Inc()
+	outboundQueueDepth.WithLabelValues(priority.String()).Observe(float64(depth))
+}
+
+func (queueMetrics) OverflowRPC(
+	_ *blossomsub.RPC,
+	_ peer.ID,
+	priority blossomsub.Priority,
+) {
+	outboundDropped.WithLabelValues(priority.String()).Inc()
+}
+
+// priorityOptions returns the blossomsub options scheduling outbound RPCs.
+// Clock frames share their bitmask with token requests, so they are raised to
+// the high priority lane individually, which keeps frame propagation fast when
+// the bitmask is flooded with requests.
+func priorityOptions(p2pConfig *config.P2PConfig) (
+	[]blossomsub.Option,
+	error,
+) {
+	opts := []blossomsub.Option{
+		blossomsub.WithMessagePriority(framePriority),
+		blossomsub.WithQueueTracer(queueMetrics{}),
+	}
+
+	if p2pConfig.PeerOutboundQueueSize != 0 {
+		opts = append(
+			opts,
+			blossomsub.WithPeerOutboundQueueSize(p2pConfig.PeerOutboundQueueSize),
+		)
+	}
+
+	for _, bitmask := range p2pConfig.HighPriorityBitmasks {
+		b, err := hex.DecodeString(bitmask)
+		if err != nil {
+			return nil, errors.Wrap(err, "priority options")
+		}
+
+		opts = append(
+			opts,
+			blossomsub.WithBitmaskPriority(b, blossomsub.PriorityHigh),
+		)
+	}
+
+	return opts, nil
+}
+
+// framePriority returns the high priority for messages carrying clock frames
+// or frame rebroadcasts. It is called for every message sent to every peer,
+// so it only reads the type URL out of the message envelope rather than
+// unmarshaling it.
+func framePriority(msg *pb.Message) blossomsub.Priority {
+	payload := protoBytesField(msg.GetData(), 3)
+	if payload == nil {
+		return blossomsub.PriorityNormal
+	}
+
+	switch string(protoBytesField(payload, 1)) {
+	case protobufs.ClockFrameType, protobufs.FrameRebroadcastType:
+		return blossomsub.PriorityHigh
+	default:
+		return blossomsub.PriorityNormal
+	}
+}
+
+// protoBytesField returns the first occurrence of the length delimited field
+// with the given number, or nil if it is absent or the data is malformed.
+func protoBytesField(data []byte, field protowire.Number) []byte {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil
+		}
+		data = data[n:]
+
+		if num == field && typ == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return nil
+			}
+			return value
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, data)
+		if n < 0 {
+			return nil
+		}
+		data = data[n:]
+	}
+
+	return nil
+}
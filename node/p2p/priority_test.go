@@ -0,0 +1,75 @@
+package p2p
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+func wrapMessage(t *testing.T, message proto.Message) *pb.Message {
+	any := &anypb.Any{}
+	require.NoError(t, any.MarshalFrom(message))
+	any.TypeUrl = strings.Replace(
+		any.TypeUrl,
+		"type.googleapis.com",
+		"types.quilibrium.com",
+		1,
+	)
+
+	payload, err := proto.Marshal(any)
+	require.NoError(t, err)
+
+	data, err := proto.Marshal(&protobufs.Message{
+		Hash:    []byte{0x01},
+		Address: []byte{0x02},
+		Payload: payload,
+	})
+	require.NoError(t, err)
+
+	return &pb.Message{Data: data}
+}
+
+func TestFramePriority(t *testing.T) {
+	assert.Equal(
+		t,
+		blossomsub.PriorityHigh,
+		framePriority(wrapMessage(t, &protobufs.ClockFrame{FrameNumber: 1})),
+	)
+	assert.Equal(
+		t,
+		blossomsub.PriorityHigh,
+		framePriority(wrapMessage(t, &protobufs.FrameRebroadcast{From: 1})),
+	)
+	assert.Equal(
+		t,
+		blossomsub.PriorityNormal,
+		framePriority(wrapMessage(t, &protobufs.TokenRequest{})),
+	)
+	assert.Equal(
+		t,
+		blossomsub.PriorityNormal,
+		framePriority(&pb.Message{Data: []byte{0xff, 0xff, 0xff}}),
+	)
+}
+
+func TestPriorityOptions(t *testing.T) {
+	_, err := priorityOptions(&config.P2PConfig{
+		HighPriorityBitmasks: []string{"not hex"},
+	})
+	assert.Error(t, err)
+
+	opts, err := priorityOptions(&config.P2PConfig{
+		HighPriorityBitmasks:  []string{"0001", "0002"},
+		PeerOutboundQueueSize: 64,
+	})
+	require.NoError(t, err)
+	assert.Len(t, opts, 5)
+}
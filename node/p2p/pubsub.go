@@ -6,14 +6,37 @@ import (
 	"github.com/multiformats/go-multiaddr"
 	"google.golang.org/grpc"
 	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 )
 
+// ValidationResult is the outcome of a gossip-layer validator. Accepted
+// messages are delivered and relayed, ignored messages are dropped without
+// penalty, and rejected messages are dropped and count against the peer that
+// relayed them.
+type ValidationResult int
+
+const (
+	ValidationResultAccept ValidationResult = iota
+	ValidationResultReject
+	ValidationResultIgnore
+)
+
 type PubSub interface {
 	PublishToBitmask(bitmask []byte, data []byte) error
 	Publish(address []byte, data []byte) error
-	Subscribe(bitmask []byte, handler func(message *pb.Message) error) error
-	Unsubscribe(bitmask []byte, raw bool)
+	Subscribe(
+		bitmask []byte,
+		handler func(message *pb.Message) error,
+		opts ...SubscribeOption,
+	) (*Subscription, error)
+	Unsubscribe(bitmask []byte)
+	RegisterValidator(
+		bitmask []byte,
+		validator func(peerID []byte, message *pb.Message) ValidationResult,
+		sync bool,
+	) error
+	UnregisterValidator(bitmask []byte) error
 	GetPeerID() []byte
 	GetBitmaskPeers() map[string][]string
 	GetPeerstoreCount() int
@@ -27,14 +50,17 @@ type PubSub interface {
 	StartDirectChannelListener(
 		key []byte,
 		purpose string,
-		server *grpc.Server,
+		policy DirectChannelPolicy,
+		register func(server *grpc.Server),
 	) error
 	GetDirectChannel(peerId []byte, purpose string) (*grpc.ClientConn, error)
 	GetNetworkInfo() *protobufs.NetworkInfoResponse
+	GetPeerScores() *protobufs.PeerScoresResponse
 	SignMessage(msg []byte) ([]byte, error)
 	GetPublicKey() []byte
 	GetPeerScore(peerId []byte) int64
 	SetPeerScore(peerId []byte, score int64)
 	AddPeerScore(peerId []byte, scoreDelta int64)
 	Reconnect(peerId []byte) error
+	ReloadAllowlist(p2pConfig *config.P2PConfig) error
 }
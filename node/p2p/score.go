@@ -0,0 +1,263 @@
+package p2p
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// scoreInspectPeriod is how often the live peer score breakdowns served by
+// GetPeerScores are refreshed.
+const scoreInspectPeriod = 10 * time.Second
+
+// masterFrameBitmask carries the master clock frames, and tokenBitmask the
+// data frames and token requests: it is the bloom filter of the token
+// intrinsic address, GetBloomFilter(TOKEN_ADDRESS, 256, 3).
+var (
+	masterFrameBitmask = make([]byte, 32)
+	tokenBitmask       = []byte{
+		0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00,
+	}
+)
+
+// defaultBitmaskScores are the bitmasks scored unless configured otherwise.
+// Peers earn a capped score for staying in the mesh and for being first to
+// deliver messages, and lose score quadratically for invalid messages, a few
+// of which bring a peer below the gossip threshold.
+var defaultBitmaskScores = []*config.BitmaskScoreConfig{
+	{
+		Bitmask:                        hex.EncodeToString(masterFrameBitmask),
+		BitmaskWeight:                  1,
+		TimeInMeshWeight:               .01,
+		TimeInMeshQuantum:              time.Second,
+		TimeInMeshCap:                  100,
+		FirstMessageDeliveriesWeight:   1,
+		FirstMessageDeliveriesDecay:    .9,
+		FirstMessageDeliveriesCap:      20,
+		InvalidMessageDeliveriesWeight: -100,
+		InvalidMessageDeliveriesDecay:  .9,
+	},
+	{
+		Bitmask:                        hex.EncodeToString(tokenBitmask),
+		BitmaskWeight:                  1,
+		TimeInMeshWeight:               .01,
+		TimeInMeshQuantum:              time.Second,
+		TimeInMeshCap:                  100,
+		FirstMessageDeliveriesWeight:   .1,
+		FirstMessageDeliveriesDecay:    .9,
+		FirstMessageDeliveriesCap:      100,
+		InvalidMessageDeliveriesWeight: -100,
+		InvalidMessageDeliveriesDecay:  .9,
+	},
+}
+
+// scoreParams builds the peer score parameters and thresholds from the score
+// section of the config, falling back to the defaults for unset values, and
+// validates them the same way WithPeerScore does so a bad config is reported
+// against the bitmask it came from.
+//
+// Message deliveries are scored on the bitmask messages are published to, and
+// time in mesh on each of its slices, so the parameters of a bitmask apply to
+// both. Configured bitmasks replace the defaults of the bitmasks and slices
+// they cover.
+func scoreParams(
+	scoreConfig *config.ScoreConfig,
+	appSpecificScore func(p peer.ID) float64,
+) (*blossomsub.PeerScoreParams, *blossomsub.PeerScoreThresholds, error) {
+	if scoreConfig == nil {
+		scoreConfig = &config.ScoreConfig{}
+	}
+
+	params := &blossomsub.PeerScoreParams{
+		SkipAtomicValidation: false,
+		Bitmasks:             map[string]*blossomsub.BitmaskScoreParams{},
+		BitmaskScoreCap:      valueOr(scoreConfig.BitmaskScoreCap, 0),
+		IPColocationFactorWeight: valueOr(
+			scoreConfig.IPColocationFactorWeight,
+			0,
+		),
+		IPColocationFactorThreshold: valueOr(
+			scoreConfig.IPColocationFactorThreshold,
+			6,
+		),
+		BehaviourPenaltyWeight: valueOr(scoreConfig.BehaviourPenaltyWeight, 0),
+		BehaviourPenaltyThreshold: valueOr(
+			scoreConfig.BehaviourPenaltyThreshold,
+			100,
+		),
+		BehaviourPenaltyDecay: valueOr(scoreConfig.BehaviourPenaltyDecay, .5),
+		DecayInterval:         valueOr(scoreConfig.DecayInterval, 10*time.Second),
+		DecayToZero:           valueOr(scoreConfig.DecayToZero, .1),
+		RetainScore:           valueOr(scoreConfig.RetainScore, 60*time.Minute),
+		AppSpecificScore:      appSpecificScore,
+		AppSpecificWeight:     valueOr(scoreConfig.AppSpecificWeight, 10.0),
+	}
+
+	thresholds := &blossomsub.PeerScoreThresholds{
+		SkipAtomicValidation: false,
+		GossipThreshold:      valueOr(scoreConfig.GossipThreshold, -2000),
+		PublishThreshold:     valueOr(scoreConfig.PublishThreshold, -5000),
+		GraylistThreshold:    valueOr(scoreConfig.GraylistThreshold, -10000),
+		AcceptPXThreshold:    valueOr(scoreConfig.AcceptPXThreshold, 1),
+		OpportunisticGraftThreshold: valueOr(
+			scoreConfig.OpportunisticGraftThreshold,
+			2,
+		),
+	}
+
+	configured := map[string]struct{}{}
+	for _, b := range scoreConfig.Bitmasks {
+		keys, bitmaskParams, err := bitmaskScoreParams(b)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "score params")
+		}
+
+		for _, key := range keys {
+			if _, ok := params.Bitmasks[key]; ok {
+				return nil, nil, errors.Wrap(
+					errors.Errorf("bitmask %s duplicated or overlapping", b.Bitmask),
+					"score params",
+				)
+			}
+
+			params.Bitmasks[key] = bitmaskParams
+			configured[key] = struct{}{}
+		}
+	}
+
+	for _, b := range defaultBitmaskScores {
+		keys, bitmaskParams, err := bitmaskScoreParams(b)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "score params")
+		}
+
+		for _, key := range keys {
+			if _, ok := configured[key]; !ok {
+				params.Bitmasks[key] = bitmaskParams
+			}
+		}
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "score params")
+	}
+
+	if err := thresholds.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "score params")
+	}
+
+	return params, thresholds, nil
+}
+
+// bitmaskScoreParams builds and validates the score parameters of a bitmask,
+// returning them along with the bitmask and its slices they apply to.
+func bitmaskScoreParams(
+	b *config.BitmaskScoreConfig,
+) ([]string, *blossomsub.BitmaskScoreParams, error) {
+	bitmask, err := hex.DecodeString(b.Bitmask)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "bitmask score params")
+	}
+
+	bitmaskParams := &blossomsub.BitmaskScoreParams{
+		SkipAtomicValidation:            true,
+		BitmaskWeight:                   b.BitmaskWeight,
+		TimeInMeshWeight:                b.TimeInMeshWeight,
+		TimeInMeshQuantum:               b.TimeInMeshQuantum,
+		TimeInMeshCap:                   b.TimeInMeshCap,
+		FirstMessageDeliveriesWeight:    b.FirstMessageDeliveriesWeight,
+		FirstMessageDeliveriesDecay:     b.FirstMessageDeliveriesDecay,
+		FirstMessageDeliveriesCap:       b.FirstMessageDeliveriesCap,
+		MeshMessageDeliveriesWeight:     b.MeshMessageDeliveriesWeight,
+		MeshMessageDeliveriesDecay:      b.MeshMessageDeliveriesDecay,
+		MeshMessageDeliveriesCap:        b.MeshMessageDeliveriesCap,
+		MeshMessageDeliveriesThreshold:  b.MeshMessageDeliveriesThreshold,
+		MeshMessageDeliveriesWindow:     b.MeshMessageDeliveriesWindow,
+		MeshMessageDeliveriesActivation: b.MeshMessageDeliveriesActivation,
+		MeshFailurePenaltyWeight:        b.MeshFailurePenaltyWeight,
+		MeshFailurePenaltyDecay:         b.MeshFailurePenaltyDecay,
+		InvalidMessageDeliveriesWeight:  b.InvalidMessageDeliveriesWeight,
+		InvalidMessageDeliveriesDecay:   b.InvalidMessageDeliveriesDecay,
+	}
+	if err := bitmaskParams.Validate(); err != nil {
+		return nil, nil, errors.Wrapf(err, "bitmask %s", b.Bitmask)
+	}
+
+	keys := []string{string(bitmask)}
+	for _, slice := range blossomsub.SliceBitmask(bitmask) {
+		if !bytes.Equal(slice, bitmask) {
+			keys = append(keys, string(slice))
+		}
+	}
+
+	return keys, bitmaskParams, nil
+}
+
+// valueOr returns the value set in the config, or the default if unset.
+func valueOr[T any](value *T, def T) T {
+	if value == nil {
+		return def
+	}
+
+	return *value
+}
+
+// inspectPeerScores is called by the router with the score breakdowns of all
+// known peers every scoreInspectPeriod.
+func (b *BlossomSub) inspectPeerScores(
+	snapshots map[peer.ID]*blossomsub.PeerScoreSnapshot,
+) {
+	b.peerScoreMx.Lock()
+	b.scoreSnapshots = snapshots
+	b.peerScoreMx.Unlock()
+}
+
+// GetPeerScores returns the most recent score breakdown of each peer, sorted
+// by descending score.
+func (b *BlossomSub) GetPeerScores() *protobufs.PeerScoresResponse {
+	b.peerScoreMx.Lock()
+	snapshots := b.scoreSnapshots
+	b.peerScoreMx.Unlock()
+
+	resp := &protobufs.PeerScoresResponse{}
+	for p, snapshot := range snapshots {
+		score := &protobufs.PeerScore{
+			PeerId:             []byte(p),
+			Score:              snapshot.Score,
+			AppSpecificScore:   snapshot.AppSpecificScore,
+			IpColocationFactor: snapshot.IPColocationFactor,
+			BehaviourPenalty:   snapshot.BehaviourPenalty,
+		}
+
+		for bitmask, bitmaskSnapshot := range snapshot.Bitmasks {
+			score.BitmaskScores = append(score.BitmaskScores, &protobufs.BitmaskScore{
+				Bitmask:                  []byte(bitmask),
+				TimeInMeshMs:             bitmaskSnapshot.TimeInMesh.Milliseconds(),
+				FirstMessageDeliveries:   bitmaskSnapshot.FirstMessageDeliveries,
+				MeshMessageDeliveries:    bitmaskSnapshot.MeshMessageDeliveries,
+				InvalidMessageDeliveries: bitmaskSnapshot.InvalidMessageDeliveries,
+			})
+		}
+		sort.Slice(score.BitmaskScores, func(i, j int) bool {
+			return string(score.BitmaskScores[i].Bitmask) <
+				string(score.BitmaskScores[j].Bitmask)
+		})
+
+		resp.PeerScores = append(resp.PeerScores, score)
+	}
+	sort.Slice(resp.PeerScores, func(i, j int) bool {
+		return resp.PeerScores[i].Score > resp.PeerScores[j].Score
+	})
+
+	return resp
+}
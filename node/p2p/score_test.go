@@ -0,0 +1,199 @@
+package p2p
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+func appScore(peer.ID) float64 { return 0 }
+
+func float(f float64) *float64 { return &f }
+
+func TestScoreParamsDefaults(t *testing.T) {
+	params, thresholds, err := scoreParams(nil, appScore)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, params.DecayInterval)
+	assert.Equal(t, 10.0, params.AppSpecificWeight)
+	assert.Equal(t, -2000.0, thresholds.GossipThreshold)
+	assert.Equal(t, -10000.0, thresholds.GraylistThreshold)
+
+	// The token bitmask is that of the token intrinsic address.
+	address, err := poseidon.HashBytes([]byte("q_mainnet_token"))
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		GetBloomFilter(address.FillBytes(make([]byte, 32)), 256, 3),
+		tokenBitmask,
+	)
+
+	// Both bitmasks are scored, along with the slices of the token bitmask.
+	keys := [][]byte{masterFrameBitmask, tokenBitmask}
+	keys = append(keys, blossomsub.SliceBitmask(tokenBitmask)...)
+	assert.Len(t, params.Bitmasks, 5)
+	for _, key := range keys {
+		require.Contains(t, params.Bitmasks, string(key))
+		assert.Equal(
+			t,
+			-100.0,
+			params.Bitmasks[string(key)].InvalidMessageDeliveriesWeight,
+		)
+	}
+}
+
+func TestScoreParamsZero(t *testing.T) {
+	var scoreConfig config.ScoreConfig
+	require.NoError(t, yaml.Unmarshal([]byte(`
+appSpecificWeight: 0
+gossipThreshold: 0
+publishThreshold: 0
+graylistThreshold: 0
+acceptPXThreshold: 0
+decayInterval: 5s
+`), &scoreConfig))
+
+	params, thresholds, err := scoreParams(&scoreConfig, appScore)
+	require.NoError(t, err)
+	assert.Zero(t, params.AppSpecificWeight)
+	assert.Zero(t, thresholds.GossipThreshold)
+	assert.Zero(t, thresholds.PublishThreshold)
+	assert.Zero(t, thresholds.GraylistThreshold)
+	assert.Zero(t, thresholds.AcceptPXThreshold)
+	assert.Equal(t, 5*time.Second, params.DecayInterval)
+	assert.Equal(t, 2.0, thresholds.OpportunisticGraftThreshold)
+}
+
+func TestScoreParamsOverrideDefaults(t *testing.T) {
+	params, _, err := scoreParams(&config.ScoreConfig{
+		Bitmasks: []*config.BitmaskScoreConfig{
+			{
+				Bitmask:                        hex.EncodeToString(tokenBitmask),
+				BitmaskWeight:                  2,
+				InvalidMessageDeliveriesWeight: -10,
+				InvalidMessageDeliveriesDecay:  .5,
+			},
+		},
+	}, appScore)
+	require.NoError(t, err)
+
+	for _, key := range append(
+		[][]byte{tokenBitmask},
+		blossomsub.SliceBitmask(tokenBitmask)...,
+	) {
+		require.Contains(t, params.Bitmasks, string(key))
+		bitmaskParams := params.Bitmasks[string(key)]
+		assert.Equal(t, 2.0, bitmaskParams.BitmaskWeight)
+		assert.Zero(t, bitmaskParams.FirstMessageDeliveriesWeight)
+	}
+	require.Contains(t, params.Bitmasks, string(masterFrameBitmask))
+	assert.Equal(
+		t,
+		1.0,
+		params.Bitmasks[string(masterFrameBitmask)].FirstMessageDeliveriesWeight,
+	)
+}
+
+func TestScoreParamsBitmasks(t *testing.T) {
+	params, _, err := scoreParams(&config.ScoreConfig{
+		BitmaskScoreCap:        float(100),
+		BehaviourPenaltyWeight: float(-10),
+		Bitmasks: []*config.BitmaskScoreConfig{
+			{
+				Bitmask:                        "0001",
+				BitmaskWeight:                  1,
+				FirstMessageDeliveriesWeight:   1,
+				FirstMessageDeliveriesDecay:    .9,
+				FirstMessageDeliveriesCap:      50,
+				InvalidMessageDeliveriesWeight: -100,
+				InvalidMessageDeliveriesDecay:  .5,
+			},
+		},
+	}, appScore)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, params.BitmaskScoreCap)
+	require.Contains(t, params.Bitmasks, string([]byte{0x00, 0x01}))
+	assert.Equal(
+		t,
+		50.0,
+		params.Bitmasks[string([]byte{0x00, 0x01})].FirstMessageDeliveriesCap,
+	)
+}
+
+func TestScoreParamsInvalid(t *testing.T) {
+	for name, scoreConfig := range map[string]*config.ScoreConfig{
+		"bad hex": {
+			Bitmasks: []*config.BitmaskScoreConfig{{Bitmask: "zz"}},
+		},
+		"duplicate bitmask": {
+			Bitmasks: []*config.BitmaskScoreConfig{
+				{Bitmask: "0001"},
+				{Bitmask: "0001"},
+			},
+		},
+		"positive invalid message weight": {
+			Bitmasks: []*config.BitmaskScoreConfig{
+				{
+					Bitmask:                        "0001",
+					InvalidMessageDeliveriesWeight: 1,
+					InvalidMessageDeliveriesDecay:  .5,
+				},
+			},
+		},
+		"first deliveries without cap": {
+			Bitmasks: []*config.BitmaskScoreConfig{
+				{
+					Bitmask:                      "0001",
+					FirstMessageDeliveriesWeight: 1,
+					FirstMessageDeliveriesDecay:  .5,
+				},
+			},
+		},
+		"overlapping bitmasks": {
+			Bitmasks: []*config.BitmaskScoreConfig{
+				{Bitmask: "0003"},
+				{Bitmask: "0001"},
+			},
+		},
+		"positive behaviour penalty": {BehaviourPenaltyWeight: float(1)},
+		"zero decay interval":        {DecayInterval: new(time.Duration)},
+		"publish above gossip": {
+			GossipThreshold:  float(-10),
+			PublishThreshold: float(-1),
+		},
+	} {
+		_, _, err := scoreParams(scoreConfig, appScore)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestGetPeerScores(t *testing.T) {
+	bs := &BlossomSub{}
+	bs.inspectPeerScores(map[peer.ID]*blossomsub.PeerScoreSnapshot{
+		peer.ID("low"): {Score: -5},
+		peer.ID("high"): {
+			Score: 10,
+			Bitmasks: map[string]*blossomsub.BitmaskScoreSnapshot{
+				string([]byte{0x01}): {
+					TimeInMesh:             2 * time.Second,
+					FirstMessageDeliveries: 3,
+				},
+			},
+		},
+	})
+
+	resp := bs.GetPeerScores()
+	require.Len(t, resp.PeerScores, 2)
+	assert.Equal(t, []byte("high"), resp.PeerScores[0].PeerId)
+	require.Len(t, resp.PeerScores[0].BitmaskScores, 1)
+	assert.Equal(t, int64(2000), resp.PeerScores[0].BitmaskScores[0].TimeInMeshMs)
+	assert.Equal(t, 3.0, resp.PeerScores[0].BitmaskScores[0].FirstMessageDeliveries)
+	assert.Equal(t, []byte("low"), resp.PeerScores[1].PeerId)
+}
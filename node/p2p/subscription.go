@@ -0,0 +1,345 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+)
+
+var (
+	subscriptionQueueDepth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "blossomsub",
+			Name:      "subscription_queue_depth",
+			Help:      "Number of messages waiting for a handler, by bitmask.",
+		},
+		[]string{"bitmask"},
+	)
+	subscriptionDropped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "blossomsub",
+			Name:      "subscription_messages_dropped_total",
+			Help:      "Number of messages dropped because a handler's queue was full.",
+		},
+		[]string{"bitmask", "policy"},
+	)
+)
+
+// OverflowPolicy decides what happens to a message delivered to a
+// subscription whose handler queue is full.
+type OverflowPolicy int
+
+const (
+	// OverflowDropNew drops the delivered message.
+	OverflowDropNew OverflowPolicy = iota
+	// OverflowDropOldest drops the oldest queued message to make room.
+	OverflowDropOldest
+	// OverflowBlock waits for the handler to catch up, which in turn fills the
+	// blossomsub subscription buffer, where further messages are dropped.
+	OverflowBlock
+)
+
+const defaultSubscriptionQueueSize = 1024
+
+var ErrSubscriptionClosed = errors.New("subscription closed")
+
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowDropNew:
+		return "drop-new"
+	case OverflowDropOldest:
+		return "drop-oldest"
+	case OverflowBlock:
+		return "block"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseOverflowPolicy parses the policy names used in the config, an empty
+// name is the default drop-new policy.
+func ParseOverflowPolicy(policy string) (OverflowPolicy, error) {
+	switch policy {
+	case "", "drop-new":
+		return OverflowDropNew, nil
+	case "drop-oldest":
+		return OverflowDropOldest, nil
+	case "block":
+		return OverflowBlock, nil
+	default:
+		return 0, errors.Wrap(
+			errors.Errorf("unknown overflow policy %q", policy),
+			"parse overflow policy",
+		)
+	}
+}
+
+type subscribeOptions struct {
+	queueSize      int
+	overflowPolicy OverflowPolicy
+}
+
+// SubscribeOption overrides the configured handler queue of a subscription.
+type SubscribeOption func(*subscribeOptions)
+
+// WithQueueSize sets the number of messages queued for the handler.
+func WithQueueSize(size int) SubscribeOption {
+	return func(o *subscribeOptions) {
+		if size > 0 {
+			o.queueSize = size
+		}
+	}
+}
+
+// WithOverflowPolicy sets what happens to messages when the handler queue is
+// full.
+func WithOverflowPolicy(policy OverflowPolicy) SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.overflowPolicy = policy
+	}
+}
+
+// Subscription is the handle of a handler subscribed to a bitmask. Cancelling
+// it stops the handler, and leaves the bitmask once no other subscription
+// remains on it.
+type Subscription struct {
+	bitmask []byte
+	subs    []*blossomsub.Subscription
+	queue   *handlerQueue
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+	once    sync.Once
+	onClose func(*Subscription)
+}
+
+// Bitmask returns the bitmask the subscription was made to.
+func (s *Subscription) Bitmask() []byte {
+	return s.bitmask
+}
+
+// Cancel stops the subscription. Messages still queued are discarded, a
+// message being handled is allowed to finish. It is safe to call Cancel more
+// than once, and from within the handler.
+func (s *Subscription) Cancel() {
+	if s == nil {
+		return
+	}
+
+	s.once.Do(func() {
+		if s.cancel != nil {
+			s.cancel()
+		}
+		for _, sub := range s.subs {
+			sub.Cancel()
+		}
+		if s.onClose != nil {
+			s.onClose(s)
+		}
+	})
+}
+
+// Done is closed once the handler has returned for the last time after the
+// subscription was cancelled.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// run forwards messages from the blossomsub subscriptions of each bit to the
+// handler queue, and runs the handler on the queued messages.
+func (s *Subscription) run(
+	logger *zap.Logger,
+	handler func(message *pb.Message) error,
+) {
+	wg := sync.WaitGroup{}
+	for _, sub := range s.subs {
+		sub := sub
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				m, err := sub.Next(s.ctx)
+				if err != nil || m == nil {
+					if s.ctx.Err() == nil {
+						logger.Error(
+							"subscription closed",
+							zap.Binary("bitmask", s.bitmask),
+							zap.Error(err),
+						)
+					}
+					return
+				}
+
+				if !bytes.Equal(m.Bitmask, s.bitmask) {
+					continue
+				}
+
+				s.queue.Push(s.ctx, m.Message)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		s.queue.Close()
+	}()
+
+	go func() {
+		defer close(s.done)
+		for {
+			msg, err := s.queue.Pop(s.ctx)
+			if err != nil {
+				return
+			}
+
+			if err := handler(msg); err != nil {
+				logger.Debug("message handler returned error", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// handlerQueue is the bounded queue of messages waiting for a subscription's
+// handler. It supports any number of producers and a single consumer.
+type handlerQueue struct {
+	mx       sync.Mutex
+	messages []*pb.Message
+	size     int
+	policy   OverflowPolicy
+	closed   bool
+	notify   chan struct{}
+	space    chan struct{}
+	depth    prometheus.Gauge
+	dropped  prometheus.Counter
+}
+
+func newHandlerQueue(
+	bitmask []byte,
+	size int,
+	policy OverflowPolicy,
+) *handlerQueue {
+	label := hex.EncodeToString(bitmask)
+	return &handlerQueue{
+		size:    size,
+		policy:  policy,
+		notify:  make(chan struct{}, 1),
+		space:   make(chan struct{}, 1),
+		depth:   subscriptionQueueDepth.WithLabelValues(label),
+		dropped: subscriptionDropped.WithLabelValues(label, policy.String()),
+	}
+}
+
+// Push queues the message, applying the overflow policy if the queue is full.
+// It returns false if a message was dropped.
+func (q *handlerQueue) Push(ctx context.Context, msg *pb.Message) bool {
+	for {
+		q.mx.Lock()
+		if q.closed {
+			q.mx.Unlock()
+			return false
+		}
+
+		if len(q.messages) < q.size {
+			q.messages = append(q.messages, msg)
+			q.depth.Inc()
+			q.mx.Unlock()
+			q.signal(q.notify)
+			return true
+		}
+
+		switch q.policy {
+		case OverflowDropOldest:
+			q.messages[0] = nil
+			q.messages = append(q.messages[1:], msg)
+			q.mx.Unlock()
+			q.dropped.Inc()
+			return false
+		case OverflowBlock:
+			q.mx.Unlock()
+			select {
+			case <-q.space:
+			case <-ctx.Done():
+				q.dropped.Inc()
+				return false
+			}
+		default:
+			q.mx.Unlock()
+			q.dropped.Inc()
+			return false
+		}
+	}
+}
+
+// Pop blocks until a message is available. Messages queued before the queue
+// was closed are still returned unless the context is done.
+func (q *handlerQueue) Pop(ctx context.Context) (*pb.Message, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			q.drain()
+			return nil, err
+		}
+
+		q.mx.Lock()
+		if len(q.messages) > 0 {
+			msg := q.messages[0]
+			q.messages[0] = nil
+			q.messages = q.messages[1:]
+			q.depth.Dec()
+			q.mx.Unlock()
+			q.signal(q.space)
+			return msg, nil
+		}
+		closed := q.closed
+		q.mx.Unlock()
+
+		if closed {
+			return nil, ErrSubscriptionClosed
+		}
+
+		select {
+		case <-q.notify:
+		case <-ctx.Done():
+		}
+	}
+}
+
+// Len returns the number of queued messages.
+func (q *handlerQueue) Len() int {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	return len(q.messages)
+}
+
+// Close stops the queue from accepting messages and wakes the consumer.
+func (q *handlerQueue) Close() {
+	q.mx.Lock()
+	q.closed = true
+	q.mx.Unlock()
+	q.signal(q.notify)
+}
+
+// drain discards the queued messages of a cancelled subscription.
+func (q *handlerQueue) drain() {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	q.depth.Sub(float64(len(q.messages)))
+	q.messages = nil
+	q.closed = true
+}
+
+func (q *handlerQueue) signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
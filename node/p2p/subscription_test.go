@@ -0,0 +1,165 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+)
+
+func TestParseOverflowPolicy(t *testing.T) {
+	for name, expected := range map[string]OverflowPolicy{
+		"":            OverflowDropNew,
+		"drop-new":    OverflowDropNew,
+		"drop-oldest": OverflowDropOldest,
+		"block":       OverflowBlock,
+	} {
+		policy, err := ParseOverflowPolicy(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, policy)
+	}
+
+	_, err := ParseOverflowPolicy("drop-all")
+	assert.Error(t, err)
+}
+
+func TestHandlerQueueDropNew(t *testing.T) {
+	q := newHandlerQueue([]byte{0x01}, 2, OverflowDropNew)
+	ctx := context.Background()
+	assert.True(t, q.Push(ctx, &pb.Message{Data: []byte{1}}))
+	assert.True(t, q.Push(ctx, &pb.Message{Data: []byte{2}}))
+	assert.False(t, q.Push(ctx, &pb.Message{Data: []byte{3}}))
+
+	msg, err := q.Pop(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, msg.Data)
+	msg, err = q.Pop(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{2}, msg.Data)
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestHandlerQueueDropOldest(t *testing.T) {
+	q := newHandlerQueue([]byte{0x02}, 2, OverflowDropOldest)
+	ctx := context.Background()
+	for i := byte(1); i <= 3; i++ {
+		q.Push(ctx, &pb.Message{Data: []byte{i}})
+	}
+
+	msg, err := q.Pop(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{2}, msg.Data)
+	msg, err = q.Pop(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3}, msg.Data)
+}
+
+func TestHandlerQueueBlock(t *testing.T) {
+	q := newHandlerQueue([]byte{0x03}, 1, OverflowBlock)
+	ctx := context.Background()
+	require.True(t, q.Push(ctx, &pb.Message{Data: []byte{1}}))
+
+	pushed := make(chan bool)
+	go func() {
+		pushed <- q.Push(ctx, &pb.Message{Data: []byte{2}})
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatal("expected push to block while the queue is full")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	msg, err := q.Pop(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, msg.Data)
+	assert.True(t, <-pushed)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	go func() {
+		pushed <- q.Push(cancelled, &pb.Message{Data: []byte{3}})
+	}()
+	cancel()
+	assert.False(t, <-pushed)
+}
+
+func TestSubscriptionCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	defer h.Close()
+
+	ps, err := blossomsub.NewBlossomSub(ctx, h)
+	require.NoError(t, err)
+
+	bs := &BlossomSub{
+		ps:            ps,
+		ctx:           ctx,
+		logger:        zap.NewNop(),
+		bitmaskMap:    make(map[string]*blossomsub.Bitmask),
+		subscriptions: make(map[string]map[*Subscription]struct{}),
+	}
+
+	bitmask := []byte{0x00, 0x01}
+	received := make(chan []byte, 8)
+	sub, err := bs.Subscribe(bitmask, func(message *pb.Message) error {
+		received <- message.Data
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, bs.bitmaskMap[string(bitmask)].Publish(
+		ctx,
+		bitmask,
+		[]byte("first"),
+	))
+	select {
+	case data := <-received:
+		assert.Equal(t, []byte("first"), data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected message to be handled")
+	}
+
+	sub.Cancel()
+	sub.Cancel()
+	select {
+	case <-sub.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected handler to stop")
+	}
+	assert.Empty(t, bs.subscriptions)
+
+	// the bitmask can be subscribed to again after leaving it
+	resub, err := bs.Subscribe(bitmask, func(message *pb.Message) error {
+		received <- message.Data
+		return nil
+	}, WithQueueSize(4), WithOverflowPolicy(OverflowDropOldest))
+	require.NoError(t, err)
+
+	require.NoError(t, bs.bitmaskMap[string(bitmask)].Publish(
+		ctx,
+		bitmask,
+		[]byte("second"),
+	))
+	select {
+	case data := <-received:
+		assert.Equal(t, []byte("second"), data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected message to be handled")
+	}
+
+	bs.Unsubscribe(bitmask)
+	select {
+	case <-resub.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected handler to stop")
+	}
+}
@@ -0,0 +1,440 @@
+package tracing
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-msgio"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+)
+
+// BitmaskReport summarizes message propagation and mesh churn on a bitmask.
+// Latency is measured from the earliest publish of a message to its delivery
+// on each traced node, so it is only as accurate as the nodes' clocks, and
+// only covers messages whose publisher was also traced.
+type BitmaskReport struct {
+	Bitmask    string
+	Published  int
+	Delivered  int
+	Duplicates int
+	Rejected   int
+	Grafts     int
+	Prunes     int
+	LatencyP50 time.Duration
+	LatencyP90 time.Duration
+	LatencyP99 time.Duration
+	LatencyMax time.Duration
+	// DuplicateRatio is the number of duplicate copies received per delivered
+	// message, a high ratio suggests D is larger than it needs to be.
+	DuplicateRatio float64
+	// ChurnPerMinute is the number of grafts and prunes per minute of trace.
+	ChurnPerMinute float64
+}
+
+// PeerReport summarizes the delivery latency observed on a traced node.
+type PeerReport struct {
+	PeerID     string
+	Deliveries int
+	LatencyP50 time.Duration
+	LatencyP90 time.Duration
+}
+
+// Report is the result of analyzing a set of traces.
+type Report struct {
+	Start         time.Time
+	End           time.Time
+	Events        int
+	Bitmasks      []*BitmaskReport
+	RejectReasons map[string]int
+	SlowestPeers  []*PeerReport
+}
+
+type delivery struct {
+	messageID string
+	peerID    string
+	timestamp int64
+}
+
+type bitmaskStats struct {
+	published  int
+	delivered  int
+	duplicates int
+	rejected   int
+	grafts     int
+	prunes     int
+	deliveries []delivery
+}
+
+// Analyzer accumulates trace events, events may be added in any order.
+type Analyzer struct {
+	start         int64
+	end           int64
+	events        int
+	published     map[string]int64
+	bitmasks      map[string]*bitmaskStats
+	rejectReasons map[string]int
+}
+
+func NewAnalyzer() *Analyzer {
+	return &Analyzer{
+		published:     make(map[string]int64),
+		bitmasks:      make(map[string]*bitmaskStats),
+		rejectReasons: make(map[string]int),
+	}
+}
+
+func (a *Analyzer) bitmask(bitmask []byte) *bitmaskStats {
+	key := hex.EncodeToString(bitmask)
+	stats, ok := a.bitmasks[key]
+	if !ok {
+		stats = &bitmaskStats{}
+		a.bitmasks[key] = stats
+	}
+
+	return stats
+}
+
+// Add records a single trace event.
+func (a *Analyzer) Add(evt *pb.TraceEvent) {
+	ts := evt.GetTimestamp()
+	if a.events == 0 || ts < a.start {
+		a.start = ts
+	}
+	if ts > a.end {
+		a.end = ts
+	}
+	a.events++
+
+	switch evt.GetType() {
+	case pb.TraceEvent_PUBLISH_MESSAGE:
+		m := evt.GetPublishMessage()
+		a.bitmask(m.GetBitmask()).published++
+		id := string(m.GetMessageID())
+		if first, ok := a.published[id]; !ok || ts < first {
+			a.published[id] = ts
+		}
+	case pb.TraceEvent_DELIVER_MESSAGE:
+		m := evt.GetDeliverMessage()
+		stats := a.bitmask(m.GetBitmask())
+		stats.delivered++
+		stats.deliveries = append(stats.deliveries, delivery{
+			messageID: string(m.GetMessageID()),
+			peerID:    string(evt.GetPeerID()),
+			timestamp: ts,
+		})
+	case pb.TraceEvent_DUPLICATE_MESSAGE:
+		a.bitmask(evt.GetDuplicateMessage().GetBitmask()).duplicates++
+	case pb.TraceEvent_REJECT_MESSAGE:
+		m := evt.GetRejectMessage()
+		a.bitmask(m.GetBitmask()).rejected++
+		a.rejectReasons[m.GetReason()]++
+	case pb.TraceEvent_GRAFT:
+		a.bitmask(evt.GetGraft().GetBitmask()).grafts++
+	case pb.TraceEvent_PRUNE:
+		a.bitmask(evt.GetPrune().GetBitmask()).prunes++
+	}
+}
+
+// Report computes the summary of all events added so far, listing at most
+// slowest peers ordered by median delivery latency.
+func (a *Analyzer) Report(slowest int) *Report {
+	report := &Report{
+		Start:         time.Unix(0, a.start),
+		End:           time.Unix(0, a.end),
+		Events:        a.events,
+		Bitmasks:      []*BitmaskReport{},
+		RejectReasons: a.rejectReasons,
+		SlowestPeers:  []*PeerReport{},
+	}
+
+	minutes := time.Duration(a.end - a.start).Minutes()
+	peerLatencies := map[string][]time.Duration{}
+
+	for key, stats := range a.bitmasks {
+		latencies := []time.Duration{}
+		for _, d := range stats.deliveries {
+			published, ok := a.published[d.messageID]
+			if !ok || d.timestamp < published {
+				continue
+			}
+
+			latency := time.Duration(d.timestamp - published)
+			latencies = append(latencies, latency)
+			peerLatencies[d.peerID] = append(peerLatencies[d.peerID], latency)
+		}
+		sortDurations(latencies)
+
+		b := &BitmaskReport{
+			Bitmask:    key,
+			Published:  stats.published,
+			Delivered:  stats.delivered,
+			Duplicates: stats.duplicates,
+			Rejected:   stats.rejected,
+			Grafts:     stats.grafts,
+			Prunes:     stats.prunes,
+			LatencyP50: percentile(latencies, 50),
+			LatencyP90: percentile(latencies, 90),
+			LatencyP99: percentile(latencies, 99),
+			LatencyMax: percentile(latencies, 100),
+		}
+
+		if stats.delivered > 0 {
+			b.DuplicateRatio = float64(stats.duplicates) / float64(stats.delivered)
+		}
+
+		if minutes > 0 {
+			b.ChurnPerMinute = float64(stats.grafts+stats.prunes) / minutes
+		}
+
+		report.Bitmasks = append(report.Bitmasks, b)
+	}
+
+	sort.Slice(report.Bitmasks, func(i, j int) bool {
+		return report.Bitmasks[i].Bitmask < report.Bitmasks[j].Bitmask
+	})
+
+	for id, latencies := range peerLatencies {
+		sortDurations(latencies)
+		report.SlowestPeers = append(report.SlowestPeers, &PeerReport{
+			PeerID:     peer.ID(id).String(),
+			Deliveries: len(latencies),
+			LatencyP50: percentile(latencies, 50),
+			LatencyP90: percentile(latencies, 90),
+		})
+	}
+
+	sort.Slice(report.SlowestPeers, func(i, j int) bool {
+		pi, pj := report.SlowestPeers[i], report.SlowestPeers[j]
+		if pi.LatencyP50 != pj.LatencyP50 {
+			return pi.LatencyP50 > pj.LatencyP50
+		}
+		return pi.PeerID < pj.PeerID
+	})
+
+	if len(report.SlowestPeers) > slowest {
+		report.SlowestPeers = report.SlowestPeers[:slowest]
+	}
+
+	return report
+}
+
+func sortDurations(d []time.Duration) {
+	sort.Slice(d, func(i, j int) bool { return d[i] < d[j] })
+}
+
+// percentile expects sorted durations and uses the nearest-rank method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// Print writes the report as human readable tables.
+func (r *Report) Print(w io.Writer) {
+	fmt.Fprintf(
+		w,
+		"%d events from %s to %s\n\n",
+		r.Events,
+		r.Start.UTC().Format(time.RFC3339),
+		r.End.UTC().Format(time.RFC3339),
+	)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(
+		tw,
+		"BITMASK\tPUBLISHED\tDELIVERED\tDUP RATIO\tP50\tP90\tP99\tMAX\t"+
+			"GRAFTS\tPRUNES\tCHURN/MIN\tREJECTED",
+	)
+	for _, b := range r.Bitmasks {
+		fmt.Fprintf(
+			tw,
+			"%s\t%d\t%d\t%.2f\t%s\t%s\t%s\t%s\t%d\t%d\t%.2f\t%d\n",
+			b.Bitmask,
+			b.Published,
+			b.Delivered,
+			b.DuplicateRatio,
+			b.LatencyP50,
+			b.LatencyP90,
+			b.LatencyP99,
+			b.LatencyMax,
+			b.Grafts,
+			b.Prunes,
+			b.ChurnPerMinute,
+			b.Rejected,
+		)
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w)
+	reasons := make([]string, 0, len(r.RejectReasons))
+	for reason := range r.RejectReasons {
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool {
+		return r.RejectReasons[reasons[i]] > r.RejectReasons[reasons[j]]
+	})
+
+	fmt.Fprintln(tw, "REJECT REASON\tCOUNT")
+	for _, reason := range reasons {
+		fmt.Fprintf(tw, "%s\t%d\n", reason, r.RejectReasons[reason])
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(tw, "SLOWEST PEERS\tDELIVERIES\tP50\tP90")
+	for _, p := range r.SlowestPeers {
+		fmt.Fprintf(
+			tw,
+			"%s\t%d\t%s\t%s\n",
+			p.PeerID,
+			p.Deliveries,
+			p.LatencyP50,
+			p.LatencyP90,
+		)
+	}
+	tw.Flush()
+}
+
+// ReadPath reads every trace event in path, which is either a single trace
+// file or a directory of files written by the collector. The format of each
+// file is detected from its content: JSON objects, one after the other as
+// written by JSONTracer or in an array, or delimited protobufs as written by
+// PBTracer and the collector.
+func ReadPath(path string, fn func(evt *pb.TraceEvent)) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return errors.Wrap(err, "read path")
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return errors.Wrap(err, "read path")
+		}
+
+		files = []string{}
+		for _, e := range entries {
+			if e.IsDir() || !strings.HasPrefix(e.Name(), traceFilePrefix) {
+				continue
+			}
+			files = append(files, filepath.Join(path, e.Name()))
+		}
+		sort.Strings(files)
+	}
+
+	for _, file := range files {
+		if err := readFile(file, fn); err != nil {
+			return errors.Wrap(err, "read path")
+		}
+	}
+
+	return nil
+}
+
+func readFile(path string, fn func(evt *pb.TraceEvent)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "read file")
+	}
+	defer f.Close()
+
+	br := bufio.NewReader(f)
+	if ok, array := jsonFormat(br); ok {
+		return errors.Wrap(readJSON(br, array, fn), "read file")
+	}
+
+	r := msgio.NewVarintReaderSize(br, maxBatchSize)
+	for {
+		msg, err := r.ReadMsg()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Wrap(err, "read file")
+		}
+
+		evt := &pb.TraceEvent{}
+		err = proto.Unmarshal(msg, evt)
+		r.ReleaseMsg(msg)
+		if err != nil {
+			return errors.Wrap(err, "read file")
+		}
+		fn(evt)
+	}
+}
+
+// jsonFormat tells whether the buffered content starts as JSON trace events,
+// either an object opening on a key or an array opening on an object, and
+// whether they are in an array. Delimited protobufs start with a length,
+// which may be the byte of an opening brace or bracket, but it is followed by
+// the tag of the event type.
+func jsonFormat(r *bufio.Reader) (ok bool, array bool) {
+	head, _ := r.Peek(64)
+	tokens := []byte{}
+	for _, b := range head {
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+
+		tokens = append(tokens, b)
+		if len(tokens) == 2 {
+			break
+		}
+	}
+
+	if len(tokens) < 2 {
+		return false, false
+	}
+
+	switch tokens[0] {
+	case '{':
+		return tokens[1] == '"' || tokens[1] == '}', false
+	case '[':
+		return tokens[1] == '{' || tokens[1] == ']', true
+	}
+
+	return false, false
+}
+
+func readJSON(r io.Reader, array bool, fn func(evt *pb.TraceEvent)) error {
+	dec := json.NewDecoder(r)
+	if array {
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+	}
+
+	for !array || dec.More() {
+		evt := &pb.TraceEvent{}
+		if err := dec.Decode(evt); err != nil {
+			if !array && errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		fn(evt)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package tracing_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-msgio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/tracing"
+)
+
+func event(
+	typ pb.TraceEvent_Type,
+	peerID string,
+	at time.Duration,
+) *pb.TraceEvent {
+	return &pb.TraceEvent{
+		Type:      &typ,
+		PeerID:    []byte(peerID),
+		Timestamp: proto64(int64(at)),
+	}
+}
+
+func proto64(v int64) *int64       { return &v }
+func protoString(v string) *string { return &v }
+
+func publish(peerID string, id string, bitmask []byte, at time.Duration) *pb.TraceEvent {
+	evt := event(pb.TraceEvent_PUBLISH_MESSAGE, peerID, at)
+	evt.PublishMessage = &pb.TraceEvent_PublishMessage{
+		MessageID: []byte(id),
+		Bitmask:   bitmask,
+	}
+	return evt
+}
+
+func deliver(peerID string, id string, bitmask []byte, at time.Duration) *pb.TraceEvent {
+	evt := event(pb.TraceEvent_DELIVER_MESSAGE, peerID, at)
+	evt.DeliverMessage = &pb.TraceEvent_DeliverMessage{
+		MessageID: []byte(id),
+		Bitmask:   bitmask,
+	}
+	return evt
+}
+
+func TestAnalyzer(t *testing.T) {
+	bitmask := []byte{0x01, 0x02}
+	events := []*pb.TraceEvent{
+		// Deliveries may be collected before the publish they belong to.
+		deliver("b", "m1", bitmask, 30*time.Millisecond),
+		publish("a", "m1", bitmask, 10*time.Millisecond),
+		deliver("c", "m1", bitmask, 110*time.Millisecond),
+		publish("a", "m2", bitmask, time.Second),
+		deliver("b", "m2", bitmask, time.Second+20*time.Millisecond),
+		deliver("c", "m2", bitmask, time.Second+300*time.Millisecond),
+		deliver("d", "unknown", bitmask, time.Second),
+	}
+
+	dup := event(pb.TraceEvent_DUPLICATE_MESSAGE, "b", time.Second)
+	dup.DuplicateMessage = &pb.TraceEvent_DuplicateMessage{Bitmask: bitmask}
+	reject := event(pb.TraceEvent_REJECT_MESSAGE, "b", time.Second)
+	reject.RejectMessage = &pb.TraceEvent_RejectMessage{
+		Bitmask: bitmask,
+		Reason:  protoString("validation failed"),
+	}
+	graft := event(pb.TraceEvent_GRAFT, "b", 0)
+	graft.Graft = &pb.TraceEvent_Graft{Bitmask: bitmask}
+	prune := event(pb.TraceEvent_PRUNE, "b", time.Minute)
+	prune.Prune = &pb.TraceEvent_Prune{Bitmask: bitmask}
+	events = append(events, dup, dup, reject, graft, prune)
+
+	a := tracing.NewAnalyzer()
+	for _, evt := range events {
+		a.Add(evt)
+	}
+
+	report := a.Report(1)
+	require.Len(t, report.Bitmasks, 1)
+	b := report.Bitmasks[0]
+	assert.Equal(t, "0102", b.Bitmask)
+	assert.Equal(t, 2, b.Published)
+	assert.Equal(t, 5, b.Delivered)
+	assert.Equal(t, 0.4, b.DuplicateRatio)
+	assert.Equal(t, 20*time.Millisecond, b.LatencyP50)
+	assert.Equal(t, 300*time.Millisecond, b.LatencyMax)
+	assert.Equal(t, 1, b.Grafts)
+	assert.Equal(t, 1, b.Prunes)
+	assert.InDelta(t, 2.0, b.ChurnPerMinute, 0.001)
+	assert.Equal(t, map[string]int{"validation failed": 1}, report.RejectReasons)
+
+	require.Len(t, report.SlowestPeers, 1)
+	assert.Equal(t, 2, report.SlowestPeers[0].Deliveries)
+	assert.Equal(t, 100*time.Millisecond, report.SlowestPeers[0].LatencyP50)
+
+	buf := &bytes.Buffer{}
+	report.Print(buf)
+	assert.Contains(t, buf.String(), "validation failed")
+}
+
+func TestCollectorRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	c, err := tracing.NewCollector(zap.NewNop(), nil, dir, 64, time.Hour)
+	require.NoError(t, err)
+
+	bitmask := []byte{0x03}
+	for i := 0; i < 10; i++ {
+		require.NoError(t, c.Write([]*pb.TraceEvent{
+			publish("a", string(rune('a'+i)), bitmask, time.Duration(i)),
+		}))
+	}
+	require.NoError(t, c.Close())
+
+	a := tracing.NewAnalyzer()
+	require.NoError(t, tracing.ReadPath(dir, a.Add))
+	report := a.Report(10)
+	require.Len(t, report.Bitmasks, 1)
+	assert.Equal(t, 10, report.Bitmasks[0].Published)
+	assert.Equal(t, 10, report.Events)
+}
+
+func TestReadPathDetectsFormat(t *testing.T) {
+	dir := t.TempDir()
+	bitmask := []byte{0x04}
+
+	// The first event is sized so its length prefix is the byte of an
+	// opening brace.
+	first := publish("a", "", bitmask, 0)
+	for proto.Size(first) < '{' {
+		first.PublishMessage.MessageID = append(
+			first.PublishMessage.MessageID,
+			'x',
+		)
+	}
+	require.Equal(t, int('{'), proto.Size(first))
+	events := []*pb.TraceEvent{first, publish("b", "m2", bitmask, 1)}
+
+	delimited := &bytes.Buffer{}
+	w := msgio.NewVarintWriter(delimited)
+	lines := []string{}
+	for _, evt := range events {
+		data, err := proto.Marshal(evt)
+		require.NoError(t, err)
+		require.NoError(t, w.WriteMsg(data))
+
+		line, err := json.Marshal(evt)
+		require.NoError(t, err)
+		lines = append(lines, string(line))
+	}
+
+	files := map[string][]byte{
+		"delimited": delimited.Bytes(),
+		"objects":   []byte(strings.Join(lines, "\n") + "\n"),
+		"array":     []byte(" [\n" + strings.Join(lines, ",\n") + "]\n"),
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, data, 0600))
+
+		a := tracing.NewAnalyzer()
+		require.NoError(t, tracing.ReadPath(path, a.Add), name)
+		report := a.Report(10)
+		assert.Equal(t, 2, report.Events, name)
+		require.Len(t, report.Bitmasks, 1, name)
+		assert.Equal(t, 2, report.Bitmasks[0].Published, name)
+	}
+}
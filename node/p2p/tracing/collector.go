@@ -0,0 +1,292 @@
+package tracing
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-msgio"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+)
+
+const (
+	DefaultMaxFileSize = 256 << 20
+	DefaultMaxFileAge  = time.Hour
+
+	// maxBatchSize bounds a single trace batch sent by a remote tracer, batches
+	// are accumulated for at most a second so this is generous.
+	maxBatchSize = 1 << 24
+
+	traceFilePrefix = "trace-"
+	traceFileSuffix = ".pb"
+	keyFileName     = "collector.key"
+)
+
+// Collector receives trace event streams sent by blossomsub's RemoteTracer
+// from any number of nodes, and appends the events as delimited protobufs to
+// files in a directory, rotating them by size and age. The files share the
+// format written by PBTracer, so they can be analyzed in the same way.
+type Collector struct {
+	logger      *zap.Logger
+	dir         string
+	maxFileSize int64
+	maxFileAge  time.Duration
+
+	mx      sync.Mutex
+	writer  msgio.WriteCloser
+	written int64
+	opened  time.Time
+	seq     int
+}
+
+// NewCollector creates a collector writing to dir, and registers it as the
+// handler of the remote tracer protocol on the host.
+func NewCollector(
+	logger *zap.Logger,
+	h host.Host,
+	dir string,
+	maxFileSize int64,
+	maxFileAge time.Duration,
+) (*Collector, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrap(err, "new collector")
+	}
+
+	if maxFileSize <= 0 {
+		maxFileSize = DefaultMaxFileSize
+	}
+
+	if maxFileAge <= 0 {
+		maxFileAge = DefaultMaxFileAge
+	}
+
+	c := &Collector{
+		logger:      logger,
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFileAge:  maxFileAge,
+	}
+
+	if err := c.rotate(); err != nil {
+		return nil, errors.Wrap(err, "new collector")
+	}
+
+	if h != nil {
+		h.SetStreamHandler(blossomsub.RemoteTracerProtoID, c.handleStream)
+	}
+
+	return c, nil
+}
+
+// NewCollectorHost creates the libp2p host a standalone collector listens on.
+// Its key is kept in dir, so nodes can keep pointing their remote tracer at
+// the same peer ID across restarts.
+func NewCollectorHost(dir string, listenMultiaddr string) (host.Host, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrap(err, "new collector host")
+	}
+
+	keyPath := filepath.Join(dir, keyFileName)
+	var privKey crypto.PrivKey
+	keyHex, err := os.ReadFile(keyPath)
+	switch {
+	case err == nil:
+		raw, err := hex.DecodeString(strings.TrimSpace(string(keyHex)))
+		if err != nil {
+			return nil, errors.Wrap(err, "new collector host")
+		}
+
+		privKey, err = crypto.UnmarshalEd448PrivateKey(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "new collector host")
+		}
+	case os.IsNotExist(err):
+		privKey, _, err = crypto.GenerateEd448Key(rand.Reader)
+		if err != nil {
+			return nil, errors.Wrap(err, "new collector host")
+		}
+
+		raw, err := privKey.Raw()
+		if err != nil {
+			return nil, errors.Wrap(err, "new collector host")
+		}
+
+		if err := os.WriteFile(
+			keyPath,
+			[]byte(hex.EncodeToString(raw)),
+			0600,
+		); err != nil {
+			return nil, errors.Wrap(err, "new collector host")
+		}
+	default:
+		return nil, errors.Wrap(err, "new collector host")
+	}
+
+	h, err := libp2p.New(
+		libp2p.Identity(privKey),
+		libp2p.ListenAddrStrings(listenMultiaddr),
+	)
+	return h, errors.Wrap(err, "new collector host")
+}
+
+// Run periodically rotates the current file once it exceeds the maximum age,
+// so quiet periods still produce files that can be picked up for analysis.
+func (c *Collector) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.mx.Lock()
+			if time.Since(c.opened) >= c.maxFileAge && c.written > 0 {
+				if err := c.rotate(); err != nil {
+					c.logger.Error("could not rotate trace file", zap.Error(err))
+				}
+			}
+			c.mx.Unlock()
+		}
+	}
+}
+
+// Close flushes and closes the current file.
+func (c *Collector) Close() error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.writer == nil {
+		return nil
+	}
+
+	err := c.writer.Close()
+	c.writer = nil
+	return errors.Wrap(err, "close")
+}
+
+func (c *Collector) handleStream(s network.Stream) {
+	defer s.Close()
+
+	remote := s.Conn().RemotePeer().String()
+	c.logger.Info("accepted trace stream", zap.String("peer_id", remote))
+
+	gzipR, err := gzip.NewReader(s)
+	if err != nil {
+		c.logger.Debug("could not open trace stream", zap.Error(err))
+		s.Reset()
+		return
+	}
+
+	if err := c.Consume(msgio.NewVarintReaderSize(gzipR, maxBatchSize)); err != nil {
+		c.logger.Debug(
+			"trace stream ended",
+			zap.String("peer_id", remote),
+			zap.Error(err),
+		)
+		s.Reset()
+	}
+}
+
+// Consume reads trace event batches from r until it is exhausted.
+func (c *Collector) Consume(r msgio.ReadCloser) error {
+	batch := &pb.TraceEventBatch{}
+	for {
+		msg, err := r.ReadMsg()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Wrap(err, "consume")
+		}
+
+		err = proto.Unmarshal(msg, batch)
+		r.ReleaseMsg(msg)
+		if err != nil {
+			return errors.Wrap(err, "consume")
+		}
+
+		if err := c.Write(batch.Batch); err != nil {
+			return errors.Wrap(err, "consume")
+		}
+	}
+}
+
+// Write appends the events to the current file, rotating it first if it has
+// grown past the size or age limits.
+func (c *Collector) Write(events []*pb.TraceEvent) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.writer == nil {
+		return errors.Wrap(errors.New("collector closed"), "write")
+	}
+
+	if c.written >= c.maxFileSize || time.Since(c.opened) >= c.maxFileAge {
+		if err := c.rotate(); err != nil {
+			return errors.Wrap(err, "write")
+		}
+	}
+
+	for _, evt := range events {
+		out, err := proto.Marshal(evt)
+		if err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if err := c.writer.WriteMsg(out); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		c.written += int64(len(out))
+	}
+
+	return nil
+}
+
+// rotate must be called with the lock held, or before the collector is
+// shared.
+func (c *Collector) rotate() error {
+	if c.writer != nil {
+		if err := c.writer.Close(); err != nil {
+			c.logger.Error("could not close trace file", zap.Error(err))
+		}
+	}
+
+	now := time.Now()
+	name := filepath.Join(c.dir, fmt.Sprintf(
+		"%s%s-%06d%s",
+		traceFilePrefix,
+		now.UTC().Format("20060102T150405.000000000"),
+		c.seq,
+		traceFileSuffix,
+	))
+	c.seq++
+
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0644)
+	if err != nil {
+		return errors.Wrap(err, "rotate")
+	}
+
+	c.writer = msgio.NewVarintWriter(f)
+	c.written = 0
+	c.opened = now
+
+	return nil
+}
@@ -20,6 +20,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AsyncTripleRatchetUpdate_UpdateType int32
+
+const (
+	// A periodic ratchet of the group key, the members are unchanged.
+	AsyncTripleRatchetUpdate_RATCHET       AsyncTripleRatchetUpdate_UpdateType = 0
+	AsyncTripleRatchetUpdate_ADD_MEMBER    AsyncTripleRatchetUpdate_UpdateType = 1
+	AsyncTripleRatchetUpdate_REMOVE_MEMBER AsyncTripleRatchetUpdate_UpdateType = 2
+)
+
+// Enum value maps for AsyncTripleRatchetUpdate_UpdateType.
+var (
+	AsyncTripleRatchetUpdate_UpdateType_name = map[int32]string{
+		0: "RATCHET",
+		1: "ADD_MEMBER",
+		2: "REMOVE_MEMBER",
+	}
+	AsyncTripleRatchetUpdate_UpdateType_value = map[string]int32{
+		"RATCHET":       0,
+		"ADD_MEMBER":    1,
+		"REMOVE_MEMBER": 2,
+	}
+)
+
+func (x AsyncTripleRatchetUpdate_UpdateType) Enum() *AsyncTripleRatchetUpdate_UpdateType {
+	p := new(AsyncTripleRatchetUpdate_UpdateType)
+	*p = x
+	return p
+}
+
+func (x AsyncTripleRatchetUpdate_UpdateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AsyncTripleRatchetUpdate_UpdateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_channel_proto_enumTypes[0].Descriptor()
+}
+
+func (AsyncTripleRatchetUpdate_UpdateType) Type() protoreflect.EnumType {
+	return &file_channel_proto_enumTypes[0]
+}
+
+func (x AsyncTripleRatchetUpdate_UpdateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AsyncTripleRatchetUpdate_UpdateType.Descriptor instead.
+func (AsyncTripleRatchetUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{21, 0}
+}
+
 // Describes a general channel envelope for a message.
 type P2PChannelEnvelope struct {
 	state         protoimpl.MessageState
@@ -783,222 +833,2422 @@ type SignedPreKey_PublicKeySignatureEd448 struct {
 
 func (*SignedPreKey_PublicKeySignatureEd448) isSignedPreKey_SignedPreKeySignature() {}
 
-var File_channel_proto protoreflect.FileDescriptor
+// Describes a message key retained for a message that arrived out of order,
+// so the message can still be decrypted when it arrives.
+type SkippedMessageKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_channel_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x1a, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
-	0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x1a, 0x0a, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x01, 0x0a, 0x12, 0x50, 0x32, 0x50, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2f,
-	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
-	0x54, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62,
-	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x69, 0x70, 0x68,
-	0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x71, 0x75,
-	0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x12, 0x33, 0x0a,
-	0x15, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65,
-	0x78, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x73, 0x73,
-	0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x22, 0xf9, 0x01, 0x0a, 0x16,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x12, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x6b, 0x65,
-	0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x10, 0x70, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f,
-	0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x64,
-	0x34, 0x34, 0x38, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x69, 0x6c,
-	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x34, 0x34, 0x38, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x48, 0x00, 0x52, 0x18, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x64, 0x34, 0x34, 0x38, 0x42, 0x17,
-	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3f, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67,
-	0x4b, 0x65, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x63,
-	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
-	0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x64, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
-	0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
-	0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x6c,
-	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xbb, 0x01, 0x0a, 0x13,
-	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x66,
-	0x72, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x79,
-	0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x79,
-	0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x15, 0x4b, 0x65,
-	0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x69, 0x6c,
-	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b,
-	0x65, 0x79, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12,
-	0x4e, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62,
-	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x65, 0x4b, 0x65,
-	0x79, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12,
-	0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x0b,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x66, 0x0a, 0x1a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
-	0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x64, 0x34, 0x34,
-	0x38, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62,
-	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x70,
-	0x62, 0x2e, 0x45, 0x64, 0x34, 0x34, 0x38, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x48, 0x00, 0x52, 0x17, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x64, 0x34, 0x34, 0x38, 0x42, 0x18, 0x0a, 0x16, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xea, 0x01, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x66,
-	0x0a, 0x1a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x64, 0x34, 0x34, 0x38, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e,
-	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x34,
-	0x34, 0x38, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x17, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x45, 0x64, 0x34, 0x34, 0x38, 0x42, 0x1a, 0x0a, 0x18, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x71, 0x75, 0x69,
-	0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x75, 0x69, 0x6c,
-	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x65, 0x70, 0x6f, 0x2f,
-	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// The header key the skipped message's header is encrypted with.
+	HeaderKey []byte `protobuf:"bytes,1,opt,name=header_key,json=headerKey,proto3" json:"header_key,omitempty"`
+	// The public key of the sender, only set for triple ratchet sessions.
+	Sender []byte `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The position of the message in its chain.
+	MessageNumber uint32 `protobuf:"varint,3,opt,name=message_number,json=messageNumber,proto3" json:"message_number,omitempty"`
+	// The message key followed by the AEAD key.
+	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// The time the key was skipped, in milliseconds since the Unix epoch. Keys
+	// older than the participant's expiry are discarded.
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SkippedMessageKey) Reset() {
+	*x = SkippedMessageKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_channel_proto_rawDescOnce sync.Once
-	file_channel_proto_rawDescData = file_channel_proto_rawDesc
-)
+func (x *SkippedMessageKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_channel_proto_rawDescGZIP() []byte {
-	file_channel_proto_rawDescOnce.Do(func() {
-		file_channel_proto_rawDescData = protoimpl.X.CompressGZIP(file_channel_proto_rawDescData)
-	})
-	return file_channel_proto_rawDescData
+func (*SkippedMessageKey) ProtoMessage() {}
+
+func (x *SkippedMessageKey) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_channel_proto_goTypes = []interface{}{
-	(*P2PChannelEnvelope)(nil),      // 0: quilibrium.node.channel.pb.P2PChannelEnvelope
-	(*MessageCiphertext)(nil),       // 1: quilibrium.node.channel.pb.MessageCiphertext
-	(*ProvingKeyAnnouncement)(nil),  // 2: quilibrium.node.channel.pb.ProvingKeyAnnouncement
-	(*ProvingKeyRequest)(nil),       // 3: quilibrium.node.channel.pb.ProvingKeyRequest
-	(*InclusionAggregateProof)(nil), // 4: quilibrium.node.channel.pb.InclusionAggregateProof
-	(*InclusionCommitment)(nil),     // 5: quilibrium.node.channel.pb.InclusionCommitment
-	(*KeyBundleAnnouncement)(nil),   // 6: quilibrium.node.channel.pb.KeyBundleAnnouncement
-	(*IdentityKey)(nil),             // 7: quilibrium.node.channel.pb.IdentityKey
-	(*SignedPreKey)(nil),            // 8: quilibrium.node.channel.pb.SignedPreKey
-	(*Ed448Signature)(nil),          // 9: quilibrium.node.keys.pb.Ed448Signature
+// Deprecated: Use SkippedMessageKey.ProtoReflect.Descriptor instead.
+func (*SkippedMessageKey) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{9}
 }
-var file_channel_proto_depIdxs = []int32{
-	1, // 0: quilibrium.node.channel.pb.P2PChannelEnvelope.message_header:type_name -> quilibrium.node.channel.pb.MessageCiphertext
-	1, // 1: quilibrium.node.channel.pb.P2PChannelEnvelope.message_body:type_name -> quilibrium.node.channel.pb.MessageCiphertext
-	9, // 2: quilibrium.node.channel.pb.ProvingKeyAnnouncement.proving_key_signature_ed448:type_name -> quilibrium.node.keys.pb.Ed448Signature
-	5, // 3: quilibrium.node.channel.pb.InclusionAggregateProof.inclusion_commitments:type_name -> quilibrium.node.channel.pb.InclusionCommitment
-	7, // 4: quilibrium.node.channel.pb.KeyBundleAnnouncement.identity_key:type_name -> quilibrium.node.channel.pb.IdentityKey
-	8, // 5: quilibrium.node.channel.pb.KeyBundleAnnouncement.signed_pre_key:type_name -> quilibrium.node.channel.pb.SignedPreKey
-	9, // 6: quilibrium.node.channel.pb.IdentityKey.public_key_signature_ed448:type_name -> quilibrium.node.keys.pb.Ed448Signature
-	9, // 7: quilibrium.node.channel.pb.SignedPreKey.public_key_signature_ed448:type_name -> quilibrium.node.keys.pb.Ed448Signature
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+
+func (x *SkippedMessageKey) GetHeaderKey() []byte {
+	if x != nil {
+		return x.HeaderKey
+	}
+	return nil
 }
 
-func init() { file_channel_proto_init() }
-func file_channel_proto_init() {
-	if File_channel_proto != nil {
-		return
+func (x *SkippedMessageKey) GetSender() []byte {
+	if x != nil {
+		return x.Sender
 	}
-	file_keys_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_channel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*P2PChannelEnvelope); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_channel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageCiphertext); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_channel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProvingKeyAnnouncement); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *SkippedMessageKey) GetMessageNumber() uint32 {
+	if x != nil {
+		return x.MessageNumber
+	}
+	return 0
+}
+
+func (x *SkippedMessageKey) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *SkippedMessageKey) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// The serialized state of a double ratchet participant. Private key material
+// is included, so it must only be persisted encrypted.
+type DoubleRatchetParticipantState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the state encoding, participants refuse to resume from
+	// versions they do not know.
+	Version                      uint32               `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Curve                        string               `protobuf:"bytes,2,opt,name=curve,proto3" json:"curve,omitempty"`
+	SendingEphemeralPrivateKey   []byte               `protobuf:"bytes,3,opt,name=sending_ephemeral_private_key,json=sendingEphemeralPrivateKey,proto3" json:"sending_ephemeral_private_key,omitempty"`
+	ReceivingEphemeralKey        []byte               `protobuf:"bytes,4,opt,name=receiving_ephemeral_key,json=receivingEphemeralKey,proto3" json:"receiving_ephemeral_key,omitempty"`
+	RootKey                      []byte               `protobuf:"bytes,5,opt,name=root_key,json=rootKey,proto3" json:"root_key,omitempty"`
+	SendingChainKey              []byte               `protobuf:"bytes,6,opt,name=sending_chain_key,json=sendingChainKey,proto3" json:"sending_chain_key,omitempty"`
+	CurrentSendingHeaderKey      []byte               `protobuf:"bytes,7,opt,name=current_sending_header_key,json=currentSendingHeaderKey,proto3" json:"current_sending_header_key,omitempty"`
+	CurrentReceivingHeaderKey    []byte               `protobuf:"bytes,8,opt,name=current_receiving_header_key,json=currentReceivingHeaderKey,proto3" json:"current_receiving_header_key,omitempty"`
+	NextSendingHeaderKey         []byte               `protobuf:"bytes,9,opt,name=next_sending_header_key,json=nextSendingHeaderKey,proto3" json:"next_sending_header_key,omitempty"`
+	NextReceivingHeaderKey       []byte               `protobuf:"bytes,10,opt,name=next_receiving_header_key,json=nextReceivingHeaderKey,proto3" json:"next_receiving_header_key,omitempty"`
+	ReceivingChainKey            []byte               `protobuf:"bytes,11,opt,name=receiving_chain_key,json=receivingChainKey,proto3" json:"receiving_chain_key,omitempty"`
+	CurrentSendingChainLength    uint32               `protobuf:"varint,12,opt,name=current_sending_chain_length,json=currentSendingChainLength,proto3" json:"current_sending_chain_length,omitempty"`
+	PreviousSendingChainLength   uint32               `protobuf:"varint,13,opt,name=previous_sending_chain_length,json=previousSendingChainLength,proto3" json:"previous_sending_chain_length,omitempty"`
+	CurrentReceivingChainLength  uint32               `protobuf:"varint,14,opt,name=current_receiving_chain_length,json=currentReceivingChainLength,proto3" json:"current_receiving_chain_length,omitempty"`
+	PreviousReceivingChainLength uint32               `protobuf:"varint,15,opt,name=previous_receiving_chain_length,json=previousReceivingChainLength,proto3" json:"previous_receiving_chain_length,omitempty"`
+	SkippedKeys                  []*SkippedMessageKey `protobuf:"bytes,16,rep,name=skipped_keys,json=skippedKeys,proto3" json:"skipped_keys,omitempty"`
+}
+
+func (x *DoubleRatchetParticipantState) Reset() {
+	*x = DoubleRatchetParticipantState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleRatchetParticipantState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleRatchetParticipantState) ProtoMessage() {}
+
+func (x *DoubleRatchetParticipantState) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_channel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProvingKeyRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleRatchetParticipantState.ProtoReflect.Descriptor instead.
+func (*DoubleRatchetParticipantState) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DoubleRatchetParticipantState) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DoubleRatchetParticipantState) GetCurve() string {
+	if x != nil {
+		return x.Curve
+	}
+	return ""
+}
+
+func (x *DoubleRatchetParticipantState) GetSendingEphemeralPrivateKey() []byte {
+	if x != nil {
+		return x.SendingEphemeralPrivateKey
+	}
+	return nil
+}
+
+func (x *DoubleRatchetParticipantState) GetReceivingEphemeralKey() []byte {
+	if x != nil {
+		return x.ReceivingEphemeralKey
+	}
+	return nil
+}
+
+func (x *DoubleRatchetParticipantState) GetRootKey() []byte {
+	if x != nil {
+		return x.RootKey
+	}
+	return nil
+}
+
+func (x *DoubleRatchetParticipantState) GetSendingChainKey() []byte {
+	if x != nil {
+		return x.SendingChainKey
+	}
+	return nil
+}
+
+func (x *DoubleRatchetParticipantState) GetCurrentSendingHeaderKey() []byte {
+	if x != nil {
+		return x.CurrentSendingHeaderKey
+	}
+	return nil
+}
+
+func (x *DoubleRatchetParticipantState) GetCurrentReceivingHeaderKey() []byte {
+	if x != nil {
+		return x.CurrentReceivingHeaderKey
+	}
+	return nil
+}
+
+func (x *DoubleRatchetParticipantState) GetNextSendingHeaderKey() []byte {
+	if x != nil {
+		return x.NextSendingHeaderKey
+	}
+	return nil
+}
+
+func (x *DoubleRatchetParticipantState) GetNextReceivingHeaderKey() []byte {
+	if x != nil {
+		return x.NextReceivingHeaderKey
+	}
+	return nil
+}
+
+func (x *DoubleRatchetParticipantState) GetReceivingChainKey() []byte {
+	if x != nil {
+		return x.ReceivingChainKey
+	}
+	return nil
+}
+
+func (x *DoubleRatchetParticipantState) GetCurrentSendingChainLength() uint32 {
+	if x != nil {
+		return x.CurrentSendingChainLength
+	}
+	return 0
+}
+
+func (x *DoubleRatchetParticipantState) GetPreviousSendingChainLength() uint32 {
+	if x != nil {
+		return x.PreviousSendingChainLength
+	}
+	return 0
+}
+
+func (x *DoubleRatchetParticipantState) GetCurrentReceivingChainLength() uint32 {
+	if x != nil {
+		return x.CurrentReceivingChainLength
+	}
+	return 0
+}
+
+func (x *DoubleRatchetParticipantState) GetPreviousReceivingChainLength() uint32 {
+	if x != nil {
+		return x.PreviousReceivingChainLength
+	}
+	return 0
+}
+
+func (x *DoubleRatchetParticipantState) GetSkippedKeys() []*SkippedMessageKey {
+	if x != nil {
+		return x.SkippedKeys
+	}
+	return nil
+}
+
+// The serialized state of a Feldman DKG participant. Scalars and points are
+// serialized in their canonical byte form, unset values are empty.
+type FeldmanState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Curve                       string            `protobuf:"bytes,1,opt,name=curve,proto3" json:"curve,omitempty"`
+	Threshold                   uint32            `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Total                       uint32            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Id                          uint32            `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	FragsForCounterparties      map[uint32][]byte `protobuf:"bytes,5,rep,name=frags_for_counterparties,json=fragsForCounterparties,proto3" json:"frags_for_counterparties,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FragsFromCounterparties     map[uint32][]byte `protobuf:"bytes,6,rep,name=frags_from_counterparties,json=fragsFromCounterparties,proto3" json:"frags_from_counterparties,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Zkpok                       []byte            `protobuf:"bytes,7,opt,name=zkpok,proto3" json:"zkpok,omitempty"`
+	Secret                      []byte            `protobuf:"bytes,8,opt,name=secret,proto3" json:"secret,omitempty"`
+	Scalar                      []byte            `protobuf:"bytes,9,opt,name=scalar,proto3" json:"scalar,omitempty"`
+	Generator                   []byte            `protobuf:"bytes,10,opt,name=generator,proto3" json:"generator,omitempty"`
+	PublicKey                   []byte            `protobuf:"bytes,11,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Point                       []byte            `protobuf:"bytes,12,opt,name=point,proto3" json:"point,omitempty"`
+	RandomCommitmentPoint       []byte            `protobuf:"bytes,13,opt,name=random_commitment_point,json=randomCommitmentPoint,proto3" json:"random_commitment_point,omitempty"`
+	Round                       uint32            `protobuf:"varint,14,opt,name=round,proto3" json:"round,omitempty"`
+	ZkcommitsFromCounterparties map[uint32][]byte `protobuf:"bytes,15,rep,name=zkcommits_from_counterparties,json=zkcommitsFromCounterparties,proto3" json:"zkcommits_from_counterparties,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PointsFromCounterparties    map[uint32][]byte `protobuf:"bytes,16,rep,name=points_from_counterparties,json=pointsFromCounterparties,proto3" json:"points_from_counterparties,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *FeldmanState) Reset() {
+	*x = FeldmanState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeldmanState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeldmanState) ProtoMessage() {}
+
+func (x *FeldmanState) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeldmanState.ProtoReflect.Descriptor instead.
+func (*FeldmanState) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FeldmanState) GetCurve() string {
+	if x != nil {
+		return x.Curve
+	}
+	return ""
+}
+
+func (x *FeldmanState) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *FeldmanState) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FeldmanState) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FeldmanState) GetFragsForCounterparties() map[uint32][]byte {
+	if x != nil {
+		return x.FragsForCounterparties
+	}
+	return nil
+}
+
+func (x *FeldmanState) GetFragsFromCounterparties() map[uint32][]byte {
+	if x != nil {
+		return x.FragsFromCounterparties
+	}
+	return nil
+}
+
+func (x *FeldmanState) GetZkpok() []byte {
+	if x != nil {
+		return x.Zkpok
+	}
+	return nil
+}
+
+func (x *FeldmanState) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *FeldmanState) GetScalar() []byte {
+	if x != nil {
+		return x.Scalar
+	}
+	return nil
+}
+
+func (x *FeldmanState) GetGenerator() []byte {
+	if x != nil {
+		return x.Generator
+	}
+	return nil
+}
+
+func (x *FeldmanState) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *FeldmanState) GetPoint() []byte {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *FeldmanState) GetRandomCommitmentPoint() []byte {
+	if x != nil {
+		return x.RandomCommitmentPoint
+	}
+	return nil
+}
+
+func (x *FeldmanState) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *FeldmanState) GetZkcommitsFromCounterparties() map[uint32][]byte {
+	if x != nil {
+		return x.ZkcommitsFromCounterparties
+	}
+	return nil
+}
+
+func (x *FeldmanState) GetPointsFromCounterparties() map[uint32][]byte {
+	if x != nil {
+		return x.PointsFromCounterparties
+	}
+	return nil
+}
+
+// The state a triple ratchet participant holds for each other member of the
+// group.
+type TripleRatchetPeerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The member's id in the DKG.
+	Id                           uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublicKey                    []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	IdentityPublicKey            []byte `protobuf:"bytes,3,opt,name=identity_public_key,json=identityPublicKey,proto3" json:"identity_public_key,omitempty"`
+	SignedPrePublicKey           []byte `protobuf:"bytes,4,opt,name=signed_pre_public_key,json=signedPrePublicKey,proto3" json:"signed_pre_public_key,omitempty"`
+	ReceivingEphemeralKey        []byte `protobuf:"bytes,5,opt,name=receiving_ephemeral_key,json=receivingEphemeralKey,proto3" json:"receiving_ephemeral_key,omitempty"`
+	ReceivingChainKey            []byte `protobuf:"bytes,6,opt,name=receiving_chain_key,json=receivingChainKey,proto3" json:"receiving_chain_key,omitempty"`
+	CurrentReceivingChainLength  uint32 `protobuf:"varint,7,opt,name=current_receiving_chain_length,json=currentReceivingChainLength,proto3" json:"current_receiving_chain_length,omitempty"`
+	PreviousReceivingChainLength uint32 `protobuf:"varint,8,opt,name=previous_receiving_chain_length,json=previousReceivingChainLength,proto3" json:"previous_receiving_chain_length,omitempty"`
+	// The pairwise double ratchet channel with the member, unset for the
+	// participant itself.
+	Channel *DoubleRatchetParticipantState `protobuf:"bytes,9,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *TripleRatchetPeerState) Reset() {
+	*x = TripleRatchetPeerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripleRatchetPeerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripleRatchetPeerState) ProtoMessage() {}
+
+func (x *TripleRatchetPeerState) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripleRatchetPeerState.ProtoReflect.Descriptor instead.
+func (*TripleRatchetPeerState) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TripleRatchetPeerState) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TripleRatchetPeerState) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetPeerState) GetIdentityPublicKey() []byte {
+	if x != nil {
+		return x.IdentityPublicKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetPeerState) GetSignedPrePublicKey() []byte {
+	if x != nil {
+		return x.SignedPrePublicKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetPeerState) GetReceivingEphemeralKey() []byte {
+	if x != nil {
+		return x.ReceivingEphemeralKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetPeerState) GetReceivingChainKey() []byte {
+	if x != nil {
+		return x.ReceivingChainKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetPeerState) GetCurrentReceivingChainLength() uint32 {
+	if x != nil {
+		return x.CurrentReceivingChainLength
+	}
+	return 0
+}
+
+func (x *TripleRatchetPeerState) GetPreviousReceivingChainLength() uint32 {
+	if x != nil {
+		return x.PreviousReceivingChainLength
+	}
+	return 0
+}
+
+func (x *TripleRatchetPeerState) GetChannel() *DoubleRatchetParticipantState {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+// The serialized state of a triple ratchet participant. Private key material
+// is included, so it must only be persisted encrypted.
+type TripleRatchetParticipantState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the state encoding, participants refuse to resume from
+	// versions they do not know.
+	Version                    uint32                    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Curve                      string                    `protobuf:"bytes,2,opt,name=curve,proto3" json:"curve,omitempty"`
+	PeerKey                    []byte                    `protobuf:"bytes,3,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
+	SendingEphemeralPrivateKey []byte                    `protobuf:"bytes,4,opt,name=sending_ephemeral_private_key,json=sendingEphemeralPrivateKey,proto3" json:"sending_ephemeral_private_key,omitempty"`
+	ReceivingGroupKey          []byte                    `protobuf:"bytes,5,opt,name=receiving_group_key,json=receivingGroupKey,proto3" json:"receiving_group_key,omitempty"`
+	RootKey                    []byte                    `protobuf:"bytes,6,opt,name=root_key,json=rootKey,proto3" json:"root_key,omitempty"`
+	SendingChainKey            []byte                    `protobuf:"bytes,7,opt,name=sending_chain_key,json=sendingChainKey,proto3" json:"sending_chain_key,omitempty"`
+	CurrentHeaderKey           []byte                    `protobuf:"bytes,8,opt,name=current_header_key,json=currentHeaderKey,proto3" json:"current_header_key,omitempty"`
+	NextHeaderKey              []byte                    `protobuf:"bytes,9,opt,name=next_header_key,json=nextHeaderKey,proto3" json:"next_header_key,omitempty"`
+	CurrentSendingChainLength  uint32                    `protobuf:"varint,10,opt,name=current_sending_chain_length,json=currentSendingChainLength,proto3" json:"current_sending_chain_length,omitempty"`
+	PreviousSendingChainLength uint32                    `protobuf:"varint,11,opt,name=previous_sending_chain_length,json=previousSendingChainLength,proto3" json:"previous_sending_chain_length,omitempty"`
+	Peers                      []*TripleRatchetPeerState `protobuf:"bytes,12,rep,name=peers,proto3" json:"peers,omitempty"`
+	SkippedKeys                []*SkippedMessageKey      `protobuf:"bytes,13,rep,name=skipped_keys,json=skippedKeys,proto3" json:"skipped_keys,omitempty"`
+	DkgRatchet                 *FeldmanState             `protobuf:"bytes,14,opt,name=dkg_ratchet,json=dkgRatchet,proto3" json:"dkg_ratchet,omitempty"`
+}
+
+func (x *TripleRatchetParticipantState) Reset() {
+	*x = TripleRatchetParticipantState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripleRatchetParticipantState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripleRatchetParticipantState) ProtoMessage() {}
+
+func (x *TripleRatchetParticipantState) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripleRatchetParticipantState.ProtoReflect.Descriptor instead.
+func (*TripleRatchetParticipantState) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TripleRatchetParticipantState) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TripleRatchetParticipantState) GetCurve() string {
+	if x != nil {
+		return x.Curve
+	}
+	return ""
+}
+
+func (x *TripleRatchetParticipantState) GetPeerKey() []byte {
+	if x != nil {
+		return x.PeerKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetParticipantState) GetSendingEphemeralPrivateKey() []byte {
+	if x != nil {
+		return x.SendingEphemeralPrivateKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetParticipantState) GetReceivingGroupKey() []byte {
+	if x != nil {
+		return x.ReceivingGroupKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetParticipantState) GetRootKey() []byte {
+	if x != nil {
+		return x.RootKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetParticipantState) GetSendingChainKey() []byte {
+	if x != nil {
+		return x.SendingChainKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetParticipantState) GetCurrentHeaderKey() []byte {
+	if x != nil {
+		return x.CurrentHeaderKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetParticipantState) GetNextHeaderKey() []byte {
+	if x != nil {
+		return x.NextHeaderKey
+	}
+	return nil
+}
+
+func (x *TripleRatchetParticipantState) GetCurrentSendingChainLength() uint32 {
+	if x != nil {
+		return x.CurrentSendingChainLength
+	}
+	return 0
+}
+
+func (x *TripleRatchetParticipantState) GetPreviousSendingChainLength() uint32 {
+	if x != nil {
+		return x.PreviousSendingChainLength
+	}
+	return 0
+}
+
+func (x *TripleRatchetParticipantState) GetPeers() []*TripleRatchetPeerState {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *TripleRatchetParticipantState) GetSkippedKeys() []*SkippedMessageKey {
+	if x != nil {
+		return x.SkippedKeys
+	}
+	return nil
+}
+
+func (x *TripleRatchetParticipantState) GetDkgRatchet() *FeldmanState {
+	if x != nil {
+		return x.DkgRatchet
+	}
+	return nil
+}
+
+// Describes a one-time prekey offered in a prekey bundle. Each one-time prekey
+// is handed out to a single initiator, and discarded once a session is
+// established with it.
+type OneTimePreKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The compressed public key.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *OneTimePreKey) Reset() {
+	*x = OneTimePreKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneTimePreKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneTimePreKey) ProtoMessage() {}
+
+func (x *OneTimePreKey) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneTimePreKey.ProtoReflect.Descriptor instead.
+func (*OneTimePreKey) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OneTimePreKey) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OneTimePreKey) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// Describes the keys a node publishes so that peers can establish X3DH
+// sessions with it while it is offline. Bundles distributed through the
+// directory never carry a one-time prekey, those are only handed out by the
+// node itself. The bundle is signed with the node's peer key, binding the
+// keys to its peer id.
+type PreKeyBundle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The compressed identity public key.
+	IdentityKey    []byte `protobuf:"bytes,1,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	SignedPreKeyId uint32 `protobuf:"varint,2,opt,name=signed_pre_key_id,json=signedPreKeyId,proto3" json:"signed_pre_key_id,omitempty"`
+	// The compressed signed prekey, rotated periodically.
+	SignedPreKey  []byte         `protobuf:"bytes,3,opt,name=signed_pre_key,json=signedPreKey,proto3" json:"signed_pre_key,omitempty"`
+	OneTimePreKey *OneTimePreKey `protobuf:"bytes,4,opt,name=one_time_pre_key,json=oneTimePreKey,proto3" json:"one_time_pre_key,omitempty"`
+	// The time the bundle was produced, in milliseconds since the Unix epoch.
+	// Newer bundles replace older ones in the directory.
+	Timestamp int64           `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature *Ed448Signature `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *PreKeyBundle) Reset() {
+	*x = PreKeyBundle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreKeyBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreKeyBundle) ProtoMessage() {}
+
+func (x *PreKeyBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreKeyBundle.ProtoReflect.Descriptor instead.
+func (*PreKeyBundle) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PreKeyBundle) GetIdentityKey() []byte {
+	if x != nil {
+		return x.IdentityKey
+	}
+	return nil
+}
+
+func (x *PreKeyBundle) GetSignedPreKeyId() uint32 {
+	if x != nil {
+		return x.SignedPreKeyId
+	}
+	return 0
+}
+
+func (x *PreKeyBundle) GetSignedPreKey() []byte {
+	if x != nil {
+		return x.SignedPreKey
+	}
+	return nil
+}
+
+func (x *PreKeyBundle) GetOneTimePreKey() *OneTimePreKey {
+	if x != nil {
+		return x.OneTimePreKey
+	}
+	return nil
+}
+
+func (x *PreKeyBundle) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PreKeyBundle) GetSignature() *Ed448Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// The first message of a session established from a prekey bundle, carrying
+// what the recipient needs to derive the session key alongside the first
+// ratchet message.
+type X3DHInitialMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The compressed identity public key of the initiator.
+	IdentityKey []byte `protobuf:"bytes,1,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	// The compressed ephemeral public key of the initiator.
+	EphemeralKey   []byte `protobuf:"bytes,2,opt,name=ephemeral_key,json=ephemeralKey,proto3" json:"ephemeral_key,omitempty"`
+	SignedPreKeyId uint32 `protobuf:"varint,3,opt,name=signed_pre_key_id,json=signedPreKeyId,proto3" json:"signed_pre_key_id,omitempty"`
+	// The id of the one-time prekey used, zero if none was available.
+	OneTimePreKeyId uint32              `protobuf:"varint,4,opt,name=one_time_pre_key_id,json=oneTimePreKeyId,proto3" json:"one_time_pre_key_id,omitempty"`
+	Envelope        *P2PChannelEnvelope `protobuf:"bytes,5,opt,name=envelope,proto3" json:"envelope,omitempty"`
+}
+
+func (x *X3DHInitialMessage) Reset() {
+	*x = X3DHInitialMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *X3DHInitialMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*X3DHInitialMessage) ProtoMessage() {}
+
+func (x *X3DHInitialMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use X3DHInitialMessage.ProtoReflect.Descriptor instead.
+func (*X3DHInitialMessage) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *X3DHInitialMessage) GetIdentityKey() []byte {
+	if x != nil {
+		return x.IdentityKey
+	}
+	return nil
+}
+
+func (x *X3DHInitialMessage) GetEphemeralKey() []byte {
+	if x != nil {
+		return x.EphemeralKey
+	}
+	return nil
+}
+
+func (x *X3DHInitialMessage) GetSignedPreKeyId() uint32 {
+	if x != nil {
+		return x.SignedPreKeyId
+	}
+	return 0
+}
+
+func (x *X3DHInitialMessage) GetOneTimePreKeyId() uint32 {
+	if x != nil {
+		return x.OneTimePreKeyId
+	}
+	return 0
+}
+
+func (x *X3DHInitialMessage) GetEnvelope() *P2PChannelEnvelope {
+	if x != nil {
+		return x.Envelope
+	}
+	return nil
+}
+
+// Describes a prekey generated by the node, for its own bookkeeping.
+type PreKeyRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// For signed prekeys the time the key was created, for one-time prekeys the
+	// time the key was handed out, in milliseconds since the Unix epoch.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *PreKeyRecord) Reset() {
+	*x = PreKeyRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreKeyRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreKeyRecord) ProtoMessage() {}
+
+func (x *PreKeyRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreKeyRecord.ProtoReflect.Descriptor instead.
+func (*PreKeyRecord) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PreKeyRecord) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PreKeyRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// The node's own prekey bookkeeping. Private keys are held by the key manager.
+type PreKeyState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextKeyId uint32 `protobuf:"varint,1,opt,name=next_key_id,json=nextKeyId,proto3" json:"next_key_id,omitempty"`
+	// The signed prekeys still accepted, oldest first. The last is current.
+	SignedPreKeys []*PreKeyRecord `protobuf:"bytes,2,rep,name=signed_pre_keys,json=signedPreKeys,proto3" json:"signed_pre_keys,omitempty"`
+	// The ids of the one-time prekeys not yet handed out.
+	OneTimePreKeys []uint32 `protobuf:"varint,3,rep,packed,name=one_time_pre_keys,json=oneTimePreKeys,proto3" json:"one_time_pre_keys,omitempty"`
+	// The one-time prekeys handed out and not yet used.
+	IssuedOneTimePreKeys []*PreKeyRecord `protobuf:"bytes,4,rep,name=issued_one_time_pre_keys,json=issuedOneTimePreKeys,proto3" json:"issued_one_time_pre_keys,omitempty"`
+}
+
+func (x *PreKeyState) Reset() {
+	*x = PreKeyState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreKeyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreKeyState) ProtoMessage() {}
+
+func (x *PreKeyState) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreKeyState.ProtoReflect.Descriptor instead.
+func (*PreKeyState) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PreKeyState) GetNextKeyId() uint32 {
+	if x != nil {
+		return x.NextKeyId
+	}
+	return 0
+}
+
+func (x *PreKeyState) GetSignedPreKeys() []*PreKeyRecord {
+	if x != nil {
+		return x.SignedPreKeys
+	}
+	return nil
+}
+
+func (x *PreKeyState) GetOneTimePreKeys() []uint32 {
+	if x != nil {
+		return x.OneTimePreKeys
+	}
+	return nil
+}
+
+func (x *PreKeyState) GetIssuedOneTimePreKeys() []*PreKeyRecord {
+	if x != nil {
+		return x.IssuedOneTimePreKeys
+	}
+	return nil
+}
+
+type GetPreKeyBundleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPreKeyBundleRequest) Reset() {
+	*x = GetPreKeyBundleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPreKeyBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreKeyBundleRequest) ProtoMessage() {}
+
+func (x *GetPreKeyBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreKeyBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetPreKeyBundleRequest) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{19}
+}
+
+// A member of an asynchronous triple ratchet group.
+type AsyncTripleRatchetMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The compressed peer key, identifying the member within the group.
+	PublicKey          []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	IdentityPublicKey  []byte `protobuf:"bytes,2,opt,name=identity_public_key,json=identityPublicKey,proto3" json:"identity_public_key,omitempty"`
+	SignedPrePublicKey []byte `protobuf:"bytes,3,opt,name=signed_pre_public_key,json=signedPrePublicKey,proto3" json:"signed_pre_public_key,omitempty"`
+}
+
+func (x *AsyncTripleRatchetMember) Reset() {
+	*x = AsyncTripleRatchetMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncTripleRatchetMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncTripleRatchetMember) ProtoMessage() {}
+
+func (x *AsyncTripleRatchetMember) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncTripleRatchetMember.ProtoReflect.Descriptor instead.
+func (*AsyncTripleRatchetMember) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AsyncTripleRatchetMember) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetMember) GetIdentityPublicKey() []byte {
+	if x != nil {
+		return x.IdentityPublicKey
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetMember) GetSignedPrePublicKey() []byte {
+	if x != nil {
+		return x.SignedPrePublicKey
+	}
+	return nil
+}
+
+// Describes a change of the group key of an asynchronous triple ratchet group.
+// It is sent to every member of the new epoch over the pairwise double ratchet
+// channel with them, so it is carried in a double ratchet P2PChannelEnvelope.
+// Members left out, such as a removed member, never learn the new key.
+type AsyncTripleRatchetUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId []byte                              `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Type    AsyncTripleRatchetUpdate_UpdateType `protobuf:"varint,2,opt,name=type,proto3,enum=quilibrium.node.channel.pb.AsyncTripleRatchetUpdate_UpdateType" json:"type,omitempty"`
+	// The public key of the member added or removed.
+	Subject []byte `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The epoch number, one more than the number of the epoch the update was
+	// made on. Of concurrent updates with the same number, the one with the
+	// lowest epoch id wins.
+	Epoch       uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	EpochSecret []byte `protobuf:"bytes,5,opt,name=epoch_secret,json=epochSecret,proto3" json:"epoch_secret,omitempty"`
+	// The id of the epoch the update was made on.
+	ParentEpochId []byte `protobuf:"bytes,6,opt,name=parent_epoch_id,json=parentEpochId,proto3" json:"parent_epoch_id,omitempty"`
+	// The members of the new epoch.
+	Members []*AsyncTripleRatchetMember `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+	// The time the update was made, in milliseconds since the Unix epoch.
+	Timestamp int64 `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *AsyncTripleRatchetUpdate) Reset() {
+	*x = AsyncTripleRatchetUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncTripleRatchetUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncTripleRatchetUpdate) ProtoMessage() {}
+
+func (x *AsyncTripleRatchetUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncTripleRatchetUpdate.ProtoReflect.Descriptor instead.
+func (*AsyncTripleRatchetUpdate) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AsyncTripleRatchetUpdate) GetGroupId() []byte {
+	if x != nil {
+		return x.GroupId
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetUpdate) GetType() AsyncTripleRatchetUpdate_UpdateType {
+	if x != nil {
+		return x.Type
+	}
+	return AsyncTripleRatchetUpdate_RATCHET
+}
+
+func (x *AsyncTripleRatchetUpdate) GetSubject() []byte {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetUpdate) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *AsyncTripleRatchetUpdate) GetEpochSecret() []byte {
+	if x != nil {
+		return x.EpochSecret
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetUpdate) GetParentEpochId() []byte {
+	if x != nil {
+		return x.ParentEpochId
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetUpdate) GetMembers() []*AsyncTripleRatchetMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetUpdate) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// An epoch of an asynchronous triple ratchet group, retained so that messages
+// delayed past a group key change can still be decrypted.
+type AsyncTripleRatchetEpoch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch         uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	EpochSecret   []byte `protobuf:"bytes,2,opt,name=epoch_secret,json=epochSecret,proto3" json:"epoch_secret,omitempty"`
+	ParentEpochId []byte `protobuf:"bytes,3,opt,name=parent_epoch_id,json=parentEpochId,proto3" json:"parent_epoch_id,omitempty"`
+	Timestamp     int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *AsyncTripleRatchetEpoch) Reset() {
+	*x = AsyncTripleRatchetEpoch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncTripleRatchetEpoch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncTripleRatchetEpoch) ProtoMessage() {}
+
+func (x *AsyncTripleRatchetEpoch) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncTripleRatchetEpoch.ProtoReflect.Descriptor instead.
+func (*AsyncTripleRatchetEpoch) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AsyncTripleRatchetEpoch) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *AsyncTripleRatchetEpoch) GetEpochSecret() []byte {
+	if x != nil {
+		return x.EpochSecret
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetEpoch) GetParentEpochId() []byte {
+	if x != nil {
+		return x.ParentEpochId
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetEpoch) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// The pairwise double ratchet channels with another member of an asynchronous
+// triple ratchet group, one for each direction.
+type AsyncTripleRatchetChannelState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte                         `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Outgoing  *DoubleRatchetParticipantState `protobuf:"bytes,2,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+	Incoming  *DoubleRatchetParticipantState `protobuf:"bytes,3,opt,name=incoming,proto3" json:"incoming,omitempty"`
+}
+
+func (x *AsyncTripleRatchetChannelState) Reset() {
+	*x = AsyncTripleRatchetChannelState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncTripleRatchetChannelState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncTripleRatchetChannelState) ProtoMessage() {}
+
+func (x *AsyncTripleRatchetChannelState) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncTripleRatchetChannelState.ProtoReflect.Descriptor instead.
+func (*AsyncTripleRatchetChannelState) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AsyncTripleRatchetChannelState) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetChannelState) GetOutgoing() *DoubleRatchetParticipantState {
+	if x != nil {
+		return x.Outgoing
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetChannelState) GetIncoming() *DoubleRatchetParticipantState {
+	if x != nil {
+		return x.Incoming
+	}
+	return nil
+}
+
+// The chain of a member's messages received in an epoch.
+type AsyncTripleRatchetChainState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender []byte `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The header key of the epoch.
+	HeaderKey   []byte `protobuf:"bytes,2,opt,name=header_key,json=headerKey,proto3" json:"header_key,omitempty"`
+	ChainKey    []byte `protobuf:"bytes,3,opt,name=chain_key,json=chainKey,proto3" json:"chain_key,omitempty"`
+	ChainLength uint32 `protobuf:"varint,4,opt,name=chain_length,json=chainLength,proto3" json:"chain_length,omitempty"`
+}
+
+func (x *AsyncTripleRatchetChainState) Reset() {
+	*x = AsyncTripleRatchetChainState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncTripleRatchetChainState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncTripleRatchetChainState) ProtoMessage() {}
+
+func (x *AsyncTripleRatchetChainState) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncTripleRatchetChainState.ProtoReflect.Descriptor instead.
+func (*AsyncTripleRatchetChainState) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AsyncTripleRatchetChainState) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetChainState) GetHeaderKey() []byte {
+	if x != nil {
+		return x.HeaderKey
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetChainState) GetChainKey() []byte {
+	if x != nil {
+		return x.ChainKey
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetChainState) GetChainLength() uint32 {
+	if x != nil {
+		return x.ChainLength
+	}
+	return 0
+}
+
+// The serialized state of an asynchronous triple ratchet participant. Private
+// key material is included, so it must only be persisted encrypted.
+type AsyncTripleRatchetParticipantState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the state encoding, participants refuse to resume from
+	// versions they do not know.
+	Version      uint32                      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Curve        string                      `protobuf:"bytes,2,opt,name=curve,proto3" json:"curve,omitempty"`
+	GroupId      []byte                      `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	PeerKey      []byte                      `protobuf:"bytes,4,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
+	IdentityKey  []byte                      `protobuf:"bytes,5,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	SignedPreKey []byte                      `protobuf:"bytes,6,opt,name=signed_pre_key,json=signedPreKey,proto3" json:"signed_pre_key,omitempty"`
+	Members      []*AsyncTripleRatchetMember `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+	// The retained epochs, oldest first. The last is current.
+	Epochs             []*AsyncTripleRatchetEpoch        `protobuf:"bytes,8,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	SendingChainKey    []byte                            `protobuf:"bytes,9,opt,name=sending_chain_key,json=sendingChainKey,proto3" json:"sending_chain_key,omitempty"`
+	SendingChainLength uint32                            `protobuf:"varint,10,opt,name=sending_chain_length,json=sendingChainLength,proto3" json:"sending_chain_length,omitempty"`
+	Channels           []*AsyncTripleRatchetChannelState `protobuf:"bytes,11,rep,name=channels,proto3" json:"channels,omitempty"`
+	ReceivingChains    []*AsyncTripleRatchetChainState   `protobuf:"bytes,12,rep,name=receiving_chains,json=receivingChains,proto3" json:"receiving_chains,omitempty"`
+	SkippedKeys        []*SkippedMessageKey              `protobuf:"bytes,13,rep,name=skipped_keys,json=skippedKeys,proto3" json:"skipped_keys,omitempty"`
+	// The participant's last membership change not yet known to be built upon.
+	Pending *AsyncTripleRatchetUpdate `protobuf:"bytes,14,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Updates received before the epoch they were made on, oldest first.
+	HeldUpdates []*AsyncTripleRatchetHeldUpdate `protobuf:"bytes,15,rep,name=held_updates,json=heldUpdates,proto3" json:"held_updates,omitempty"`
+}
+
+func (x *AsyncTripleRatchetParticipantState) Reset() {
+	*x = AsyncTripleRatchetParticipantState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncTripleRatchetParticipantState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncTripleRatchetParticipantState) ProtoMessage() {}
+
+func (x *AsyncTripleRatchetParticipantState) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncTripleRatchetParticipantState.ProtoReflect.Descriptor instead.
+func (*AsyncTripleRatchetParticipantState) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetCurve() string {
+	if x != nil {
+		return x.Curve
+	}
+	return ""
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetGroupId() []byte {
+	if x != nil {
+		return x.GroupId
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetPeerKey() []byte {
+	if x != nil {
+		return x.PeerKey
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetIdentityKey() []byte {
+	if x != nil {
+		return x.IdentityKey
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetSignedPreKey() []byte {
+	if x != nil {
+		return x.SignedPreKey
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetMembers() []*AsyncTripleRatchetMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetEpochs() []*AsyncTripleRatchetEpoch {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetSendingChainKey() []byte {
+	if x != nil {
+		return x.SendingChainKey
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetSendingChainLength() uint32 {
+	if x != nil {
+		return x.SendingChainLength
+	}
+	return 0
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetChannels() []*AsyncTripleRatchetChannelState {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetReceivingChains() []*AsyncTripleRatchetChainState {
+	if x != nil {
+		return x.ReceivingChains
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetSkippedKeys() []*SkippedMessageKey {
+	if x != nil {
+		return x.SkippedKeys
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetPending() *AsyncTripleRatchetUpdate {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetParticipantState) GetHeldUpdates() []*AsyncTripleRatchetHeldUpdate {
+	if x != nil {
+		return x.HeldUpdates
+	}
+	return nil
+}
+
+// An update held until the epoch it was made on is known, with the member it
+// was received from.
+type AsyncTripleRatchetHeldUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender []byte                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Update *AsyncTripleRatchetUpdate `protobuf:"bytes,2,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (x *AsyncTripleRatchetHeldUpdate) Reset() {
+	*x = AsyncTripleRatchetHeldUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channel_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncTripleRatchetHeldUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncTripleRatchetHeldUpdate) ProtoMessage() {}
+
+func (x *AsyncTripleRatchetHeldUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_channel_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncTripleRatchetHeldUpdate.ProtoReflect.Descriptor instead.
+func (*AsyncTripleRatchetHeldUpdate) Descriptor() ([]byte, []int) {
+	return file_channel_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AsyncTripleRatchetHeldUpdate) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *AsyncTripleRatchetHeldUpdate) GetUpdate() *AsyncTripleRatchetUpdate {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+var File_channel_proto protoreflect.FileDescriptor
+
+var file_channel_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x1a, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
+	0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x1a, 0x0a, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x01, 0x0a, 0x12, 0x50, 0x32, 0x50, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2f,
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x54, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62,
+	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x71, 0x75,
+	0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x12, 0x33, 0x0a,
+	0x15, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x73, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x22, 0xf9, 0x01, 0x0a, 0x16,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x12, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x6b, 0x65,
+	0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x10, 0x70, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x64,
+	0x34, 0x34, 0x38, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x69, 0x6c,
+	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x34, 0x34, 0x38, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x48, 0x00, 0x52, 0x18, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x64, 0x34, 0x34, 0x38, 0x42, 0x17,
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3f, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x64, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
+	0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xbb, 0x01, 0x0a, 0x13,
+	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x79,
+	0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x15, 0x4b, 0x65,
+	0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x69, 0x6c,
+	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b,
+	0x65, 0x79, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x4e, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62,
+	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x0b,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x66, 0x0a, 0x1a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x64, 0x34, 0x34,
+	0x38, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62,
+	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x64, 0x34, 0x34, 0x38, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x48, 0x00, 0x52, 0x17, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x64, 0x34, 0x34, 0x38, 0x42, 0x18, 0x0a, 0x16, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xea, 0x01, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x66,
+	0x0a, 0x1a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x64, 0x34, 0x34, 0x38, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x34,
+	0x34, 0x38, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x17, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x45, 0x64, 0x34, 0x34, 0x38, 0x42, 0x1a, 0x0a, 0x18, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x11, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x93, 0x07, 0x0a, 0x1d, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x73, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x1a, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
+	0x6c, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
+	0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2a,
+	0x0a, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x1c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x17, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x53,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12,
+	0x39, 0x0a, 0x19, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e,
+	0x67, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e,
+	0x67, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x1c, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x19, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x41, 0x0a, 0x1d, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x1a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x43,
+	0x0a, 0x1e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x1f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x0c, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f,
+	0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x52,
+	0x0b, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x9e, 0x09, 0x0a,
+	0x0c, 0x46, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x75,
+	0x72, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x7e, 0x0a, 0x18, 0x66, 0x72, 0x61, 0x67, 0x73,
+	0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x71, 0x75, 0x69, 0x6c,
+	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x46, 0x72, 0x61, 0x67, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x16, 0x66, 0x72, 0x61, 0x67, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x19, 0x66, 0x72, 0x61, 0x67,
+	0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x71, 0x75,
+	0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x72, 0x61, 0x67, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x17, 0x66, 0x72, 0x61, 0x67, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x7a,
+	0x6b, 0x70, 0x6f, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x7a, 0x6b, 0x70, 0x6f,
+	0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x8d, 0x01, 0x0a, 0x1d, 0x7a, 0x6b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x71, 0x75, 0x69,
+	0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x5a, 0x6b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x72,
+	0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1b, 0x7a, 0x6b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x1a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62,
+	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x18, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x49, 0x0a, 0x1b, 0x46, 0x72, 0x61,
+	0x67, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4a, 0x0a, 0x1c, 0x46, 0x72, 0x61, 0x67, 0x73, 0x46, 0x72, 0x6f,
+	0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x4e, 0x0a, 0x20, 0x5a, 0x6b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x72, 0x6f,
+	0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x4b, 0x0a, 0x1d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf3, 0x03,
+	0x0a, 0x16, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72,
+	0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
+	0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4b,
+	0x65, 0x79, 0x12, 0x43, 0x0a, 0x1e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x1f, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x1c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x53,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x39, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64,
+	0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x22, 0xe5, 0x05, 0x0a, 0x1d, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x75, 0x72, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x12, 0x41, 0x0a, 0x1d, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x68, 0x65,
+	0x6d, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1a, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2a,
+	0x0a, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x12, 0x3f, 0x0a, 0x1c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x41, 0x0a, 0x1d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x48, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62,
+	0x2e, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x50,
+	0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75,
+	0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4b, 0x65, 0x79, 0x52, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x49, 0x0a, 0x0b, 0x64, 0x6b, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0a, 0x64, 0x6b, 0x67, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x22, 0x31, 0x0a, 0x0d, 0x4f,
+	0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xbb,
+	0x02, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b,
+	0x65, 0x79, 0x12, 0x29, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x52, 0x0a, 0x10, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x70, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69,
+	0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x70, 0x62, 0x2e, 0x45, 0x64, 0x34, 0x34, 0x38, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x81, 0x02, 0x0a,
+	0x12, 0x58, 0x33, 0x44, 0x48, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65,
+	0x72, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65,
+	0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x11, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72,
+	0x65, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x13, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x4b,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72,
+	0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x22, 0x3c, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x8c,
+	0x02, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x50,
+	0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62,
+	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x29, 0x0a, 0x11, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0e, 0x6f, 0x6e, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x60, 0x0a, 0x18, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70,
+	0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x4f,
+	0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x18, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x18, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x72,
+	0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x65, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0xb1, 0x03, 0x0a, 0x18, 0x41, 0x73, 0x79, 0x6e, 0x63,
+	0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x53,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3f, 0x2e, 0x71,
+	0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x54,
+	0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x4e,
+	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64,
+	0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3c, 0x0a, 0x0a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x41,
+	0x54, 0x43, 0x48, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x44, 0x44, 0x5f, 0x4d,
+	0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x22, 0x98, 0x01, 0x0a, 0x17, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x26, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xed, 0x01, 0x0a, 0x1e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x54,
+	0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x55, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x71, 0x75, 0x69, 0x6c,
+	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x12, 0x55,
+	0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f,
+	0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x22, 0x95, 0x01, 0x0a, 0x1c, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x54,
+	0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x8a, 0x07,
+	0x0a, 0x22, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x75, 0x72, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a,
+	0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x4e, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75,
+	0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x14,
+	0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x56,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3a, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f,
+	0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x73,
+	0x79, 0x6e, 0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x63, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f,
+	0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x73,
+	0x79, 0x6e, 0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x0c, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e,
+	0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79,
+	0x52, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x4e, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
+	0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x5b, 0x0a,
+	0x0c, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x68,
+	0x65, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x1c, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x74, 0x48, 0x65, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x32, 0x80, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x6f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x32, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72,
+	0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x71, 0x75, 0x69,
+	0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x71,
+	0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x75,
+	0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x65, 0x70,
+	0x6f, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_channel_proto_rawDescOnce sync.Once
+	file_channel_proto_rawDescData = file_channel_proto_rawDesc
+)
+
+func file_channel_proto_rawDescGZIP() []byte {
+	file_channel_proto_rawDescOnce.Do(func() {
+		file_channel_proto_rawDescData = protoimpl.X.CompressGZIP(file_channel_proto_rawDescData)
+	})
+	return file_channel_proto_rawDescData
+}
+
+var file_channel_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_channel_proto_goTypes = []interface{}{
+	(AsyncTripleRatchetUpdate_UpdateType)(0),   // 0: quilibrium.node.channel.pb.AsyncTripleRatchetUpdate.UpdateType
+	(*P2PChannelEnvelope)(nil),                 // 1: quilibrium.node.channel.pb.P2PChannelEnvelope
+	(*MessageCiphertext)(nil),                  // 2: quilibrium.node.channel.pb.MessageCiphertext
+	(*ProvingKeyAnnouncement)(nil),             // 3: quilibrium.node.channel.pb.ProvingKeyAnnouncement
+	(*ProvingKeyRequest)(nil),                  // 4: quilibrium.node.channel.pb.ProvingKeyRequest
+	(*InclusionAggregateProof)(nil),            // 5: quilibrium.node.channel.pb.InclusionAggregateProof
+	(*InclusionCommitment)(nil),                // 6: quilibrium.node.channel.pb.InclusionCommitment
+	(*KeyBundleAnnouncement)(nil),              // 7: quilibrium.node.channel.pb.KeyBundleAnnouncement
+	(*IdentityKey)(nil),                        // 8: quilibrium.node.channel.pb.IdentityKey
+	(*SignedPreKey)(nil),                       // 9: quilibrium.node.channel.pb.SignedPreKey
+	(*SkippedMessageKey)(nil),                  // 10: quilibrium.node.channel.pb.SkippedMessageKey
+	(*DoubleRatchetParticipantState)(nil),      // 11: quilibrium.node.channel.pb.DoubleRatchetParticipantState
+	(*FeldmanState)(nil),                       // 12: quilibrium.node.channel.pb.FeldmanState
+	(*TripleRatchetPeerState)(nil),             // 13: quilibrium.node.channel.pb.TripleRatchetPeerState
+	(*TripleRatchetParticipantState)(nil),      // 14: quilibrium.node.channel.pb.TripleRatchetParticipantState
+	(*OneTimePreKey)(nil),                      // 15: quilibrium.node.channel.pb.OneTimePreKey
+	(*PreKeyBundle)(nil),                       // 16: quilibrium.node.channel.pb.PreKeyBundle
+	(*X3DHInitialMessage)(nil),                 // 17: quilibrium.node.channel.pb.X3DHInitialMessage
+	(*PreKeyRecord)(nil),                       // 18: quilibrium.node.channel.pb.PreKeyRecord
+	(*PreKeyState)(nil),                        // 19: quilibrium.node.channel.pb.PreKeyState
+	(*GetPreKeyBundleRequest)(nil),             // 20: quilibrium.node.channel.pb.GetPreKeyBundleRequest
+	(*AsyncTripleRatchetMember)(nil),           // 21: quilibrium.node.channel.pb.AsyncTripleRatchetMember
+	(*AsyncTripleRatchetUpdate)(nil),           // 22: quilibrium.node.channel.pb.AsyncTripleRatchetUpdate
+	(*AsyncTripleRatchetEpoch)(nil),            // 23: quilibrium.node.channel.pb.AsyncTripleRatchetEpoch
+	(*AsyncTripleRatchetChannelState)(nil),     // 24: quilibrium.node.channel.pb.AsyncTripleRatchetChannelState
+	(*AsyncTripleRatchetChainState)(nil),       // 25: quilibrium.node.channel.pb.AsyncTripleRatchetChainState
+	(*AsyncTripleRatchetParticipantState)(nil), // 26: quilibrium.node.channel.pb.AsyncTripleRatchetParticipantState
+	(*AsyncTripleRatchetHeldUpdate)(nil),       // 27: quilibrium.node.channel.pb.AsyncTripleRatchetHeldUpdate
+	nil,                                        // 28: quilibrium.node.channel.pb.FeldmanState.FragsForCounterpartiesEntry
+	nil,                                        // 29: quilibrium.node.channel.pb.FeldmanState.FragsFromCounterpartiesEntry
+	nil,                                        // 30: quilibrium.node.channel.pb.FeldmanState.ZkcommitsFromCounterpartiesEntry
+	nil,                                        // 31: quilibrium.node.channel.pb.FeldmanState.PointsFromCounterpartiesEntry
+	(*Ed448Signature)(nil),                     // 32: quilibrium.node.keys.pb.Ed448Signature
+}
+var file_channel_proto_depIdxs = []int32{
+	2,  // 0: quilibrium.node.channel.pb.P2PChannelEnvelope.message_header:type_name -> quilibrium.node.channel.pb.MessageCiphertext
+	2,  // 1: quilibrium.node.channel.pb.P2PChannelEnvelope.message_body:type_name -> quilibrium.node.channel.pb.MessageCiphertext
+	32, // 2: quilibrium.node.channel.pb.ProvingKeyAnnouncement.proving_key_signature_ed448:type_name -> quilibrium.node.keys.pb.Ed448Signature
+	6,  // 3: quilibrium.node.channel.pb.InclusionAggregateProof.inclusion_commitments:type_name -> quilibrium.node.channel.pb.InclusionCommitment
+	8,  // 4: quilibrium.node.channel.pb.KeyBundleAnnouncement.identity_key:type_name -> quilibrium.node.channel.pb.IdentityKey
+	9,  // 5: quilibrium.node.channel.pb.KeyBundleAnnouncement.signed_pre_key:type_name -> quilibrium.node.channel.pb.SignedPreKey
+	32, // 6: quilibrium.node.channel.pb.IdentityKey.public_key_signature_ed448:type_name -> quilibrium.node.keys.pb.Ed448Signature
+	32, // 7: quilibrium.node.channel.pb.SignedPreKey.public_key_signature_ed448:type_name -> quilibrium.node.keys.pb.Ed448Signature
+	10, // 8: quilibrium.node.channel.pb.DoubleRatchetParticipantState.skipped_keys:type_name -> quilibrium.node.channel.pb.SkippedMessageKey
+	28, // 9: quilibrium.node.channel.pb.FeldmanState.frags_for_counterparties:type_name -> quilibrium.node.channel.pb.FeldmanState.FragsForCounterpartiesEntry
+	29, // 10: quilibrium.node.channel.pb.FeldmanState.frags_from_counterparties:type_name -> quilibrium.node.channel.pb.FeldmanState.FragsFromCounterpartiesEntry
+	30, // 11: quilibrium.node.channel.pb.FeldmanState.zkcommits_from_counterparties:type_name -> quilibrium.node.channel.pb.FeldmanState.ZkcommitsFromCounterpartiesEntry
+	31, // 12: quilibrium.node.channel.pb.FeldmanState.points_from_counterparties:type_name -> quilibrium.node.channel.pb.FeldmanState.PointsFromCounterpartiesEntry
+	11, // 13: quilibrium.node.channel.pb.TripleRatchetPeerState.channel:type_name -> quilibrium.node.channel.pb.DoubleRatchetParticipantState
+	13, // 14: quilibrium.node.channel.pb.TripleRatchetParticipantState.peers:type_name -> quilibrium.node.channel.pb.TripleRatchetPeerState
+	10, // 15: quilibrium.node.channel.pb.TripleRatchetParticipantState.skipped_keys:type_name -> quilibrium.node.channel.pb.SkippedMessageKey
+	12, // 16: quilibrium.node.channel.pb.TripleRatchetParticipantState.dkg_ratchet:type_name -> quilibrium.node.channel.pb.FeldmanState
+	15, // 17: quilibrium.node.channel.pb.PreKeyBundle.one_time_pre_key:type_name -> quilibrium.node.channel.pb.OneTimePreKey
+	32, // 18: quilibrium.node.channel.pb.PreKeyBundle.signature:type_name -> quilibrium.node.keys.pb.Ed448Signature
+	1,  // 19: quilibrium.node.channel.pb.X3DHInitialMessage.envelope:type_name -> quilibrium.node.channel.pb.P2PChannelEnvelope
+	18, // 20: quilibrium.node.channel.pb.PreKeyState.signed_pre_keys:type_name -> quilibrium.node.channel.pb.PreKeyRecord
+	18, // 21: quilibrium.node.channel.pb.PreKeyState.issued_one_time_pre_keys:type_name -> quilibrium.node.channel.pb.PreKeyRecord
+	0,  // 22: quilibrium.node.channel.pb.AsyncTripleRatchetUpdate.type:type_name -> quilibrium.node.channel.pb.AsyncTripleRatchetUpdate.UpdateType
+	21, // 23: quilibrium.node.channel.pb.AsyncTripleRatchetUpdate.members:type_name -> quilibrium.node.channel.pb.AsyncTripleRatchetMember
+	11, // 24: quilibrium.node.channel.pb.AsyncTripleRatchetChannelState.outgoing:type_name -> quilibrium.node.channel.pb.DoubleRatchetParticipantState
+	11, // 25: quilibrium.node.channel.pb.AsyncTripleRatchetChannelState.incoming:type_name -> quilibrium.node.channel.pb.DoubleRatchetParticipantState
+	21, // 26: quilibrium.node.channel.pb.AsyncTripleRatchetParticipantState.members:type_name -> quilibrium.node.channel.pb.AsyncTripleRatchetMember
+	23, // 27: quilibrium.node.channel.pb.AsyncTripleRatchetParticipantState.epochs:type_name -> quilibrium.node.channel.pb.AsyncTripleRatchetEpoch
+	24, // 28: quilibrium.node.channel.pb.AsyncTripleRatchetParticipantState.channels:type_name -> quilibrium.node.channel.pb.AsyncTripleRatchetChannelState
+	25, // 29: quilibrium.node.channel.pb.AsyncTripleRatchetParticipantState.receiving_chains:type_name -> quilibrium.node.channel.pb.AsyncTripleRatchetChainState
+	10, // 30: quilibrium.node.channel.pb.AsyncTripleRatchetParticipantState.skipped_keys:type_name -> quilibrium.node.channel.pb.SkippedMessageKey
+	22, // 31: quilibrium.node.channel.pb.AsyncTripleRatchetParticipantState.pending:type_name -> quilibrium.node.channel.pb.AsyncTripleRatchetUpdate
+	27, // 32: quilibrium.node.channel.pb.AsyncTripleRatchetParticipantState.held_updates:type_name -> quilibrium.node.channel.pb.AsyncTripleRatchetHeldUpdate
+	22, // 33: quilibrium.node.channel.pb.AsyncTripleRatchetHeldUpdate.update:type_name -> quilibrium.node.channel.pb.AsyncTripleRatchetUpdate
+	20, // 34: quilibrium.node.channel.pb.PreKeyService.GetPreKeyBundle:input_type -> quilibrium.node.channel.pb.GetPreKeyBundleRequest
+	16, // 35: quilibrium.node.channel.pb.PreKeyService.GetPreKeyBundle:output_type -> quilibrium.node.channel.pb.PreKeyBundle
+	35, // [35:36] is the sub-list for method output_type
+	34, // [34:35] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_channel_proto_init() }
+func file_channel_proto_init() {
+	if File_channel_proto != nil {
+		return
+	}
+	file_keys_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_channel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*P2PChannelEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageCiphertext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvingKeyAnnouncement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvingKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
@@ -1065,6 +3315,222 @@ func file_channel_proto_init() {
 				return nil
 			}
 		}
+		file_channel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkippedMessageKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleRatchetParticipantState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeldmanState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripleRatchetPeerState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripleRatchetParticipantState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneTimePreKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreKeyBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*X3DHInitialMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreKeyRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreKeyState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPreKeyBundleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncTripleRatchetMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncTripleRatchetUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncTripleRatchetEpoch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncTripleRatchetChannelState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncTripleRatchetChainState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncTripleRatchetParticipantState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channel_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncTripleRatchetHeldUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_channel_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*ProvingKeyAnnouncement_ProvingKeySignatureEd448)(nil),
@@ -1080,13 +3546,14 @@ func file_channel_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_channel_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      1,
+			NumMessages:   31,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   1,
 		},
 		GoTypes:           file_channel_proto_goTypes,
 		DependencyIndexes: file_channel_proto_depIdxs,
+		EnumInfos:         file_channel_proto_enumTypes,
 		MessageInfos:      file_channel_proto_msgTypes,
 	}.Build()
 	File_channel_proto = out.File
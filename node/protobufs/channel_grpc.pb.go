@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: channel.proto
+
+package protobufs
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PreKeyService_GetPreKeyBundle_FullMethodName = "/quilibrium.node.channel.pb.PreKeyService/GetPreKeyBundle"
+)
+
+// PreKeyServiceClient is the client API for PreKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PreKeyServiceClient interface {
+	GetPreKeyBundle(ctx context.Context, in *GetPreKeyBundleRequest, opts ...grpc.CallOption) (*PreKeyBundle, error)
+}
+
+type preKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPreKeyServiceClient(cc grpc.ClientConnInterface) PreKeyServiceClient {
+	return &preKeyServiceClient{cc}
+}
+
+func (c *preKeyServiceClient) GetPreKeyBundle(ctx context.Context, in *GetPreKeyBundleRequest, opts ...grpc.CallOption) (*PreKeyBundle, error) {
+	out := new(PreKeyBundle)
+	err := c.cc.Invoke(ctx, PreKeyService_GetPreKeyBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PreKeyServiceServer is the server API for PreKeyService service.
+// All implementations must embed UnimplementedPreKeyServiceServer
+// for forward compatibility
+type PreKeyServiceServer interface {
+	GetPreKeyBundle(context.Context, *GetPreKeyBundleRequest) (*PreKeyBundle, error)
+	mustEmbedUnimplementedPreKeyServiceServer()
+}
+
+// UnimplementedPreKeyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPreKeyServiceServer struct {
+}
+
+func (UnimplementedPreKeyServiceServer) GetPreKeyBundle(context.Context, *GetPreKeyBundleRequest) (*PreKeyBundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreKeyBundle not implemented")
+}
+func (UnimplementedPreKeyServiceServer) mustEmbedUnimplementedPreKeyServiceServer() {}
+
+// UnsafePreKeyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PreKeyServiceServer will
+// result in compilation errors.
+type UnsafePreKeyServiceServer interface {
+	mustEmbedUnimplementedPreKeyServiceServer()
+}
+
+func RegisterPreKeyServiceServer(s grpc.ServiceRegistrar, srv PreKeyServiceServer) {
+	s.RegisterService(&PreKeyService_ServiceDesc, srv)
+}
+
+func _PreKeyService_GetPreKeyBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreKeyBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PreKeyServiceServer).GetPreKeyBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PreKeyService_GetPreKeyBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PreKeyServiceServer).GetPreKeyBundle(ctx, req.(*GetPreKeyBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PreKeyService_ServiceDesc is the grpc.ServiceDesc for PreKeyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PreKeyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "quilibrium.node.channel.pb.PreKeyService",
+	HandlerType: (*PreKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPreKeyBundle",
+			Handler:    _PreKeyService_GetPreKeyBundle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "channel.proto",
+}
@@ -461,6 +461,266 @@ func (x *ClockFramesResponse) GetClockFrames() []*ClockFrame {
 	return nil
 }
 
+// Identifies a data clock frame.
+type FrameRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FrameNumber uint64 `protobuf:"varint,1,opt,name=frame_number,json=frameNumber,proto3" json:"frame_number,omitempty"`
+	Selector    []byte `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (x *FrameRef) Reset() {
+	*x = FrameRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clock_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameRef) ProtoMessage() {}
+
+func (x *FrameRef) ProtoReflect() protoreflect.Message {
+	mi := &file_clock_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameRef.ProtoReflect.Descriptor instead.
+func (*FrameRef) Descriptor() ([]byte, []int) {
+	return file_clock_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FrameRef) GetFrameNumber() uint64 {
+	if x != nil {
+		return x.FrameNumber
+	}
+	return 0
+}
+
+func (x *FrameRef) GetSelector() []byte {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// Records a switch of the data time reel head to a competing branch.
+type ReorgEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldHead *FrameRef `protobuf:"bytes,1,opt,name=old_head,json=oldHead,proto3" json:"old_head,omitempty"`
+	NewHead *FrameRef `protobuf:"bytes,2,opt,name=new_head,json=newHead,proto3" json:"new_head,omitempty"`
+	// The last frame shared by the old and new branches.
+	CommonAncestor *FrameRef `protobuf:"bytes,3,opt,name=common_ancestor,json=commonAncestor,proto3" json:"common_ancestor,omitempty"`
+	// The number of frames dropped from the old branch.
+	Depth uint64 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	// The total distances of the old and new branches from the common
+	// ancestor, compared to choose the new head.
+	OldDistance []byte `protobuf:"bytes,5,opt,name=old_distance,json=oldDistance,proto3" json:"old_distance,omitempty"`
+	NewDistance []byte `protobuf:"bytes,6,opt,name=new_distance,json=newDistance,proto3" json:"new_distance,omitempty"`
+	// The frames dropped from the old branch, lowest first.
+	DroppedFrames []*FrameRef `protobuf:"bytes,7,rep,name=dropped_frames,json=droppedFrames,proto3" json:"dropped_frames,omitempty"`
+	// The time of the switch, in milliseconds since the epoch.
+	Timestamp int64 `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ReorgEvent) Reset() {
+	*x = ReorgEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clock_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorgEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorgEvent) ProtoMessage() {}
+
+func (x *ReorgEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_clock_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorgEvent.ProtoReflect.Descriptor instead.
+func (*ReorgEvent) Descriptor() ([]byte, []int) {
+	return file_clock_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReorgEvent) GetOldHead() *FrameRef {
+	if x != nil {
+		return x.OldHead
+	}
+	return nil
+}
+
+func (x *ReorgEvent) GetNewHead() *FrameRef {
+	if x != nil {
+		return x.NewHead
+	}
+	return nil
+}
+
+func (x *ReorgEvent) GetCommonAncestor() *FrameRef {
+	if x != nil {
+		return x.CommonAncestor
+	}
+	return nil
+}
+
+func (x *ReorgEvent) GetDepth() uint64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ReorgEvent) GetOldDistance() []byte {
+	if x != nil {
+		return x.OldDistance
+	}
+	return nil
+}
+
+func (x *ReorgEvent) GetNewDistance() []byte {
+	if x != nil {
+		return x.NewDistance
+	}
+	return nil
+}
+
+func (x *ReorgEvent) GetDroppedFrames() []*FrameRef {
+	if x != nil {
+		return x.DroppedFrames
+	}
+	return nil
+}
+
+func (x *ReorgEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// A branch of frames competing with the data time reel head.
+type ForkBranch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tip *FrameRef `protobuf:"bytes,1,opt,name=tip,proto3" json:"tip,omitempty"`
+	// The parent of the first frame of the branch.
+	Ancestor *FrameRef `protobuf:"bytes,2,opt,name=ancestor,proto3" json:"ancestor,omitempty"`
+	// Whether the ancestor is on the chain of the head. If not, the branch
+	// descends from frames the time reel has not seen.
+	Connected bool `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
+	// The number of frames in the branch.
+	Length uint64 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	// The total distance of the frames of the branch.
+	Distance []byte `protobuf:"bytes,5,opt,name=distance,proto3" json:"distance,omitempty"`
+	// The time the branch last grew, in milliseconds since the epoch.
+	LastSeen int64 `protobuf:"varint,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+}
+
+func (x *ForkBranch) Reset() {
+	*x = ForkBranch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clock_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkBranch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkBranch) ProtoMessage() {}
+
+func (x *ForkBranch) ProtoReflect() protoreflect.Message {
+	mi := &file_clock_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkBranch.ProtoReflect.Descriptor instead.
+func (*ForkBranch) Descriptor() ([]byte, []int) {
+	return file_clock_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ForkBranch) GetTip() *FrameRef {
+	if x != nil {
+		return x.Tip
+	}
+	return nil
+}
+
+func (x *ForkBranch) GetAncestor() *FrameRef {
+	if x != nil {
+		return x.Ancestor
+	}
+	return nil
+}
+
+func (x *ForkBranch) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *ForkBranch) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *ForkBranch) GetDistance() []byte {
+	if x != nil {
+		return x.Distance
+	}
+	return nil
+}
+
+func (x *ForkBranch) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
 var File_clock_proto protoreflect.FileDescriptor
 
 var file_clock_proto_rawDesc = []byte{
@@ -541,11 +801,57 @@ var file_clock_proto_rawDesc = []byte{
 	0x24, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64,
 	0x65, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
 	0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d,
-	0x65, 0x73, 0x42, 0x3a, 0x5a, 0x38, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x71, 0x75, 0x69,
-	0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x75, 0x69, 0x6c,
-	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x65, 0x70, 0x6f, 0x2f,
-	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x22, 0x49, 0x0a, 0x08, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x12, 0x21,
+	0x0a, 0x0c, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x9c, 0x03,
+	0x0a, 0x0a, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x08,
+	0x6f, 0x6c, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
+	0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x66, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x48, 0x65, 0x61, 0x64, 0x12, 0x3d, 0x0a, 0x08, 0x6e,
+	0x65, 0x77, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
+	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x66, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x48, 0x65, 0x61, 0x64, 0x12, 0x4b, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41,
+	0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x44, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x71, 0x75,
+	0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x52,
+	0x0d, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xf1, 0x01, 0x0a,
+	0x0a, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x34, 0x0a, 0x03, 0x74,
+	0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69,
+	0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x52, 0x03, 0x74, 0x69,
+	0x70, 0x12, 0x3e, 0x0a, 0x08, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x42, 0x3a, 0x5a, 0x38, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69,
+	0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62,
+	0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -560,27 +866,36 @@ func file_clock_proto_rawDescGZIP() []byte {
 	return file_clock_proto_rawDescData
 }
 
-var file_clock_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_clock_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_clock_proto_goTypes = []interface{}{
 	(*ClockFrame)(nil),                // 0: quilibrium.node.clock.pb.ClockFrame
 	(*ClockFrameParentSelectors)(nil), // 1: quilibrium.node.clock.pb.ClockFrameParentSelectors
 	(*ClockFramesRequest)(nil),        // 2: quilibrium.node.clock.pb.ClockFramesRequest
 	(*ClockFramesPreflight)(nil),      // 3: quilibrium.node.clock.pb.ClockFramesPreflight
 	(*ClockFramesResponse)(nil),       // 4: quilibrium.node.clock.pb.ClockFramesResponse
-	(*InclusionAggregateProof)(nil),   // 5: quilibrium.node.channel.pb.InclusionAggregateProof
-	(*Ed448Signature)(nil),            // 6: quilibrium.node.keys.pb.Ed448Signature
+	(*FrameRef)(nil),                  // 5: quilibrium.node.clock.pb.FrameRef
+	(*ReorgEvent)(nil),                // 6: quilibrium.node.clock.pb.ReorgEvent
+	(*ForkBranch)(nil),                // 7: quilibrium.node.clock.pb.ForkBranch
+	(*InclusionAggregateProof)(nil),   // 8: quilibrium.node.channel.pb.InclusionAggregateProof
+	(*Ed448Signature)(nil),            // 9: quilibrium.node.keys.pb.Ed448Signature
 }
 var file_clock_proto_depIdxs = []int32{
-	5, // 0: quilibrium.node.clock.pb.ClockFrame.aggregate_proofs:type_name -> quilibrium.node.channel.pb.InclusionAggregateProof
-	6, // 1: quilibrium.node.clock.pb.ClockFrame.public_key_signature_ed448:type_name -> quilibrium.node.keys.pb.Ed448Signature
-	1, // 2: quilibrium.node.clock.pb.ClockFramesRequest.range_parent_selectors:type_name -> quilibrium.node.clock.pb.ClockFrameParentSelectors
-	1, // 3: quilibrium.node.clock.pb.ClockFramesPreflight.range_parent_selectors:type_name -> quilibrium.node.clock.pb.ClockFrameParentSelectors
-	0, // 4: quilibrium.node.clock.pb.ClockFramesResponse.clock_frames:type_name -> quilibrium.node.clock.pb.ClockFrame
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	8,  // 0: quilibrium.node.clock.pb.ClockFrame.aggregate_proofs:type_name -> quilibrium.node.channel.pb.InclusionAggregateProof
+	9,  // 1: quilibrium.node.clock.pb.ClockFrame.public_key_signature_ed448:type_name -> quilibrium.node.keys.pb.Ed448Signature
+	1,  // 2: quilibrium.node.clock.pb.ClockFramesRequest.range_parent_selectors:type_name -> quilibrium.node.clock.pb.ClockFrameParentSelectors
+	1,  // 3: quilibrium.node.clock.pb.ClockFramesPreflight.range_parent_selectors:type_name -> quilibrium.node.clock.pb.ClockFrameParentSelectors
+	0,  // 4: quilibrium.node.clock.pb.ClockFramesResponse.clock_frames:type_name -> quilibrium.node.clock.pb.ClockFrame
+	5,  // 5: quilibrium.node.clock.pb.ReorgEvent.old_head:type_name -> quilibrium.node.clock.pb.FrameRef
+	5,  // 6: quilibrium.node.clock.pb.ReorgEvent.new_head:type_name -> quilibrium.node.clock.pb.FrameRef
+	5,  // 7: quilibrium.node.clock.pb.ReorgEvent.common_ancestor:type_name -> quilibrium.node.clock.pb.FrameRef
+	5,  // 8: quilibrium.node.clock.pb.ReorgEvent.dropped_frames:type_name -> quilibrium.node.clock.pb.FrameRef
+	5,  // 9: quilibrium.node.clock.pb.ForkBranch.tip:type_name -> quilibrium.node.clock.pb.FrameRef
+	5,  // 10: quilibrium.node.clock.pb.ForkBranch.ancestor:type_name -> quilibrium.node.clock.pb.FrameRef
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_clock_proto_init() }
@@ -651,6 +966,42 @@ func file_clock_proto_init() {
 				return nil
 			}
 		}
+		file_clock_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clock_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorgEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clock_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkBranch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_clock_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*ClockFrame_PublicKeySignatureEd448)(nil),
@@ -661,7 +1012,7 @@ func file_clock_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_clock_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -25,6 +25,7 @@ const (
 	DataService_GetDataFrame_FullMethodName                  = "/quilibrium.node.data.pb.DataService/GetDataFrame"
 	DataService_HandlePreMidnightMint_FullMethodName         = "/quilibrium.node.data.pb.DataService/HandlePreMidnightMint"
 	DataService_GetPreMidnightMintStatus_FullMethodName      = "/quilibrium.node.data.pb.DataService/GetPreMidnightMintStatus"
+	DataService_GetCheckpoint_FullMethodName                 = "/quilibrium.node.data.pb.DataService/GetCheckpoint"
 )
 
 // DataServiceClient is the client API for DataService service.
@@ -37,6 +38,7 @@ type DataServiceClient interface {
 	GetDataFrame(ctx context.Context, in *GetDataFrameRequest, opts ...grpc.CallOption) (*DataFrameResponse, error)
 	HandlePreMidnightMint(ctx context.Context, in *MintCoinRequest, opts ...grpc.CallOption) (*PreMidnightMintResponse, error)
 	GetPreMidnightMintStatus(ctx context.Context, in *PreMidnightMintStatusRequest, opts ...grpc.CallOption) (*PreMidnightMintResponse, error)
+	GetCheckpoint(ctx context.Context, in *GetCheckpointRequest, opts ...grpc.CallOption) (DataService_GetCheckpointClient, error)
 }
 
 type dataServiceClient struct {
@@ -168,6 +170,38 @@ func (c *dataServiceClient) GetPreMidnightMintStatus(ctx context.Context, in *Pr
 	return out, nil
 }
 
+func (c *dataServiceClient) GetCheckpoint(ctx context.Context, in *GetCheckpointRequest, opts ...grpc.CallOption) (DataService_GetCheckpointClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DataService_ServiceDesc.Streams[3], DataService_GetCheckpoint_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dataServiceGetCheckpointClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DataService_GetCheckpointClient interface {
+	Recv() (*CheckpointResponse, error)
+	grpc.ClientStream
+}
+
+type dataServiceGetCheckpointClient struct {
+	grpc.ClientStream
+}
+
+func (x *dataServiceGetCheckpointClient) Recv() (*CheckpointResponse, error) {
+	m := new(CheckpointResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DataServiceServer is the server API for DataService service.
 // All implementations must embed UnimplementedDataServiceServer
 // for forward compatibility
@@ -178,6 +212,7 @@ type DataServiceServer interface {
 	GetDataFrame(context.Context, *GetDataFrameRequest) (*DataFrameResponse, error)
 	HandlePreMidnightMint(context.Context, *MintCoinRequest) (*PreMidnightMintResponse, error)
 	GetPreMidnightMintStatus(context.Context, *PreMidnightMintStatusRequest) (*PreMidnightMintResponse, error)
+	GetCheckpoint(*GetCheckpointRequest, DataService_GetCheckpointServer) error
 	mustEmbedUnimplementedDataServiceServer()
 }
 
@@ -203,6 +238,9 @@ func (UnimplementedDataServiceServer) HandlePreMidnightMint(context.Context, *Mi
 func (UnimplementedDataServiceServer) GetPreMidnightMintStatus(context.Context, *PreMidnightMintStatusRequest) (*PreMidnightMintResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPreMidnightMintStatus not implemented")
 }
+func (UnimplementedDataServiceServer) GetCheckpoint(*GetCheckpointRequest, DataService_GetCheckpointServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetCheckpoint not implemented")
+}
 func (UnimplementedDataServiceServer) mustEmbedUnimplementedDataServiceServer() {}
 
 // UnsafeDataServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -343,6 +381,27 @@ func _DataService_GetPreMidnightMintStatus_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataService_GetCheckpoint_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCheckpointRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServiceServer).GetCheckpoint(m, &dataServiceGetCheckpointServer{stream})
+}
+
+type DataService_GetCheckpointServer interface {
+	Send(*CheckpointResponse) error
+	grpc.ServerStream
+}
+
+type dataServiceGetCheckpointServer struct {
+	grpc.ServerStream
+}
+
+func (x *dataServiceGetCheckpointServer) Send(m *CheckpointResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // DataService_ServiceDesc is the grpc.ServiceDesc for DataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +440,11 @@ var DataService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "GetCheckpoint",
+			Handler:       _DataService_GetCheckpoint_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "data.proto",
 }
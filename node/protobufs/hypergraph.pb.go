@@ -0,0 +1,1458 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: hypergraph.proto
+
+package protobufs
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The add and remove sets of a hypergraph shard. Replicas converge by taking
+// the union of each set.
+type HypergraphSet int32
+
+const (
+	HypergraphSet_HYPERGRAPH_SET_VERTEX_ADDS       HypergraphSet = 0
+	HypergraphSet_HYPERGRAPH_SET_VERTEX_REMOVES    HypergraphSet = 1
+	HypergraphSet_HYPERGRAPH_SET_HYPEREDGE_ADDS    HypergraphSet = 2
+	HypergraphSet_HYPERGRAPH_SET_HYPEREDGE_REMOVES HypergraphSet = 3
+)
+
+// Enum value maps for HypergraphSet.
+var (
+	HypergraphSet_name = map[int32]string{
+		0: "HYPERGRAPH_SET_VERTEX_ADDS",
+		1: "HYPERGRAPH_SET_VERTEX_REMOVES",
+		2: "HYPERGRAPH_SET_HYPEREDGE_ADDS",
+		3: "HYPERGRAPH_SET_HYPEREDGE_REMOVES",
+	}
+	HypergraphSet_value = map[string]int32{
+		"HYPERGRAPH_SET_VERTEX_ADDS":       0,
+		"HYPERGRAPH_SET_VERTEX_REMOVES":    1,
+		"HYPERGRAPH_SET_HYPEREDGE_ADDS":    2,
+		"HYPERGRAPH_SET_HYPEREDGE_REMOVES": 3,
+	}
+)
+
+func (x HypergraphSet) Enum() *HypergraphSet {
+	p := new(HypergraphSet)
+	*p = x
+	return p
+}
+
+func (x HypergraphSet) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HypergraphSet) Descriptor() protoreflect.EnumDescriptor {
+	return file_hypergraph_proto_enumTypes[0].Descriptor()
+}
+
+func (HypergraphSet) Type() protoreflect.EnumType {
+	return &file_hypergraph_proto_enumTypes[0]
+}
+
+func (x HypergraphSet) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HypergraphSet.Descriptor instead.
+func (HypergraphSet) EnumDescriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{0}
+}
+
+type HypergraphShardDigestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShardAddress []byte `protobuf:"bytes,1,opt,name=shard_address,json=shardAddress,proto3" json:"shard_address,omitempty"`
+}
+
+func (x *HypergraphShardDigestRequest) Reset() {
+	*x = HypergraphShardDigestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypergraphShardDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypergraphShardDigestRequest) ProtoMessage() {}
+
+func (x *HypergraphShardDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypergraphShardDigestRequest.ProtoReflect.Descriptor instead.
+func (*HypergraphShardDigestRequest) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HypergraphShardDigestRequest) GetShardAddress() []byte {
+	if x != nil {
+		return x.ShardAddress
+	}
+	return nil
+}
+
+// The root hash of each set of the shard, in HypergraphSet order. A root is
+// the hash of the set's bucket hashes.
+type HypergraphShardDigestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SetRoots [][]byte `protobuf:"bytes,1,rep,name=set_roots,json=setRoots,proto3" json:"set_roots,omitempty"`
+}
+
+func (x *HypergraphShardDigestResponse) Reset() {
+	*x = HypergraphShardDigestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypergraphShardDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypergraphShardDigestResponse) ProtoMessage() {}
+
+func (x *HypergraphShardDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypergraphShardDigestResponse.ProtoReflect.Descriptor instead.
+func (*HypergraphShardDigestResponse) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HypergraphShardDigestResponse) GetSetRoots() [][]byte {
+	if x != nil {
+		return x.SetRoots
+	}
+	return nil
+}
+
+type HypergraphBucketDigestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShardAddress []byte        `protobuf:"bytes,1,opt,name=shard_address,json=shardAddress,proto3" json:"shard_address,omitempty"`
+	Set          HypergraphSet `protobuf:"varint,2,opt,name=set,proto3,enum=quilibrium.node.hypergraph.pb.HypergraphSet" json:"set,omitempty"`
+}
+
+func (x *HypergraphBucketDigestsRequest) Reset() {
+	*x = HypergraphBucketDigestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypergraphBucketDigestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypergraphBucketDigestsRequest) ProtoMessage() {}
+
+func (x *HypergraphBucketDigestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypergraphBucketDigestsRequest.ProtoReflect.Descriptor instead.
+func (*HypergraphBucketDigestsRequest) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HypergraphBucketDigestsRequest) GetShardAddress() []byte {
+	if x != nil {
+		return x.ShardAddress
+	}
+	return nil
+}
+
+func (x *HypergraphBucketDigestsRequest) GetSet() HypergraphSet {
+	if x != nil {
+		return x.Set
+	}
+	return HypergraphSet_HYPERGRAPH_SET_VERTEX_ADDS
+}
+
+// The hash of each bucket of the set. Atoms fall in the bucket of the first
+// byte of the hash of their id, a bucket hash is the hash of its atom ids in
+// order.
+type HypergraphBucketDigestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BucketHashes [][]byte `protobuf:"bytes,1,rep,name=bucket_hashes,json=bucketHashes,proto3" json:"bucket_hashes,omitempty"`
+}
+
+func (x *HypergraphBucketDigestsResponse) Reset() {
+	*x = HypergraphBucketDigestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypergraphBucketDigestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypergraphBucketDigestsResponse) ProtoMessage() {}
+
+func (x *HypergraphBucketDigestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypergraphBucketDigestsResponse.ProtoReflect.Descriptor instead.
+func (*HypergraphBucketDigestsResponse) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HypergraphBucketDigestsResponse) GetBucketHashes() [][]byte {
+	if x != nil {
+		return x.BucketHashes
+	}
+	return nil
+}
+
+type HypergraphAtomIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShardAddress []byte        `protobuf:"bytes,1,opt,name=shard_address,json=shardAddress,proto3" json:"shard_address,omitempty"`
+	Set          HypergraphSet `protobuf:"varint,2,opt,name=set,proto3,enum=quilibrium.node.hypergraph.pb.HypergraphSet" json:"set,omitempty"`
+	Buckets      []uint32      `protobuf:"varint,3,rep,packed,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *HypergraphAtomIdsRequest) Reset() {
+	*x = HypergraphAtomIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypergraphAtomIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypergraphAtomIdsRequest) ProtoMessage() {}
+
+func (x *HypergraphAtomIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypergraphAtomIdsRequest.ProtoReflect.Descriptor instead.
+func (*HypergraphAtomIdsRequest) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HypergraphAtomIdsRequest) GetShardAddress() []byte {
+	if x != nil {
+		return x.ShardAddress
+	}
+	return nil
+}
+
+func (x *HypergraphAtomIdsRequest) GetSet() HypergraphSet {
+	if x != nil {
+		return x.Set
+	}
+	return HypergraphSet_HYPERGRAPH_SET_VERTEX_ADDS
+}
+
+func (x *HypergraphAtomIdsRequest) GetBuckets() []uint32 {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type HypergraphAtomIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AtomIds [][]byte `protobuf:"bytes,1,rep,name=atom_ids,json=atomIds,proto3" json:"atom_ids,omitempty"`
+}
+
+func (x *HypergraphAtomIdsResponse) Reset() {
+	*x = HypergraphAtomIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypergraphAtomIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypergraphAtomIdsResponse) ProtoMessage() {}
+
+func (x *HypergraphAtomIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypergraphAtomIdsResponse.ProtoReflect.Descriptor instead.
+func (*HypergraphAtomIdsResponse) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HypergraphAtomIdsResponse) GetAtomIds() [][]byte {
+	if x != nil {
+		return x.AtomIds
+	}
+	return nil
+}
+
+type HypergraphAtomsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShardAddress []byte        `protobuf:"bytes,1,opt,name=shard_address,json=shardAddress,proto3" json:"shard_address,omitempty"`
+	Set          HypergraphSet `protobuf:"varint,2,opt,name=set,proto3,enum=quilibrium.node.hypergraph.pb.HypergraphSet" json:"set,omitempty"`
+	AtomIds      [][]byte      `protobuf:"bytes,3,rep,name=atom_ids,json=atomIds,proto3" json:"atom_ids,omitempty"`
+}
+
+func (x *HypergraphAtomsRequest) Reset() {
+	*x = HypergraphAtomsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypergraphAtomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypergraphAtomsRequest) ProtoMessage() {}
+
+func (x *HypergraphAtomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypergraphAtomsRequest.ProtoReflect.Descriptor instead.
+func (*HypergraphAtomsRequest) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HypergraphAtomsRequest) GetShardAddress() []byte {
+	if x != nil {
+		return x.ShardAddress
+	}
+	return nil
+}
+
+func (x *HypergraphAtomsRequest) GetSet() HypergraphSet {
+	if x != nil {
+		return x.Set
+	}
+	return HypergraphSet_HYPERGRAPH_SET_VERTEX_ADDS
+}
+
+func (x *HypergraphAtomsRequest) GetAtomIds() [][]byte {
+	if x != nil {
+		return x.AtomIds
+	}
+	return nil
+}
+
+// The requested atoms the set holds, as encoded by the hypergraph.
+type HypergraphAtomsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Atoms [][]byte `protobuf:"bytes,1,rep,name=atoms,proto3" json:"atoms,omitempty"`
+}
+
+func (x *HypergraphAtomsResponse) Reset() {
+	*x = HypergraphAtomsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypergraphAtomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypergraphAtomsResponse) ProtoMessage() {}
+
+func (x *HypergraphAtomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypergraphAtomsResponse.ProtoReflect.Descriptor instead.
+func (*HypergraphAtomsResponse) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HypergraphAtomsResponse) GetAtoms() [][]byte {
+	if x != nil {
+		return x.Atoms
+	}
+	return nil
+}
+
+// An atom returned by a hypergraph query. Its id is the app address, the data
+// address, and the segment order of a vertex or the index of a hyperedge.
+type HypergraphAtom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsHyperedge bool   `protobuf:"varint,2,opt,name=is_hyperedge,json=isHyperedge,proto3" json:"is_hyperedge,omitempty"`
+	// The ids of the atoms a hyperedge contains.
+	ExtrinsicIds [][]byte `protobuf:"bytes,3,rep,name=extrinsic_ids,json=extrinsicIds,proto3" json:"extrinsic_ids,omitempty"`
+}
+
+func (x *HypergraphAtom) Reset() {
+	*x = HypergraphAtom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypergraphAtom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypergraphAtom) ProtoMessage() {}
+
+func (x *HypergraphAtom) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypergraphAtom.ProtoReflect.Descriptor instead.
+func (*HypergraphAtom) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HypergraphAtom) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *HypergraphAtom) GetIsHyperedge() bool {
+	if x != nil {
+		return x.IsHyperedge
+	}
+	return false
+}
+
+func (x *HypergraphAtom) GetExtrinsicIds() [][]byte {
+	if x != nil {
+		return x.ExtrinsicIds
+	}
+	return nil
+}
+
+type GetIncidentHyperedgesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AtomId []byte `protobuf:"bytes,1,opt,name=atom_id,json=atomId,proto3" json:"atom_id,omitempty"`
+}
+
+func (x *GetIncidentHyperedgesRequest) Reset() {
+	*x = GetIncidentHyperedgesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIncidentHyperedgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncidentHyperedgesRequest) ProtoMessage() {}
+
+func (x *GetIncidentHyperedgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncidentHyperedgesRequest.ProtoReflect.Descriptor instead.
+func (*GetIncidentHyperedgesRequest) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetIncidentHyperedgesRequest) GetAtomId() []byte {
+	if x != nil {
+		return x.AtomId
+	}
+	return nil
+}
+
+type GetIncidentHyperedgesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hyperedges []*HypergraphAtom `protobuf:"bytes,1,rep,name=hyperedges,proto3" json:"hyperedges,omitempty"`
+}
+
+func (x *GetIncidentHyperedgesResponse) Reset() {
+	*x = GetIncidentHyperedgesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIncidentHyperedgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncidentHyperedgesResponse) ProtoMessage() {}
+
+func (x *GetIncidentHyperedgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncidentHyperedgesResponse.ProtoReflect.Descriptor instead.
+func (*GetIncidentHyperedgesResponse) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetIncidentHyperedgesResponse) GetHyperedges() []*HypergraphAtom {
+	if x != nil {
+		return x.Hyperedges
+	}
+	return nil
+}
+
+type GetNeighborsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AtomId []byte `protobuf:"bytes,1,opt,name=atom_id,json=atomId,proto3" json:"atom_id,omitempty"`
+	// How many hops to expand to, one lists the atoms sharing a hyperedge with
+	// the atom.
+	Hops uint32 `protobuf:"varint,2,opt,name=hops,proto3" json:"hops,omitempty"`
+	// Whether the atom is a hyperedge, whose extrinsics are then its neighbors
+	// too.
+	IsHyperedge bool `protobuf:"varint,3,opt,name=is_hyperedge,json=isHyperedge,proto3" json:"is_hyperedge,omitempty"`
+}
+
+func (x *GetNeighborsRequest) Reset() {
+	*x = GetNeighborsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNeighborsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNeighborsRequest) ProtoMessage() {}
+
+func (x *GetNeighborsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNeighborsRequest.ProtoReflect.Descriptor instead.
+func (*GetNeighborsRequest) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetNeighborsRequest) GetAtomId() []byte {
+	if x != nil {
+		return x.AtomId
+	}
+	return nil
+}
+
+func (x *GetNeighborsRequest) GetHops() uint32 {
+	if x != nil {
+		return x.Hops
+	}
+	return 0
+}
+
+func (x *GetNeighborsRequest) GetIsHyperedge() bool {
+	if x != nil {
+		return x.IsHyperedge
+	}
+	return false
+}
+
+type HypergraphNeighbor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Atom *HypergraphAtom `protobuf:"bytes,1,opt,name=atom,proto3" json:"atom,omitempty"`
+	Hops uint32          `protobuf:"varint,2,opt,name=hops,proto3" json:"hops,omitempty"`
+}
+
+func (x *HypergraphNeighbor) Reset() {
+	*x = HypergraphNeighbor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypergraphNeighbor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypergraphNeighbor) ProtoMessage() {}
+
+func (x *HypergraphNeighbor) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypergraphNeighbor.ProtoReflect.Descriptor instead.
+func (*HypergraphNeighbor) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HypergraphNeighbor) GetAtom() *HypergraphAtom {
+	if x != nil {
+		return x.Atom
+	}
+	return nil
+}
+
+func (x *HypergraphNeighbor) GetHops() uint32 {
+	if x != nil {
+		return x.Hops
+	}
+	return 0
+}
+
+// The part of the hypergraph within the hops of an atom: the atoms reached
+// and the hyperedges they were reached through.
+type GetNeighborsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Neighbors  []*HypergraphNeighbor `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+	Hyperedges []*HypergraphAtom     `protobuf:"bytes,2,rep,name=hyperedges,proto3" json:"hyperedges,omitempty"`
+	// Whether the expansion stopped at the result limit before reaching every
+	// atom within the hops.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *GetNeighborsResponse) Reset() {
+	*x = GetNeighborsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNeighborsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNeighborsResponse) ProtoMessage() {}
+
+func (x *GetNeighborsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNeighborsResponse.ProtoReflect.Descriptor instead.
+func (*GetNeighborsResponse) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetNeighborsResponse) GetNeighbors() []*HypergraphNeighbor {
+	if x != nil {
+		return x.Neighbors
+	}
+	return nil
+}
+
+func (x *GetNeighborsResponse) GetHyperedges() []*HypergraphAtom {
+	if x != nil {
+		return x.Hyperedges
+	}
+	return nil
+}
+
+func (x *GetNeighborsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type ListAtomsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppAddress []byte `protobuf:"bytes,1,opt,name=app_address,json=appAddress,proto3" json:"app_address,omitempty"`
+	// Restricts the listing to a data address of the app, if set.
+	DataAddress []byte `protobuf:"bytes,2,opt,name=data_address,json=dataAddress,proto3" json:"data_address,omitempty"`
+	// The next_cursor of the previous page, unset for the first page.
+	Cursor []byte `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListAtomsRequest) Reset() {
+	*x = ListAtomsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAtomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAtomsRequest) ProtoMessage() {}
+
+func (x *ListAtomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAtomsRequest.ProtoReflect.Descriptor instead.
+func (*ListAtomsRequest) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListAtomsRequest) GetAppAddress() []byte {
+	if x != nil {
+		return x.AppAddress
+	}
+	return nil
+}
+
+func (x *ListAtomsRequest) GetDataAddress() []byte {
+	if x != nil {
+		return x.DataAddress
+	}
+	return nil
+}
+
+func (x *ListAtomsRequest) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListAtomsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAtomsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Atoms []*HypergraphAtom `protobuf:"bytes,1,rep,name=atoms,proto3" json:"atoms,omitempty"`
+	// The cursor of the next page, unset on the last page.
+	NextCursor []byte `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListAtomsResponse) Reset() {
+	*x = ListAtomsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hypergraph_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAtomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAtomsResponse) ProtoMessage() {}
+
+func (x *ListAtomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hypergraph_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAtomsResponse.ProtoReflect.Descriptor instead.
+func (*ListAtomsResponse) Descriptor() ([]byte, []int) {
+	return file_hypergraph_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAtomsResponse) GetAtoms() []*HypergraphAtom {
+	if x != nil {
+		return x.Atoms
+	}
+	return nil
+}
+
+func (x *ListAtomsResponse) GetNextCursor() []byte {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+var File_hypergraph_proto protoreflect.FileDescriptor
+
+var file_hypergraph_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x1d, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e,
+	0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70,
+	0x62, 0x22, 0x43, 0x0a, 0x1c, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3c, 0x0a, 0x1d, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x53, 0x68, 0x61, 0x72, 0x64, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x65, 0x74, 0x52,
+	0x6f, 0x6f, 0x74, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x1e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x64,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x03,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x71, 0x75, 0x69, 0x6c,
+	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65,
+	0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x53, 0x65, 0x74, 0x52, 0x03, 0x73, 0x65, 0x74, 0x22, 0x46, 0x0a, 0x1f,
+	0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x18, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x41, 0x74, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x53, 0x65,
+	0x74, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x22, 0x36, 0x0a, 0x19, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x41, 0x74,
+	0x6f, 0x6d, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x74, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x74, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x16, 0x48, 0x79, 0x70,
+	0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x53, 0x65, 0x74, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x74, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x74, 0x6f, 0x6d,
+	0x49, 0x64, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x61,
+	0x74, 0x6f, 0x6d, 0x73, 0x22, 0x68, 0x0a, 0x0e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x41, 0x74, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x68, 0x79, 0x70,
+	0x65, 0x72, 0x65, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73,
+	0x48, 0x79, 0x70, 0x65, 0x72, 0x65, 0x64, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74,
+	0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x49, 0x64, 0x73, 0x22, 0x37,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x48, 0x79, 0x70,
+	0x65, 0x72, 0x65, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x61, 0x74, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x61, 0x74, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x48, 0x79, 0x70, 0x65, 0x72, 0x65, 0x64, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x68, 0x79, 0x70, 0x65,
+	0x72, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x71,
+	0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68,
+	0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70,
+	0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x41, 0x74, 0x6f, 0x6d, 0x52, 0x0a, 0x68, 0x79, 0x70,
+	0x65, 0x72, 0x65, 0x64, 0x67, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x61, 0x74, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x61, 0x74, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x73, 0x5f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x65, 0x64, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x48, 0x79, 0x70, 0x65, 0x72, 0x65, 0x64, 0x67, 0x65, 0x22, 0x6b,
+	0x0a, 0x12, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x04, 0x61, 0x74, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e,
+	0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x41, 0x74, 0x6f,
+	0x6d, 0x52, 0x04, 0x61, 0x74, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62,
+	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x4d, 0x0a, 0x0a, 0x68, 0x79, 0x70, 0x65, 0x72, 0x65, 0x64,
+	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x71, 0x75, 0x69, 0x6c,
+	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65,
+	0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x41, 0x74, 0x6f, 0x6d, 0x52, 0x0a, 0x68, 0x79, 0x70, 0x65, 0x72, 0x65,
+	0x64, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x6f, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x70,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x79, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x05, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
+	0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79,
+	0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x41, 0x74, 0x6f, 0x6d, 0x52, 0x05, 0x61, 0x74,
+	0x6f, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x2a, 0x9b, 0x01, 0x0a, 0x0d, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x59, 0x50, 0x45, 0x52, 0x47,
+	0x52, 0x41, 0x50, 0x48, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x54, 0x45, 0x58, 0x5f,
+	0x41, 0x44, 0x44, 0x53, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x59, 0x50, 0x45, 0x52, 0x47,
+	0x52, 0x41, 0x50, 0x48, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x54, 0x45, 0x58, 0x5f,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x53, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x59, 0x50,
+	0x45, 0x52, 0x47, 0x52, 0x41, 0x50, 0x48, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x48, 0x59, 0x50, 0x45,
+	0x52, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x53, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20,
+	0x48, 0x59, 0x50, 0x45, 0x52, 0x47, 0x52, 0x41, 0x50, 0x48, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x48,
+	0x59, 0x50, 0x45, 0x52, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x53,
+	0x10, 0x03, 0x32, 0xb5, 0x04, 0x0a, 0x15, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8b, 0x01, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x64, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12,
+	0x3b, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64,
+	0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e,
+	0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x53, 0x68, 0x61, 0x72, 0x64, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x71,
+	0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68,
+	0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70,
+	0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x53, 0x68, 0x61, 0x72, 0x64, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x3d, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64,
+	0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e,
+	0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e,
+	0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
+	0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48,
+	0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x74, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x37, 0x2e, 0x71,
+	0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68,
+	0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70,
+	0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x41, 0x74, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x41, 0x74, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x79, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x35, 0x2e, 0x71, 0x75,
+	0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79,
+	0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x65,
+	0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e,
+	0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x41, 0x74, 0x6f,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x8e, 0x04, 0x0a, 0x16, 0x48,
+	0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x48, 0x79, 0x70, 0x65, 0x72, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12,
+	0x3b, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64,
+	0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x48, 0x79, 0x70, 0x65, 0x72,
+	0x65, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x71,
+	0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68,
+	0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x48, 0x79, 0x70, 0x65, 0x72, 0x65, 0x64, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x2e, 0x71, 0x75, 0x69,
+	0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70,
+	0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
+	0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x74, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75,
+	0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x79,
+	0x70, 0x65, 0x72, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69,
+	0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x6f,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x71, 0x75, 0x69, 0x6c,
+	0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x79, 0x70, 0x65,
+	0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74,
+	0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x75, 0x69, 0x6c, 0x69, 0x62, 0x72, 0x69, 0x75, 0x6d, 0x2f,
+	0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hypergraph_proto_rawDescOnce sync.Once
+	file_hypergraph_proto_rawDescData = file_hypergraph_proto_rawDesc
+)
+
+func file_hypergraph_proto_rawDescGZIP() []byte {
+	file_hypergraph_proto_rawDescOnce.Do(func() {
+		file_hypergraph_proto_rawDescData = protoimpl.X.CompressGZIP(file_hypergraph_proto_rawDescData)
+	})
+	return file_hypergraph_proto_rawDescData
+}
+
+var file_hypergraph_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_hypergraph_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_hypergraph_proto_goTypes = []interface{}{
+	(HypergraphSet)(0),                      // 0: quilibrium.node.hypergraph.pb.HypergraphSet
+	(*HypergraphShardDigestRequest)(nil),    // 1: quilibrium.node.hypergraph.pb.HypergraphShardDigestRequest
+	(*HypergraphShardDigestResponse)(nil),   // 2: quilibrium.node.hypergraph.pb.HypergraphShardDigestResponse
+	(*HypergraphBucketDigestsRequest)(nil),  // 3: quilibrium.node.hypergraph.pb.HypergraphBucketDigestsRequest
+	(*HypergraphBucketDigestsResponse)(nil), // 4: quilibrium.node.hypergraph.pb.HypergraphBucketDigestsResponse
+	(*HypergraphAtomIdsRequest)(nil),        // 5: quilibrium.node.hypergraph.pb.HypergraphAtomIdsRequest
+	(*HypergraphAtomIdsResponse)(nil),       // 6: quilibrium.node.hypergraph.pb.HypergraphAtomIdsResponse
+	(*HypergraphAtomsRequest)(nil),          // 7: quilibrium.node.hypergraph.pb.HypergraphAtomsRequest
+	(*HypergraphAtomsResponse)(nil),         // 8: quilibrium.node.hypergraph.pb.HypergraphAtomsResponse
+	(*HypergraphAtom)(nil),                  // 9: quilibrium.node.hypergraph.pb.HypergraphAtom
+	(*GetIncidentHyperedgesRequest)(nil),    // 10: quilibrium.node.hypergraph.pb.GetIncidentHyperedgesRequest
+	(*GetIncidentHyperedgesResponse)(nil),   // 11: quilibrium.node.hypergraph.pb.GetIncidentHyperedgesResponse
+	(*GetNeighborsRequest)(nil),             // 12: quilibrium.node.hypergraph.pb.GetNeighborsRequest
+	(*HypergraphNeighbor)(nil),              // 13: quilibrium.node.hypergraph.pb.HypergraphNeighbor
+	(*GetNeighborsResponse)(nil),            // 14: quilibrium.node.hypergraph.pb.GetNeighborsResponse
+	(*ListAtomsRequest)(nil),                // 15: quilibrium.node.hypergraph.pb.ListAtomsRequest
+	(*ListAtomsResponse)(nil),               // 16: quilibrium.node.hypergraph.pb.ListAtomsResponse
+}
+var file_hypergraph_proto_depIdxs = []int32{
+	0,  // 0: quilibrium.node.hypergraph.pb.HypergraphBucketDigestsRequest.set:type_name -> quilibrium.node.hypergraph.pb.HypergraphSet
+	0,  // 1: quilibrium.node.hypergraph.pb.HypergraphAtomIdsRequest.set:type_name -> quilibrium.node.hypergraph.pb.HypergraphSet
+	0,  // 2: quilibrium.node.hypergraph.pb.HypergraphAtomsRequest.set:type_name -> quilibrium.node.hypergraph.pb.HypergraphSet
+	9,  // 3: quilibrium.node.hypergraph.pb.GetIncidentHyperedgesResponse.hyperedges:type_name -> quilibrium.node.hypergraph.pb.HypergraphAtom
+	9,  // 4: quilibrium.node.hypergraph.pb.HypergraphNeighbor.atom:type_name -> quilibrium.node.hypergraph.pb.HypergraphAtom
+	13, // 5: quilibrium.node.hypergraph.pb.GetNeighborsResponse.neighbors:type_name -> quilibrium.node.hypergraph.pb.HypergraphNeighbor
+	9,  // 6: quilibrium.node.hypergraph.pb.GetNeighborsResponse.hyperedges:type_name -> quilibrium.node.hypergraph.pb.HypergraphAtom
+	9,  // 7: quilibrium.node.hypergraph.pb.ListAtomsResponse.atoms:type_name -> quilibrium.node.hypergraph.pb.HypergraphAtom
+	1,  // 8: quilibrium.node.hypergraph.pb.HypergraphSyncService.GetShardDigest:input_type -> quilibrium.node.hypergraph.pb.HypergraphShardDigestRequest
+	3,  // 9: quilibrium.node.hypergraph.pb.HypergraphSyncService.GetBucketDigests:input_type -> quilibrium.node.hypergraph.pb.HypergraphBucketDigestsRequest
+	5,  // 10: quilibrium.node.hypergraph.pb.HypergraphSyncService.GetAtomIds:input_type -> quilibrium.node.hypergraph.pb.HypergraphAtomIdsRequest
+	7,  // 11: quilibrium.node.hypergraph.pb.HypergraphSyncService.GetAtoms:input_type -> quilibrium.node.hypergraph.pb.HypergraphAtomsRequest
+	10, // 12: quilibrium.node.hypergraph.pb.HypergraphQueryService.GetIncidentHyperedges:input_type -> quilibrium.node.hypergraph.pb.GetIncidentHyperedgesRequest
+	12, // 13: quilibrium.node.hypergraph.pb.HypergraphQueryService.GetNeighbors:input_type -> quilibrium.node.hypergraph.pb.GetNeighborsRequest
+	15, // 14: quilibrium.node.hypergraph.pb.HypergraphQueryService.ListVertices:input_type -> quilibrium.node.hypergraph.pb.ListAtomsRequest
+	15, // 15: quilibrium.node.hypergraph.pb.HypergraphQueryService.ListHyperedges:input_type -> quilibrium.node.hypergraph.pb.ListAtomsRequest
+	2,  // 16: quilibrium.node.hypergraph.pb.HypergraphSyncService.GetShardDigest:output_type -> quilibrium.node.hypergraph.pb.HypergraphShardDigestResponse
+	4,  // 17: quilibrium.node.hypergraph.pb.HypergraphSyncService.GetBucketDigests:output_type -> quilibrium.node.hypergraph.pb.HypergraphBucketDigestsResponse
+	6,  // 18: quilibrium.node.hypergraph.pb.HypergraphSyncService.GetAtomIds:output_type -> quilibrium.node.hypergraph.pb.HypergraphAtomIdsResponse
+	8,  // 19: quilibrium.node.hypergraph.pb.HypergraphSyncService.GetAtoms:output_type -> quilibrium.node.hypergraph.pb.HypergraphAtomsResponse
+	11, // 20: quilibrium.node.hypergraph.pb.HypergraphQueryService.GetIncidentHyperedges:output_type -> quilibrium.node.hypergraph.pb.GetIncidentHyperedgesResponse
+	14, // 21: quilibrium.node.hypergraph.pb.HypergraphQueryService.GetNeighbors:output_type -> quilibrium.node.hypergraph.pb.GetNeighborsResponse
+	16, // 22: quilibrium.node.hypergraph.pb.HypergraphQueryService.ListVertices:output_type -> quilibrium.node.hypergraph.pb.ListAtomsResponse
+	16, // 23: quilibrium.node.hypergraph.pb.HypergraphQueryService.ListHyperedges:output_type -> quilibrium.node.hypergraph.pb.ListAtomsResponse
+	16, // [16:24] is the sub-list for method output_type
+	8,  // [8:16] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_hypergraph_proto_init() }
+func file_hypergraph_proto_init() {
+	if File_hypergraph_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_hypergraph_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypergraphShardDigestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypergraphShardDigestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypergraphBucketDigestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypergraphBucketDigestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypergraphAtomIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypergraphAtomIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypergraphAtomsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypergraphAtomsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypergraphAtom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIncidentHyperedgesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIncidentHyperedgesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNeighborsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypergraphNeighbor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNeighborsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAtomsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hypergraph_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAtomsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hypergraph_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_hypergraph_proto_goTypes,
+		DependencyIndexes: file_hypergraph_proto_depIdxs,
+		EnumInfos:         file_hypergraph_proto_enumTypes,
+		MessageInfos:      file_hypergraph_proto_msgTypes,
+	}.Build()
+	File_hypergraph_proto = out.File
+	file_hypergraph_proto_rawDesc = nil
+	file_hypergraph_proto_goTypes = nil
+	file_hypergraph_proto_depIdxs = nil
+}
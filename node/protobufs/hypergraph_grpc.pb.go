@@ -0,0 +1,422 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: hypergraph.proto
+
+package protobufs
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	HypergraphSyncService_GetShardDigest_FullMethodName   = "/quilibrium.node.hypergraph.pb.HypergraphSyncService/GetShardDigest"
+	HypergraphSyncService_GetBucketDigests_FullMethodName = "/quilibrium.node.hypergraph.pb.HypergraphSyncService/GetBucketDigests"
+	HypergraphSyncService_GetAtomIds_FullMethodName       = "/quilibrium.node.hypergraph.pb.HypergraphSyncService/GetAtomIds"
+	HypergraphSyncService_GetAtoms_FullMethodName         = "/quilibrium.node.hypergraph.pb.HypergraphSyncService/GetAtoms"
+)
+
+// HypergraphSyncServiceClient is the client API for HypergraphSyncService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HypergraphSyncServiceClient interface {
+	GetShardDigest(ctx context.Context, in *HypergraphShardDigestRequest, opts ...grpc.CallOption) (*HypergraphShardDigestResponse, error)
+	GetBucketDigests(ctx context.Context, in *HypergraphBucketDigestsRequest, opts ...grpc.CallOption) (*HypergraphBucketDigestsResponse, error)
+	GetAtomIds(ctx context.Context, in *HypergraphAtomIdsRequest, opts ...grpc.CallOption) (*HypergraphAtomIdsResponse, error)
+	GetAtoms(ctx context.Context, in *HypergraphAtomsRequest, opts ...grpc.CallOption) (*HypergraphAtomsResponse, error)
+}
+
+type hypergraphSyncServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHypergraphSyncServiceClient(cc grpc.ClientConnInterface) HypergraphSyncServiceClient {
+	return &hypergraphSyncServiceClient{cc}
+}
+
+func (c *hypergraphSyncServiceClient) GetShardDigest(ctx context.Context, in *HypergraphShardDigestRequest, opts ...grpc.CallOption) (*HypergraphShardDigestResponse, error) {
+	out := new(HypergraphShardDigestResponse)
+	err := c.cc.Invoke(ctx, HypergraphSyncService_GetShardDigest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hypergraphSyncServiceClient) GetBucketDigests(ctx context.Context, in *HypergraphBucketDigestsRequest, opts ...grpc.CallOption) (*HypergraphBucketDigestsResponse, error) {
+	out := new(HypergraphBucketDigestsResponse)
+	err := c.cc.Invoke(ctx, HypergraphSyncService_GetBucketDigests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hypergraphSyncServiceClient) GetAtomIds(ctx context.Context, in *HypergraphAtomIdsRequest, opts ...grpc.CallOption) (*HypergraphAtomIdsResponse, error) {
+	out := new(HypergraphAtomIdsResponse)
+	err := c.cc.Invoke(ctx, HypergraphSyncService_GetAtomIds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hypergraphSyncServiceClient) GetAtoms(ctx context.Context, in *HypergraphAtomsRequest, opts ...grpc.CallOption) (*HypergraphAtomsResponse, error) {
+	out := new(HypergraphAtomsResponse)
+	err := c.cc.Invoke(ctx, HypergraphSyncService_GetAtoms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HypergraphSyncServiceServer is the server API for HypergraphSyncService service.
+// All implementations must embed UnimplementedHypergraphSyncServiceServer
+// for forward compatibility
+type HypergraphSyncServiceServer interface {
+	GetShardDigest(context.Context, *HypergraphShardDigestRequest) (*HypergraphShardDigestResponse, error)
+	GetBucketDigests(context.Context, *HypergraphBucketDigestsRequest) (*HypergraphBucketDigestsResponse, error)
+	GetAtomIds(context.Context, *HypergraphAtomIdsRequest) (*HypergraphAtomIdsResponse, error)
+	GetAtoms(context.Context, *HypergraphAtomsRequest) (*HypergraphAtomsResponse, error)
+	mustEmbedUnimplementedHypergraphSyncServiceServer()
+}
+
+// UnimplementedHypergraphSyncServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedHypergraphSyncServiceServer struct {
+}
+
+func (UnimplementedHypergraphSyncServiceServer) GetShardDigest(context.Context, *HypergraphShardDigestRequest) (*HypergraphShardDigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardDigest not implemented")
+}
+func (UnimplementedHypergraphSyncServiceServer) GetBucketDigests(context.Context, *HypergraphBucketDigestsRequest) (*HypergraphBucketDigestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBucketDigests not implemented")
+}
+func (UnimplementedHypergraphSyncServiceServer) GetAtomIds(context.Context, *HypergraphAtomIdsRequest) (*HypergraphAtomIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAtomIds not implemented")
+}
+func (UnimplementedHypergraphSyncServiceServer) GetAtoms(context.Context, *HypergraphAtomsRequest) (*HypergraphAtomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAtoms not implemented")
+}
+func (UnimplementedHypergraphSyncServiceServer) mustEmbedUnimplementedHypergraphSyncServiceServer() {}
+
+// UnsafeHypergraphSyncServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HypergraphSyncServiceServer will
+// result in compilation errors.
+type UnsafeHypergraphSyncServiceServer interface {
+	mustEmbedUnimplementedHypergraphSyncServiceServer()
+}
+
+func RegisterHypergraphSyncServiceServer(s grpc.ServiceRegistrar, srv HypergraphSyncServiceServer) {
+	s.RegisterService(&HypergraphSyncService_ServiceDesc, srv)
+}
+
+func _HypergraphSyncService_GetShardDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HypergraphShardDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HypergraphSyncServiceServer).GetShardDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HypergraphSyncService_GetShardDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HypergraphSyncServiceServer).GetShardDigest(ctx, req.(*HypergraphShardDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HypergraphSyncService_GetBucketDigests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HypergraphBucketDigestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HypergraphSyncServiceServer).GetBucketDigests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HypergraphSyncService_GetBucketDigests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HypergraphSyncServiceServer).GetBucketDigests(ctx, req.(*HypergraphBucketDigestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HypergraphSyncService_GetAtomIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HypergraphAtomIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HypergraphSyncServiceServer).GetAtomIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HypergraphSyncService_GetAtomIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HypergraphSyncServiceServer).GetAtomIds(ctx, req.(*HypergraphAtomIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HypergraphSyncService_GetAtoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HypergraphAtomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HypergraphSyncServiceServer).GetAtoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HypergraphSyncService_GetAtoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HypergraphSyncServiceServer).GetAtoms(ctx, req.(*HypergraphAtomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HypergraphSyncService_ServiceDesc is the grpc.ServiceDesc for HypergraphSyncService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HypergraphSyncService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "quilibrium.node.hypergraph.pb.HypergraphSyncService",
+	HandlerType: (*HypergraphSyncServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetShardDigest",
+			Handler:    _HypergraphSyncService_GetShardDigest_Handler,
+		},
+		{
+			MethodName: "GetBucketDigests",
+			Handler:    _HypergraphSyncService_GetBucketDigests_Handler,
+		},
+		{
+			MethodName: "GetAtomIds",
+			Handler:    _HypergraphSyncService_GetAtomIds_Handler,
+		},
+		{
+			MethodName: "GetAtoms",
+			Handler:    _HypergraphSyncService_GetAtoms_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hypergraph.proto",
+}
+
+const (
+	HypergraphQueryService_GetIncidentHyperedges_FullMethodName = "/quilibrium.node.hypergraph.pb.HypergraphQueryService/GetIncidentHyperedges"
+	HypergraphQueryService_GetNeighbors_FullMethodName          = "/quilibrium.node.hypergraph.pb.HypergraphQueryService/GetNeighbors"
+	HypergraphQueryService_ListVertices_FullMethodName          = "/quilibrium.node.hypergraph.pb.HypergraphQueryService/ListVertices"
+	HypergraphQueryService_ListHyperedges_FullMethodName        = "/quilibrium.node.hypergraph.pb.HypergraphQueryService/ListHyperedges"
+)
+
+// HypergraphQueryServiceClient is the client API for HypergraphQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HypergraphQueryServiceClient interface {
+	GetIncidentHyperedges(ctx context.Context, in *GetIncidentHyperedgesRequest, opts ...grpc.CallOption) (*GetIncidentHyperedgesResponse, error)
+	GetNeighbors(ctx context.Context, in *GetNeighborsRequest, opts ...grpc.CallOption) (*GetNeighborsResponse, error)
+	ListVertices(ctx context.Context, in *ListAtomsRequest, opts ...grpc.CallOption) (*ListAtomsResponse, error)
+	ListHyperedges(ctx context.Context, in *ListAtomsRequest, opts ...grpc.CallOption) (*ListAtomsResponse, error)
+}
+
+type hypergraphQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHypergraphQueryServiceClient(cc grpc.ClientConnInterface) HypergraphQueryServiceClient {
+	return &hypergraphQueryServiceClient{cc}
+}
+
+func (c *hypergraphQueryServiceClient) GetIncidentHyperedges(ctx context.Context, in *GetIncidentHyperedgesRequest, opts ...grpc.CallOption) (*GetIncidentHyperedgesResponse, error) {
+	out := new(GetIncidentHyperedgesResponse)
+	err := c.cc.Invoke(ctx, HypergraphQueryService_GetIncidentHyperedges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hypergraphQueryServiceClient) GetNeighbors(ctx context.Context, in *GetNeighborsRequest, opts ...grpc.CallOption) (*GetNeighborsResponse, error) {
+	out := new(GetNeighborsResponse)
+	err := c.cc.Invoke(ctx, HypergraphQueryService_GetNeighbors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hypergraphQueryServiceClient) ListVertices(ctx context.Context, in *ListAtomsRequest, opts ...grpc.CallOption) (*ListAtomsResponse, error) {
+	out := new(ListAtomsResponse)
+	err := c.cc.Invoke(ctx, HypergraphQueryService_ListVertices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hypergraphQueryServiceClient) ListHyperedges(ctx context.Context, in *ListAtomsRequest, opts ...grpc.CallOption) (*ListAtomsResponse, error) {
+	out := new(ListAtomsResponse)
+	err := c.cc.Invoke(ctx, HypergraphQueryService_ListHyperedges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HypergraphQueryServiceServer is the server API for HypergraphQueryService service.
+// All implementations must embed UnimplementedHypergraphQueryServiceServer
+// for forward compatibility
+type HypergraphQueryServiceServer interface {
+	GetIncidentHyperedges(context.Context, *GetIncidentHyperedgesRequest) (*GetIncidentHyperedgesResponse, error)
+	GetNeighbors(context.Context, *GetNeighborsRequest) (*GetNeighborsResponse, error)
+	ListVertices(context.Context, *ListAtomsRequest) (*ListAtomsResponse, error)
+	ListHyperedges(context.Context, *ListAtomsRequest) (*ListAtomsResponse, error)
+	mustEmbedUnimplementedHypergraphQueryServiceServer()
+}
+
+// UnimplementedHypergraphQueryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedHypergraphQueryServiceServer struct {
+}
+
+func (UnimplementedHypergraphQueryServiceServer) GetIncidentHyperedges(context.Context, *GetIncidentHyperedgesRequest) (*GetIncidentHyperedgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentHyperedges not implemented")
+}
+func (UnimplementedHypergraphQueryServiceServer) GetNeighbors(context.Context, *GetNeighborsRequest) (*GetNeighborsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNeighbors not implemented")
+}
+func (UnimplementedHypergraphQueryServiceServer) ListVertices(context.Context, *ListAtomsRequest) (*ListAtomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVertices not implemented")
+}
+func (UnimplementedHypergraphQueryServiceServer) ListHyperedges(context.Context, *ListAtomsRequest) (*ListAtomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHyperedges not implemented")
+}
+func (UnimplementedHypergraphQueryServiceServer) mustEmbedUnimplementedHypergraphQueryServiceServer() {
+}
+
+// UnsafeHypergraphQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HypergraphQueryServiceServer will
+// result in compilation errors.
+type UnsafeHypergraphQueryServiceServer interface {
+	mustEmbedUnimplementedHypergraphQueryServiceServer()
+}
+
+func RegisterHypergraphQueryServiceServer(s grpc.ServiceRegistrar, srv HypergraphQueryServiceServer) {
+	s.RegisterService(&HypergraphQueryService_ServiceDesc, srv)
+}
+
+func _HypergraphQueryService_GetIncidentHyperedges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncidentHyperedgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HypergraphQueryServiceServer).GetIncidentHyperedges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HypergraphQueryService_GetIncidentHyperedges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HypergraphQueryServiceServer).GetIncidentHyperedges(ctx, req.(*GetIncidentHyperedgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HypergraphQueryService_GetNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HypergraphQueryServiceServer).GetNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HypergraphQueryService_GetNeighbors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HypergraphQueryServiceServer).GetNeighbors(ctx, req.(*GetNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HypergraphQueryService_ListVertices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAtomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HypergraphQueryServiceServer).ListVertices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HypergraphQueryService_ListVertices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HypergraphQueryServiceServer).ListVertices(ctx, req.(*ListAtomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HypergraphQueryService_ListHyperedges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAtomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HypergraphQueryServiceServer).ListHyperedges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HypergraphQueryService_ListHyperedges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HypergraphQueryServiceServer).ListHyperedges(ctx, req.(*ListAtomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HypergraphQueryService_ServiceDesc is the grpc.ServiceDesc for HypergraphQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HypergraphQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "quilibrium.node.hypergraph.pb.HypergraphQueryService",
+	HandlerType: (*HypergraphQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetIncidentHyperedges",
+			Handler:    _HypergraphQueryService_GetIncidentHyperedges_Handler,
+		},
+		{
+			MethodName: "GetNeighbors",
+			Handler:    _HypergraphQueryService_GetNeighbors_Handler,
+		},
+		{
+			MethodName: "ListVertices",
+			Handler:    _HypergraphQueryService_ListVertices_Handler,
+		},
+		{
+			MethodName: "ListHyperedges",
+			Handler:    _HypergraphQueryService_ListHyperedges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hypergraph.proto",
+}
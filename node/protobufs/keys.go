@@ -1,6 +1,8 @@
 package protobufs
 
 import (
+	"encoding/binary"
+
 	"golang.org/x/crypto/sha3"
 	"source.quilibrium.com/quilibrium/monorepo/nekryptology/pkg/core/curves"
 	"source.quilibrium.com/quilibrium/monorepo/nekryptology/pkg/zkp/schnorr"
@@ -214,3 +216,36 @@ func (s *Ed448Signature) VerifyCrossSigned(
 
 	return nil
 }
+
+// SignatureMessage returns the bytes of the bundle covered by its signature.
+func (b *PreKeyBundle) SignatureMessage() []byte {
+	msg := append([]byte("prekey-bundle"), b.IdentityKey...)
+	msg = binary.BigEndian.AppendUint32(msg, b.SignedPreKeyId)
+	msg = append(msg, b.SignedPreKey...)
+	if b.OneTimePreKey != nil {
+		msg = binary.BigEndian.AppendUint32(msg, b.OneTimePreKey.Id)
+		msg = append(msg, b.OneTimePreKey.Key...)
+	}
+	msg = binary.BigEndian.AppendUint64(msg, uint64(b.Timestamp))
+	return msg
+}
+
+func (b *PreKeyBundle) Verify() error {
+	if len(b.IdentityKey) != 57 {
+		return errors.Wrap(errors.New("invalid identity key"), "verify")
+	}
+
+	if len(b.SignedPreKey) != 57 {
+		return errors.Wrap(errors.New("invalid signed pre key"), "verify")
+	}
+
+	if b.OneTimePreKey != nil && len(b.OneTimePreKey.Key) != 57 {
+		return errors.Wrap(errors.New("invalid one time pre key"), "verify")
+	}
+
+	if b.Signature == nil {
+		return errors.Wrap(errors.New("signature nil"), "verify")
+	}
+
+	return b.Signature.Verify(b.SignatureMessage())
+}
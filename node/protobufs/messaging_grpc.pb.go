@@ -0,0 +1,348 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: messaging.proto
+
+package protobufs
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MessagingService_Send_FullMethodName          = "/quilibrium.node.messaging.pb.MessagingService/Send"
+	MessagingService_GetInbox_FullMethodName      = "/quilibrium.node.messaging.pb.MessagingService/GetInbox"
+	MessagingService_GetHistory_FullMethodName    = "/quilibrium.node.messaging.pb.MessagingService/GetHistory"
+	MessagingService_CreateGroup_FullMethodName   = "/quilibrium.node.messaging.pb.MessagingService/CreateGroup"
+	MessagingService_InviteToGroup_FullMethodName = "/quilibrium.node.messaging.pb.MessagingService/InviteToGroup"
+)
+
+// MessagingServiceClient is the client API for MessagingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MessagingServiceClient interface {
+	Send(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResult, error)
+	GetInbox(ctx context.Context, in *GetInboxRequest, opts ...grpc.CallOption) (*MessagesResponse, error)
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*MessagesResponse, error)
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	InviteToGroup(ctx context.Context, in *InviteToGroupRequest, opts ...grpc.CallOption) (*InviteToGroupResponse, error)
+}
+
+type messagingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMessagingServiceClient(cc grpc.ClientConnInterface) MessagingServiceClient {
+	return &messagingServiceClient{cc}
+}
+
+func (c *messagingServiceClient) Send(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResult, error) {
+	out := new(SendMessageResult)
+	err := c.cc.Invoke(ctx, MessagingService_Send_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) GetInbox(ctx context.Context, in *GetInboxRequest, opts ...grpc.CallOption) (*MessagesResponse, error) {
+	out := new(MessagesResponse)
+	err := c.cc.Invoke(ctx, MessagingService_GetInbox_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*MessagesResponse, error) {
+	out := new(MessagesResponse)
+	err := c.cc.Invoke(ctx, MessagingService_GetHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, MessagingService_CreateGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) InviteToGroup(ctx context.Context, in *InviteToGroupRequest, opts ...grpc.CallOption) (*InviteToGroupResponse, error) {
+	out := new(InviteToGroupResponse)
+	err := c.cc.Invoke(ctx, MessagingService_InviteToGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MessagingServiceServer is the server API for MessagingService service.
+// All implementations must embed UnimplementedMessagingServiceServer
+// for forward compatibility
+type MessagingServiceServer interface {
+	Send(context.Context, *SendMessageRequest) (*SendMessageResult, error)
+	GetInbox(context.Context, *GetInboxRequest) (*MessagesResponse, error)
+	GetHistory(context.Context, *GetHistoryRequest) (*MessagesResponse, error)
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	InviteToGroup(context.Context, *InviteToGroupRequest) (*InviteToGroupResponse, error)
+	mustEmbedUnimplementedMessagingServiceServer()
+}
+
+// UnimplementedMessagingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMessagingServiceServer struct {
+}
+
+func (UnimplementedMessagingServiceServer) Send(context.Context, *SendMessageRequest) (*SendMessageResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (UnimplementedMessagingServiceServer) GetInbox(context.Context, *GetInboxRequest) (*MessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInbox not implemented")
+}
+func (UnimplementedMessagingServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*MessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedMessagingServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedMessagingServiceServer) InviteToGroup(context.Context, *InviteToGroupRequest) (*InviteToGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToGroup not implemented")
+}
+func (UnimplementedMessagingServiceServer) mustEmbedUnimplementedMessagingServiceServer() {}
+
+// UnsafeMessagingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MessagingServiceServer will
+// result in compilation errors.
+type UnsafeMessagingServiceServer interface {
+	mustEmbedUnimplementedMessagingServiceServer()
+}
+
+func RegisterMessagingServiceServer(s grpc.ServiceRegistrar, srv MessagingServiceServer) {
+	s.RegisterService(&MessagingService_ServiceDesc, srv)
+}
+
+func _MessagingService_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_Send_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).Send(ctx, req.(*SendMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_GetInbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).GetInbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_GetInbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).GetInbox(ctx, req.(*GetInboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_InviteToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).InviteToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_InviteToGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).InviteToGroup(ctx, req.(*InviteToGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MessagingService_ServiceDesc is the grpc.ServiceDesc for MessagingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MessagingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "quilibrium.node.messaging.pb.MessagingService",
+	HandlerType: (*MessagingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Send",
+			Handler:    _MessagingService_Send_Handler,
+		},
+		{
+			MethodName: "GetInbox",
+			Handler:    _MessagingService_GetInbox_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _MessagingService_GetHistory_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _MessagingService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "InviteToGroup",
+			Handler:    _MessagingService_InviteToGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "messaging.proto",
+}
+
+const (
+	MessageDeliveryService_Deliver_FullMethodName = "/quilibrium.node.messaging.pb.MessageDeliveryService/Deliver"
+)
+
+// MessageDeliveryServiceClient is the client API for MessageDeliveryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MessageDeliveryServiceClient interface {
+	Deliver(ctx context.Context, in *DeliverMessageRequest, opts ...grpc.CallOption) (*DeliverMessageResponse, error)
+}
+
+type messageDeliveryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMessageDeliveryServiceClient(cc grpc.ClientConnInterface) MessageDeliveryServiceClient {
+	return &messageDeliveryServiceClient{cc}
+}
+
+func (c *messageDeliveryServiceClient) Deliver(ctx context.Context, in *DeliverMessageRequest, opts ...grpc.CallOption) (*DeliverMessageResponse, error) {
+	out := new(DeliverMessageResponse)
+	err := c.cc.Invoke(ctx, MessageDeliveryService_Deliver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MessageDeliveryServiceServer is the server API for MessageDeliveryService service.
+// All implementations must embed UnimplementedMessageDeliveryServiceServer
+// for forward compatibility
+type MessageDeliveryServiceServer interface {
+	Deliver(context.Context, *DeliverMessageRequest) (*DeliverMessageResponse, error)
+	mustEmbedUnimplementedMessageDeliveryServiceServer()
+}
+
+// UnimplementedMessageDeliveryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMessageDeliveryServiceServer struct {
+}
+
+func (UnimplementedMessageDeliveryServiceServer) Deliver(context.Context, *DeliverMessageRequest) (*DeliverMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deliver not implemented")
+}
+func (UnimplementedMessageDeliveryServiceServer) mustEmbedUnimplementedMessageDeliveryServiceServer() {
+}
+
+// UnsafeMessageDeliveryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MessageDeliveryServiceServer will
+// result in compilation errors.
+type UnsafeMessageDeliveryServiceServer interface {
+	mustEmbedUnimplementedMessageDeliveryServiceServer()
+}
+
+func RegisterMessageDeliveryServiceServer(s grpc.ServiceRegistrar, srv MessageDeliveryServiceServer) {
+	s.RegisterService(&MessageDeliveryService_ServiceDesc, srv)
+}
+
+func _MessageDeliveryService_Deliver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliverMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageDeliveryServiceServer).Deliver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageDeliveryService_Deliver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageDeliveryServiceServer).Deliver(ctx, req.(*DeliverMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MessageDeliveryService_ServiceDesc is the grpc.ServiceDesc for MessageDeliveryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MessageDeliveryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "quilibrium.node.messaging.pb.MessageDeliveryService",
+	HandlerType: (*MessageDeliveryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deliver",
+			Handler:    _MessageDeliveryService_Deliver_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "messaging.proto",
+}
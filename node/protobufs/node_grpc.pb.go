@@ -179,11 +179,17 @@ const (
 	NodeService_GetPeerInfo_FullMethodName               = "/quilibrium.node.node.pb.NodeService/GetPeerInfo"
 	NodeService_GetNodeInfo_FullMethodName               = "/quilibrium.node.node.pb.NodeService/GetNodeInfo"
 	NodeService_GetNetworkInfo_FullMethodName            = "/quilibrium.node.node.pb.NodeService/GetNetworkInfo"
+	NodeService_GetPeerScores_FullMethodName             = "/quilibrium.node.node.pb.NodeService/GetPeerScores"
 	NodeService_GetTokenInfo_FullMethodName              = "/quilibrium.node.node.pb.NodeService/GetTokenInfo"
 	NodeService_GetPeerManifests_FullMethodName          = "/quilibrium.node.node.pb.NodeService/GetPeerManifests"
 	NodeService_SendMessage_FullMethodName               = "/quilibrium.node.node.pb.NodeService/SendMessage"
 	NodeService_GetTokensByAccount_FullMethodName        = "/quilibrium.node.node.pb.NodeService/GetTokensByAccount"
 	NodeService_GetPreCoinProofsByAccount_FullMethodName = "/quilibrium.node.node.pb.NodeService/GetPreCoinProofsByAccount"
+	NodeService_GetMempool_FullMethodName                = "/quilibrium.node.node.pb.NodeService/GetMempool"
+	NodeService_SimulateTokenRequests_FullMethodName     = "/quilibrium.node.node.pb.NodeService/SimulateTokenRequests"
+	NodeService_GetForks_FullMethodName                  = "/quilibrium.node.node.pb.NodeService/GetForks"
+	NodeService_GetReorgHistory_FullMethodName           = "/quilibrium.node.node.pb.NodeService/GetReorgHistory"
+	NodeService_GetRewardProofs_FullMethodName           = "/quilibrium.node.node.pb.NodeService/GetRewardProofs"
 )
 
 // NodeServiceClient is the client API for NodeService service.
@@ -195,11 +201,17 @@ type NodeServiceClient interface {
 	GetPeerInfo(ctx context.Context, in *GetPeerInfoRequest, opts ...grpc.CallOption) (*PeerInfoResponse, error)
 	GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
 	GetNetworkInfo(ctx context.Context, in *GetNetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfoResponse, error)
+	GetPeerScores(ctx context.Context, in *GetPeerScoresRequest, opts ...grpc.CallOption) (*PeerScoresResponse, error)
 	GetTokenInfo(ctx context.Context, in *GetTokenInfoRequest, opts ...grpc.CallOption) (*TokenInfoResponse, error)
 	GetPeerManifests(ctx context.Context, in *GetPeerManifestsRequest, opts ...grpc.CallOption) (*PeerManifestsResponse, error)
 	SendMessage(ctx context.Context, in *TokenRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
 	GetTokensByAccount(ctx context.Context, in *GetTokensByAccountRequest, opts ...grpc.CallOption) (*TokensByAccountResponse, error)
 	GetPreCoinProofsByAccount(ctx context.Context, in *GetPreCoinProofsByAccountRequest, opts ...grpc.CallOption) (*PreCoinProofsByAccountResponse, error)
+	GetMempool(ctx context.Context, in *GetMempoolRequest, opts ...grpc.CallOption) (*MempoolResponse, error)
+	SimulateTokenRequests(ctx context.Context, in *TokenRequests, opts ...grpc.CallOption) (*SimulateTokenRequestsResponse, error)
+	GetForks(ctx context.Context, in *GetForksRequest, opts ...grpc.CallOption) (*ForksResponse, error)
+	GetReorgHistory(ctx context.Context, in *GetReorgHistoryRequest, opts ...grpc.CallOption) (*ReorgHistoryResponse, error)
+	GetRewardProofs(ctx context.Context, in *GetRewardProofsRequest, opts ...grpc.CallOption) (*RewardProofsResponse, error)
 }
 
 type nodeServiceClient struct {
@@ -255,6 +267,15 @@ func (c *nodeServiceClient) GetNetworkInfo(ctx context.Context, in *GetNetworkIn
 	return out, nil
 }
 
+func (c *nodeServiceClient) GetPeerScores(ctx context.Context, in *GetPeerScoresRequest, opts ...grpc.CallOption) (*PeerScoresResponse, error) {
+	out := new(PeerScoresResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetPeerScores_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodeServiceClient) GetTokenInfo(ctx context.Context, in *GetTokenInfoRequest, opts ...grpc.CallOption) (*TokenInfoResponse, error) {
 	out := new(TokenInfoResponse)
 	err := c.cc.Invoke(ctx, NodeService_GetTokenInfo_FullMethodName, in, out, opts...)
@@ -300,6 +321,51 @@ func (c *nodeServiceClient) GetPreCoinProofsByAccount(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *nodeServiceClient) GetMempool(ctx context.Context, in *GetMempoolRequest, opts ...grpc.CallOption) (*MempoolResponse, error) {
+	out := new(MempoolResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetMempool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) SimulateTokenRequests(ctx context.Context, in *TokenRequests, opts ...grpc.CallOption) (*SimulateTokenRequestsResponse, error) {
+	out := new(SimulateTokenRequestsResponse)
+	err := c.cc.Invoke(ctx, NodeService_SimulateTokenRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetForks(ctx context.Context, in *GetForksRequest, opts ...grpc.CallOption) (*ForksResponse, error) {
+	out := new(ForksResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetForks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetReorgHistory(ctx context.Context, in *GetReorgHistoryRequest, opts ...grpc.CallOption) (*ReorgHistoryResponse, error) {
+	out := new(ReorgHistoryResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetReorgHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetRewardProofs(ctx context.Context, in *GetRewardProofsRequest, opts ...grpc.CallOption) (*RewardProofsResponse, error) {
+	out := new(RewardProofsResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetRewardProofs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServiceServer is the server API for NodeService service.
 // All implementations must embed UnimplementedNodeServiceServer
 // for forward compatibility
@@ -309,11 +375,17 @@ type NodeServiceServer interface {
 	GetPeerInfo(context.Context, *GetPeerInfoRequest) (*PeerInfoResponse, error)
 	GetNodeInfo(context.Context, *GetNodeInfoRequest) (*NodeInfoResponse, error)
 	GetNetworkInfo(context.Context, *GetNetworkInfoRequest) (*NetworkInfoResponse, error)
+	GetPeerScores(context.Context, *GetPeerScoresRequest) (*PeerScoresResponse, error)
 	GetTokenInfo(context.Context, *GetTokenInfoRequest) (*TokenInfoResponse, error)
 	GetPeerManifests(context.Context, *GetPeerManifestsRequest) (*PeerManifestsResponse, error)
 	SendMessage(context.Context, *TokenRequest) (*SendMessageResponse, error)
 	GetTokensByAccount(context.Context, *GetTokensByAccountRequest) (*TokensByAccountResponse, error)
 	GetPreCoinProofsByAccount(context.Context, *GetPreCoinProofsByAccountRequest) (*PreCoinProofsByAccountResponse, error)
+	GetMempool(context.Context, *GetMempoolRequest) (*MempoolResponse, error)
+	SimulateTokenRequests(context.Context, *TokenRequests) (*SimulateTokenRequestsResponse, error)
+	GetForks(context.Context, *GetForksRequest) (*ForksResponse, error)
+	GetReorgHistory(context.Context, *GetReorgHistoryRequest) (*ReorgHistoryResponse, error)
+	GetRewardProofs(context.Context, *GetRewardProofsRequest) (*RewardProofsResponse, error)
 	mustEmbedUnimplementedNodeServiceServer()
 }
 
@@ -336,6 +408,9 @@ func (UnimplementedNodeServiceServer) GetNodeInfo(context.Context, *GetNodeInfoR
 func (UnimplementedNodeServiceServer) GetNetworkInfo(context.Context, *GetNetworkInfoRequest) (*NetworkInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInfo not implemented")
 }
+func (UnimplementedNodeServiceServer) GetPeerScores(context.Context, *GetPeerScoresRequest) (*PeerScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerScores not implemented")
+}
 func (UnimplementedNodeServiceServer) GetTokenInfo(context.Context, *GetTokenInfoRequest) (*TokenInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTokenInfo not implemented")
 }
@@ -351,6 +426,21 @@ func (UnimplementedNodeServiceServer) GetTokensByAccount(context.Context, *GetTo
 func (UnimplementedNodeServiceServer) GetPreCoinProofsByAccount(context.Context, *GetPreCoinProofsByAccountRequest) (*PreCoinProofsByAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPreCoinProofsByAccount not implemented")
 }
+func (UnimplementedNodeServiceServer) GetMempool(context.Context, *GetMempoolRequest) (*MempoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMempool not implemented")
+}
+func (UnimplementedNodeServiceServer) SimulateTokenRequests(context.Context, *TokenRequests) (*SimulateTokenRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTokenRequests not implemented")
+}
+func (UnimplementedNodeServiceServer) GetForks(context.Context, *GetForksRequest) (*ForksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForks not implemented")
+}
+func (UnimplementedNodeServiceServer) GetReorgHistory(context.Context, *GetReorgHistoryRequest) (*ReorgHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReorgHistory not implemented")
+}
+func (UnimplementedNodeServiceServer) GetRewardProofs(context.Context, *GetRewardProofsRequest) (*RewardProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardProofs not implemented")
+}
 func (UnimplementedNodeServiceServer) mustEmbedUnimplementedNodeServiceServer() {}
 
 // UnsafeNodeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -454,6 +544,24 @@ func _NodeService_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeService_GetPeerScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetPeerScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetPeerScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetPeerScores(ctx, req.(*GetPeerScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodeService_GetTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTokenInfoRequest)
 	if err := dec(in); err != nil {
@@ -544,6 +652,96 @@ func _NodeService_GetPreCoinProofsByAccount_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeService_GetMempool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMempoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetMempool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetMempool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetMempool(ctx, req.(*GetMempoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_SimulateTokenRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenRequests)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).SimulateTokenRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_SimulateTokenRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).SimulateTokenRequests(ctx, req.(*TokenRequests))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetForks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetForks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetForks(ctx, req.(*GetForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetReorgHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReorgHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetReorgHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetReorgHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetReorgHistory(ctx, req.(*GetReorgHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetRewardProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRewardProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetRewardProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetRewardProofs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetRewardProofs(ctx, req.(*GetRewardProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NodeService_ServiceDesc is the grpc.ServiceDesc for NodeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -571,6 +769,10 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNetworkInfo",
 			Handler:    _NodeService_GetNetworkInfo_Handler,
 		},
+		{
+			MethodName: "GetPeerScores",
+			Handler:    _NodeService_GetPeerScores_Handler,
+		},
 		{
 			MethodName: "GetTokenInfo",
 			Handler:    _NodeService_GetTokenInfo_Handler,
@@ -591,6 +793,26 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPreCoinProofsByAccount",
 			Handler:    _NodeService_GetPreCoinProofsByAccount_Handler,
 		},
+		{
+			MethodName: "GetMempool",
+			Handler:    _NodeService_GetMempool_Handler,
+		},
+		{
+			MethodName: "SimulateTokenRequests",
+			Handler:    _NodeService_SimulateTokenRequests_Handler,
+		},
+		{
+			MethodName: "GetForks",
+			Handler:    _NodeService_GetForks_Handler,
+		},
+		{
+			MethodName: "GetReorgHistory",
+			Handler:    _NodeService_GetReorgHistory_Handler,
+		},
+		{
+			MethodName: "GetRewardProofs",
+			Handler:    _NodeService_GetRewardProofs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "node.proto",
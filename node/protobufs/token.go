@@ -0,0 +1,126 @@
+package protobufs
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Verify checks that the request is well formed and that its signatures are
+// valid over the same payloads the token application verifies when applying
+// the request. It does not consult any state, so a verified request may still
+// fail to apply if the coins it references no longer exist.
+func (t *TokenRequest) Verify() error {
+	switch r := t.Request.(type) {
+	case *TokenRequest_Transfer:
+		if r.Transfer == nil || r.Transfer.Signature == nil ||
+			r.Transfer.OfCoin == nil || len(r.Transfer.OfCoin.Address) != 32 ||
+			r.Transfer.ToAccount == nil ||
+			r.Transfer.ToAccount.GetImplicitAccount() == nil ||
+			len(r.Transfer.ToAccount.GetImplicitAccount().Address) != 32 {
+			return errors.Wrap(errors.New("invalid transfer"), "verify")
+		}
+
+		payload := []byte("transfer")
+		payload = append(payload, r.Transfer.OfCoin.Address...)
+		payload = append(
+			payload,
+			r.Transfer.ToAccount.GetImplicitAccount().Address...,
+		)
+		return errors.Wrap(r.Transfer.Signature.Verify(payload), "verify")
+	case *TokenRequest_Split:
+		if r.Split == nil || r.Split.Signature == nil || r.Split.OfCoin == nil ||
+			r.Split.OfCoin.Address == nil || len(r.Split.Amounts) > 100 {
+			return errors.Wrap(errors.New("invalid split"), "verify")
+		}
+
+		payload := []byte("split")
+		payload = append(payload, r.Split.OfCoin.Address...)
+		for _, a := range r.Split.Amounts {
+			if len(a) > 32 {
+				return errors.Wrap(errors.New("invalid split"), "verify")
+			}
+			payload = append(payload, a...)
+		}
+		return errors.Wrap(r.Split.Signature.Verify(payload), "verify")
+	case *TokenRequest_Merge:
+		if r.Merge == nil || r.Merge.Coins == nil || r.Merge.Signature == nil {
+			return errors.Wrap(errors.New("invalid merge"), "verify")
+		}
+
+		payload := []byte("merge")
+		seen := map[string]struct{}{}
+		for _, c := range r.Merge.Coins {
+			if c == nil || c.Address == nil {
+				return errors.Wrap(errors.New("invalid merge"), "verify")
+			}
+			if _, ok := seen[string(c.Address)]; ok {
+				return errors.Wrap(errors.New("duplicate coin in merge"), "verify")
+			}
+			seen[string(c.Address)] = struct{}{}
+			payload = append(payload, c.Address...)
+		}
+		return errors.Wrap(r.Merge.Signature.Verify(payload), "verify")
+	case *TokenRequest_Mint:
+		if r.Mint == nil || r.Mint.Proofs == nil || r.Mint.Signature == nil {
+			return errors.Wrap(errors.New("invalid mint"), "verify")
+		}
+
+		payload := []byte("mint")
+		for _, p := range r.Mint.Proofs {
+			payload = append(payload, p...)
+		}
+		return errors.Wrap(r.Mint.Signature.Verify(payload), "verify")
+	case *TokenRequest_Announce:
+		if r.Announce == nil || len(r.Announce.PublicKeySignaturesEd448) == 0 {
+			return errors.Wrap(errors.New("invalid announce"), "verify")
+		}
+
+		var primary *Ed448Signature
+		payload := []byte{}
+		for i, p := range r.Announce.PublicKeySignaturesEd448 {
+			if p == nil || p.PublicKey == nil || p.Signature == nil ||
+				p.PublicKey.KeyValue == nil {
+				return errors.Wrap(errors.New("invalid announce"), "verify")
+			}
+			if i == 0 {
+				primary = p
+				continue
+			}
+
+			payload = append(payload, p.PublicKey.KeyValue...)
+			if err := p.Verify(primary.PublicKey.KeyValue); err != nil {
+				return errors.Wrap(err, "verify")
+			}
+		}
+		return errors.Wrap(primary.Verify(payload), "verify")
+	default:
+		return errors.Wrap(errors.New("unsupported request type"), "verify")
+	}
+}
+
+// CoinAddresses returns the addresses of the coins the request consumes, so
+// callers can detect requests that spend coins which no longer exist or that
+// conflict with each other.
+func (t *TokenRequest) CoinAddresses() [][]byte {
+	switch r := t.Request.(type) {
+	case *TokenRequest_Transfer:
+		if r.Transfer != nil && r.Transfer.OfCoin != nil {
+			return [][]byte{r.Transfer.OfCoin.Address}
+		}
+	case *TokenRequest_Split:
+		if r.Split != nil && r.Split.OfCoin != nil {
+			return [][]byte{r.Split.OfCoin.Address}
+		}
+	case *TokenRequest_Merge:
+		if r.Merge != nil {
+			addresses := [][]byte{}
+			for _, c := range r.Merge.Coins {
+				if c != nil {
+					addresses = append(addresses, c.Address)
+				}
+			}
+			return addresses
+		}
+	}
+
+	return nil
+}
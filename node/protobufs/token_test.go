@@ -0,0 +1,352 @@
+package protobufs_test
+
+import (
+	gocrypto "crypto"
+	"crypto/rand"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/ed448"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+func sign(
+	t *testing.T,
+	key ed448.PrivateKey,
+	payload []byte,
+) *protobufs.Ed448Signature {
+	sig, err := key.Sign(rand.Reader, payload, gocrypto.Hash(0))
+	require.NoError(t, err)
+
+	return &protobufs.Ed448Signature{
+		PublicKey: &protobufs.Ed448PublicKey{
+			KeyValue: key.Public().(ed448.PublicKey),
+		},
+		Signature: sig,
+	}
+}
+
+func address(b byte, size int) []byte {
+	a := make([]byte, size)
+	a[0] = b
+	return a
+}
+
+func transfer(
+	t *testing.T,
+	key ed448.PrivateKey,
+	coin []byte,
+	to []byte,
+) *protobufs.TokenRequest {
+	payload := append(append([]byte("transfer"), coin...), to...)
+	return &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Transfer{
+			Transfer: &protobufs.TransferCoinRequest{
+				OfCoin: &protobufs.CoinRef{Address: coin},
+				ToAccount: &protobufs.AccountRef{
+					Account: &protobufs.AccountRef_ImplicitAccount{
+						ImplicitAccount: &protobufs.ImplicitAccount{Address: to},
+					},
+				},
+				Signature: sign(t, key, payload),
+			},
+		},
+	}
+}
+
+func split(
+	t *testing.T,
+	key ed448.PrivateKey,
+	coin []byte,
+	amounts [][]byte,
+) *protobufs.TokenRequest {
+	payload := append([]byte("split"), coin...)
+	for _, a := range amounts {
+		payload = append(payload, a...)
+	}
+	return &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Split{
+			Split: &protobufs.SplitCoinRequest{
+				OfCoin:    &protobufs.CoinRef{Address: coin},
+				Amounts:   amounts,
+				Signature: sign(t, key, payload),
+			},
+		},
+	}
+}
+
+func merge(
+	t *testing.T,
+	key ed448.PrivateKey,
+	coins ...[]byte,
+) *protobufs.TokenRequest {
+	payload := []byte("merge")
+	refs := []*protobufs.CoinRef{}
+	for _, c := range coins {
+		payload = append(payload, c...)
+		refs = append(refs, &protobufs.CoinRef{Address: c})
+	}
+	return &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Merge{
+			Merge: &protobufs.MergeCoinRequest{
+				Coins:     refs,
+				Signature: sign(t, key, payload),
+			},
+		},
+	}
+}
+
+func mint(
+	t *testing.T,
+	key ed448.PrivateKey,
+	proofs ...[]byte,
+) *protobufs.TokenRequest {
+	payload := []byte("mint")
+	for _, p := range proofs {
+		payload = append(payload, p...)
+	}
+	return &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Mint{
+			Mint: &protobufs.MintCoinRequest{
+				Proofs:    proofs,
+				Signature: sign(t, key, payload),
+			},
+		},
+	}
+}
+
+func announce(
+	t *testing.T,
+	primary ed448.PrivateKey,
+	others ...ed448.PrivateKey,
+) *protobufs.TokenRequest {
+	primaryKey := primary.Public().(ed448.PublicKey)
+	payload := []byte{}
+	signatures := []*protobufs.Ed448Signature{nil}
+	for _, o := range others {
+		payload = append(payload, o.Public().(ed448.PublicKey)...)
+		signatures = append(signatures, sign(t, o, primaryKey))
+	}
+	signatures[0] = sign(t, primary, payload)
+	return &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Announce{
+			Announce: &protobufs.AnnounceProverRequest{
+				PublicKeySignaturesEd448: signatures,
+			},
+		},
+	}
+}
+
+// signatureOf returns the signature of the request, so cases can tamper with
+// it after signing.
+func signatureOf(r *protobufs.TokenRequest) *protobufs.Ed448Signature {
+	switch r := r.Request.(type) {
+	case *protobufs.TokenRequest_Transfer:
+		return r.Transfer.Signature
+	case *protobufs.TokenRequest_Split:
+		return r.Split.Signature
+	case *protobufs.TokenRequest_Merge:
+		return r.Merge.Signature
+	case *protobufs.TokenRequest_Mint:
+		return r.Mint.Signature
+	case *protobufs.TokenRequest_Announce:
+		return r.Announce.PublicKeySignaturesEd448[0]
+	}
+	return nil
+}
+
+func badSignature(r *protobufs.TokenRequest) *protobufs.TokenRequest {
+	signatureOf(r).Signature[0] ^= 0xff
+	return r
+}
+
+func oversizedKey(r *protobufs.TokenRequest) *protobufs.TokenRequest {
+	sig := signatureOf(r)
+	sig.PublicKey.KeyValue = append(sig.PublicKey.KeyValue, 0x00)
+	return r
+}
+
+func oversizedSignature(r *protobufs.TokenRequest) *protobufs.TokenRequest {
+	sig := signatureOf(r)
+	sig.Signature = append(sig.Signature, 0x00)
+	return r
+}
+
+func TestTokenRequestVerify(t *testing.T) {
+	_, key, err := ed448.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, other, err := ed448.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	coin := address(0x01, 32)
+	to := address(0x02, 32)
+	amounts := [][]byte{{0x01}, {0x02}}
+	tooManyAmounts := [][]byte{}
+	for i := 0; i < 101; i++ {
+		tooManyAmounts = append(tooManyAmounts, []byte{byte(i)})
+	}
+
+	tests := []struct {
+		name    string
+		request *protobufs.TokenRequest
+		valid   bool
+	}{
+		{"transfer", transfer(t, key, coin, to), true},
+		{
+			"transfer with bad signature",
+			badSignature(transfer(t, key, coin, to)),
+			false,
+		},
+		{
+			"transfer without coin",
+			&protobufs.TokenRequest{
+				Request: &protobufs.TokenRequest_Transfer{
+					Transfer: &protobufs.TransferCoinRequest{
+						Signature: sign(t, key, []byte("transfer")),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"transfer of oversized coin address",
+			transfer(t, key, address(0x01, 33), to),
+			false,
+		},
+		{
+			"transfer to oversized address",
+			transfer(t, key, coin, address(0x02, 33)),
+			false,
+		},
+		{"split", split(t, key, coin, amounts), true},
+		{
+			"split with bad signature",
+			badSignature(split(t, key, coin, amounts)),
+			false,
+		},
+		{
+			"split without coin",
+			&protobufs.TokenRequest{
+				Request: &protobufs.TokenRequest_Split{
+					Split: &protobufs.SplitCoinRequest{
+						Amounts:   amounts,
+						Signature: sign(t, key, []byte("split")),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"split into too many amounts",
+			split(t, key, coin, tooManyAmounts),
+			false,
+		},
+		{
+			"split into oversized amount",
+			split(t, key, coin, [][]byte{address(0x01, 33)}),
+			false,
+		},
+		{"merge", merge(t, key, coin, to), true},
+		{
+			"merge with bad signature",
+			badSignature(merge(t, key, coin, to)),
+			false,
+		},
+		{
+			"merge without signature",
+			&protobufs.TokenRequest{
+				Request: &protobufs.TokenRequest_Merge{
+					Merge: &protobufs.MergeCoinRequest{
+						Coins: []*protobufs.CoinRef{{Address: coin}},
+					},
+				},
+			},
+			false,
+		},
+		{"merge of duplicate coins", merge(t, key, coin, coin), false},
+		{
+			"merge with oversized key",
+			oversizedKey(merge(t, key, coin, to)),
+			false,
+		},
+		{"mint", mint(t, key, address(0x03, 64)), true},
+		{
+			"mint with bad signature",
+			badSignature(mint(t, key, address(0x03, 64))),
+			false,
+		},
+		{
+			"mint without proofs",
+			&protobufs.TokenRequest{
+				Request: &protobufs.TokenRequest_Mint{
+					Mint: &protobufs.MintCoinRequest{
+						Signature: sign(t, key, []byte("mint")),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"mint with oversized signature",
+			oversizedSignature(mint(t, key, address(0x03, 64))),
+			false,
+		},
+		{"announce", announce(t, key, other), true},
+		{
+			"announce with bad signature",
+			badSignature(announce(t, key, other)),
+			false,
+		},
+		{
+			"announce with bad cross signature",
+			func() *protobufs.TokenRequest {
+				r := announce(t, key, other)
+				r.GetAnnounce().PublicKeySignaturesEd448[1].Signature[0] ^= 0xff
+				return r
+			}(),
+			false,
+		},
+		{
+			"announce without signatures",
+			&protobufs.TokenRequest{
+				Request: &protobufs.TokenRequest_Announce{
+					Announce: &protobufs.AnnounceProverRequest{},
+				},
+			},
+			false,
+		},
+		{
+			"announce with oversized key",
+			oversizedKey(announce(t, key, other)),
+			false,
+		},
+		{"unsupported request", &protobufs.TokenRequest{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Verify()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTokenRequestCoinAddresses(t *testing.T) {
+	_, key, err := ed448.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	coin := address(0x01, 32)
+	to := address(0x02, 32)
+	assert.Equal(t, [][]byte{coin}, transfer(t, key, coin, to).CoinAddresses())
+	assert.Equal(
+		t,
+		[][]byte{coin},
+		split(t, key, coin, [][]byte{{0x01}}).CoinAddresses(),
+	)
+	assert.Equal(t, [][]byte{coin, to}, merge(t, key, coin, to).CoinAddresses())
+	assert.Nil(t, mint(t, key, address(0x03, 64)).CoinAddresses())
+}
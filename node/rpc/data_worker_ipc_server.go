@@ -165,8 +165,10 @@ func (r *DataWorkerIPCServer) CalculateChallengeProof(
 		return nil, errors.Wrap(err, "calculate challenge proof")
 	}
 
+	// The challenge follows the proof, as mint requests carry both for the
+	// token application to verify the proof and credit its peer.
 	return &protobufs.ChallengeProofResponse{
-		Output: proof,
+		Output: append(proof, challenge...),
 	}, nil
 }
 
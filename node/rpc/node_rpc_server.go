@@ -25,6 +25,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/master"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution"
+	hypergraph "source.quilibrium.com/quilibrium/monorepo/node/hypergraph/application"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
@@ -43,6 +44,8 @@ type RPCServer struct {
 	pubSub           p2p.PubSub
 	masterClock      *master.MasterClockConsensusEngine
 	executionEngines []execution.ExecutionEngine
+	messenger        *p2p.Messenger
+	hypergraphQuery  *hypergraph.HypergraphQueryService
 }
 
 // GetFrameInfo implements protobufs.NodeServiceServer.
@@ -150,6 +153,14 @@ func (r *RPCServer) GetNetworkInfo(
 	return r.pubSub.GetNetworkInfo(), nil
 }
 
+// GetPeerScores implements protobufs.NodeServiceServer.
+func (r *RPCServer) GetPeerScores(
+	ctx context.Context,
+	req *protobufs.GetPeerScoresRequest,
+) (*protobufs.PeerScoresResponse, error) {
+	return r.pubSub.GetPeerScores(), nil
+}
+
 // GetNodeInfo implements protobufs.NodeServiceServer.
 func (r *RPCServer) GetNodeInfo(
 	ctx context.Context,
@@ -265,6 +276,52 @@ func (r *RPCServer) GetTokensByAccount(
 	}, nil
 }
 
+func (r *RPCServer) GetMempool(
+	ctx context.Context,
+	req *protobufs.GetMempoolRequest,
+) (*protobufs.MempoolResponse, error) {
+	var sender []byte
+	if len(req.Sender) != 0 {
+		sender = req.Sender
+	}
+
+	return r.executionEngines[0].GetMempool(sender), nil
+}
+
+func (r *RPCServer) GetForks(
+	ctx context.Context,
+	req *protobufs.GetForksRequest,
+) (*protobufs.ForksResponse, error) {
+	return r.executionEngines[0].GetForks(), nil
+}
+
+func (r *RPCServer) GetReorgHistory(
+	ctx context.Context,
+	req *protobufs.GetReorgHistoryRequest,
+) (*protobufs.ReorgHistoryResponse, error) {
+	resp, err := r.executionEngines[0].GetReorgHistory(int(req.Limit))
+	return resp, errors.Wrap(err, "get reorg history")
+}
+
+func (r *RPCServer) GetRewardProofs(
+	ctx context.Context,
+	req *protobufs.GetRewardProofsRequest,
+) (*protobufs.RewardProofsResponse, error) {
+	return r.executionEngines[0].GetRewardProofs(), nil
+}
+
+func (r *RPCServer) SimulateTokenRequests(
+	ctx context.Context,
+	req *protobufs.TokenRequests,
+) (*protobufs.SimulateTokenRequestsResponse, error) {
+	if len(req.Requests) == 0 {
+		return nil, errors.New("no requests")
+	}
+
+	resp, err := r.executionEngines[0].SimulateTokenRequests(req)
+	return resp, errors.Wrap(err, "simulate token requests")
+}
+
 func (r *RPCServer) GetTokenInfo(
 	ctx context.Context,
 	req *protobufs.GetTokenInfoRequest,
@@ -349,6 +406,8 @@ func NewRPCServer(
 	pubSub p2p.PubSub,
 	masterClock *master.MasterClockConsensusEngine,
 	executionEngines []execution.ExecutionEngine,
+	messenger *p2p.Messenger,
+	hypergraphQuery *hypergraph.HypergraphQueryService,
 ) (*RPCServer, error) {
 	return &RPCServer{
 		listenAddrGRPC:   listenAddrGRPC,
@@ -361,6 +420,8 @@ func NewRPCServer(
 		pubSub:           pubSub,
 		masterClock:      masterClock,
 		executionEngines: executionEngines,
+		messenger:        messenger,
+		hypergraphQuery:  hypergraphQuery,
 	}, nil
 }
 
@@ -370,6 +431,8 @@ func (r *RPCServer) Start() error {
 		grpc.MaxSendMsgSize(600*1024*1024),
 	)
 	protobufs.RegisterNodeServiceServer(s, r)
+	protobufs.RegisterMessagingServiceServer(s, r.messenger)
+	protobufs.RegisterHypergraphQueryServiceServer(s, r.hypergraphQuery)
 	reflection.Register(s)
 
 	mg, err := multiaddr.NewMultiaddr(r.listenAddrGRPC)
@@ -423,6 +486,24 @@ func (r *RPCServer) Start() error {
 				panic(err)
 			}
 
+			if err := protobufs.RegisterMessagingServiceHandlerFromEndpoint(
+				context.Background(),
+				mux,
+				mga.String(),
+				opts,
+			); err != nil {
+				panic(err)
+			}
+
+			if err := protobufs.RegisterHypergraphQueryServiceHandlerFromEndpoint(
+				context.Background(),
+				mux,
+				mga.String(),
+				opts,
+			); err != nil {
+				panic(err)
+			}
+
 			if err := http.ListenAndServe(ma.String(), mux); err != nil {
 				panic(err)
 			}
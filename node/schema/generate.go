@@ -0,0 +1,249 @@
+package schema
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GenerateQCL generates a type for each class of the schema, with a function
+// marshaling it into the class's encoding and one unmarshaling and checking
+// an encoding, as Class.Encode and Class.Decode do.
+func (s *Schema) GenerateQCL() (string, error) {
+	imports := map[string]bool{}
+	body := &strings.Builder{}
+
+	for _, class := range s.Classes {
+		fmt.Fprintf(body, "type %s struct {\n", class.Name)
+		for _, field := range class.Fields {
+			if field.Comment != "" {
+				fmt.Fprintf(
+					body,
+					"// %s\n",
+					strings.ReplaceAll(field.Comment, "\n", "\n// "),
+				)
+			}
+			fmt.Fprintf(
+				body,
+				"%s %s `rdf:\"%s\"`\n",
+				field.Name,
+				field.Type,
+				field.Annotation,
+			)
+		}
+		body.WriteString("}\n\n")
+	}
+
+	for _, class := range s.Classes {
+		imports["errors"] = true
+		fmt.Fprintf(
+			body,
+			"func Unmarshal%s(payload []byte) (*%s, error) {\n",
+			class.Name,
+			class.Name,
+		)
+		fmt.Fprintf(
+			body,
+			"if len(payload) != %d {\nreturn nil, errors.New(\"invalid %s size\")\n}\n\n",
+			class.Size(),
+			class.Name,
+		)
+		fmt.Fprintf(body, "result := &%s{}\n", class.Name)
+
+		offset := uint32(0)
+		for _, field := range class.Fields {
+			code, fieldImports := unmarshalField(field, offset)
+			body.WriteString(code)
+			for _, i := range fieldImports {
+				imports[i] = true
+			}
+			offset += field.Size
+		}
+		body.WriteString("return result, nil\n}\n\n")
+	}
+
+	for _, class := range s.Classes {
+		fmt.Fprintf(
+			body,
+			"func Marshal%s(obj *%s) ([]byte, error) {\n",
+			class.Name,
+			class.Name,
+		)
+		fmt.Fprintf(body, "buf := make([]byte, %d)\n", class.Size())
+
+		offset := uint32(0)
+		for _, field := range class.Fields {
+			code, fieldImports := marshalField(field, offset)
+			body.WriteString(code)
+			for _, i := range fieldImports {
+				imports[i] = true
+			}
+			offset += field.Size
+		}
+		body.WriteString("return buf, nil\n}\n\n")
+	}
+
+	sortedImports := []string{}
+	for i := range imports {
+		sortedImports = append(sortedImports, i)
+	}
+	sort.Strings(sortedImports)
+
+	output := &strings.Builder{}
+	output.WriteString("package main\n\nimport (\n")
+	for _, i := range sortedImports {
+		fmt.Fprintf(output, "%q\n", i)
+	}
+	output.WriteString(")\n\n")
+	output.WriteString(body.String())
+
+	formatted, err := format.Source([]byte(output.String()))
+	if err != nil {
+		return "", errors.Wrap(err, "generate qcl")
+	}
+
+	return string(formatted), nil
+}
+
+// unmarshalField returns the code setting the field of result from payload,
+// and the imports it uses.
+func unmarshalField(field *Field, offset uint32) (string, []string) {
+	end := offset + field.Size
+	bits := field.Size * 8
+	switch field.RdfType {
+	case "Uint":
+		if field.Size == 1 {
+			return fmt.Sprintf(
+				"result.%s = payload[%d]\n",
+				field.Name,
+				offset,
+			), nil
+		}
+
+		return fmt.Sprintf(
+			"result.%s = binary.BigEndian.Uint%d(payload[%d:%d])\n",
+			field.Name,
+			bits,
+			offset,
+			end,
+		), []string{"encoding/binary"}
+	case "Int":
+		if field.Size == 1 {
+			return fmt.Sprintf(
+				"result.%s = int8(payload[%d])\n",
+				field.Name,
+				offset,
+			), nil
+		}
+
+		return fmt.Sprintf(
+			"result.%s = int%d(binary.BigEndian.Uint%d(payload[%d:%d]))\n",
+			field.Name,
+			bits,
+			bits,
+			offset,
+			end,
+		), []string{"encoding/binary"}
+	case "Float":
+		return fmt.Sprintf(
+			"result.%s = math.Float%dfrombits(binary.BigEndian.Uint%d(payload[%d:%d]))\n",
+			field.Name,
+			bits,
+			bits,
+			offset,
+			end,
+		), []string{"encoding/binary", "math"}
+	case "Bool":
+		return fmt.Sprintf(
+			"switch payload[%d] {\ncase 0x00:\ncase 0xff:\nresult.%s = true\n"+
+				"default:\nreturn nil, errors.New(\"invalid %s\")\n}\n",
+			offset,
+			field.Name,
+			field.Name,
+		), nil
+	case "String":
+		return fmt.Sprintf(
+			"result.%s = strings.TrimRight(string(payload[%d:%d]), \"\\x00\")\n",
+			field.Name,
+			offset,
+			end,
+		), []string{"strings"}
+	default:
+		return fmt.Sprintf(
+			"copy(result.%s[:], payload[%d:%d])\n",
+			field.Name,
+			offset,
+			end,
+		), nil
+	}
+}
+
+// marshalField returns the code writing the field of obj into buf, and the
+// imports it uses.
+func marshalField(field *Field, offset uint32) (string, []string) {
+	end := offset + field.Size
+	bits := field.Size * 8
+	switch field.RdfType {
+	case "Uint":
+		if field.Size == 1 {
+			return fmt.Sprintf("buf[%d] = obj.%s\n", offset, field.Name), nil
+		}
+
+		return fmt.Sprintf(
+			"binary.BigEndian.PutUint%d(buf[%d:%d], obj.%s)\n",
+			bits,
+			offset,
+			end,
+			field.Name,
+		), []string{"encoding/binary"}
+	case "Int":
+		if field.Size == 1 {
+			return fmt.Sprintf("buf[%d] = byte(obj.%s)\n", offset, field.Name), nil
+		}
+
+		return fmt.Sprintf(
+			"binary.BigEndian.PutUint%d(buf[%d:%d], uint%d(obj.%s))\n",
+			bits,
+			offset,
+			end,
+			bits,
+			field.Name,
+		), []string{"encoding/binary"}
+	case "Float":
+		return fmt.Sprintf(
+			"binary.BigEndian.PutUint%d(buf[%d:%d], math.Float%dbits(obj.%s))\n",
+			bits,
+			offset,
+			end,
+			bits,
+			field.Name,
+		), []string{"encoding/binary", "math"}
+	case "Bool":
+		return fmt.Sprintf(
+			"if obj.%s {\nbuf[%d] = 0xff\n}\n",
+			field.Name,
+			offset,
+		), nil
+	case "String":
+		return fmt.Sprintf(
+			"if len(obj.%s) > %d {\nreturn nil, errors.New(\"%s too long\")\n}\n"+
+				"copy(buf[%d:%d], obj.%s)\n",
+			field.Name,
+			field.Size,
+			field.Name,
+			offset,
+			end,
+			field.Name,
+		), nil
+	default:
+		return fmt.Sprintf(
+			"copy(buf[%d:%d], obj.%s[:])\n",
+			offset,
+			end,
+			field.Name,
+		), nil
+	}
+}
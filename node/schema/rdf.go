@@ -2,6 +2,7 @@ package schema
 
 import (
 	"fmt"
+	"go/token"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,6 +16,7 @@ type RDFParser interface {
 }
 
 type TurtleRDFParser struct {
+	registry *SchemaRegistry
 }
 
 type Field struct {
@@ -26,6 +28,8 @@ type Field struct {
 	RdfType    string
 	Order      int
 	ClassUrl   rdf2go.Term
+	// Reference is the url of the class a Struct field refers to.
+	Reference string
 }
 
 const RdfNS = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
@@ -59,26 +63,66 @@ var qclRdfTypeMap = map[string]string{
 	"Bool":      "bool",
 	"Float":     "float%d",
 	"String":    "string",
-	"Struct":    "struct",
 }
 
+// The sizes in bytes the fixed width types can have.
+var qclRdfTypeSizes = map[string][]uint32{
+	"Uint":  {1, 2, 4, 8},
+	"Int":   {1, 2, 4, 8},
+	"Float": {4, 8},
+	"Bool":  {1},
+}
+
+// Struct fields hold a reference to an instance of the class.
+const referenceSize = 32
+
+// NewTurtleRDFParser creates a parser resolving references to the classes of
+// other schemas with the registry. Without a registry, Struct fields may only
+// reference classes of the same document.
+func NewTurtleRDFParser(registry *SchemaRegistry) *TurtleRDFParser {
+	return &TurtleRDFParser{
+		registry: registry,
+	}
+}
+
+// Validate checks the document parses into a valid schema and its Struct
+// fields reference known classes.
 func (t *TurtleRDFParser) Validate(document string) (bool, error) {
-	g := rdf2go.NewGraph("https://types.quilibrium.com/schema-repository/")
-	reader := strings.NewReader(document)
-	err := g.Parse(reader, "text/turtle")
-	if err != nil {
+	if _, err := t.Parse(document); err != nil {
 		return false, errors.Wrap(err, "validate")
 	}
 
 	return true, nil
 }
 
+// GenerateQCL generates the types of the document's classes, with functions
+// marshaling them to and unmarshaling them from their fixed size encoding.
 func (t *TurtleRDFParser) GenerateQCL(document string) (string, error) {
-	g := rdf2go.NewGraph("https://types.quilibrium.com/schema-repository/")
+	schema, err := t.Parse(document)
+	if err != nil {
+		return "", errors.Wrap(err, "generate qcl")
+	}
+
+	output, err := schema.GenerateQCL()
+	return output, errors.Wrap(err, "generate qcl")
+}
+
+// splitIri splits an iri into its namespace and local name.
+func splitIri(iri string) (string, string) {
+	parts := strings.Split(iri, "#")
+	name := parts[len(parts)-1]
+	parts = strings.Split(name, "/")
+	name = parts[len(parts)-1]
+	return iri[:len(iri)-len(name)], name
+}
+
+// Parse parses the document's classes and their fields, ordered by qcl:order.
+func (t *TurtleRDFParser) Parse(document string) (*Schema, error) {
+	g := rdf2go.NewGraph(SchemaRepositoryNS)
 	reader := strings.NewReader(document)
 	err := g.Parse(reader, "text/turtle")
 	if err != nil {
-		return "", errors.Wrap(err, "validate")
+		return nil, errors.Wrap(err, "parse")
 	}
 
 	prefixMap := make(map[string]string)
@@ -88,364 +132,267 @@ func (t *TurtleRDFParser) GenerateQCL(document string) (string, error) {
 		switch parts[0] {
 		case "PREFIX":
 			if len(parts) != 3 {
-				return "", errors.Wrap(err, "invalid PREFIX line")
+				return nil, errors.Wrap(
+					errors.New("invalid PREFIX line"),
+					"parse",
+				)
 			}
 
 			prefixMap[strings.Trim(parts[2], "<>")] = parts[1]
 		}
 	}
 
-	iter := g.IterTriples()
-	classes := []string{}
+	schema := &Schema{
+		Address: SchemaAddress(document),
+		Classes: []*Class{},
+	}
 	classTerms := []rdf2go.Term{}
-	classUrls := []string{}
-	fields := make(map[string]map[string]*Field)
-	for a := range iter {
+	for a := range g.IterTriples() {
 		if a.Predicate.String() == rdfTypeN &&
 			a.Object.String() == rdfsClassN {
-			subj := a.Subject.RawValue()
-			parts := strings.Split(subj, "#")
-			className := parts[len(parts)-1]
-			parts = strings.Split(className, "/")
-			className = parts[len(parts)-1]
-			classUrl := subj[:len(subj)-len(className)]
-			classes = append(classes, className)
-			classUrls = append(classUrls, classUrl)
+			_, className := splitIri(a.Subject.RawValue())
+			if !token.IsIdentifier(className) {
+				return nil, errors.Wrap(
+					fmt.Errorf("invalid class name: %s", className),
+					"parse",
+				)
+			}
+
+			schema.Classes = append(schema.Classes, &Class{
+				Name:   className,
+				Url:    a.Subject.RawValue(),
+				Fields: []*Field{},
+			})
 			classTerms = append(classTerms, a.Subject)
 		}
 	}
 
 	for i, c := range classTerms {
+		class := schema.Classes[i]
 		for _, prop := range g.All(nil, rdf2go.NewResource(rdfsRange), c) {
-			subj := prop.Subject.RawValue()
-			parts := strings.Split(subj, "#")
-			className := parts[len(parts)-1]
-			parts = strings.Split(className, "/")
-			className = parts[len(parts)-1]
-			classUrl := subj[:len(subj)-len(className)]
-			if _, ok := fields[classes[i]]; !ok {
-				fields[classes[i]] = make(map[string]*Field)
+			classUrl, fieldName := splitIri(prop.Subject.RawValue())
+			if !token.IsIdentifier(fieldName) {
+				return nil, errors.Wrap(
+					fmt.Errorf("invalid field name: %s", fieldName),
+					"parse",
+				)
 			}
 
-			fields[classes[i]][className] = &Field{
-				Name:       className,
+			field := &Field{
+				Name:       fieldName,
 				ClassUrl:   prop.Subject,
-				Annotation: prefixMap[classUrl] + className,
+				Annotation: prefixMap[classUrl] + fieldName,
 				Order:      -1,
 			}
+			if err := parseField(g, prefixMap, field); err != nil {
+				return nil, errors.Wrap(err, "parse")
+			}
+
+			class.Fields = append(class.Fields, field)
 		}
-	}
 
-	for _, class := range fields {
-		for fieldName, field := range class {
-			// scan the types
-			for _, prop := range g.All(field.ClassUrl, rdf2go.NewResource(
-				rdfsDomain,
-			), nil) {
-				obj := prop.Object.RawValue()
-				parts := strings.Split(obj, "#")
-				className := parts[len(parts)-1]
-				parts = strings.Split(className, "/")
-				className = parts[len(parts)-1]
-				classUrl := obj[:len(obj)-len(className)]
-				switch classUrl {
-				case QCLNS:
-					field.Type = qclRdfTypeMap[className]
-					for _, sprop := range g.All(field.ClassUrl, rdf2go.NewResource(
-						qclSize,
-					), nil) {
-						sobj := sprop.Object.RawValue()
-						parts := strings.Split(sobj, "#")
-						size := parts[len(parts)-1]
-						parts = strings.Split(size, "/")
-						size = parts[len(parts)-1]
-						s, err := strconv.Atoi(size)
-						fieldSize := s
-						if className != "String" && className != "ByteArray" && className != "Struct" {
-							fieldSize *= 8
-						}
-						if err != nil || s < 1 {
-							return "", errors.Wrap(
-								fmt.Errorf(
-									"invalid size for %s: %s",
-									fieldName,
-									size,
-								),
-								"generate qcl",
-							)
-						}
-						if strings.Contains(field.Type, "%") {
-							field.Type = fmt.Sprintf(field.Type, fieldSize)
-						}
-						field.RdfType = className
-						field.Size = uint32(s)
-					}
-					if strings.Contains(field.Type, "%d") {
-						return "", errors.Wrap(
-							fmt.Errorf(
-								"size unspecified for %s, add a qcl:size predicate",
-								fieldName,
-							),
-							"generate qcl",
-						)
-					}
-				case RdfsNS:
-					if className != "Literal" {
-						return "", errors.Wrap(
-							fmt.Errorf(
-								"invalid property type for %s: %s",
-								fieldName,
-								className,
-							),
-							"generate qcl",
-						)
-					}
-					field.Type = className
-				default:
-					field.Type = "hypergraph.Extrinsic"
-					field.Annotation += ",extrinsic=" + prefixMap[classUrl] + className
-					field.Size = 32
-					field.RdfType = "Struct"
-				}
-				break
+		sort.Slice(class.Fields, func(i, j int) bool {
+			return class.Fields[i].Order < class.Fields[j].Order
+		})
+		for j := 1; j < len(class.Fields); j++ {
+			if class.Fields[j].Order == class.Fields[j-1].Order {
+				return nil, errors.Wrap(
+					fmt.Errorf(
+						"duplicate order for %s and %s",
+						class.Fields[j-1].Name,
+						class.Fields[j].Name,
+					),
+					"parse",
+				)
 			}
+		}
+	}
 
-			for _, sprop := range g.All(field.ClassUrl, rdf2go.NewResource(
-				qclOrder,
-			), nil) {
-				sobj := sprop.Object.RawValue()
-				parts := strings.Split(sobj, "#")
-				order := parts[len(parts)-1]
-				parts = strings.Split(order, "/")
-				order = parts[len(parts)-1]
-				o, err := strconv.Atoi(order)
-				fieldOrder := o
-				if err != nil || o < 0 {
-					return "", errors.Wrap(
-						fmt.Errorf(
-							"invalid order for %s: %s",
-							fieldName,
-							order,
-						),
-						"generate qcl",
-					)
-				}
-				field.Order = fieldOrder
-			}
-			if field.Order < 0 {
-				return "", errors.Wrap(
+	sort.Slice(schema.Classes, func(i, j int) bool {
+		return strings.Compare(schema.Classes[i].Name, schema.Classes[j].Name) < 0
+	})
+
+	if err := t.resolveReferences(schema); err != nil {
+		return nil, errors.Wrap(err, "parse")
+	}
+
+	return schema, nil
+}
+
+// parseField reads the type, size, order and comment of the field.
+func parseField(
+	g *rdf2go.Graph,
+	prefixMap map[string]string,
+	field *Field,
+) error {
+	fieldName := field.Name
+	for _, prop := range g.All(field.ClassUrl, rdf2go.NewResource(
+		rdfsDomain,
+	), nil) {
+		obj := prop.Object.RawValue()
+		classUrl, className := splitIri(obj)
+		switch classUrl {
+		case QCLNS:
+			fieldType, ok := qclRdfTypeMap[className]
+			if !ok {
+				return errors.Wrap(
 					fmt.Errorf(
-						"field order unspecified for %s, add a qcl:order predicate",
+						"invalid property type for %s: %s",
 						fieldName,
+						className,
 					),
-					"generate qcl",
+					"parse field",
 				)
 			}
 
-			for _, prop := range g.All(field.ClassUrl, rdf2go.NewResource(
-				rdfsComment,
-			), nil) {
-				field.Comment = prop.Object.String()
+			field.Type = fieldType
+			field.RdfType = className
+		case RdfsNS:
+			if className != "Literal" {
+				return errors.Wrap(
+					fmt.Errorf(
+						"invalid property type for %s: %s",
+						fieldName,
+						className,
+					),
+					"parse field",
+				)
 			}
+
+			// Literals are held as strings.
+			field.Type = qclRdfTypeMap["String"]
+			field.RdfType = "String"
+		default:
+			field.Type = fmt.Sprintf("[%d]byte", referenceSize)
+			field.Annotation += ",extrinsic=" + prefixMap[classUrl] + className
+			field.Size = referenceSize
+			field.RdfType = "Struct"
+			field.Reference = obj
 		}
+		break
 	}
 
-	output := "package main\n\n"
-
-	sort.Slice(classes, func(i, j int) bool {
-		return strings.Compare(classes[i], classes[j]) < 0
-	})
+	if field.RdfType == "" {
+		return errors.Wrap(
+			fmt.Errorf(
+				"type unspecified for %s, add a rdfs:domain predicate",
+				fieldName,
+			),
+			"parse field",
+		)
+	}
 
-	for _, class := range classes {
-		output += fmt.Sprintf("type %s struct {\n", class)
+	if field.RdfType == "Bool" {
+		field.Size = 1
+	}
 
-		sortedFields := []*Field{}
-		for _, field := range fields[class] {
-			sortedFields = append(sortedFields, field)
-		}
-		sort.Slice(sortedFields, func(i, j int) bool {
-			return sortedFields[i].Order < sortedFields[j].Order
-		})
-		for _, field := range sortedFields {
-			if field.Comment != "" {
-				output += fmt.Sprintf("  // %s\n", field.Comment)
-			}
-			output += fmt.Sprintf(
-				"  %s %s `rdf:\"%s\"`\n",
-				field.Name,
-				field.Type,
-				field.Annotation,
+	for _, sprop := range g.All(field.ClassUrl, rdf2go.NewResource(
+		qclSize,
+	), nil) {
+		_, size := splitIri(sprop.Object.RawValue())
+		s, err := strconv.Atoi(size)
+		if err != nil || s < 1 || !validSize(field.RdfType, uint32(s)) {
+			return errors.Wrap(
+				fmt.Errorf(
+					"invalid size for %s: %s",
+					fieldName,
+					size,
+				),
+				"parse field",
 			)
 		}
-		output += "}\n\n"
-	}
 
-	for _, class := range classes {
-		totalSize := uint32(0)
-		for _, field := range fields[class] {
-			totalSize += field.Size
+		fieldSize := s
+		if field.RdfType != "String" && field.RdfType != "ByteArray" {
+			fieldSize *= 8
 		}
-		output += fmt.Sprintf(
-			"func Unmarshal%s(payload [%d]byte) %s {\n  result := %s{}\n",
-			class,
-			totalSize,
-			class,
-			class,
-		)
-		s := uint32(0)
-		sortedFields := []*Field{}
-		for _, field := range fields[class] {
-			sortedFields = append(sortedFields, field)
+		if strings.Contains(field.Type, "%") {
+			field.Type = fmt.Sprintf(field.Type, fieldSize)
 		}
-		sort.Slice(sortedFields, func(i, j int) bool {
-			return sortedFields[i].Order < sortedFields[j].Order
-		})
-		for _, field := range sortedFields {
-			sizedType := ""
-			switch field.RdfType {
-			case "Uint":
-				sizedType = fmt.Sprintf(
-					"binary.GetUint(payload[%d:%d])",
-					s,
-					s+field.Size,
-				)
-				s += field.Size
-			case "Int":
-				sizedType = fmt.Sprintf(
-					"int%d(binary.GetUint(payload[%d:%d]))",
-					field.Size,
-					s,
-					s+field.Size,
-				)
-				s += field.Size
-			case "ByteArray":
-				sizedType = fmt.Sprintf(
-					"payload[%d:%d]",
-					s,
-					s+field.Size,
-				)
-				s += field.Size
-			case "Bool":
-				sizedType = "bool"
-				s++
-			case "Float":
-				sizedType = fmt.Sprintf(
-					"payload[%d:%d]",
-					s,
-					s+field.Size,
-				)
-				s += field.Size
-			case "String":
-				sizedType = fmt.Sprintf(
-					"string(payload[%d:%d])",
-					s,
-					s+field.Size,
-				)
-				s += field.Size
-			case "Struct":
-				sizedType = fmt.Sprintf(
-					"hypergraph.Extrinsic{}\n  result.%s.Ref = payload[%d:%d]",
-					field.Name,
-					s,
-					s+field.Size,
-				)
-				s += field.Size
-			}
-			output += fmt.Sprintf(
-				"  result.%s = %s\n",
-				field.Name,
-				sizedType,
-			)
-		}
-		output += "  return result\n}\n\n"
+		field.Size = uint32(s)
+	}
+	if strings.Contains(field.Type, "%d") || field.Size == 0 {
+		return errors.Wrap(
+			fmt.Errorf(
+				"size unspecified for %s, add a qcl:size predicate",
+				fieldName,
+			),
+			"parse field",
+		)
 	}
 
-	for _, class := range classes {
-		totalSize := uint32(0)
-		for _, field := range fields[class] {
-			totalSize += field.Size
+	for _, sprop := range g.All(field.ClassUrl, rdf2go.NewResource(
+		qclOrder,
+	), nil) {
+		_, order := splitIri(sprop.Object.RawValue())
+		o, err := strconv.Atoi(order)
+		if err != nil || o < 0 {
+			return errors.Wrap(
+				fmt.Errorf(
+					"invalid order for %s: %s",
+					fieldName,
+					order,
+				),
+				"parse field",
+			)
 		}
-		output += fmt.Sprintf(
-			"func Marshal%s(obj %s) [%d]byte {\n",
-			class,
-			class,
-			totalSize,
+		field.Order = o
+	}
+	if field.Order < 0 {
+		return errors.Wrap(
+			fmt.Errorf(
+				"field order unspecified for %s, add a qcl:order predicate",
+				fieldName,
+			),
+			"parse field",
 		)
-		s := uint32(0)
-		sortedFields := []*Field{}
-		for _, field := range fields[class] {
-			sortedFields = append(sortedFields, field)
+	}
+
+	for _, prop := range g.All(field.ClassUrl, rdf2go.NewResource(
+		rdfsComment,
+	), nil) {
+		field.Comment = prop.Object.RawValue()
+	}
+
+	return nil
+}
+
+func validSize(rdfType string, size uint32) bool {
+	sizes, ok := qclRdfTypeSizes[rdfType]
+	if !ok {
+		return true
+	}
+
+	for _, s := range sizes {
+		if s == size {
+			return true
 		}
-		sort.Slice(sortedFields, func(i, j int) bool {
-			return sortedFields[i].Order < sortedFields[j].Order
-		})
-		output += fmt.Sprintf("  buf := make([]byte, %d)\n", totalSize)
-
-		for _, field := range sortedFields {
-			sizedType := ""
-			switch field.RdfType {
-			case "Uint":
-				sizedType = fmt.Sprintf(
-					"binary.PutUint(buf, %d, obj.%s)",
-					s,
-					field.Name,
-				)
-				s += field.Size
-			case "Int":
-				sizedType = fmt.Sprintf(
-					"binary.PutInt(buf, %d, obj.%s)",
-					s,
-					field.Name,
-				)
-				s += field.Size
-			case "ByteArray":
-				sizedType = fmt.Sprintf(
-					"copy(buf[%d:%d], obj.%s)",
-					s,
-					s+field.Size,
-					field.Name,
-				)
-				s += field.Size
-			case "Bool":
-				sizedType = fmt.Sprintf(
-					"if obj.%s { buf[%d] = 0xff } else { buf[%d] = 0x00 }",
-					field.Name,
-					s,
-					s,
-				)
-				s++
-			case "Float":
-				sizedType = fmt.Sprintf(
-					"copy(buf[%d:%d], obj.%s)",
-					s,
-					s+field.Size,
-					field.Name,
-				)
-				s += field.Size
-			case "String":
-				sizedType = fmt.Sprintf(
-					"copy(buf[%d:%d], []byte(obj.%s))",
-					s,
-					s+field.Size,
-					field.Name,
-				)
-				s += field.Size
-			case "Struct":
-				sizedType = fmt.Sprintf(
-					"copy(buf[%d:%d], obj.%s.Ref)",
-					s,
-					s+field.Size,
-					field.Name,
+	}
+
+	return false
+}
+
+// resolveReferences checks each Struct field references a class of the
+// schema or, through the registry, of a registered schema.
+func (t *TurtleRDFParser) resolveReferences(schema *Schema) error {
+	for _, class := range schema.Classes {
+		for _, field := range class.Fields {
+			if field.RdfType != "Struct" || schema.classByUrl(field.Reference) != nil {
+				continue
+			}
+
+			if t.registry == nil {
+				return errors.Wrap(
+					errors.Wrap(ErrUnresolvedReference, field.Reference),
+					"resolve references",
 				)
-				s += field.Size
 			}
-			output += fmt.Sprintf(
-				"  %s\n",
-				sizedType,
-			)
+
+			if _, err := t.registry.Resolve(field.Reference); err != nil {
+				return errors.Wrap(err, "resolve references")
+			}
 		}
-		output += "  return buf\n}\n\n"
 	}
 
-	return output, nil
+	return nil
 }
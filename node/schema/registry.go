@@ -0,0 +1,182 @@
+package schema
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+var ErrSchemaNotFound = errors.New("schema not found")
+
+// SchemaAddress returns the content address of a schema document: the schema
+// repository url of the hash of the document. Other schemas reference a
+// class of the document as the address followed by the class name.
+func SchemaAddress(document string) string {
+	h := sha3.Sum256([]byte(document))
+	return SchemaRepositoryNS + hex.EncodeToString(h[:]) + "/"
+}
+
+// SchemaRegistry holds schema documents by content address, resolving the
+// Struct fields of schemas that reference classes of other schemas. It is
+// kept in memory, or in a directory with a file per document.
+type SchemaRegistry struct {
+	mx        sync.RWMutex
+	dir       string
+	documents map[string]string
+}
+
+// NewSchemaRegistry creates a registry kept in memory.
+func NewSchemaRegistry() *SchemaRegistry {
+	return &SchemaRegistry{
+		documents: map[string]string{},
+	}
+}
+
+// NewFileSchemaRegistry creates a registry kept in the directory, creating it
+// if needed.
+func NewFileSchemaRegistry(dir string) (*SchemaRegistry, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "new file schema registry")
+	}
+
+	return &SchemaRegistry{
+		dir:       dir,
+		documents: map[string]string{},
+	}, nil
+}
+
+// addressHash returns the hex encoded hash of a content address.
+func addressHash(address string) (string, error) {
+	hash, ok := strings.CutPrefix(address, SchemaRepositoryNS)
+	hash, _ = strings.CutSuffix(hash, "/")
+	if !ok || len(hash) != 64 {
+		return "", errors.Wrap(ErrSchemaNotFound, address)
+	}
+
+	if _, err := hex.DecodeString(hash); err != nil {
+		return "", errors.Wrap(ErrSchemaNotFound, address)
+	}
+
+	return hash, nil
+}
+
+// Register checks the document is a valid schema whose references resolve,
+// and adds it to the registry. It returns the document's address.
+func (r *SchemaRegistry) Register(document string) (string, error) {
+	schema, err := NewTurtleRDFParser(r).Parse(document)
+	if err != nil {
+		return "", errors.Wrap(err, "register")
+	}
+
+	hash, err := addressHash(schema.Address)
+	if err != nil {
+		return "", errors.Wrap(err, "register")
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.dir != "" {
+		if err := os.WriteFile(
+			filepath.Join(r.dir, hash+".ttl"),
+			[]byte(document),
+			0600,
+		); err != nil {
+			return "", errors.Wrap(err, "register")
+		}
+	}
+
+	r.documents[hash] = document
+	return schema.Address, nil
+}
+
+// GetDocument returns the document at the address.
+func (r *SchemaRegistry) GetDocument(address string) (string, error) {
+	hash, err := addressHash(address)
+	if err != nil {
+		return "", errors.Wrap(err, "get document")
+	}
+
+	r.mx.RLock()
+	document, ok := r.documents[hash]
+	r.mx.RUnlock()
+	if ok {
+		return document, nil
+	}
+
+	if r.dir == "" {
+		return "", errors.Wrap(
+			errors.Wrap(ErrSchemaNotFound, address),
+			"get document",
+		)
+	}
+
+	data, err := os.ReadFile(filepath.Join(r.dir, hash+".ttl"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", errors.Wrap(
+				errors.Wrap(ErrSchemaNotFound, address),
+				"get document",
+			)
+		}
+
+		return "", errors.Wrap(err, "get document")
+	}
+
+	// A document stored under another hash was not written by the registry.
+	if SchemaAddress(string(data)) != SchemaRepositoryNS+hash+"/" {
+		return "", errors.Wrap(
+			errors.Errorf("document does not match address %s", address),
+			"get document",
+		)
+	}
+
+	r.mx.Lock()
+	r.documents[hash] = string(data)
+	r.mx.Unlock()
+
+	return string(data), nil
+}
+
+// GetSchema returns the parsed schema at the address.
+func (r *SchemaRegistry) GetSchema(address string) (*Schema, error) {
+	document, err := r.GetDocument(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "get schema")
+	}
+
+	schema, err := NewTurtleRDFParser(r).Parse(document)
+	return schema, errors.Wrap(err, "get schema")
+}
+
+// Resolve returns the class a reference of another schema points at, the
+// address of the class's schema followed by the class name.
+func (r *SchemaRegistry) Resolve(classUrl string) (*Class, error) {
+	address, className := splitIri(classUrl)
+	schema, err := r.GetSchema(address)
+	if err != nil {
+		if errors.Is(err, ErrSchemaNotFound) {
+			return nil, errors.Wrap(
+				errors.Wrap(ErrUnresolvedReference, classUrl),
+				"resolve",
+			)
+		}
+
+		return nil, errors.Wrap(err, "resolve")
+	}
+
+	class := schema.GetClass(className)
+	if class == nil {
+		return nil, errors.Wrap(
+			errors.Wrap(ErrUnresolvedReference, classUrl),
+			"resolve",
+		)
+	}
+
+	return class, nil
+}
@@ -0,0 +1,357 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidInstance = errors.New("invalid instance")
+var ErrUnresolvedReference = errors.New("unresolved reference")
+
+// Schema is the classes of a schema document, in name order.
+type Schema struct {
+	// Address is the content address of the document, which other schemas
+	// reference its classes under.
+	Address string
+	Classes []*Class
+}
+
+// Class is a type of a schema, encoded as its fields in order, each taking
+// its size.
+type Class struct {
+	Name   string
+	Url    string
+	Fields []*Field
+}
+
+// GetClass returns the class of the schema with the name, nil if there is
+// none.
+func (s *Schema) GetClass(name string) *Class {
+	for _, class := range s.Classes {
+		if class.Name == name {
+			return class
+		}
+	}
+
+	return nil
+}
+
+func (s *Schema) classByUrl(url string) *Class {
+	for _, class := range s.Classes {
+		if class.Url == url {
+			return class
+		}
+	}
+
+	return nil
+}
+
+// Size returns the size of the encoding of the class.
+func (c *Class) Size() uint32 {
+	size := uint32(0)
+	for _, field := range c.Fields {
+		size += field.Size
+	}
+
+	return size
+}
+
+// ValidateInstance checks the instance has a value for each field of the
+// class and no others, and that each value fits the type and size of its
+// field.
+func (c *Class) ValidateInstance(instance map[string]any) error {
+	_, err := c.Encode(instance)
+	return errors.Wrap(err, "validate instance")
+}
+
+// Encode validates the instance and encodes it. Unsigned and signed
+// integers, booleans and floats are encoded big endian, strings are zero
+// padded and byte arrays and Struct references are encoded as is. Byte
+// arrays and references can be given as bytes or hex strings, numbers as Go
+// numbers or json.Number.
+func (c *Class) Encode(instance map[string]any) ([]byte, error) {
+	for name := range instance {
+		if c.field(name) == nil {
+			return nil, errors.Wrap(
+				errors.Wrapf(ErrInvalidInstance, "unknown field %s", name),
+				"encode",
+			)
+		}
+	}
+
+	buf := make([]byte, 0, c.Size())
+	for _, field := range c.Fields {
+		value, ok := instance[field.Name]
+		if !ok {
+			return nil, errors.Wrap(
+				errors.Wrapf(ErrInvalidInstance, "missing field %s", field.Name),
+				"encode",
+			)
+		}
+
+		encoded, err := field.encode(value)
+		if err != nil {
+			return nil, errors.Wrap(
+				errors.Wrapf(ErrInvalidInstance, "field %s: %s", field.Name, err),
+				"encode",
+			)
+		}
+
+		buf = append(buf, encoded...)
+	}
+
+	return buf, nil
+}
+
+// Decode checks the payload is an encoded instance of the class and decodes
+// it. Unsigned integers decode to uint64, signed integers to int64, floats
+// to float64, byte arrays and references to []byte.
+func (c *Class) Decode(payload []byte) (map[string]any, error) {
+	if len(payload) != int(c.Size()) {
+		return nil, errors.Wrap(
+			errors.Wrapf(
+				ErrInvalidInstance,
+				"payload of %d bytes, expected %d",
+				len(payload),
+				c.Size(),
+			),
+			"decode",
+		)
+	}
+
+	instance := map[string]any{}
+	offset := uint32(0)
+	for _, field := range c.Fields {
+		value, err := field.decode(payload[offset : offset+field.Size])
+		if err != nil {
+			return nil, errors.Wrap(
+				errors.Wrapf(ErrInvalidInstance, "field %s: %s", field.Name, err),
+				"decode",
+			)
+		}
+
+		instance[field.Name] = value
+		offset += field.Size
+	}
+
+	return instance, nil
+}
+
+func (c *Class) field(name string) *Field {
+	for _, field := range c.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+func (f *Field) encode(value any) ([]byte, error) {
+	buf := make([]byte, 8)
+	switch f.RdfType {
+	case "Uint":
+		u, err := toUint(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if f.Size < 8 && u>>(f.Size*8) != 0 {
+			return nil, errors.New("value out of range")
+		}
+
+		binary.BigEndian.PutUint64(buf, u)
+		return buf[8-f.Size:], nil
+	case "Int":
+		i, err := toInt(value)
+		if err != nil {
+			return nil, err
+		}
+
+		bits := f.Size * 8
+		if bits < 64 && (i < -(1<<(bits-1)) || i >= 1<<(bits-1)) {
+			return nil, errors.New("value out of range")
+		}
+
+		binary.BigEndian.PutUint64(buf, uint64(i))
+		return buf[8-f.Size:], nil
+	case "Float":
+		v, err := toFloat(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if f.Size == 4 {
+			if !math.IsInf(v, 0) && math.Abs(v) > math.MaxFloat32 {
+				return nil, errors.New("value out of range")
+			}
+
+			binary.BigEndian.PutUint32(buf, math.Float32bits(float32(v)))
+			return buf[:4], nil
+		}
+
+		binary.BigEndian.PutUint64(buf, math.Float64bits(v))
+		return buf, nil
+	case "Bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, errors.New("not a bool")
+		}
+
+		if b {
+			return []byte{0xff}, nil
+		}
+
+		return []byte{0x00}, nil
+	case "String":
+		s, ok := value.(string)
+		if !ok {
+			return nil, errors.New("not a string")
+		}
+
+		if !utf8.ValidString(s) || strings.ContainsRune(s, 0) {
+			return nil, errors.New("invalid string")
+		}
+
+		if len(s) > int(f.Size) {
+			return nil, errors.New("string too long")
+		}
+
+		encoded := make([]byte, f.Size)
+		copy(encoded, s)
+		return encoded, nil
+	case "ByteArray", "Struct":
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) != int(f.Size) {
+			return nil, errors.Errorf("expected %d bytes, got %d", f.Size, len(b))
+		}
+
+		return b, nil
+	default:
+		return nil, errors.Errorf("unknown type %s", f.RdfType)
+	}
+}
+
+func (f *Field) decode(data []byte) (any, error) {
+	buf := make([]byte, 8)
+	switch f.RdfType {
+	case "Uint":
+		copy(buf[8-f.Size:], data)
+		return binary.BigEndian.Uint64(buf), nil
+	case "Int":
+		copy(buf[8-f.Size:], data)
+		bits := 64 - f.Size*8
+		return int64(binary.BigEndian.Uint64(buf)<<bits) >> bits, nil
+	case "Float":
+		if f.Size == 4 {
+			return float64(math.Float32frombits(binary.BigEndian.Uint32(data))), nil
+		}
+
+		return math.Float64frombits(binary.BigEndian.Uint64(data)), nil
+	case "Bool":
+		switch data[0] {
+		case 0x00:
+			return false, nil
+		case 0xff:
+			return true, nil
+		default:
+			return nil, errors.New("invalid bool")
+		}
+	case "String":
+		s := bytes.TrimRight(data, "\x00")
+		if !utf8.Valid(s) || bytes.IndexByte(s, 0) != -1 {
+			return nil, errors.New("invalid string")
+		}
+
+		return string(s), nil
+	case "ByteArray", "Struct":
+		return append([]byte{}, data...), nil
+	default:
+		return nil, errors.Errorf("unknown type %s", f.RdfType)
+	}
+}
+
+func toUint(value any) (uint64, error) {
+	if n, ok := value.(json.Number); ok {
+		u, err := strconv.ParseUint(n.String(), 10, 64)
+		return u, errors.Wrap(err, "not an unsigned integer")
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		return v.Uint(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		if v.Int() < 0 {
+			return 0, errors.New("value out of range")
+		}
+		return uint64(v.Int()), nil
+	default:
+		return 0, errors.New("not an unsigned integer")
+	}
+}
+
+func toInt(value any) (int64, error) {
+	if n, ok := value.(json.Number); ok {
+		i, err := strconv.ParseInt(n.String(), 10, 64)
+		return i, errors.Wrap(err, "not an integer")
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		if v.Uint() > math.MaxInt64 {
+			return 0, errors.New("value out of range")
+		}
+		return int64(v.Uint()), nil
+	default:
+		return 0, errors.New("not an integer")
+	}
+}
+
+func toFloat(value any) (float64, error) {
+	if n, ok := value.(json.Number); ok {
+		f, err := n.Float64()
+		return f, errors.Wrap(err, "not a float")
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	default:
+		return 0, errors.New("not a float")
+	}
+}
+
+func toBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		s, _ := strings.CutPrefix(v, "0x")
+		b, err := hex.DecodeString(s)
+		return b, errors.Wrap(err, "not hex encoded bytes")
+	default:
+		return nil, errors.New("not bytes")
+	}
+}
@@ -0,0 +1,247 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/schema"
+)
+
+const prefixes = `PREFIX rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#>
+PREFIX rdfs: <http://www.w3.org/2000/01/rdf-schema#>
+PREFIX qcl: <https://types.quilibrium.com/qcl/>
+PREFIX ex: <https://types.quilibrium.com/schema-repository/example/>
+`
+
+const accountSchema = prefixes + `
+ex:Account a rdfs:Class.
+ex:Balance a rdfs:Property;
+  rdfs:domain qcl:Uint;
+  qcl:size 8;
+  qcl:order 0;
+  rdfs:comment "The balance of the account";
+  rdfs:range ex:Account.
+ex:Delta a rdfs:Property;
+  rdfs:domain qcl:Int;
+  qcl:size 2;
+  qcl:order 1;
+  rdfs:range ex:Account.
+ex:Rate a rdfs:Property;
+  rdfs:domain qcl:Float;
+  qcl:size 4;
+  qcl:order 2;
+  rdfs:range ex:Account.
+ex:Frozen a rdfs:Property;
+  rdfs:domain qcl:Bool;
+  qcl:order 3;
+  rdfs:range ex:Account.
+ex:Label a rdfs:Property;
+  rdfs:domain qcl:String;
+  qcl:size 16;
+  qcl:order 4;
+  rdfs:range ex:Account.
+ex:Key a rdfs:Property;
+  rdfs:domain qcl:ByteArray;
+  qcl:size 4;
+  qcl:order 5;
+  rdfs:range ex:Account.
+ex:Parent a rdfs:Property;
+  rdfs:domain ex:Account;
+  qcl:order 6;
+  rdfs:range ex:Account.
+`
+
+func TestParse(t *testing.T) {
+	p := schema.NewTurtleRDFParser(nil)
+	s, err := p.Parse(accountSchema)
+	require.NoError(t, err)
+	require.Len(t, s.Classes, 1)
+
+	account := s.GetClass("Account")
+	require.NotNil(t, account)
+	names := []string{}
+	for _, field := range account.Fields {
+		names = append(names, field.Name)
+	}
+	assert.Equal(
+		t,
+		[]string{"Balance", "Delta", "Rate", "Frozen", "Label", "Key", "Parent"},
+		names,
+	)
+	assert.Equal(t, uint32(8+2+4+1+16+4+32), account.Size())
+	assert.Equal(t, "The balance of the account", account.Fields[0].Comment)
+	assert.Equal(t, "[32]byte", account.Fields[6].Type)
+	assert.Equal(t, "ex:Parent,extrinsic=ex:Account", account.Fields[6].Annotation)
+
+	ok, err := p.Validate(accountSchema)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestParseInvalid(t *testing.T) {
+	field := func(predicates string) string {
+		return prefixes + `
+ex:Account a rdfs:Class.
+ex:Value a rdfs:Property;
+  ` + predicates + `
+  rdfs:range ex:Account.
+`
+	}
+
+	for name, document := range map[string]string{
+		"no order":    field("rdfs:domain qcl:Uint; qcl:size 8;"),
+		"no size":     field("rdfs:domain qcl:ByteArray; qcl:order 0;"),
+		"no type":     field("qcl:size 8; qcl:order 0;"),
+		"bad size":    field("rdfs:domain qcl:Uint; qcl:size 3; qcl:order 0;"),
+		"bad type":    field("rdfs:domain qcl:Decimal; qcl:size 8; qcl:order 0;"),
+		"unresolved":  field("rdfs:domain ex:Missing; qcl:order 0;"),
+		"not turtle":  "ex:Account a",
+		"dup order":   accountSchema + "ex:Other a rdfs:Property; rdfs:domain qcl:Bool; qcl:order 0; rdfs:range ex:Account.\n",
+		"bad literal": field("rdfs:domain rdfs:Resource; qcl:order 0;"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			ok, err := schema.NewTurtleRDFParser(nil).Validate(document)
+			assert.Error(t, err)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestGenerateQCL(t *testing.T) {
+	output, err := schema.NewTurtleRDFParser(nil).GenerateQCL(accountSchema)
+	require.NoError(t, err)
+	assert.Contains(t, output, "func MarshalAccount(obj *Account) ([]byte, error)")
+	assert.Contains(
+		t,
+		output,
+		"func UnmarshalAccount(payload []byte) (*Account, error)",
+	)
+	assert.Contains(t, output, "// The balance of the account")
+
+	// The generated code type checks.
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "account.go", output, 0)
+	require.NoError(t, err)
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check("main", fset, []*ast.File{file}, nil)
+	require.NoError(t, err)
+}
+
+func TestEncodeDecode(t *testing.T) {
+	s, err := schema.NewTurtleRDFParser(nil).Parse(accountSchema)
+	require.NoError(t, err)
+	account := s.GetClass("Account")
+
+	parent := strings.Repeat("ab", 32)
+	instance := map[string]any{}
+	decoder := json.NewDecoder(strings.NewReader(`{
+		"Balance": 1000,
+		"Delta": -5,
+		"Rate": 0.5,
+		"Frozen": true,
+		"Label": "savings",
+		"Key": "0x01020304",
+		"Parent": "` + parent + `"
+	}`))
+	decoder.UseNumber()
+	require.NoError(t, decoder.Decode(&instance))
+	require.NoError(t, account.ValidateInstance(instance))
+
+	payload, err := account.Encode(instance)
+	require.NoError(t, err)
+	assert.Len(t, payload, int(account.Size()))
+
+	decoded, err := account.Decode(payload)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), decoded["Balance"])
+	assert.Equal(t, int64(-5), decoded["Delta"])
+	assert.Equal(t, 0.5, decoded["Rate"])
+	assert.Equal(t, true, decoded["Frozen"])
+	assert.Equal(t, "savings", decoded["Label"])
+	assert.Equal(t, []byte{1, 2, 3, 4}, decoded["Key"])
+
+	reencoded, err := account.Encode(decoded)
+	require.NoError(t, err)
+	assert.Equal(t, payload, reencoded)
+
+	_, err = account.Decode(payload[1:])
+	assert.ErrorIs(t, err, schema.ErrInvalidInstance)
+
+	badBool := append([]byte{}, payload...)
+	badBool[14] = 0x01
+	_, err = account.Decode(badBool)
+	assert.ErrorIs(t, err, schema.ErrInvalidInstance)
+
+	for name, change := range map[string]func(map[string]any){
+		"missing field":  func(i map[string]any) { delete(i, "Label") },
+		"unknown field":  func(i map[string]any) { i["Other"] = true },
+		"uint too large": func(i map[string]any) { i["Balance"] = json.Number("18446744073709551616") },
+		"int too large":  func(i map[string]any) { i["Delta"] = 40000 },
+		"wrong type":     func(i map[string]any) { i["Frozen"] = "yes" },
+		"long string":    func(i map[string]any) { i["Label"] = strings.Repeat("a", 17) },
+		"short array":    func(i map[string]any) { i["Key"] = []byte{1} },
+		"bad reference":  func(i map[string]any) { i["Parent"] = "0x01" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			invalid := map[string]any{}
+			for k, v := range instance {
+				invalid[k] = v
+			}
+			change(invalid)
+			assert.ErrorIs(
+				t,
+				account.ValidateInstance(invalid),
+				schema.ErrInvalidInstance,
+			)
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	dir := t.TempDir()
+	registry, err := schema.NewFileSchemaRegistry(dir)
+	require.NoError(t, err)
+
+	address, err := registry.Register(accountSchema)
+	require.NoError(t, err)
+	assert.Equal(t, schema.SchemaAddress(accountSchema), address)
+	assert.True(t, strings.HasPrefix(address, schema.SchemaRepositoryNS))
+
+	// A schema referencing a class of the registered one.
+	ledgerSchema := prefixes + `
+PREFIX acct: <` + address + `>
+ex:Ledger a rdfs:Class.
+ex:Head a rdfs:Property;
+  rdfs:domain acct:Account;
+  qcl:order 0;
+  rdfs:range ex:Ledger.
+`
+	_, err = schema.NewTurtleRDFParser(nil).Parse(ledgerSchema)
+	assert.ErrorIs(t, err, schema.ErrUnresolvedReference)
+
+	ledger, err := schema.NewTurtleRDFParser(registry).Parse(ledgerSchema)
+	require.NoError(t, err)
+	head := ledger.GetClass("Ledger").Fields[0]
+	assert.Equal(t, address+"Account", head.Reference)
+
+	// The registry persists in its directory.
+	reopened, err := schema.NewFileSchemaRegistry(dir)
+	require.NoError(t, err)
+	class, err := reopened.Resolve(head.Reference)
+	require.NoError(t, err)
+	assert.Equal(t, "Account", class.Name)
+
+	_, err = reopened.Resolve(address + "Missing")
+	assert.ErrorIs(t, err, schema.ErrUnresolvedReference)
+
+	_, err = schema.NewSchemaRegistry().Register(ledgerSchema)
+	assert.ErrorIs(t, err, schema.ErrUnresolvedReference)
+}
@@ -0,0 +1,181 @@
+package store
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+)
+
+// ChannelStore persists the state of encrypted channel sessions, so they
+// survive a restart. Session state holds private key material, it is
+// encrypted at rest with a key held by the KeyManager.
+type ChannelStore interface {
+	GetSession(channelId []byte) ([]byte, error)
+	PutSession(channelId []byte, session []byte) error
+	DeleteSession(channelId []byte) error
+}
+
+type PebbleChannelStore struct {
+	db     KVDB
+	logger *zap.Logger
+	aead   cipher.AEAD
+}
+
+var _ ChannelStore = (*PebbleChannelStore)(nil)
+
+const (
+	CHANNEL         = 0x09
+	CHANNEL_SESSION = 0x00
+)
+
+// The id of the key in the KeyManager encrypting channel sessions at rest.
+const ChannelSessionKeyId = "q-channel-session-key"
+
+func NewPebbleChannelStore(
+	db KVDB,
+	keyManager keys.KeyManager,
+	logger *zap.Logger,
+) *PebbleChannelStore {
+	aead, err := encryptionAEAD(keyManager, ChannelSessionKeyId)
+	if err != nil {
+		panic(err)
+	}
+
+	return &PebbleChannelStore{
+		db,
+		logger,
+		aead,
+	}
+}
+
+// encryptionAEAD returns the cipher of the at rest encryption key with the
+// given id, creating the key on first use.
+func encryptionAEAD(keyManager keys.KeyManager, id string) (cipher.AEAD, error) {
+	key, err := encryptionKey(keyManager, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "encryption aead")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "encryption aead")
+	}
+
+	aead, err := cipher.NewGCM(block)
+	return aead, errors.Wrap(err, "encryption aead")
+}
+
+func encryptionKey(keyManager keys.KeyManager, id string) ([]byte, error) {
+	key, err := keyManager.GetRawKey(id)
+	if err == nil {
+		if key.Type != keys.KeyTypeAES256 || len(key.PrivateKey) != 32 {
+			return nil, errors.Wrap(
+				errors.New("invalid encryption key"),
+				"encryption key",
+			)
+		}
+
+		return key.PrivateKey, nil
+	}
+
+	if !errors.Is(err, keys.KeyNotFoundErr) {
+		return nil, errors.Wrap(err, "encryption key")
+	}
+
+	privateKey := make([]byte, 32)
+	if _, err := rand.Read(privateKey); err != nil {
+		return nil, errors.Wrap(err, "encryption key")
+	}
+
+	if err := keyManager.PutRawKey(&keys.Key{
+		Id:         id,
+		Type:       keys.KeyTypeAES256,
+		PrivateKey: privateKey,
+	}); err != nil {
+		return nil, errors.Wrap(err, "encryption key")
+	}
+
+	return privateKey, nil
+}
+
+// seal encrypts the value, with the storage key as the associated data so it
+// can't be swapped to another key.
+func seal(aead cipher.AEAD, key []byte, value []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "seal")
+	}
+
+	return aead.Seal(nonce, nonce, value, key), nil
+}
+
+func open(aead cipher.AEAD, key []byte, value []byte) ([]byte, error) {
+	nonceSize := aead.NonceSize()
+	if len(value) < nonceSize {
+		return nil, ErrInvalidData
+	}
+
+	plaintext, err := aead.Open(nil, value[:nonceSize], value[nonceSize:], key)
+	if err != nil {
+		return nil, ErrInvalidData
+	}
+
+	return plaintext, nil
+}
+
+func channelSessionKey(channelId []byte) []byte {
+	key := []byte{CHANNEL, CHANNEL_SESSION}
+	key = append(key, channelId...)
+	return key
+}
+
+// GetSession returns the decrypted session state of the channel, or
+// ErrNotFound if none is stored.
+func (p *PebbleChannelStore) GetSession(channelId []byte) ([]byte, error) {
+	key := channelSessionKey(channelId)
+	value, closer, err := p.db.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, errors.Wrap(err, "get session")
+	}
+
+	defer closer.Close()
+
+	session, err := open(p.aead, key, value)
+	if err != nil {
+		return nil, errors.Wrap(err, "get session")
+	}
+
+	return session, nil
+}
+
+// PutSession encrypts and stores the session state of the channel, replacing
+// any previous state.
+func (p *PebbleChannelStore) PutSession(
+	channelId []byte,
+	session []byte,
+) error {
+	key := channelSessionKey(channelId)
+	value, err := seal(p.aead, key, session)
+	if err != nil {
+		return errors.Wrap(err, "put session")
+	}
+
+	return errors.Wrap(p.db.Set(key, value), "put session")
+}
+
+// DeleteSession removes the session state of the channel.
+func (p *PebbleChannelStore) DeleteSession(channelId []byte) error {
+	return errors.Wrap(
+		p.db.Delete(channelSessionKey(channelId)),
+		"delete session",
+	)
+}
@@ -0,0 +1,118 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func sessionKey(channelId string) []byte {
+	return append([]byte{store.CHANNEL, store.CHANNEL_SESSION}, channelId...)
+}
+
+func TestPebbleChannelStoreRoundTrip(t *testing.T) {
+	db := store.NewInMemKVDB()
+	keyManager := keys.NewInMemoryKeyManager()
+	channelStore := store.NewPebbleChannelStore(db, keyManager, zap.NewNop())
+
+	_, err := channelStore.GetSession([]byte("a"))
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	session := []byte("ratchet state")
+	require.NoError(t, channelStore.PutSession([]byte("a"), session))
+
+	// The session is encrypted at rest.
+	raw, closer, err := db.Get(sessionKey("a"))
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), string(session))
+	require.NoError(t, closer.Close())
+
+	got, err := channelStore.GetSession([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, session, got)
+
+	// The key is kept by the key manager, so a restarted store reads it.
+	channelStore = store.NewPebbleChannelStore(db, keyManager, zap.NewNop())
+	got, err = channelStore.GetSession([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, session, got)
+
+	require.NoError(t, channelStore.PutSession([]byte("a"), []byte("newer")))
+	got, err = channelStore.GetSession([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("newer"), got)
+
+	require.NoError(t, channelStore.DeleteSession([]byte("a")))
+	_, err = channelStore.GetSession([]byte("a"))
+	require.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestPebbleChannelStoreRejectsMovedData(t *testing.T) {
+	db := store.NewInMemKVDB()
+	channelStore := store.NewPebbleChannelStore(
+		db,
+		keys.NewInMemoryKeyManager(),
+		zap.NewNop(),
+	)
+
+	require.NoError(t, channelStore.PutSession([]byte("a"), []byte("of a")))
+	require.NoError(t, channelStore.PutSession([]byte("b"), []byte("of b")))
+
+	// The storage key is the associated data, so a session copied under
+	// another channel doesn't decrypt.
+	raw, closer, err := db.Get(sessionKey("a"))
+	require.NoError(t, err)
+	require.NoError(t, db.Set(sessionKey("b"), append([]byte{}, raw...)))
+	require.NoError(t, closer.Close())
+
+	_, err = channelStore.GetSession([]byte("b"))
+	require.ErrorIs(t, err, store.ErrInvalidData)
+	got, err := channelStore.GetSession([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("of a"), got)
+
+	// Tampered and truncated values are rejected too.
+	tampered := append([]byte{}, raw...)
+	tampered[len(tampered)-1] ^= 0xff
+	require.NoError(t, db.Set(sessionKey("a"), tampered))
+	_, err = channelStore.GetSession([]byte("a"))
+	require.ErrorIs(t, err, store.ErrInvalidData)
+
+	require.NoError(t, db.Set(sessionKey("a"), raw[:4]))
+	_, err = channelStore.GetSession([]byte("a"))
+	require.ErrorIs(t, err, store.ErrInvalidData)
+}
+
+func TestPebbleChannelStoreRejectsOtherKey(t *testing.T) {
+	db := store.NewInMemKVDB()
+	channelStore := store.NewPebbleChannelStore(
+		db,
+		keys.NewInMemoryKeyManager(),
+		zap.NewNop(),
+	)
+	require.NoError(t, channelStore.PutSession([]byte("a"), []byte("of a")))
+
+	otherStore := store.NewPebbleChannelStore(
+		db,
+		keys.NewInMemoryKeyManager(),
+		zap.NewNop(),
+	)
+	_, err := otherStore.GetSession([]byte("a"))
+	require.ErrorIs(t, err, store.ErrInvalidData)
+}
+
+func TestNewPebbleChannelStoreRejectsInvalidKey(t *testing.T) {
+	keyManager := keys.NewInMemoryKeyManager()
+	require.NoError(t, keyManager.PutRawKey(&keys.Key{
+		Id:         store.ChannelSessionKeyId,
+		Type:       keys.KeyTypeAES256,
+		PrivateKey: make([]byte, 16),
+	}))
+
+	require.Panics(t, func() {
+		store.NewPebbleChannelStore(store.NewInMemKVDB(), keyManager, zap.NewNop())
+	})
+}
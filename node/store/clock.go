@@ -87,6 +87,8 @@ type ClockStore interface {
 		selector []byte,
 		totalDistance *big.Int,
 	) error
+	PutReorgEvent(filter []byte, event *protobufs.ReorgEvent) error
+	GetReorgEvents(filter []byte, limit int) ([]*protobufs.ReorgEvent, error)
 }
 
 type PebbleClockStore struct {
@@ -281,6 +283,7 @@ const CLOCK_DATA_FRAME_CANDIDATE_DATA = 0x02
 const CLOCK_DATA_FRAME_FRECENCY_DATA = 0x03
 const CLOCK_DATA_FRAME_DISTANCE_DATA = 0x04
 const CLOCK_COMPACTION_DATA = 0x05
+const CLOCK_DATA_FRAME_REORG_DATA = 0x06
 const CLOCK_MASTER_FRAME_INDEX_EARLIEST = 0x10 | CLOCK_MASTER_FRAME_DATA
 const CLOCK_MASTER_FRAME_INDEX_LATEST = 0x20 | CLOCK_MASTER_FRAME_DATA
 const CLOCK_MASTER_FRAME_INDEX_PARENT = 0x30 | CLOCK_MASTER_FRAME_DATA
@@ -428,6 +431,23 @@ func clockDataTotalDistanceKey(
 	return key
 }
 
+func clockDataReorgEventKey(
+	filter []byte,
+	timestamp int64,
+	frameNumber uint64,
+) []byte {
+	key := clockDataReorgEventPrefix(filter)
+	key = binary.BigEndian.AppendUint64(key, uint64(timestamp))
+	key = binary.BigEndian.AppendUint64(key, frameNumber)
+	return key
+}
+
+func clockDataReorgEventPrefix(filter []byte) []byte {
+	key := []byte{CLOCK_FRAME, CLOCK_DATA_FRAME_REORG_DATA}
+	key = append(key, filter...)
+	return key
+}
+
 func (p *PebbleClockStore) NewTransaction() (Transaction, error) {
 	return p.db.NewBatch(), nil
 }
@@ -1471,3 +1491,93 @@ func (p *PebbleClockStore) SetTotalDistance(
 
 	return errors.Wrap(err, "set total distance")
 }
+
+// maxReorgEvents bounds the reorg events retained per filter, the oldest being
+// dropped first.
+const maxReorgEvents = 1000
+
+// PutReorgEvent stores the event, dropping the oldest events of the filter
+// beyond the retained maximum.
+func (p *PebbleClockStore) PutReorgEvent(
+	filter []byte,
+	event *protobufs.ReorgEvent,
+) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "put reorg event")
+	}
+
+	err = p.db.Set(
+		clockDataReorgEventKey(
+			filter,
+			event.Timestamp,
+			event.NewHead.GetFrameNumber(),
+		),
+		data,
+	)
+	if err != nil {
+		return errors.Wrap(err, "put reorg event")
+	}
+
+	prefix := clockDataReorgEventPrefix(filter)
+	iter, err := p.db.NewIter(prefix, prefixUpperBound(prefix))
+	if err != nil {
+		return errors.Wrap(err, "put reorg event")
+	}
+
+	count := 0
+	var end []byte
+	for valid := iter.Last(); valid; valid = iter.Prev() {
+		count++
+		if count > maxReorgEvents {
+			end = append([]byte{}, iter.Key()...)
+			break
+		}
+	}
+
+	if err := iter.Close(); err != nil {
+		return errors.Wrap(err, "put reorg event")
+	}
+
+	if end == nil {
+		return nil
+	}
+
+	return errors.Wrap(
+		p.db.DeleteRange(prefix, append(end, 0x00)),
+		"put reorg event",
+	)
+}
+
+// GetReorgEvents returns up to limit of the most recent reorg events of the
+// filter, most recent first. A limit of zero returns all of them.
+func (p *PebbleClockStore) GetReorgEvents(
+	filter []byte,
+	limit int,
+) ([]*protobufs.ReorgEvent, error) {
+	prefix := clockDataReorgEventPrefix(filter)
+	iter, err := p.db.NewIter(prefix, prefixUpperBound(prefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "get reorg events")
+	}
+	defer iter.Close()
+
+	events := []*protobufs.ReorgEvent{}
+	for valid := iter.Last(); valid; valid = iter.Prev() {
+		if limit != 0 && len(events) == limit {
+			break
+		}
+
+		event := &protobufs.ReorgEvent{}
+		if err := proto.Unmarshal(iter.Value(), event); err != nil {
+			return nil, errors.Wrap(
+				errors.Wrap(err, ErrInvalidData.Error()),
+				"get reorg events",
+			)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
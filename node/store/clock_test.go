@@ -0,0 +1,43 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func TestPutReorgEventTrimsHistory(t *testing.T) {
+	clockStore := store.NewPebbleClockStore(store.NewInMemKVDB(), zap.NewNop())
+	filter := make([]byte, 32)
+
+	// Only the most recent thousand events are kept.
+	for i := int64(1); i <= 1005; i++ {
+		require.NoError(t, clockStore.PutReorgEvent(filter, &protobufs.ReorgEvent{
+			NewHead:   &protobufs.FrameRef{FrameNumber: uint64(i)},
+			Depth:     1,
+			Timestamp: i,
+		}))
+	}
+
+	events, err := clockStore.GetReorgEvents(filter, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 1000)
+	assert.Equal(t, int64(1005), events[0].Timestamp)
+	assert.Equal(t, int64(6), events[len(events)-1].Timestamp)
+
+	events, err = clockStore.GetReorgEvents(filter, 3)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, int64(1003), events[2].Timestamp)
+
+	// Events are kept per filter.
+	other := make([]byte, 32)
+	other[0] = 0x01
+	events, err = clockStore.GetReorgEvents(other, 0)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
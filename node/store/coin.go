@@ -45,8 +45,29 @@ type CoinStore interface {
 		address []byte,
 		preCoinProof *protobufs.PreCoinProof,
 	) error
+	JournalFrame(
+		txn Transaction,
+		frameNumber uint64,
+		selector []byte,
+	) (Transaction, error)
+	RollbackFrame(txn Transaction, frameNumber uint64, selector []byte) error
+	PruneJournal(txn Transaction, frameNumber uint64) error
 	GetLatestFrameProcessed() (uint64, error)
 	SetLatestFrameProcessed(txn Transaction, frameNumber uint64) error
+	SnapshotCheckpointState(frameNumber uint64, selector []byte) error
+	GetCheckpointState(frameNumber uint64, selector []byte) (
+		[]*protobufs.CheckpointCoin,
+		[]*protobufs.CheckpointPreCoinProof,
+		error,
+	)
+	GetCheckpointFrames() ([]uint64, error)
+	DeleteCheckpointState(frameNumber uint64) error
+	RestoreCheckpointState(
+		frameNumber uint64,
+		selector []byte,
+		coins []*protobufs.CheckpointCoin,
+		proofs []*protobufs.CheckpointPreCoinProof,
+	) error
 	SetMigrationVersion(genesisSeedHex string) error
 	Migrate(filter []byte, genesisSeedHex string) error
 }
@@ -74,6 +95,9 @@ const (
 	COIN_BY_ADDRESS  = 0x00
 	COIN_BY_OWNER    = 0x01
 	MIGRATION        = 0x02
+	CHECKPOINT       = 0x03
+	CHECKPOINT_FRAME = 0x04
+	JOURNAL          = 0x05
 	GENESIS          = 0xFE
 	LATEST_EXECUTION = 0xFF
 )
@@ -108,6 +132,19 @@ func proofByOwnerKey(owner []byte, address []byte) []byte {
 	return key
 }
 
+func checkpointKey(prefix byte, frameNumber uint64, address []byte) []byte {
+	key := []byte{prefix, CHECKPOINT}
+	key = binary.BigEndian.AppendUint64(key, frameNumber)
+	key = append(key, address...)
+	return key
+}
+
+func checkpointFrameKey(frameNumber uint64) []byte {
+	key := []byte{COIN, CHECKPOINT_FRAME}
+	key = binary.BigEndian.AppendUint64(key, frameNumber)
+	return key
+}
+
 func migrationKey() []byte {
 	return []byte{COIN, MIGRATION}
 }
@@ -394,6 +431,293 @@ func (p *PebbleCoinStore) SetLatestFrameProcessed(
 	return nil
 }
 
+// SnapshotCheckpointState copies the current coins and pre coin proofs aside
+// as the state of the checkpoint frame with the selector, replacing any
+// earlier snapshot of the frame. It must be called once the frame has been
+// processed, before the next one is. The snapshots of other frames are kept.
+func (p *PebbleCoinStore) SnapshotCheckpointState(
+	frameNumber uint64,
+	selector []byte,
+) error {
+	txn, err := p.NewTransaction()
+	if err != nil {
+		return errors.Wrap(err, "snapshot checkpoint state")
+	}
+
+	if err := p.deleteCheckpointState(txn, frameNumber); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "snapshot checkpoint state")
+	}
+
+	for _, prefix := range []byte{COIN, PROOF} {
+		iter, err := p.db.NewIter(
+			[]byte{prefix, COIN_BY_ADDRESS},
+			[]byte{prefix, COIN_BY_OWNER},
+		)
+		if err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "snapshot checkpoint state")
+		}
+
+		for iter.First(); iter.Valid(); iter.Next() {
+			err := txn.Set(
+				checkpointKey(prefix, frameNumber, iter.Key()[2:]),
+				append([]byte{}, iter.Value()...),
+			)
+			if err != nil {
+				iter.Close()
+				txn.Abort()
+				return errors.Wrap(err, "snapshot checkpoint state")
+			}
+		}
+
+		iter.Close()
+	}
+
+	if err := txn.Set(
+		checkpointFrameKey(frameNumber),
+		append([]byte{}, selector...),
+	); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "snapshot checkpoint state")
+	}
+
+	return errors.Wrap(txn.Commit(), "snapshot checkpoint state")
+}
+
+// GetCheckpointState returns the coins and pre coin proofs snapshotted at the
+// checkpoint frame, ordered by address, or ErrNotFound if the frame with the
+// selector has no snapshot.
+func (p *PebbleCoinStore) GetCheckpointState(
+	frameNumber uint64,
+	selector []byte,
+) (
+	[]*protobufs.CheckpointCoin,
+	[]*protobufs.CheckpointPreCoinProof,
+	error,
+) {
+	v, closer, err := p.db.Get(checkpointFrameKey(frameNumber))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil, ErrNotFound
+		}
+
+		return nil, nil, errors.Wrap(err, "get checkpoint state")
+	}
+
+	snapshotted := bytes.Equal(v, selector)
+	closer.Close()
+	if !snapshotted {
+		return nil, nil, ErrNotFound
+	}
+
+	coins := []*protobufs.CheckpointCoin{}
+	proofs := []*protobufs.CheckpointPreCoinProof{}
+	for _, prefix := range []byte{COIN, PROOF} {
+		iter, err := p.db.NewIter(
+			checkpointKey(prefix, frameNumber, nil),
+			checkpointKey(prefix, frameNumber+1, nil),
+		)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "get checkpoint state")
+		}
+
+		for iter.First(); iter.Valid(); iter.Next() {
+			address := append([]byte{}, iter.Key()[10:]...)
+			value := iter.Value()
+			if prefix == COIN {
+				coin := &protobufs.Coin{}
+				if err := proto.Unmarshal(value[8:], coin); err != nil {
+					iter.Close()
+					return nil, nil, errors.Wrap(err, "get checkpoint state")
+				}
+
+				coins = append(coins, &protobufs.CheckpointCoin{
+					Address:     address,
+					Coin:        coin,
+					FrameNumber: binary.BigEndian.Uint64(value[:8]),
+				})
+			} else {
+				proof := &protobufs.PreCoinProof{}
+				if err := proto.Unmarshal(value[8:], proof); err != nil {
+					iter.Close()
+					return nil, nil, errors.Wrap(err, "get checkpoint state")
+				}
+
+				proofs = append(proofs, &protobufs.CheckpointPreCoinProof{
+					Address:     address,
+					Proof:       proof,
+					FrameNumber: binary.BigEndian.Uint64(value[:8]),
+				})
+			}
+		}
+
+		iter.Close()
+	}
+
+	return coins, proofs, nil
+}
+
+// GetCheckpointFrames returns the numbers of the frames with a snapshot, in
+// ascending order.
+func (p *PebbleCoinStore) GetCheckpointFrames() ([]uint64, error) {
+	iter, err := p.db.NewIter(
+		[]byte{COIN, CHECKPOINT_FRAME},
+		[]byte{COIN, CHECKPOINT_FRAME + 1},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "get checkpoint frames")
+	}
+
+	defer iter.Close()
+	frameNumbers := []uint64{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		frameNumbers = append(
+			frameNumbers,
+			binary.BigEndian.Uint64(iter.Key()[2:]),
+		)
+	}
+
+	return frameNumbers, nil
+}
+
+// DeleteCheckpointState drops the snapshot of the frame, if any.
+func (p *PebbleCoinStore) DeleteCheckpointState(frameNumber uint64) error {
+	txn, err := p.NewTransaction()
+	if err != nil {
+		return errors.Wrap(err, "delete checkpoint state")
+	}
+
+	if err := p.deleteCheckpointState(txn, frameNumber); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "delete checkpoint state")
+	}
+
+	return errors.Wrap(txn.Commit(), "delete checkpoint state")
+}
+
+func (p *PebbleCoinStore) deleteCheckpointState(
+	txn Transaction,
+	frameNumber uint64,
+) error {
+	for _, prefix := range []byte{COIN, PROOF} {
+		if err := p.deleteRange(
+			txn,
+			checkpointKey(prefix, frameNumber, nil),
+			checkpointKey(prefix, frameNumber+1, nil),
+		); err != nil {
+			return errors.Wrap(err, "delete checkpoint state")
+		}
+	}
+
+	return errors.Wrap(
+		txn.Delete(checkpointFrameKey(frameNumber)),
+		"delete checkpoint state",
+	)
+}
+
+// RestoreCheckpointState replaces the current coins and pre coin proofs with
+// the state of a checkpoint frame, and marks the frame as the latest
+// processed. The state is also kept as the only snapshot, so the node can
+// serve the checkpoint in turn.
+func (p *PebbleCoinStore) RestoreCheckpointState(
+	frameNumber uint64,
+	selector []byte,
+	coins []*protobufs.CheckpointCoin,
+	proofs []*protobufs.CheckpointPreCoinProof,
+) error {
+	txn, err := p.NewTransaction()
+	if err != nil {
+		return errors.Wrap(err, "restore checkpoint state")
+	}
+
+	for _, prefix := range []byte{COIN, PROOF} {
+		for _, sub := range []byte{COIN_BY_ADDRESS, COIN_BY_OWNER, CHECKPOINT} {
+			if err := p.deleteRange(
+				txn,
+				[]byte{prefix, sub},
+				[]byte{prefix, sub + 1},
+			); err != nil {
+				txn.Abort()
+				return errors.Wrap(err, "restore checkpoint state")
+			}
+		}
+	}
+
+	if err := p.deleteRange(
+		txn,
+		[]byte{COIN, CHECKPOINT_FRAME},
+		[]byte{COIN, CHECKPOINT_FRAME + 1},
+	); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "restore checkpoint state")
+	}
+
+	// The frames journaled before the checkpoint can no longer be rolled back.
+	if err := p.deleteRange(
+		txn,
+		[]byte{COIN, JOURNAL},
+		[]byte{COIN, JOURNAL + 1},
+	); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "restore checkpoint state")
+	}
+
+	for _, c := range coins {
+		if err := p.PutCoin(txn, c.FrameNumber, c.Address, c.Coin); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "restore checkpoint state")
+		}
+	}
+
+	for _, c := range proofs {
+		if err := p.PutPreCoinProof(
+			txn,
+			c.FrameNumber,
+			c.Address,
+			c.Proof,
+		); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "restore checkpoint state")
+		}
+	}
+
+	if err := p.SetLatestFrameProcessed(txn, frameNumber); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "restore checkpoint state")
+	}
+
+	if err := txn.Commit(); err != nil {
+		return errors.Wrap(err, "restore checkpoint state")
+	}
+
+	return errors.Wrap(
+		p.SnapshotCheckpointState(frameNumber, selector),
+		"restore checkpoint state",
+	)
+}
+
+// deleteRange deletes the keys in [lower, upper) within the transaction.
+func (p *PebbleCoinStore) deleteRange(
+	txn Transaction,
+	lower []byte,
+	upper []byte,
+) error {
+	iter, err := p.db.NewIter(lower, upper)
+	if err != nil {
+		return errors.Wrap(err, "delete range")
+	}
+
+	defer iter.Close()
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := txn.Delete(append([]byte{}, iter.Key()...)); err != nil {
+			return errors.Wrap(err, "delete range")
+		}
+	}
+
+	return nil
+}
+
 func (p *PebbleCoinStore) SetMigrationVersion(
 	genesisSeedHex string,
 ) error {
@@ -473,6 +797,22 @@ func (p *PebbleCoinStore) internalMigrate(
 	if err != nil {
 		panic(err)
 	}
+	for _, prefix := range []byte{COIN, PROOF} {
+		err = p.db.DeleteRange(
+			[]byte{prefix, CHECKPOINT},
+			[]byte{prefix, CHECKPOINT + 1},
+		)
+		if err != nil {
+			panic(err)
+		}
+	}
+	err = p.db.DeleteRange(
+		[]byte{COIN, CHECKPOINT_FRAME},
+		[]byte{COIN, CHECKPOINT_FRAME + 1},
+	)
+	if err != nil {
+		panic(err)
+	}
 	if err := p.db.Delete(clockDataEarliestIndex(filter)); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"encoding/binary"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+)
+
+// journalKey is the key of the marker opening the journal of the frame. The
+// marker is followed by an entry for every key the frame wrote or deleted, in
+// order, each holding the key, whether it was present and the value it held.
+func journalKey(frameNumber uint64, selector []byte) []byte {
+	key := []byte{COIN, JOURNAL}
+	key = binary.BigEndian.AppendUint64(key, frameNumber)
+	key = append(key, selector...)
+	return key
+}
+
+// journalTransaction applies writes to the underlying transaction, recording
+// beforehand what each key held when the frame first touched it.
+type journalTransaction struct {
+	Transaction
+	key     []byte
+	seqno   uint32
+	touched map[string]struct{}
+}
+
+func (t *journalTransaction) record(key []byte) error {
+	if _, ok := t.touched[string(key)]; ok {
+		return nil
+	}
+
+	entry := binary.AppendUvarint(nil, uint64(len(key)))
+	entry = append(entry, key...)
+
+	value, closer, err := t.Transaction.Get(key)
+	switch {
+	case err == nil:
+		entry = append(entry, 0x01)
+		entry = append(entry, value...)
+		closer.Close()
+	case errors.Is(err, pebble.ErrNotFound):
+		entry = append(entry, 0x00)
+	default:
+		return errors.Wrap(err, "record")
+	}
+
+	t.seqno++
+	if err := t.Transaction.Set(
+		binary.BigEndian.AppendUint32(append([]byte{}, t.key...), t.seqno),
+		entry,
+	); err != nil {
+		return errors.Wrap(err, "record")
+	}
+
+	t.touched[string(key)] = struct{}{}
+	return nil
+}
+
+func (t *journalTransaction) Set(key []byte, value []byte) error {
+	if err := t.record(key); err != nil {
+		return errors.Wrap(err, "set")
+	}
+
+	return t.Transaction.Set(key, value)
+}
+
+func (t *journalTransaction) Delete(key []byte) error {
+	if err := t.record(key); err != nil {
+		return errors.Wrap(err, "delete")
+	}
+
+	return t.Transaction.Delete(key)
+}
+
+// JournalFrame returns a transaction writing through txn that journals every
+// write and delete as made by the frame, so RollbackFrame can undo them.
+func (p *PebbleCoinStore) JournalFrame(
+	txn Transaction,
+	frameNumber uint64,
+	selector []byte,
+) (Transaction, error) {
+	key := journalKey(frameNumber, selector)
+	if err := txn.Set(key, []byte{}); err != nil {
+		return nil, errors.Wrap(err, "journal frame")
+	}
+
+	return &journalTransaction{
+		Transaction: txn,
+		key:         key,
+		touched:     map[string]struct{}{},
+	}, nil
+}
+
+// RollbackFrame restores within the transaction what the keys written by the
+// frame held before it, and drops the journal of the frame. It returns
+// ErrNotFound if the frame has no journal.
+func (p *PebbleCoinStore) RollbackFrame(
+	txn Transaction,
+	frameNumber uint64,
+	selector []byte,
+) error {
+	key := journalKey(frameNumber, selector)
+	_, closer, err := p.db.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return errors.Wrap(err, "rollback frame")
+	}
+	closer.Close()
+
+	iter, err := p.db.NewIter(
+		append(append([]byte{}, key...), 0x00),
+		prefixUpperBound(key),
+	)
+	if err != nil {
+		return errors.Wrap(err, "rollback frame")
+	}
+
+	defer iter.Close()
+	for valid := iter.Last(); valid; valid = iter.Prev() {
+		if err := restoreEntry(txn, iter.Value()); err != nil {
+			return errors.Wrap(err, "rollback frame")
+		}
+
+		if err := txn.Delete(append([]byte{}, iter.Key()...)); err != nil {
+			return errors.Wrap(err, "rollback frame")
+		}
+	}
+
+	return errors.Wrap(txn.Delete(key), "rollback frame")
+}
+
+func restoreEntry(txn Transaction, entry []byte) error {
+	length, n := binary.Uvarint(entry)
+	if n <= 0 || length >= uint64(len(entry)-n) {
+		return errors.Wrap(ErrInvalidData, "restore entry")
+	}
+
+	key := append([]byte{}, entry[n:n+int(length)]...)
+	rest := entry[n+int(length):]
+	if rest[0] == 0x00 {
+		return errors.Wrap(txn.Delete(key), "restore entry")
+	}
+
+	return errors.Wrap(
+		txn.Set(key, append([]byte{}, rest[1:]...)),
+		"restore entry",
+	)
+}
+
+// PruneJournal drops within the transaction the journals of the frames before
+// frameNumber, which can then no longer be rolled back.
+func (p *PebbleCoinStore) PruneJournal(
+	txn Transaction,
+	frameNumber uint64,
+) error {
+	return errors.Wrap(
+		p.deleteRange(
+			txn,
+			[]byte{COIN, JOURNAL},
+			journalKey(frameNumber, nil),
+		),
+		"prune journal",
+	)
+}
@@ -0,0 +1,291 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func testCoin(owner byte, amount byte) *protobufs.Coin {
+	address := make([]byte, 32)
+	address[0] = owner
+	return &protobufs.Coin{
+		Amount: []byte{amount},
+		Owner: &protobufs.AccountRef{
+			Account: &protobufs.AccountRef_ImplicitAccount{
+				ImplicitAccount: &protobufs.ImplicitAccount{Address: address},
+			},
+		},
+	}
+}
+
+// selector returns a 32 byte value, as used for selectors and addresses.
+func selector(b byte) []byte {
+	s := make([]byte, 32)
+	s[0] = b
+	return s
+}
+
+// assertCoins checks the coins held, by address and by owner.
+func assertCoins(
+	t *testing.T,
+	coinStore store.CoinStore,
+	owner byte,
+	coins map[string]*protobufs.Coin,
+) {
+	for address, coin := range coins {
+		stored, err := coinStore.GetCoinByAddress(nil, []byte(address))
+		require.NoError(t, err)
+		assert.Equal(t, coin.Amount, stored.Amount)
+	}
+
+	_, addresses, _, err := coinStore.GetCoinsForOwner(
+		testCoin(owner, 0).Owner.GetImplicitAccount().Address,
+	)
+	require.NoError(t, err)
+	assert.Len(t, addresses, len(coins))
+	for _, address := range addresses {
+		assert.Contains(t, coins, string(address))
+	}
+}
+
+func TestCoinStoreRollbackFrame(t *testing.T) {
+	coinStore := store.NewPebbleCoinStore(store.NewInMemKVDB(), zap.NewNop())
+	c1, c2, c3 := testCoin(1, 1), testCoin(1, 2), testCoin(1, 3)
+	proof := &protobufs.PreCoinProof{
+		Amount: []byte{4},
+		Owner:  c1.Owner,
+	}
+
+	txn, err := coinStore.NewTransaction()
+	require.NoError(t, err)
+	require.NoError(t, coinStore.PutCoin(txn, 1, selector(0xc1), c1))
+	require.NoError(t, txn.Commit())
+
+	// Frame 2 spends c1 into c2 and adds a proof, frame 3 spends c2 into c3.
+	txn, err = coinStore.NewTransaction()
+	require.NoError(t, err)
+	journal, err := coinStore.JournalFrame(txn, 2, selector(2))
+	require.NoError(t, err)
+	require.NoError(t, coinStore.DeleteCoin(journal, selector(0xc1), c1))
+	require.NoError(t, coinStore.PutCoin(journal, 2, selector(0xc2), c2))
+	require.NoError(t, coinStore.PutPreCoinProof(journal, 2, selector(0xaa), proof))
+	require.NoError(t, txn.Commit())
+
+	txn, err = coinStore.NewTransaction()
+	require.NoError(t, err)
+	journal, err = coinStore.JournalFrame(txn, 3, selector(3))
+	require.NoError(t, err)
+	require.NoError(t, coinStore.DeleteCoin(journal, selector(0xc2), c2))
+	require.NoError(t, coinStore.PutCoin(journal, 3, selector(0xc3), c3))
+	require.NoError(t, txn.Commit())
+
+	assertCoins(t, coinStore, 1, map[string]*protobufs.Coin{string(selector(0xc3)): c3})
+
+	txn, err = coinStore.NewTransaction()
+	require.NoError(t, err)
+	assert.ErrorIs(
+		t,
+		coinStore.RollbackFrame(txn, 3, selector(2)),
+		store.ErrNotFound,
+	)
+	require.NoError(t, coinStore.RollbackFrame(txn, 3, selector(3)))
+	require.NoError(t, txn.Commit())
+
+	assertCoins(t, coinStore, 1, map[string]*protobufs.Coin{string(selector(0xc2)): c2})
+	_, err = coinStore.GetPreCoinProofByAddress(selector(0xaa))
+	require.NoError(t, err)
+
+	// The journal of a frame is dropped with its rollback.
+	txn, err = coinStore.NewTransaction()
+	require.NoError(t, err)
+	assert.ErrorIs(
+		t,
+		coinStore.RollbackFrame(txn, 3, selector(3)),
+		store.ErrNotFound,
+	)
+	require.NoError(t, coinStore.RollbackFrame(txn, 2, selector(2)))
+	require.NoError(t, txn.Commit())
+
+	assertCoins(t, coinStore, 1, map[string]*protobufs.Coin{string(selector(0xc1)): c1})
+	_, err = coinStore.GetPreCoinProofByAddress(selector(0xaa))
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	_, proofs, err := coinStore.GetPreCoinProofsForOwner(
+		c1.Owner.GetImplicitAccount().Address,
+	)
+	require.NoError(t, err)
+	assert.Empty(t, proofs)
+}
+
+func TestCoinStorePruneJournal(t *testing.T) {
+	coinStore := store.NewPebbleCoinStore(store.NewInMemKVDB(), zap.NewNop())
+	for frameNumber := uint64(1); frameNumber <= 3; frameNumber++ {
+		txn, err := coinStore.NewTransaction()
+		require.NoError(t, err)
+		journal, err := coinStore.JournalFrame(
+			txn,
+			frameNumber,
+			selector(byte(frameNumber)),
+		)
+		require.NoError(t, err)
+		require.NoError(t, coinStore.PutCoin(
+			journal,
+			frameNumber,
+			[]byte{byte(frameNumber)},
+			testCoin(1, byte(frameNumber)),
+		))
+		require.NoError(t, txn.Commit())
+	}
+
+	txn, err := coinStore.NewTransaction()
+	require.NoError(t, err)
+	require.NoError(t, coinStore.PruneJournal(txn, 3))
+	require.NoError(t, txn.Commit())
+
+	txn, err = coinStore.NewTransaction()
+	require.NoError(t, err)
+	defer txn.Abort()
+	for frameNumber := uint64(1); frameNumber < 3; frameNumber++ {
+		assert.ErrorIs(
+			t,
+			coinStore.RollbackFrame(txn, frameNumber, selector(byte(frameNumber))),
+			store.ErrNotFound,
+		)
+	}
+	assert.NoError(t, coinStore.RollbackFrame(txn, 3, selector(3)))
+}
+
+func TestCoinStoreCheckpointState(t *testing.T) {
+	coinStore := store.NewPebbleCoinStore(store.NewInMemKVDB(), zap.NewNop())
+	c1, c2, c3 := testCoin(1, 1), testCoin(1, 2), testCoin(1, 3)
+	proof := &protobufs.PreCoinProof{
+		Amount: []byte{4},
+		Owner:  c1.Owner,
+	}
+
+	txn, err := coinStore.NewTransaction()
+	require.NoError(t, err)
+	require.NoError(t, coinStore.PutCoin(txn, 1, selector(0xc2), c2))
+	require.NoError(t, coinStore.PutCoin(txn, 2, selector(0xc1), c1))
+	require.NoError(t, coinStore.PutPreCoinProof(txn, 2, selector(0xaa), proof))
+	require.NoError(t, txn.Commit())
+
+	_, _, err = coinStore.GetCheckpointState(2, selector(2))
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	require.NoError(t, coinStore.SnapshotCheckpointState(2, selector(2)))
+
+	// Later writes do not change the snapshot.
+	txn, err = coinStore.NewTransaction()
+	require.NoError(t, err)
+	require.NoError(t, coinStore.DeleteCoin(txn, selector(0xc1), c1))
+	require.NoError(t, coinStore.PutCoin(txn, 3, selector(0xc3), c3))
+	require.NoError(t, txn.Commit())
+	require.NoError(t, coinStore.SnapshotCheckpointState(3, selector(3)))
+
+	_, _, err = coinStore.GetCheckpointState(2, selector(3))
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	coins, proofs, err := coinStore.GetCheckpointState(2, selector(2))
+	require.NoError(t, err)
+	require.Len(t, coins, 2)
+	assert.Equal(t, selector(0xc1), coins[0].Address)
+	assert.Equal(t, uint64(2), coins[0].FrameNumber)
+	assert.Equal(t, c1.Amount, coins[0].Coin.Amount)
+	assert.Equal(t, selector(0xc2), coins[1].Address)
+	assert.Equal(t, uint64(1), coins[1].FrameNumber)
+	require.Len(t, proofs, 1)
+	assert.Equal(t, selector(0xaa), proofs[0].Address)
+	assert.Equal(t, proof.Amount, proofs[0].Proof.Amount)
+
+	coins, _, err = coinStore.GetCheckpointState(3, selector(3))
+	require.NoError(t, err)
+	require.Len(t, coins, 2)
+	assert.Equal(t, selector(0xc2), coins[0].Address)
+	assert.Equal(t, selector(0xc3), coins[1].Address)
+
+	frameNumbers, err := coinStore.GetCheckpointFrames()
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 3}, frameNumbers)
+
+	require.NoError(t, coinStore.DeleteCheckpointState(2))
+	_, _, err = coinStore.GetCheckpointState(2, selector(2))
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	frameNumbers, err = coinStore.GetCheckpointFrames()
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3}, frameNumbers)
+}
+
+func TestCoinStoreRestoreCheckpointState(t *testing.T) {
+	source := store.NewPebbleCoinStore(store.NewInMemKVDB(), zap.NewNop())
+	c1, c2 := testCoin(1, 1), testCoin(1, 2)
+	txn, err := source.NewTransaction()
+	require.NoError(t, err)
+	require.NoError(t, source.PutCoin(txn, 4, selector(0xc1), c1))
+	require.NoError(t, source.PutCoin(txn, 5, selector(0xc2), c2))
+	require.NoError(t, source.PutPreCoinProof(
+		txn,
+		5,
+		selector(0xaa),
+		&protobufs.PreCoinProof{Amount: []byte{3}, Owner: c1.Owner},
+	))
+	require.NoError(t, txn.Commit())
+	require.NoError(t, source.SnapshotCheckpointState(5, selector(5)))
+	coins, proofs, err := source.GetCheckpointState(5, selector(5))
+	require.NoError(t, err)
+
+	// The restoring node holds coins, a journal and a snapshot of its own,
+	// all replaced by the checkpoint.
+	coinStore := store.NewPebbleCoinStore(store.NewInMemKVDB(), zap.NewNop())
+	txn, err = coinStore.NewTransaction()
+	require.NoError(t, err)
+	journal, err := coinStore.JournalFrame(txn, 1, selector(1))
+	require.NoError(t, err)
+	require.NoError(t, coinStore.PutCoin(journal, 1, selector(0xc3), testCoin(1, 3)))
+	require.NoError(t, coinStore.PutCoin(journal, 1, selector(0xc4), testCoin(2, 4)))
+	require.NoError(t, coinStore.SetLatestFrameProcessed(txn, 1))
+	require.NoError(t, txn.Commit())
+	require.NoError(t, coinStore.SnapshotCheckpointState(1, selector(1)))
+
+	require.NoError(t, coinStore.RestoreCheckpointState(
+		5,
+		selector(5),
+		coins,
+		proofs,
+	))
+
+	assertCoins(t, coinStore, 1, map[string]*protobufs.Coin{
+		string(selector(0xc1)): c1,
+		string(selector(0xc2)): c2,
+	})
+	_, err = coinStore.GetCoinByAddress(nil, selector(0xc4))
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	_, err = coinStore.GetPreCoinProofByAddress(selector(0xaa))
+	assert.NoError(t, err)
+
+	latest, err := coinStore.GetLatestFrameProcessed()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), latest)
+
+	txn, err = coinStore.NewTransaction()
+	require.NoError(t, err)
+	assert.ErrorIs(
+		t,
+		coinStore.RollbackFrame(txn, 1, selector(1)),
+		store.ErrNotFound,
+	)
+	txn.Abort()
+
+	// Only the restored checkpoint is kept, and can be served in turn.
+	frameNumbers, err := coinStore.GetCheckpointFrames()
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{5}, frameNumbers)
+	restored, restoredProofs, err := coinStore.GetCheckpointState(5, selector(5))
+	require.NoError(t, err)
+	assert.Len(t, restored, len(coins))
+	assert.Len(t, restoredProofs, len(proofs))
+}
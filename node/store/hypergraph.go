@@ -0,0 +1,232 @@
+package store
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// HypergraphStore holds the add and remove sets of the hypergraph. Atoms are
+// opaque to the store, keyed by set, shard address and atom id so the atoms
+// of a shard are kept together and iterate in id order. Indexes the
+// hypergraph maintains alongside the sets are kept as entries keyed by index
+// and an index defined key.
+type HypergraphStore interface {
+	NewTransaction() (Transaction, error)
+	GetAtom(
+		txn Transaction,
+		set byte,
+		shardAddress []byte,
+		atomId []byte,
+	) ([]byte, error)
+	PutAtom(
+		txn Transaction,
+		set byte,
+		shardAddress []byte,
+		atomId []byte,
+		data []byte,
+	) error
+	RangeAtoms(
+		set byte,
+		shardAddress []byte,
+	) (*PebbleHypergraphIterator, error)
+	PutIndexEntry(txn Transaction, index byte, key []byte, value []byte) error
+	DeleteIndexEntry(txn Transaction, index byte, key []byte) error
+	RangeIndex(
+		index byte,
+		prefix []byte,
+		after []byte,
+	) (*PebbleHypergraphIterator, error)
+}
+
+type PebbleHypergraphStore struct {
+	db     KVDB
+	logger *zap.Logger
+}
+
+var _ HypergraphStore = (*PebbleHypergraphStore)(nil)
+
+func NewPebbleHypergraphStore(
+	db KVDB,
+	logger *zap.Logger,
+) *PebbleHypergraphStore {
+	return &PebbleHypergraphStore{
+		db,
+		logger,
+	}
+}
+
+const (
+	HYPERGRAPH                   = 0x0C
+	HYPERGRAPH_VERTEX_ADDS       = 0x00
+	HYPERGRAPH_VERTEX_REMOVES    = 0x01
+	HYPERGRAPH_HYPEREDGE_ADDS    = 0x02
+	HYPERGRAPH_HYPEREDGE_REMOVES = 0x03
+	HYPERGRAPH_VERTEX_INDEX      = 0x04
+	HYPERGRAPH_HYPEREDGE_INDEX   = 0x05
+	HYPERGRAPH_INCIDENCE_INDEX   = 0x06
+)
+
+func hypergraphAtomKey(set byte, shardAddress []byte, atomId []byte) []byte {
+	key := []byte{HYPERGRAPH, set}
+	key = append(key, shardAddress...)
+	key = append(key, atomId...)
+	return key
+}
+
+func (p *PebbleHypergraphStore) NewTransaction() (Transaction, error) {
+	return p.db.NewBatch(), nil
+}
+
+// GetAtom returns the atom's data in the set, or ErrNotFound if the set
+// doesn't hold it. With a transaction, its pending writes are visible.
+func (p *PebbleHypergraphStore) GetAtom(
+	txn Transaction,
+	set byte,
+	shardAddress []byte,
+	atomId []byte,
+) ([]byte, error) {
+	key := hypergraphAtomKey(set, shardAddress, atomId)
+
+	var value []byte
+	var closer io.Closer
+	var err error
+	if txn != nil {
+		value, closer, err = txn.Get(key)
+	} else {
+		value, closer, err = p.db.Get(key)
+	}
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, errors.Wrap(err, "get atom")
+	}
+
+	defer closer.Close()
+
+	data := make([]byte, len(value))
+	copy(data, value)
+
+	return data, nil
+}
+
+// PutAtom adds the atom to the set. Sets only grow, putting an atom again
+// replaces its data.
+func (p *PebbleHypergraphStore) PutAtom(
+	txn Transaction,
+	set byte,
+	shardAddress []byte,
+	atomId []byte,
+	data []byte,
+) error {
+	return errors.Wrap(
+		txn.Set(hypergraphAtomKey(set, shardAddress, atomId), data),
+		"put atom",
+	)
+}
+
+// RangeAtoms iterates the atoms of the set in the shard, or in every shard
+// if the shard address is empty.
+func (p *PebbleHypergraphStore) RangeAtoms(
+	set byte,
+	shardAddress []byte,
+) (*PebbleHypergraphIterator, error) {
+	prefix := hypergraphAtomKey(set, shardAddress, nil)
+	iter, err := p.db.NewIter(prefix, prefixUpperBound(prefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "range atoms")
+	}
+
+	return &PebbleHypergraphIterator{iter}, nil
+}
+
+func (p *PebbleHypergraphStore) PutIndexEntry(
+	txn Transaction,
+	index byte,
+	key []byte,
+	value []byte,
+) error {
+	return errors.Wrap(
+		txn.Set(hypergraphAtomKey(index, nil, key), value),
+		"put index entry",
+	)
+}
+
+func (p *PebbleHypergraphStore) DeleteIndexEntry(
+	txn Transaction,
+	index byte,
+	key []byte,
+) error {
+	return errors.Wrap(
+		txn.Delete(hypergraphAtomKey(index, nil, key)),
+		"delete index entry",
+	)
+}
+
+// RangeIndex iterates the entries of the index whose keys have the prefix,
+// starting after the given key if any.
+func (p *PebbleHypergraphStore) RangeIndex(
+	index byte,
+	prefix []byte,
+	after []byte,
+) (*PebbleHypergraphIterator, error) {
+	lower := hypergraphAtomKey(index, nil, prefix)
+	upper := prefixUpperBound(lower)
+	if after != nil {
+		start := append(hypergraphAtomKey(index, nil, after), 0x00)
+		if bytes.Compare(start, lower) > 0 {
+			lower = start
+		}
+	}
+
+	iter, err := p.db.NewIter(lower, upper)
+	if err != nil {
+		return nil, errors.Wrap(err, "range index")
+	}
+
+	return &PebbleHypergraphIterator{iter}, nil
+}
+
+type PebbleHypergraphIterator struct {
+	i Iterator
+}
+
+func (p *PebbleHypergraphIterator) First() bool {
+	return p.i.First()
+}
+
+func (p *PebbleHypergraphIterator) Next() bool {
+	return p.i.Next()
+}
+
+func (p *PebbleHypergraphIterator) Valid() bool {
+	return p.i.Valid()
+}
+
+// Key returns a copy of the current key, without the set or index prefix.
+func (p *PebbleHypergraphIterator) Key() []byte {
+	key := p.i.Key()
+	return append([]byte{}, key[2:]...)
+}
+
+// Value returns a copy of the current atom's data.
+func (p *PebbleHypergraphIterator) Value() ([]byte, error) {
+	if !p.i.Valid() {
+		return nil, ErrNotFound
+	}
+
+	value := p.i.Value()
+	data := make([]byte, len(value))
+	copy(data, value)
+
+	return data, nil
+}
+
+func (p *PebbleHypergraphIterator) Close() error {
+	return errors.Wrap(p.i.Close(), "closing hypergraph iterator")
+}
@@ -50,10 +50,13 @@ func (i *InMemKVDBIterator) Key() []byte {
 		return nil
 	}
 	i.db.storeMx.Lock()
+	defer i.db.storeMx.Unlock()
+	if i.pos < 0 || i.pos >= len(i.db.sortedKeys) {
+		return nil
+	}
 	if _, ok := i.db.store[i.db.sortedKeys[i.pos]]; !ok {
 		return nil
 	}
-	i.db.storeMx.Unlock()
 
 	return []byte(i.db.sortedKeys[i.pos])
 }
@@ -81,6 +84,10 @@ func (i *InMemKVDBIterator) Next() bool {
 	}
 	i.db.storeMx.Lock()
 	found := false
+	if i.pos < 0 || i.pos >= len(i.db.sortedKeys) {
+		i.db.storeMx.Unlock()
+		return false
+	}
 	if _, ok := i.db.store[i.db.sortedKeys[i.pos]]; ok {
 		final := sort.SearchStrings(i.db.sortedKeys, string(i.end))
 		if i.pos < final {
@@ -99,13 +106,10 @@ func (i *InMemKVDBIterator) Last() bool {
 	}
 	i.db.storeMx.Lock()
 	found := false
+	start := sort.SearchStrings(i.db.sortedKeys, string(i.start))
 	final := sort.SearchStrings(i.db.sortedKeys, string(i.end))
-	if len(i.db.sortedKeys) == final ||
-		!bytes.Equal([]byte(i.db.sortedKeys[final]), i.end) {
-		final--
-	}
-	i.pos = final
-	found = true
+	i.pos = final - 1
+	found = i.pos >= start
 	i.db.storeMx.Unlock()
 
 	return found
@@ -117,11 +121,15 @@ func (i *InMemKVDBIterator) Prev() bool {
 	}
 	i.db.storeMx.Lock()
 	found := false
+	if i.pos < 0 || i.pos >= len(i.db.sortedKeys) {
+		i.db.storeMx.Unlock()
+		return false
+	}
 	if _, ok := i.db.store[i.db.sortedKeys[i.pos]]; ok {
 		start := sort.SearchStrings(i.db.sortedKeys, string(i.start))
-		if i.pos-1 > start {
+		if i.pos >= start {
 			i.pos = i.pos - 1
-			found = true
+			found = i.pos >= start
 		}
 	}
 	i.db.storeMx.Unlock()
@@ -88,3 +88,29 @@ func TestIter(t *testing.T) {
 	assert.True(t, iter.Next())
 	assert.False(t, iter.Valid())
 }
+
+func TestIterReverse(t *testing.T) {
+	db := store.NewInMemKVDB()
+	db.Set([]byte{0x01}, []byte{0x01})
+	db.Set([]byte{0x02}, []byte{0x02})
+	db.Set([]byte{0x03}, []byte{0x03})
+	db.Set([]byte{0x04}, []byte{0x04})
+
+	iter, err := db.NewIter([]byte{0x02}, []byte{0x04})
+	assert.NoError(t, err)
+	assert.True(t, iter.Last())
+	assert.True(t, iter.Valid())
+	assert.ElementsMatch(t, iter.Key(), []byte{0x03})
+	assert.True(t, iter.Prev())
+	assert.True(t, iter.Valid())
+	assert.ElementsMatch(t, iter.Key(), []byte{0x02})
+	assert.False(t, iter.Prev())
+	assert.False(t, iter.Valid())
+	assert.NoError(t, iter.Close())
+
+	iter, err = db.NewIter([]byte{0x05}, []byte{0x09})
+	assert.NoError(t, err)
+	assert.False(t, iter.Last())
+	assert.False(t, iter.Valid())
+	assert.NoError(t, iter.Close())
+}
@@ -0,0 +1,104 @@
+package store
+
+import (
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// MempoolStore holds the token requests pending inclusion in a frame, so they
+// survive restarts of the node.
+type MempoolStore interface {
+	PutMempoolEntry(entry *protobufs.MempoolEntry) error
+	DeleteMempoolEntries(requestIds [][]byte) error
+	GetMempoolEntries() ([]*protobufs.MempoolEntry, error)
+}
+
+type PebbleMempoolStore struct {
+	db     KVDB
+	logger *zap.Logger
+}
+
+var _ MempoolStore = (*PebbleMempoolStore)(nil)
+
+func NewPebbleMempoolStore(
+	db KVDB,
+	logger *zap.Logger,
+) *PebbleMempoolStore {
+	return &PebbleMempoolStore{
+		db,
+		logger,
+	}
+}
+
+const (
+	MEMPOOL       = 0x0D
+	MEMPOOL_ENTRY = 0x00
+)
+
+func mempoolEntryKey(requestId []byte) []byte {
+	key := []byte{MEMPOOL, MEMPOOL_ENTRY}
+	key = append(key, requestId...)
+	return key
+}
+
+// PutMempoolEntry stores the entry under its request id, replacing any entry
+// already stored under it.
+func (p *PebbleMempoolStore) PutMempoolEntry(
+	entry *protobufs.MempoolEntry,
+) error {
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "put mempool entry")
+	}
+
+	return errors.Wrap(
+		p.db.Set(mempoolEntryKey(entry.RequestId), data),
+		"put mempool entry",
+	)
+}
+
+func (p *PebbleMempoolStore) DeleteMempoolEntries(requestIds [][]byte) error {
+	if len(requestIds) == 0 {
+		return nil
+	}
+
+	txn := p.db.NewBatch()
+	for _, id := range requestIds {
+		if err := txn.Delete(mempoolEntryKey(id)); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "delete mempool entries")
+		}
+	}
+
+	return errors.Wrap(txn.Commit(), "delete mempool entries")
+}
+
+// GetMempoolEntries returns the stored entries in request id order.
+func (p *PebbleMempoolStore) GetMempoolEntries() (
+	[]*protobufs.MempoolEntry,
+	error,
+) {
+	prefix := []byte{MEMPOOL, MEMPOOL_ENTRY}
+	iter, err := p.db.NewIter(prefix, prefixUpperBound(prefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "get mempool entries")
+	}
+	defer iter.Close()
+
+	entries := []*protobufs.MempoolEntry{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		entry := &protobufs.MempoolEntry{}
+		if err := proto.Unmarshal(iter.Value(), entry); err != nil {
+			return nil, errors.Wrap(
+				errors.Wrap(err, ErrInvalidData.Error()),
+				"get mempool entries",
+			)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,473 @@
+package store
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// MessageStore holds the node's operator messages: the history of each
+// conversation, the inbox of received messages, the outbox of messages not
+// yet delivered and the groups the node is a member of. Everything is
+// encrypted at rest with a key held by the KeyManager.
+type MessageStore interface {
+	PutMessage(message *protobufs.StoredMessage) error
+	GetHistory(
+		conversationId []byte,
+		limit int,
+	) ([]*protobufs.StoredMessage, error)
+	GetInbox(limit int) ([]*protobufs.StoredMessage, error)
+	PutOutbound(message *protobufs.OutboundMessage) error
+	GetOutbound() ([]*protobufs.OutboundMessage, error)
+	DeleteOutbound(message *protobufs.OutboundMessage) error
+	GetGroup(groupId []byte) (*protobufs.MessagingGroup, error)
+	PutGroup(group *protobufs.MessagingGroup) error
+}
+
+type PebbleMessageStore struct {
+	db     KVDB
+	logger *zap.Logger
+	aead   cipher.AEAD
+}
+
+var _ MessageStore = (*PebbleMessageStore)(nil)
+
+const (
+	MESSAGE          = 0x0B
+	MESSAGE_HISTORY  = 0x00
+	MESSAGE_INBOX    = 0x01
+	MESSAGE_OUTBOUND = 0x02
+	MESSAGE_GROUP    = 0x03
+	MESSAGE_COUNT    = 0x04
+)
+
+// The id of the key in the KeyManager encrypting messages at rest.
+const MessageStoreKeyId = "q-message-store-key"
+
+// Received messages are kept up to these limits, per conversation and in
+// total, dropping the oldest first, so peers can't grow the store without
+// bound.
+const (
+	MaxConversationMessages = 1000
+	MaxInboxMessages        = 10000
+)
+
+func NewPebbleMessageStore(
+	db KVDB,
+	keyManager keys.KeyManager,
+	logger *zap.Logger,
+) *PebbleMessageStore {
+	aead, err := encryptionAEAD(keyManager, MessageStoreKeyId)
+	if err != nil {
+		panic(err)
+	}
+
+	return &PebbleMessageStore{
+		db,
+		logger,
+		aead,
+	}
+}
+
+// conversationId returns the group of a group message, and the peer of a
+// direct message.
+func conversationId(message *protobufs.StoredMessage) []byte {
+	if len(message.GroupId) != 0 {
+		return message.GroupId
+	}
+
+	return message.PeerId
+}
+
+func messageHistoryPrefix(conversationId []byte) []byte {
+	key := []byte{MESSAGE, MESSAGE_HISTORY, byte(len(conversationId))}
+	key = append(key, conversationId...)
+	return key
+}
+
+func messageHistoryKey(message *protobufs.StoredMessage) []byte {
+	key := messageHistoryPrefix(conversationId(message))
+	key = binary.BigEndian.AppendUint64(key, uint64(message.Timestamp))
+	key = append(key, message.MessageId...)
+	return key
+}
+
+func messageInboxKey(message *protobufs.StoredMessage) []byte {
+	key := []byte{MESSAGE, MESSAGE_INBOX}
+	key = binary.BigEndian.AppendUint64(key, uint64(message.Timestamp))
+	key = append(key, message.MessageId...)
+	return key
+}
+
+func messageOutboundKey(message *protobufs.OutboundMessage) []byte {
+	key := []byte{MESSAGE, MESSAGE_OUTBOUND}
+	key = binary.BigEndian.AppendUint64(key, uint64(message.Timestamp))
+	key = append(key, message.MessageId...)
+	key = append(key, message.PeerId...)
+	return key
+}
+
+// messageCountKey is the key of the number of messages stored under the
+// history or inbox prefix.
+func messageCountKey(prefix []byte) []byte {
+	key := []byte{MESSAGE, MESSAGE_COUNT}
+	key = append(key, prefix...)
+	return key
+}
+
+func messageGroupKey(groupId []byte) []byte {
+	key := []byte{MESSAGE, MESSAGE_GROUP}
+	key = append(key, groupId...)
+	return key
+}
+
+// PutMessage stores the message in the history of its conversation, and in
+// the inbox if it was received. Storing it again replaces it. Storing a
+// received message drops the oldest messages of its conversation past
+// MaxConversationMessages, and the oldest received messages past
+// MaxInboxMessages.
+func (p *PebbleMessageStore) PutMessage(
+	message *protobufs.StoredMessage,
+) error {
+	if len(conversationId(message)) > 255 {
+		return errors.Wrap(errors.New("conversation id too long"), "put message")
+	}
+
+	historyKey := messageHistoryKey(message)
+	_, closer, err := p.db.Get(historyKey)
+	if err != nil && !errors.Is(err, pebble.ErrNotFound) {
+		return errors.Wrap(err, "put message")
+	}
+	stored := err == nil
+	if stored {
+		closer.Close()
+	}
+
+	historyPrefix := messageHistoryPrefix(conversationId(message))
+	inboxPrefix := []byte{MESSAGE, MESSAGE_INBOX}
+	counts := map[string]int64{}
+	txn := p.db.NewBatch()
+	if err := p.put(txn, historyKey, message); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "put message")
+	}
+	if !stored {
+		counts[string(historyPrefix)]++
+	}
+
+	if !message.Outgoing {
+		if err := p.put(txn, messageInboxKey(message), message); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "put message")
+		}
+		if !stored {
+			counts[string(inboxPrefix)]++
+		}
+
+		pruned := map[string]struct{}{}
+		if err := p.prune(
+			txn,
+			counts,
+			pruned,
+			historyPrefix,
+			MaxConversationMessages,
+		); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "put message")
+		}
+
+		if err := p.prune(
+			txn,
+			counts,
+			pruned,
+			inboxPrefix,
+			MaxInboxMessages,
+		); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "put message")
+		}
+	}
+
+	for prefix, delta := range counts {
+		count, err := p.count([]byte(prefix))
+		if err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "put message")
+		}
+
+		if err := txn.Set(
+			messageCountKey([]byte(prefix)),
+			binary.BigEndian.AppendUint64(nil, uint64(count+delta)),
+		); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "put message")
+		}
+	}
+
+	return errors.Wrap(txn.Commit(), "put message")
+}
+
+// count returns the number of messages stored under the prefix, as of the
+// last commit.
+func (p *PebbleMessageStore) count(prefix []byte) (int64, error) {
+	value, closer, err := p.db.Get(messageCountKey(prefix))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.Wrap(err, "count")
+	}
+	defer closer.Close()
+
+	if len(value) != 8 {
+		return 0, errors.Wrap(ErrInvalidData, "count")
+	}
+
+	return int64(binary.BigEndian.Uint64(value)), nil
+}
+
+// prune deletes the oldest messages under the prefix, from both the history
+// and the inbox, until at most limit remain. The changes to the counts are
+// added to counts, and the deleted keys to pruned, as the batch is not visible
+// to reads.
+func (p *PebbleMessageStore) prune(
+	txn Transaction,
+	counts map[string]int64,
+	pruned map[string]struct{},
+	prefix []byte,
+	limit int64,
+) error {
+	count, err := p.count(prefix)
+	if err != nil {
+		return errors.Wrap(err, "prune")
+	}
+
+	excess := count + counts[string(prefix)] - limit
+	if excess <= 0 {
+		return nil
+	}
+
+	iter, err := p.db.NewIter(prefix, prefixUpperBound(prefix))
+	if err != nil {
+		return errors.Wrap(err, "prune")
+	}
+	defer iter.Close()
+
+	inboxPrefix := []byte{MESSAGE, MESSAGE_INBOX}
+	for iter.First(); iter.Valid() && excess > 0; iter.Next() {
+		if _, ok := pruned[string(iter.Key())]; ok {
+			continue
+		}
+
+		message := &protobufs.StoredMessage{}
+		if err := p.get(iter.Key(), iter.Value(), message); err != nil {
+			return errors.Wrap(err, "prune")
+		}
+
+		historyKey := messageHistoryKey(message)
+		if err := txn.Delete(historyKey); err != nil {
+			return errors.Wrap(err, "prune")
+		}
+		pruned[string(historyKey)] = struct{}{}
+		counts[string(messageHistoryPrefix(conversationId(message)))]--
+
+		if !message.Outgoing {
+			inboxKey := messageInboxKey(message)
+			if err := txn.Delete(inboxKey); err != nil {
+				return errors.Wrap(err, "prune")
+			}
+			pruned[string(inboxKey)] = struct{}{}
+			counts[string(inboxPrefix)]--
+		}
+
+		excess--
+	}
+
+	return nil
+}
+
+// GetHistory returns the latest messages of the conversation with a peer or
+// group, oldest first.
+func (p *PebbleMessageStore) GetHistory(
+	conversationId []byte,
+	limit int,
+) ([]*protobufs.StoredMessage, error) {
+	prefix := messageHistoryPrefix(conversationId)
+	messages, err := p.latest(prefix, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "get history")
+	}
+
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+
+	return messages, nil
+}
+
+// GetInbox returns the latest received messages, newest first.
+func (p *PebbleMessageStore) GetInbox(
+	limit int,
+) ([]*protobufs.StoredMessage, error) {
+	messages, err := p.latest([]byte{MESSAGE, MESSAGE_INBOX}, limit)
+	return messages, errors.Wrap(err, "get inbox")
+}
+
+func (p *PebbleMessageStore) latest(
+	prefix []byte,
+	limit int,
+) ([]*protobufs.StoredMessage, error) {
+	iter, err := p.db.NewIter(prefix, prefixUpperBound(prefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "latest")
+	}
+	defer iter.Close()
+
+	messages := []*protobufs.StoredMessage{}
+	for iter.Last(); iter.Valid() && len(messages) < limit; iter.Prev() {
+		message := &protobufs.StoredMessage{}
+		if err := p.get(iter.Key(), iter.Value(), message); err != nil {
+			return nil, errors.Wrap(err, "latest")
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// PutOutbound queues the message for delivery to its peer.
+func (p *PebbleMessageStore) PutOutbound(
+	message *protobufs.OutboundMessage,
+) error {
+	txn := p.db.NewBatch()
+	if err := p.put(txn, messageOutboundKey(message), message); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "put outbound")
+	}
+
+	return errors.Wrap(txn.Commit(), "put outbound")
+}
+
+// GetOutbound returns the messages queued for delivery, oldest first.
+func (p *PebbleMessageStore) GetOutbound() (
+	[]*protobufs.OutboundMessage,
+	error,
+) {
+	prefix := []byte{MESSAGE, MESSAGE_OUTBOUND}
+	iter, err := p.db.NewIter(prefix, prefixUpperBound(prefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "get outbound")
+	}
+	defer iter.Close()
+
+	messages := []*protobufs.OutboundMessage{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		message := &protobufs.OutboundMessage{}
+		if err := p.get(iter.Key(), iter.Value(), message); err != nil {
+			return nil, errors.Wrap(err, "get outbound")
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+func (p *PebbleMessageStore) DeleteOutbound(
+	message *protobufs.OutboundMessage,
+) error {
+	return errors.Wrap(
+		p.db.Delete(messageOutboundKey(message)),
+		"delete outbound",
+	)
+}
+
+// GetGroup returns the group, or ErrNotFound if the node is not a member.
+func (p *PebbleMessageStore) GetGroup(
+	groupId []byte,
+) (*protobufs.MessagingGroup, error) {
+	key := messageGroupKey(groupId)
+	value, closer, err := p.db.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, errors.Wrap(err, "get group")
+	}
+
+	defer closer.Close()
+
+	group := &protobufs.MessagingGroup{}
+	if err := p.get(key, value, group); err != nil {
+		return nil, errors.Wrap(err, "get group")
+	}
+
+	return group, nil
+}
+
+func (p *PebbleMessageStore) PutGroup(group *protobufs.MessagingGroup) error {
+	txn := p.db.NewBatch()
+	if err := p.put(txn, messageGroupKey(group.GroupId), group); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "put group")
+	}
+
+	return errors.Wrap(txn.Commit(), "put group")
+}
+
+func (p *PebbleMessageStore) put(
+	txn Transaction,
+	key []byte,
+	message proto.Message,
+) error {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return errors.Wrap(err, "put")
+	}
+
+	value, err := seal(p.aead, key, data)
+	if err != nil {
+		return errors.Wrap(err, "put")
+	}
+
+	return errors.Wrap(txn.Set(key, value), "put")
+}
+
+func (p *PebbleMessageStore) get(
+	key []byte,
+	value []byte,
+	message proto.Message,
+) error {
+	data, err := open(p.aead, key, value)
+	if err != nil {
+		return errors.Wrap(err, "get")
+	}
+
+	if err := proto.Unmarshal(data, message); err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidData.Error()), "get")
+	}
+
+	return nil
+}
+
+// prefixUpperBound returns the first key after all keys with the prefix.
+func prefixUpperBound(prefix []byte) []byte {
+	upper := append([]byte{}, prefix...)
+	for i := len(upper) - 1; i >= 0; i-- {
+		if upper[i] != 0xff {
+			upper[i]++
+			return upper[:i+1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package store_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func receivedMessage(peerId string, i int) *protobufs.StoredMessage {
+	return &protobufs.StoredMessage{
+		MessageId: binary.BigEndian.AppendUint32(nil, uint32(i)),
+		PeerId:    []byte(peerId),
+		Text:      "hello",
+		Timestamp: int64(i),
+	}
+}
+
+func TestPebbleMessageStoreLimitsConversation(t *testing.T) {
+	messageStore := store.NewPebbleMessageStore(
+		store.NewInMemKVDB(),
+		keys.NewInMemoryKeyManager(),
+		zap.NewNop(),
+	)
+
+	require.NoError(t, messageStore.PutMessage(&protobufs.StoredMessage{
+		MessageId: []byte("sent"),
+		PeerId:    []byte("a"),
+		Text:      "hi",
+		Timestamp: 0,
+		Outgoing:  true,
+	}))
+	for i := 1; i <= store.MaxConversationMessages+10; i++ {
+		require.NoError(t, messageStore.PutMessage(receivedMessage("a", i)))
+	}
+	require.NoError(t, messageStore.PutMessage(receivedMessage("b", 1)))
+
+	// The oldest messages of the conversation are dropped, from the inbox as
+	// well, other conversations are left alone.
+	history, err := messageStore.GetHistory([]byte("a"), 2*store.MaxConversationMessages)
+	require.NoError(t, err)
+	require.Len(t, history, store.MaxConversationMessages)
+	require.Equal(t, int64(11), history[0].Timestamp)
+	require.Equal(
+		t,
+		int64(store.MaxConversationMessages+10),
+		history[len(history)-1].Timestamp,
+	)
+
+	inbox, err := messageStore.GetInbox(2 * store.MaxConversationMessages)
+	require.NoError(t, err)
+	require.Len(t, inbox, store.MaxConversationMessages+1)
+
+	history, err = messageStore.GetHistory([]byte("b"), 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+}
+
+func TestPebbleMessageStoreLimitsInbox(t *testing.T) {
+	messageStore := store.NewPebbleMessageStore(
+		store.NewInMemKVDB(),
+		keys.NewInMemoryKeyManager(),
+		zap.NewNop(),
+	)
+
+	// Spread over many senders, so only the total limit applies.
+	senders := store.MaxInboxMessages/store.MaxConversationMessages + 1
+	perSender := store.MaxConversationMessages - 1
+	i := 0
+	for s := 0; s < senders; s++ {
+		for j := 0; j < perSender; j++ {
+			i++
+			require.NoError(t, messageStore.PutMessage(
+				receivedMessage(string(rune('a'+s)), i),
+			))
+		}
+	}
+	require.Greater(t, i, store.MaxInboxMessages)
+
+	inbox, err := messageStore.GetInbox(2 * store.MaxInboxMessages)
+	require.NoError(t, err)
+	require.Len(t, inbox, store.MaxInboxMessages)
+	require.Equal(t, int64(i), inbox[0].Timestamp)
+	require.Equal(
+		t,
+		int64(i-store.MaxInboxMessages+1),
+		inbox[len(inbox)-1].Timestamp,
+	)
+
+	// The messages dropped from the inbox are gone from the history too.
+	dropped := i - store.MaxInboxMessages
+	history, err := messageStore.GetHistory([]byte("a"), perSender)
+	require.NoError(t, err)
+	require.Len(t, history, perSender-dropped)
+	require.Equal(t, int64(dropped+1), history[0].Timestamp)
+}
@@ -0,0 +1,96 @@
+package store
+
+import (
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// PeerReputationStore holds the record of how peers have served frames during
+// sync, so peer choice survives restarts of the node.
+type PeerReputationStore interface {
+	PutPeerReputation(reputation *protobufs.PeerReputation) error
+	GetPeerReputations() ([]*protobufs.PeerReputation, error)
+	DeletePeerReputation(peerId []byte) error
+}
+
+type PebblePeerReputationStore struct {
+	db     KVDB
+	logger *zap.Logger
+}
+
+var _ PeerReputationStore = (*PebblePeerReputationStore)(nil)
+
+func NewPebblePeerReputationStore(
+	db KVDB,
+	logger *zap.Logger,
+) *PebblePeerReputationStore {
+	return &PebblePeerReputationStore{
+		db,
+		logger,
+	}
+}
+
+const (
+	PEER_REPUTATION       = 0x0E
+	PEER_REPUTATION_ENTRY = 0x00
+)
+
+func peerReputationKey(peerId []byte) []byte {
+	key := []byte{PEER_REPUTATION, PEER_REPUTATION_ENTRY}
+	key = append(key, peerId...)
+	return key
+}
+
+// PutPeerReputation stores the reputation under its peer id, replacing any
+// reputation already stored for the peer.
+func (p *PebblePeerReputationStore) PutPeerReputation(
+	reputation *protobufs.PeerReputation,
+) error {
+	data, err := proto.Marshal(reputation)
+	if err != nil {
+		return errors.Wrap(err, "put peer reputation")
+	}
+
+	return errors.Wrap(
+		p.db.Set(peerReputationKey(reputation.PeerId), data),
+		"put peer reputation",
+	)
+}
+
+// DeletePeerReputation removes the reputation stored for the peer, if any.
+func (p *PebblePeerReputationStore) DeletePeerReputation(peerId []byte) error {
+	return errors.Wrap(
+		p.db.Delete(peerReputationKey(peerId)),
+		"delete peer reputation",
+	)
+}
+
+// GetPeerReputations returns the stored reputations in peer id order.
+func (p *PebblePeerReputationStore) GetPeerReputations() (
+	[]*protobufs.PeerReputation,
+	error,
+) {
+	prefix := []byte{PEER_REPUTATION, PEER_REPUTATION_ENTRY}
+	iter, err := p.db.NewIter(prefix, prefixUpperBound(prefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "get peer reputations")
+	}
+	defer iter.Close()
+
+	reputations := []*protobufs.PeerReputation{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		reputation := &protobufs.PeerReputation{}
+		if err := proto.Unmarshal(iter.Value(), reputation); err != nil {
+			return nil, errors.Wrap(
+				errors.Wrap(err, ErrInvalidData.Error()),
+				"get peer reputations",
+			)
+		}
+
+		reputations = append(reputations, reputation)
+	}
+
+	return reputations, nil
+}
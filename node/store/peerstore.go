@@ -123,28 +123,38 @@ func (d *PeerstoreDatastore) Query(ctx context.Context, q dsq.Query) (
 		qNaive.Prefix = ""
 	}
 
-	i, err := d.db.NewIter(rnge, nil)
+	// The upper bound is the range with its last byte incremented, so the query
+	// does not run past the peerstore keys into the rest of the database.
+	upper := append([]byte{}, rnge...)
+	upper[len(upper)-1]++
+
+	i, err := d.db.NewIter(rnge, upper)
 	if err != nil {
 		return nil, errors.Wrap(err, "query")
 	}
 
-	next := i.Next
+	seek := i.First
+	advance := i.Next
 	if len(q.Orders) > 0 {
 		switch q.Orders[0].(type) {
 		case dsq.OrderByKey, *dsq.OrderByKey:
 			qNaive.Orders = nil
-			i.First()
 		case dsq.OrderByKeyDescending, *dsq.OrderByKeyDescending:
-			next = func() bool {
-				next = i.Prev
-				return i.Last()
-			}
+			seek = i.Last
+			advance = i.Prev
 			qNaive.Orders = nil
-		default:
-			i.First()
 		}
-	} else {
-		i.First()
+	}
+
+	started := false
+	next := func() bool {
+		if !started {
+			started = true
+			seek()
+		} else {
+			advance()
+		}
+		return i.Valid()
 	}
 	r := dsq.ResultsFromIterator(q, dsq.Iterator{
 		Next: func() (dsq.Result, bool) {
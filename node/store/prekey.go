@@ -0,0 +1,245 @@
+package store
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// PreKeyStore holds the node's own prekey bookkeeping and one-time prekeys,
+// and the directory of prekey bundles published by other peers. One-time
+// prekeys are created and consumed in bulk, so unlike the long lived keys they
+// are kept here rather than in the KeyManager, encrypted at rest.
+type PreKeyStore interface {
+	GetPreKeyState() (*protobufs.PreKeyState, error)
+	PutPreKeyState(state *protobufs.PreKeyState) error
+	GetPreKeyBundle(peerId []byte) (*protobufs.PreKeyBundle, error)
+	PutPreKeyBundle(peerId []byte, bundle *protobufs.PreKeyBundle) error
+	DeletePreKeyBundle(peerId []byte) error
+	GetPreKeyBundlePeers() ([][]byte, error)
+	GetOneTimePreKey(id uint32) ([]byte, error)
+	PutOneTimePreKeys(privateKeys map[uint32][]byte) error
+	DeleteOneTimePreKeys(ids []uint32) error
+}
+
+type PebblePreKeyStore struct {
+	db     KVDB
+	logger *zap.Logger
+	aead   cipher.AEAD
+}
+
+var _ PreKeyStore = (*PebblePreKeyStore)(nil)
+
+// The id of the key in the KeyManager encrypting one-time prekeys at rest.
+const OneTimePreKeyKeyId = "q-prekey-opk-key"
+
+func NewPebblePreKeyStore(
+	db KVDB,
+	keyManager keys.KeyManager,
+	logger *zap.Logger,
+) *PebblePreKeyStore {
+	aead, err := encryptionAEAD(keyManager, OneTimePreKeyKeyId)
+	if err != nil {
+		panic(err)
+	}
+
+	return &PebblePreKeyStore{
+		db,
+		logger,
+		aead,
+	}
+}
+
+const (
+	PREKEY          = 0x0A
+	PREKEY_STATE    = 0x00
+	PREKEY_BUNDLE   = 0x01
+	PREKEY_ONE_TIME = 0x02
+)
+
+func preKeyStateKey() []byte {
+	return []byte{PREKEY, PREKEY_STATE}
+}
+
+func preKeyBundleKey(peerId []byte) []byte {
+	key := []byte{PREKEY, PREKEY_BUNDLE}
+	key = append(key, peerId...)
+	return key
+}
+
+func oneTimePreKeyKey(id uint32) []byte {
+	key := []byte{PREKEY, PREKEY_ONE_TIME}
+	key = binary.BigEndian.AppendUint32(key, id)
+	return key
+}
+
+// GetPreKeyState returns the node's prekey bookkeeping, or ErrNotFound if no
+// prekeys were generated yet.
+func (p *PebblePreKeyStore) GetPreKeyState() (*protobufs.PreKeyState, error) {
+	value, closer, err := p.db.Get(preKeyStateKey())
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, errors.Wrap(err, "get prekey state")
+	}
+
+	defer closer.Close()
+
+	state := &protobufs.PreKeyState{}
+	if err := proto.Unmarshal(value, state); err != nil {
+		return nil, errors.Wrap(
+			errors.Wrap(err, ErrInvalidData.Error()),
+			"get prekey state",
+		)
+	}
+
+	return state, nil
+}
+
+func (p *PebblePreKeyStore) PutPreKeyState(
+	state *protobufs.PreKeyState,
+) error {
+	data, err := proto.Marshal(state)
+	if err != nil {
+		return errors.Wrap(err, "put prekey state")
+	}
+
+	return errors.Wrap(p.db.Set(preKeyStateKey(), data), "put prekey state")
+}
+
+// GetPreKeyBundle returns the latest bundle published by the peer, or
+// ErrNotFound if none was received.
+func (p *PebblePreKeyStore) GetPreKeyBundle(
+	peerId []byte,
+) (*protobufs.PreKeyBundle, error) {
+	value, closer, err := p.db.Get(preKeyBundleKey(peerId))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, errors.Wrap(err, "get prekey bundle")
+	}
+
+	defer closer.Close()
+
+	bundle := &protobufs.PreKeyBundle{}
+	if err := proto.Unmarshal(value, bundle); err != nil {
+		return nil, errors.Wrap(
+			errors.Wrap(err, ErrInvalidData.Error()),
+			"get prekey bundle",
+		)
+	}
+
+	return bundle, nil
+}
+
+func (p *PebblePreKeyStore) PutPreKeyBundle(
+	peerId []byte,
+	bundle *protobufs.PreKeyBundle,
+) error {
+	data, err := proto.Marshal(bundle)
+	if err != nil {
+		return errors.Wrap(err, "put prekey bundle")
+	}
+
+	return errors.Wrap(
+		p.db.Set(preKeyBundleKey(peerId), data),
+		"put prekey bundle",
+	)
+}
+
+func (p *PebblePreKeyStore) DeletePreKeyBundle(peerId []byte) error {
+	return errors.Wrap(
+		p.db.Delete(preKeyBundleKey(peerId)),
+		"delete prekey bundle",
+	)
+}
+
+// GetPreKeyBundlePeers returns the peer IDs of all bundles held.
+func (p *PebblePreKeyStore) GetPreKeyBundlePeers() ([][]byte, error) {
+	prefix := []byte{PREKEY, PREKEY_BUNDLE}
+	iter, err := p.db.NewIter(prefix, prefixUpperBound(prefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "get prekey bundle peers")
+	}
+
+	peerIds := [][]byte{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		peerIds = append(peerIds, append([]byte{}, iter.Key()[len(prefix):]...))
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, errors.Wrap(err, "get prekey bundle peers")
+	}
+
+	return peerIds, nil
+}
+
+// GetOneTimePreKey returns the decrypted private key of the one-time prekey,
+// or ErrNotFound if it was consumed or discarded.
+func (p *PebblePreKeyStore) GetOneTimePreKey(id uint32) ([]byte, error) {
+	key := oneTimePreKeyKey(id)
+	value, closer, err := p.db.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, errors.Wrap(err, "get one time prekey")
+	}
+
+	defer closer.Close()
+
+	privateKey, err := open(p.aead, key, value)
+	if err != nil {
+		return nil, errors.Wrap(err, "get one time prekey")
+	}
+
+	return privateKey, nil
+}
+
+// PutOneTimePreKeys encrypts and stores the private keys of one-time prekeys
+// by id, in a single batch.
+func (p *PebblePreKeyStore) PutOneTimePreKeys(
+	privateKeys map[uint32][]byte,
+) error {
+	txn := p.db.NewBatch()
+	for id, privateKey := range privateKeys {
+		key := oneTimePreKeyKey(id)
+		value, err := seal(p.aead, key, privateKey)
+		if err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "put one time prekeys")
+		}
+
+		if err := txn.Set(key, value); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "put one time prekeys")
+		}
+	}
+
+	return errors.Wrap(txn.Commit(), "put one time prekeys")
+}
+
+// DeleteOneTimePreKeys removes the private keys of one-time prekeys, in a
+// single batch.
+func (p *PebblePreKeyStore) DeleteOneTimePreKeys(ids []uint32) error {
+	txn := p.db.NewBatch()
+	for _, id := range ids {
+		if err := txn.Delete(oneTimePreKeyKey(id)); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "delete one time prekeys")
+		}
+	}
+
+	return errors.Wrap(txn.Commit(), "delete one time prekeys")
+}
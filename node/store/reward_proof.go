@@ -0,0 +1,118 @@
+package store
+
+import (
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// RewardProofStore holds the reward proofs computed by the data workers until
+// they are minted or expire, so they survive restarts of the node.
+type RewardProofStore interface {
+	PutRewardProofs(proofs []*protobufs.RewardProof) error
+	DeleteRewardProofs(proofs []*protobufs.RewardProof) error
+	GetRewardProofs() ([]*protobufs.RewardProof, error)
+}
+
+type PebbleRewardProofStore struct {
+	db     KVDB
+	logger *zap.Logger
+}
+
+var _ RewardProofStore = (*PebbleRewardProofStore)(nil)
+
+func NewPebbleRewardProofStore(
+	db KVDB,
+	logger *zap.Logger,
+) *PebbleRewardProofStore {
+	return &PebbleRewardProofStore{
+		db,
+		logger,
+	}
+}
+
+const (
+	REWARD_PROOF       = 0x0F
+	REWARD_PROOF_ENTRY = 0x00
+)
+
+func rewardProofKey(proof []byte) []byte {
+	id := sha3.Sum256(proof)
+	key := []byte{REWARD_PROOF, REWARD_PROOF_ENTRY}
+	key = append(key, id[:]...)
+	return key
+}
+
+// PutRewardProofs stores the reward proofs, keyed by the hash of the proof
+// bytes, replacing those already stored under the same key.
+func (p *PebbleRewardProofStore) PutRewardProofs(
+	proofs []*protobufs.RewardProof,
+) error {
+	if len(proofs) == 0 {
+		return nil
+	}
+
+	txn := p.db.NewBatch()
+	for _, proof := range proofs {
+		data, err := proto.Marshal(proof)
+		if err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "put reward proofs")
+		}
+
+		if err := txn.Set(rewardProofKey(proof.Proof), data); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "put reward proofs")
+		}
+	}
+
+	return errors.Wrap(txn.Commit(), "put reward proofs")
+}
+
+func (p *PebbleRewardProofStore) DeleteRewardProofs(
+	proofs []*protobufs.RewardProof,
+) error {
+	if len(proofs) == 0 {
+		return nil
+	}
+
+	txn := p.db.NewBatch()
+	for _, proof := range proofs {
+		if err := txn.Delete(rewardProofKey(proof.Proof)); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "delete reward proofs")
+		}
+	}
+
+	return errors.Wrap(txn.Commit(), "delete reward proofs")
+}
+
+// GetRewardProofs returns the stored reward proofs in key order.
+func (p *PebbleRewardProofStore) GetRewardProofs() (
+	[]*protobufs.RewardProof,
+	error,
+) {
+	prefix := []byte{REWARD_PROOF, REWARD_PROOF_ENTRY}
+	iter, err := p.db.NewIter(prefix, prefixUpperBound(prefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "get reward proofs")
+	}
+	defer iter.Close()
+
+	proofs := []*protobufs.RewardProof{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		proof := &protobufs.RewardProof{}
+		if err := proto.Unmarshal(iter.Value(), proof); err != nil {
+			return nil, errors.Wrap(
+				errors.Wrap(err, ErrInvalidData.Error()),
+				"get reward proofs",
+			)
+		}
+
+		proofs = append(proofs, proof)
+	}
+
+	return proofs, nil
+}